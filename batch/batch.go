@@ -0,0 +1,247 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+// Package batch converts large lists of domain names, one per line, in
+// parallel while keeping their order.
+//
+// A Processor is built from a conversion function, usually the ToASCII or
+// ToUnicode method of an idna2003.Converter:
+//
+//	p := batch.New(idna2003.Registration.ToASCII)
+//	err := p.Convert(os.Stdout, os.Stdin, batch.TSV)
+//
+// This package is in beta and has not been extensively tested.
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+)
+
+// MaxLineLength is the length in bytes of the longest line that is
+// converted, not counting its line ending.
+const MaxLineLength = 64 * 1024
+
+// ErrLineLength is the error in the Result of a line longer than
+// MaxLineLength. The Input of the Result is empty.
+var ErrLineLength = errors.New("batch: Line too long")
+
+// A Func converts a domain name, such as idna2003.ToASCII.
+type Func func(string) (string, error)
+
+// A Result is the conversion of a line of the input.
+type Result struct {
+	Line   int    // the line number, starting at 1
+	Input  string // the line, without its line ending
+	Output string // the converted name, if Err is nil
+	Err    error  // the error returned by the conversion
+}
+
+// A Processor converts the lines of its input with a Func, using a pool of
+// goroutines. A Processor is safe for concurrent use.
+type Processor struct {
+	convert Func
+	workers int
+}
+
+// An Option configures a Processor.
+type Option func(*Processor)
+
+// Workers sets the number of goroutines converting names. It defaults to
+// runtime.GOMAXPROCS(0).
+func Workers(n int) Option {
+	return func(p *Processor) { p.workers = n }
+}
+
+// New returns a Processor converting names with convert, which must be safe
+// for concurrent use.
+func New(convert Func, opts ...Option) *Processor {
+	p := &Processor{convert: convert}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if p.workers < 1 {
+		p.workers = runtime.GOMAXPROCS(0)
+	}
+	return p
+}
+
+// job is a line waiting to be converted by a worker.
+type job struct {
+	result Result
+	done   chan Result
+}
+
+// Process converts each line of r and calls f with the results in the order
+// of the lines. Lines end with "\n" or "\r\n". Process stops when f returns
+// an error, and returns it; otherwise it returns the error reading r, if
+// any. Errors of the conversions are given to f in the results, as are lines
+// longer than MaxLineLength, which are not converted.
+func (p *Processor) Process(r io.Reader, f func(Result) error) error {
+	jobs := make(chan job)
+	// queue holds the jobs in the order of the lines; its capacity limits
+	// how far the workers may get ahead of f.
+	queue := make(chan chan Result, 4*p.workers)
+	stop := make(chan struct{})
+	var readErr error
+
+	go func() {
+		defer close(queue)
+		defer close(jobs)
+		br := bufio.NewReader(r)
+		for n := 1; ; n++ {
+			line, err := readLine(br)
+			if err == io.EOF {
+				return
+			}
+			j := job{Result{Line: n, Input: string(line)}, make(chan Result, 1)}
+			if err == ErrLineLength {
+				j.result.Err = err
+			} else if err != nil {
+				readErr = err
+				return
+			}
+			select {
+			case queue <- j.done:
+			case <-stop:
+				return
+			}
+			select {
+			case jobs <- j:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < p.workers; i++ {
+		go func() {
+			for j := range jobs {
+				if j.result.Err == nil {
+					j.result.Output, j.result.Err = p.convert(j.result.Input)
+				}
+				if j.result.Err != nil {
+					j.result.Output = ""
+				}
+				j.done <- j.result
+			}
+		}()
+	}
+
+	for done := range queue {
+		if err := f(<-done); err != nil {
+			close(stop)
+			return err
+		}
+	}
+	return readErr
+}
+
+// readLine reads a line from br and returns it without its line ending. A
+// line longer than MaxLineLength is read to its end and discarded, and
+// ErrLineLength is returned. At the end of the input readLine returns io.EOF.
+func readLine(br *bufio.Reader) ([]byte, error) {
+	var line []byte
+	n := 0
+	for {
+		chunk, err := br.ReadSlice('\n')
+		n += len(chunk)
+		if n <= MaxLineLength+len("\r\n") {
+			line = append(line, chunk...)
+		}
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		if err == io.EOF && n > 0 {
+			err = nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	line = bytes.TrimSuffix(line, []byte("\r"))
+	if n > MaxLineLength+len("\r\n") || len(line) > MaxLineLength {
+		return nil, ErrLineLength
+	}
+	return line, nil
+}
+
+// A Format is a format in which Convert writes results.
+type Format int
+
+// Formats of Convert.
+const (
+	// TSV writes a line for each result with the input, the output and the
+	// error separated by tabs. The output is empty if there is an error, and
+	// the error is empty otherwise. Backslashes, tabs and line breaks in the
+	// fields are written as \\, \t, \r and \n.
+	TSV Format = iota
+
+	// JSON writes a JSON object for each result on a line of its own, with
+	// the fields "line", "input", and either "output" or "error".
+	JSON
+)
+
+// jsonResult is the JSON form of a Result.
+type jsonResult struct {
+	Line   int    `json:"line"`
+	Input  string `json:"input"`
+	Output string `json:"output,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Convert converts each line of r and writes the results to w in the given
+// format, in the order of the lines. It returns the first error reading r
+// or writing w; the errors of the conversions are written to w. The results
+// written before an error are always flushed to w.
+func (p *Processor) Convert(w io.Writer, r io.Reader, format Format) error {
+	bw := bufio.NewWriter(w)
+	var write func(Result) error
+	switch format {
+	case TSV:
+		write = func(res Result) error {
+			var msg string
+			if res.Err != nil {
+				msg = res.Err.Error()
+			}
+			_, err := fmt.Fprintf(bw, "%s\t%s\t%s\n", escapeTSV(res.Input), escapeTSV(res.Output), escapeTSV(msg))
+			return err
+		}
+	case JSON:
+		enc := json.NewEncoder(bw)
+		enc.SetEscapeHTML(false)
+		write = func(res Result) error {
+			j := jsonResult{Line: res.Line, Input: res.Input, Output: res.Output}
+			if res.Err != nil {
+				j.Error = res.Err.Error()
+			}
+			return enc.Encode(j)
+		}
+	default:
+		return fmt.Errorf("batch: unknown format %d", format)
+	}
+
+	err := p.Process(r, write)
+	if ferr := bw.Flush(); err == nil {
+		err = ferr
+	}
+	return err
+}
+
+var tsvEscaper = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\r", `\r`, "\n", `\n`)
+
+// escapeTSV escapes the characters of s that cannot appear in a TSV field.
+func escapeTSV(s string) string {
+	return tsvEscaper.Replace(s)
+}
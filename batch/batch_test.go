@@ -0,0 +1,180 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package batch
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/DanielOaks/go-idn/idna2003"
+)
+
+const input = "bücher.example\r\nunder_score.example\n\nstraße.de\n"
+
+func TestProcess(t *testing.T) {
+	var results []Result
+	err := New(idna2003.ToASCII).Process(strings.NewReader(input), func(r Result) error {
+		results = append(results, r)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Process error = %v", err)
+	}
+	want := []struct {
+		input, output string
+		err           error
+	}{
+		{"bücher.example", "xn--bcher-kva.example", nil},
+		{"under_score.example", "", idna2003.ErrNonLDH},
+		{"", "", idna2003.ErrLabelLength},
+		{"straße.de", "strasse.de", nil},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("Process gave %d results; want %d", len(results), len(want))
+	}
+	for i, r := range results {
+		w := want[i]
+		if r.Line != i+1 || r.Input != w.input || r.Output != w.output || !errors.Is(r.Err, w.err) {
+			t.Errorf("result %d = %+v; want %+v", i, r, w)
+		}
+	}
+}
+
+func TestOrder(t *testing.T) {
+	// Earlier lines take longer, so that the workers finish out of order.
+	var in strings.Builder
+	for i := 0; i < 200; i++ {
+		fmt.Fprintf(&in, "%d\n", i)
+	}
+	convert := func(s string) (string, error) {
+		var n int
+		fmt.Sscan(s, &n)
+		time.Sleep(time.Duration(200-n) * time.Microsecond)
+		return s, nil
+	}
+	next := 0
+	err := New(convert, Workers(8)).Process(strings.NewReader(in.String()), func(r Result) error {
+		if r.Output != fmt.Sprint(next) {
+			return fmt.Errorf("got %q; want %d", r.Output, next)
+		}
+		next++
+		return nil
+	})
+	if err != nil || next != 200 {
+		t.Errorf("Process error = %v after %d lines", err, next)
+	}
+}
+
+func TestProcessStop(t *testing.T) {
+	stop := errors.New("stop")
+	var in strings.Builder
+	for i := 0; i < 1000; i++ {
+		in.WriteString("example\n")
+	}
+	n := 0
+	err := New(idna2003.ToASCII, Workers(4)).Process(strings.NewReader(in.String()), func(r Result) error {
+		n++
+		if n == 10 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || n != 10 {
+		t.Errorf("Process error = %v after %d results; want %v after 10", err, n, stop)
+	}
+}
+
+var convertTests = []struct {
+	format Format
+	output string
+}{
+	{TSV, "bücher.example\txn--bcher-kva.example\t\n" +
+		"under_score.example\t\tidna: label 0: Contains non-LDH ASCII codepoints: U+005F at offset 5\n" +
+		"\t\tidna: label 0: Label empty or too long\n" +
+		"straße.de\tstrasse.de\t\n"},
+	{JSON, `{"line":1,"input":"bücher.example","output":"xn--bcher-kva.example"}` + "\n" +
+		`{"line":2,"input":"under_score.example","error":"idna: label 0: Contains non-LDH ASCII codepoints: U+005F at offset 5"}` + "\n" +
+		`{"line":3,"input":"","error":"idna: label 0: Label empty or too long"}` + "\n" +
+		`{"line":4,"input":"straße.de","output":"strasse.de"}` + "\n"},
+}
+
+func TestConvert(t *testing.T) {
+	p := New(idna2003.ToASCII)
+	for _, test := range convertTests {
+		var out bytes.Buffer
+		if err := p.Convert(&out, strings.NewReader(input), test.format); err != nil {
+			t.Errorf("Convert(%d) error = %v", test.format, err)
+		}
+		if out.String() != test.output {
+			t.Errorf("Convert(%d) = %q; want %q", test.format, out.String(), test.output)
+		}
+	}
+
+	if err := p.Convert(&bytes.Buffer{}, strings.NewReader(input), Format(-1)); err == nil {
+		t.Errorf("Convert(-1) error = nil; want error")
+	}
+}
+
+func TestLongLine(t *testing.T) {
+	in := "a.example\n" + strings.Repeat("x", 70000) + "\nb.example\n"
+	var results []Result
+	err := New(idna2003.ToASCII).Process(strings.NewReader(in), func(r Result) error {
+		results = append(results, r)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Process error = %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("Process gave %d results; want 3", len(results))
+	}
+	if r := results[0]; r.Output != "a.example" || r.Err != nil {
+		t.Errorf("result 0 = %+v; want a.example", r)
+	}
+	if r := results[1]; r.Line != 2 || r.Input != "" || r.Err != ErrLineLength {
+		t.Errorf("result 1 = %+v; want %v", r, ErrLineLength)
+	}
+	if r := results[2]; r.Line != 3 || r.Output != "b.example" || r.Err != nil {
+		t.Errorf("result 2 = %+v; want b.example", r)
+	}
+
+	// A line of MaxLineLength bytes is converted.
+	in = strings.Repeat("x", MaxLineLength) + "\r\n"
+	err = New(func(s string) (string, error) { return s, nil }).Process(strings.NewReader(in), func(r Result) error {
+		if len(r.Output) != MaxLineLength || r.Err != nil {
+			t.Errorf("line of MaxLineLength bytes gave %d bytes, %v", len(r.Output), r.Err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Process error = %v", err)
+	}
+}
+
+func TestConvertFlush(t *testing.T) {
+	readErr := errors.New("read error")
+	r := io.MultiReader(strings.NewReader("a.example\n"), iotest.ErrReader(readErr))
+	var out bytes.Buffer
+	if err := New(idna2003.ToASCII).Convert(&out, r, TSV); err != readErr {
+		t.Errorf("Convert error = %v; want %v", err, readErr)
+	}
+	if want := "a.example\ta.example\t\n"; out.String() != want {
+		t.Errorf("Convert wrote %q before the error; want %q", out.String(), want)
+	}
+}
+
+func TestEscapeTSV(t *testing.T) {
+	if s := escapeTSV("a\tb\\c\r\n"); s != `a\tb\\c\r\n` {
+		t.Errorf("escapeTSV = %q", s)
+	}
+}
@@ -0,0 +1,361 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+// Idn converts internationalized domain names and prepares strings, like
+// the idn tool of GNU libidn.
+//
+// Usage:
+//
+//	idn [flags] [string ...]
+//
+// Each string given as an argument is converted and printed on a line of its
+// own. Without arguments, the lines of the standard input are converted. The
+// flags are:
+//
+//	-a, --idna-to-ascii      convert with IDNA2003 ToASCII (the default)
+//	-u, --idna-to-unicode    convert with IDNA2003 ToUnicode
+//	-e, --punycode-encode    encode with Punycode
+//	-d, --punycode-decode    decode Punycode
+//	-s, --stringprep         prepare with the stringprep profile given by --profile
+//	-p, --profile=NAME       stringprep profile, such as nameprep or saslprep
+//	-t, --tld                also check the labels against the table of their TLD
+//	--allow-unassigned       allow unassigned code points
+//	--usestd3asciirules      enforce the STD3 ASCII rules
+//	--debug                  print the code points of the input and output, and
+//	                         after each stringprep step, to the standard error
+//	--batch                  convert the lines of the standard input in parallel
+//	--format=FORMAT          batch output format, tsv or json
+//	--workers=N              number of batch workers, by default the number of CPUs
+//
+// In batch mode each line of the standard input gives a line of output, in
+// the same order, with the input, the result and any error; see the batch
+// package for the formats.
+//
+// With --debug in the IDNA modes, the steps of Nameprep are printed for each
+// label that is prepared: the labels that are not ASCII and, with
+// --idna-to-unicode, the ACE labels after decoding.
+//
+// As with libidn, the STD3 ASCII rules are not enforced unless
+// --usestd3asciirules is given. Idn exits with status 1 if a string could not
+// be converted, and 2 if the flags are wrong.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/DanielOaks/go-idn/batch"
+	"github.com/DanielOaks/go-idn/idna2003"
+	"github.com/DanielOaks/go-idn/idna2003/punycode"
+	"github.com/DanielOaks/go-idn/idna2003/stringprep"
+	"github.com/DanielOaks/go-idn/tld"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// options holds the flags of a run.
+type options struct {
+	toASCII, toUnicode       bool
+	encode, decode           bool
+	stringprep               bool
+	profile                  string
+	tld                      bool
+	allowUnassigned, useSTD3 bool
+	debug                    bool
+	batch                    bool
+	format                   string
+	workers                  int
+}
+
+// run runs idn with the given arguments and files, and returns its exit
+// status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	var o options
+	fs := flag.NewFlagSet("idn", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: idn [flags] [string ...]")
+		fs.PrintDefaults()
+	}
+	boolFlag(fs, &o.toASCII, "idna-to-ascii", "a", "convert with IDNA2003 ToASCII (the default)")
+	boolFlag(fs, &o.toUnicode, "idna-to-unicode", "u", "convert with IDNA2003 ToUnicode")
+	boolFlag(fs, &o.encode, "punycode-encode", "e", "encode with Punycode")
+	boolFlag(fs, &o.decode, "punycode-decode", "d", "decode Punycode")
+	boolFlag(fs, &o.stringprep, "stringprep", "s", "prepare with the stringprep profile given by --profile")
+	fs.StringVar(&o.profile, "profile", "nameprep", "stringprep `profile`, such as nameprep or saslprep")
+	fs.StringVar(&o.profile, "p", "nameprep", "short for --profile")
+	boolFlag(fs, &o.tld, "tld", "t", "also check the labels against the table of their TLD")
+	fs.BoolVar(&o.allowUnassigned, "allow-unassigned", false, "allow unassigned code points")
+	fs.BoolVar(&o.useSTD3, "usestd3asciirules", false, "enforce the STD3 ASCII rules")
+	fs.BoolVar(&o.debug, "debug", false, "print the code points of the input and output, and after each stringprep step")
+	fs.BoolVar(&o.batch, "batch", false, "convert the lines of the standard input in parallel")
+	fs.StringVar(&o.format, "format", "tsv", "batch output `format`, tsv or json")
+	fs.IntVar(&o.workers, "workers", 0, "number of batch workers, by default the number of CPUs")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	modes := 0
+	for _, set := range []bool{o.toASCII, o.toUnicode, o.encode, o.decode, o.stringprep} {
+		if set {
+			modes++
+		}
+	}
+	switch {
+	case modes > 1:
+		fmt.Fprintln(stderr, "idn: only one of --idna-to-ascii, --idna-to-unicode, --punycode-encode, --punycode-decode and --stringprep may be given")
+		return 2
+	case modes == 0:
+		o.toASCII = true
+	}
+	if o.tld && !o.toASCII && !o.toUnicode {
+		fmt.Fprintln(stderr, "idn: --tld is only supported with --idna-to-ascii and --idna-to-unicode")
+		return 2
+	}
+	if _, ok := stringprep.Profiles[strings.ToLower(o.profile)]; o.stringprep && !ok {
+		fmt.Fprintf(stderr, "idn: unknown stringprep profile %q\n", o.profile)
+		return 2
+	}
+
+	if o.batch {
+		return o.runBatch(fs.Args(), stdin, stdout, stderr)
+	}
+
+	status := 0
+	convert := func(s string) {
+		out, err := o.convert(s, stderr)
+		if err != nil {
+			fmt.Fprintf(stderr, "idn: %s: %v\n", s, err)
+			status = 1
+			return
+		}
+		fmt.Fprintln(stdout, out)
+	}
+
+	if fs.NArg() > 0 {
+		for _, s := range fs.Args() {
+			convert(s)
+		}
+		return status
+	}
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		convert(strings.TrimSuffix(scanner.Text(), "\r"))
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(stderr, "idn: %v\n", err)
+		return 1
+	}
+	return status
+}
+
+// runBatch converts the lines of stdin with a batch.Processor.
+func (o *options) runBatch(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	formats := map[string]batch.Format{"tsv": batch.TSV, "json": batch.JSON}
+	format, ok := formats[strings.ToLower(o.format)]
+	switch {
+	case !ok:
+		fmt.Fprintf(stderr, "idn: unknown batch format %q\n", o.format)
+		return 2
+	case len(args) > 0:
+		fmt.Fprintln(stderr, "idn: --batch reads the standard input and takes no arguments")
+		return 2
+	case o.debug:
+		fmt.Fprintln(stderr, "idn: --debug is not supported with --batch")
+		return 2
+	}
+
+	p := batch.New(func(s string) (string, error) { return o.convert(s, io.Discard) }, batch.Workers(o.workers))
+	if err := p.Convert(stdout, stdin, format); err != nil {
+		fmt.Fprintf(stderr, "idn: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// boolFlag defines a bool flag with a long and a short name.
+func boolFlag(fs *flag.FlagSet, p *bool, name, short, usage string) {
+	fs.BoolVar(p, name, false, usage)
+	fs.BoolVar(p, short, false, "short for --"+name)
+}
+
+// convert converts s as the options say, printing debugging output to w.
+func (o *options) convert(s string, w io.Writer) (string, error) {
+	if o.debug {
+		printRunes(w, "input", []rune(s))
+	}
+
+	var out string
+	var err error
+	c := idna2003.New(idna2003.AllowUnassigned(o.allowUnassigned), idna2003.UseSTD3ASCIIRules(o.useSTD3))
+	if o.debug && (o.toASCII || o.toUnicode) {
+		o.debugNameprep(c, s, w)
+	}
+	switch {
+	case o.toASCII:
+		out, err = c.ToASCII(s)
+		if err == nil && o.tld {
+			var u string
+			if u, err = c.ToUnicode(out); err == nil {
+				err = checkTLD(u)
+			}
+		}
+	case o.toUnicode:
+		out, err = c.ToUnicode(s)
+		if err == nil && o.tld {
+			err = checkTLD(out)
+		}
+	case o.encode:
+		out, err = punycode.EncodeString(s)
+	case o.decode:
+		out, err = punycode.DecodeString(s)
+	case o.stringprep:
+		out, err = o.prepare(s, w)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if o.debug {
+		printRunes(w, "output", []rune(out))
+	}
+	return out, nil
+}
+
+// debugNameprep prints the code points after each step of Nameprep for the
+// labels of domain that the conversion prepares. Errors are left for the
+// conversion to report.
+func (o *options) debugNameprep(c *idna2003.Converter, domain string, w io.Writer) {
+	for i, l := range c.ValidateDomain(domain).Labels {
+		label := l.Original
+		if lower := strings.ToLower(label); o.toUnicode && strings.HasPrefix(lower, idna2003.AcePrefix) {
+			u, err := punycode.DecodeString(lower[len(idna2003.AcePrefix):])
+			if err != nil {
+				continue
+			}
+			label = u
+		}
+		if isASCII(label) {
+			continue
+		}
+		o.prepareSteps(stringprep.Profiles["nameprep"], label, fmt.Sprintf("label %d ", i), w)
+	}
+}
+
+// prepare prepares s with the stringprep profile of the options. In debug
+// mode each step of the profile is applied on its own, and the code points
+// are printed after it.
+func (o *options) prepare(s string, w io.Writer) (string, error) {
+	profile := stringprep.Profiles[strings.ToLower(o.profile)]
+	if !o.debug {
+		out, err := stringprep.PrepareRunesFlags(profile, []rune(s), o.flags())
+		return string(out), err
+	}
+	return o.prepareSteps(profile, s, "", w)
+}
+
+// flags returns the stringprep flags of the options.
+func (o *options) flags() stringprep.Flags {
+	var flags stringprep.Flags
+	if o.allowUnassigned {
+		flags |= stringprep.AllowUnassigned
+	}
+	return flags
+}
+
+// prepareSteps prepares s with profile one step at a time, printing the code
+// points after each step prefixed with prefix.
+func (o *options) prepareSteps(profile stringprep.Profile, s, prefix string, w io.Writer) (string, error) {
+	flags := o.flags()
+	runes := []rune(s)
+	for i, e := range profile {
+		step := stringprep.Profile{e}
+		switch e.Step {
+		case stringprep.BIDI_PROHIBIT_TABLE, stringprep.BIDI_RAL_TABLE, stringprep.BIDI_L_TABLE:
+			// These only give the tables of the BIDI step.
+			continue
+		case stringprep.BIDI:
+			for _, t := range profile {
+				switch t.Step {
+				case stringprep.BIDI_PROHIBIT_TABLE, stringprep.BIDI_RAL_TABLE, stringprep.BIDI_L_TABLE:
+					step = append(step, t)
+				}
+			}
+		}
+
+		var err error
+		runes, err = stringprep.PrepareRunesFlags(step, runes, flags)
+		if err != nil {
+			return "", err
+		}
+		printRunes(w, fmt.Sprintf("%sstep %d (%s)", prefix, i+1, stepName(e)), runes)
+	}
+	return string(runes), nil
+}
+
+// stepName describes a step of a stringprep profile.
+func stepName(e stringprep.ProfileElement) string {
+	table := stringprep.TableName(e.Table)
+	switch e.Step {
+	case stringprep.NFKC:
+		return "normalize NFKC"
+	case stringprep.BIDI:
+		return "check bidi"
+	case stringprep.MAP_TABLE:
+		return "map " + table
+	case stringprep.UNASSIGNED_TABLE:
+		return "check unassigned " + table
+	case stringprep.PROHIBIT_TABLE:
+		return "check prohibited " + table
+	case stringprep.INSIGNIFICANT_SPACE:
+		return "insignificant space handling"
+	}
+	return fmt.Sprintf("step type %d", e.Step)
+}
+
+// printRunes prints the code points of s, prefixed with name.
+func printRunes(w io.Writer, name string, s []rune) {
+	var b strings.Builder
+	b.WriteString(name)
+	b.WriteByte(':')
+	for _, r := range s {
+		fmt.Fprintf(&b, " %U", r)
+	}
+	fmt.Fprintln(w, b.String())
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] > 127 {
+			return false
+		}
+	}
+	return true
+}
+
+// checkTLD checks the labels of the Unicode domain name domain against the
+// table of its TLD, if there is one.
+func checkTLD(domain string) error {
+	labels := strings.Split(strings.TrimSuffix(domain, "."), ".")
+	name := labels[len(labels)-1]
+	for i, label := range labels {
+		if err := tld.CheckLabel(name, label); err != nil {
+			var terr *tld.Error
+			if errors.As(err, &terr) {
+				terr.Label = i
+			}
+			return err
+		}
+	}
+	return nil
+}
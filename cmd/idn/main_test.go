@@ -0,0 +1,103 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+var runTests = []struct {
+	args   []string
+	stdin  string
+	stdout string
+	status int
+}{
+	{[]string{"bücher.example"}, "", "xn--bcher-kva.example\n", 0},
+	{[]string{"--idna-to-ascii", "bücher.example", "straße.de"}, "", "xn--bcher-kva.example\nstrasse.de\n", 0},
+	{[]string{"-a"}, "bücher.example\r\nfaß.de\n", "xn--bcher-kva.example\nfass.de\n", 0},
+	{[]string{"--idna-to-unicode", "xn--bcher-kva.example"}, "", "bücher.example\n", 0},
+	{[]string{"--punycode-encode", "bücher"}, "", "bcher-kva\n", 0},
+	{[]string{"--punycode-decode", "bcher-kva"}, "", "bücher\n", 0},
+	{[]string{"--stringprep", "Bücher"}, "", "bücher\n", 0},
+	{[]string{"--stringprep", "--profile=saslprep", "I\u00ADX"}, "", "IX\n", 0},
+	{[]string{"-s", "-p", "SASLprep", "a\u00A0b"}, "", "a b\n", 0},
+	{[]string{"under_score.example"}, "", "under_score.example\n", 0},
+	{[]string{"--usestd3asciirules", "under_score.example"}, "", "", 1},
+	{[]string{"ȡ.example"}, "", "", 1},
+	{[]string{"--allow-unassigned", "ȡ.example"}, "", "xn--6la.example\n", 0},
+	{[]string{"--tld", "ære.no"}, "", "xn--re-0ia.no\n", 0},
+	{[]string{"--tld", "señor.dk"}, "", "", 1},
+	{[]string{"--tld", "-u", "xn--seor-hqa.dk"}, "", "", 1},
+	{[]string{"--tld", "señor.example"}, "", "xn--seor-hqa.example\n", 0},
+	{[]string{"a.example", "-.example", "b.example"}, "", "a.example\n-.example\nb.example\n", 0},
+	{[]string{"--usestd3asciirules", "a.example", "-.example", "b.example"}, "", "a.example\nb.example\n", 1},
+	{[]string{"-a", "-u", "example"}, "", "", 2},
+	{[]string{"--tld", "-e", "example"}, "", "", 2},
+	{[]string{"-s", "--profile=nosuch", "example"}, "", "", 2},
+	{[]string{"--nosuchflag"}, "", "", 2},
+	{[]string{"--batch"}, "bücher.example\n-.example\n", "bücher.example\txn--bcher-kva.example\t\n-.example\t-.example\t\n", 0},
+	{[]string{"--batch", "--usestd3asciirules", "--workers=2"}, "-.example\n", "-.example\t\tidna: label 0: Contains hyphen at either end of the string: U+002D at offset 0\n", 0},
+	{[]string{"--batch", "--format=json", "-u"}, "xn--bcher-kva.example\n", `{"line":1,"input":"xn--bcher-kva.example","output":"bücher.example"}` + "\n", 0},
+	{[]string{"--batch", "--format=xml"}, "", "", 2},
+	{[]string{"--batch", "example"}, "", "", 2},
+	{[]string{"--batch", "--debug"}, "", "", 2},
+}
+
+func TestRun(t *testing.T) {
+	for _, test := range runTests {
+		var stdout, stderr bytes.Buffer
+		status := run(test.args, strings.NewReader(test.stdin), &stdout, &stderr)
+		if status != test.status || stdout.String() != test.stdout {
+			t.Errorf("run(%+q) = %d, %+q; want %d, %+q (stderr %+q)", test.args, status, stdout.String(), test.status, test.stdout, stderr.String())
+		}
+	}
+}
+
+func TestDebug(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"--stringprep", "--debug", "A\u00AD"}, nil, &stdout, &stderr); status != 0 {
+		t.Fatalf("run = %d; want 0 (stderr %+q)", status, stderr.String())
+	}
+	for _, line := range []string{
+		"input: U+0041 U+00AD\n",
+		"step 1 (map B.1): U+0041\n",
+		"step 2 (map B.2): U+0061\n",
+		"step 3 (normalize NFKC): U+0061\n",
+		"output: U+0061\n",
+	} {
+		if !strings.Contains(stderr.String(), line) {
+			t.Errorf("debug output %q does not contain %q", stderr.String(), line)
+		}
+	}
+
+	stderr.Reset()
+	if status := run([]string{"--stringprep", "--debug", "a\u0085"}, nil, &stdout, &stderr); status != 1 {
+		t.Errorf("run = %d; want 1", status)
+	}
+	if !strings.Contains(stderr.String(), "U+0085 at offset 1 (table C.2.2)") {
+		t.Errorf("debug output %q does not name the failing table", stderr.String())
+	}
+
+	// In the IDNA modes Nameprep is shown for each label it is applied to.
+	for _, args := range [][]string{
+		{"--debug", "example.B\u00DCcher"},
+		{"--debug", "-u", "example.xn--bcher-kva"},
+	} {
+		stderr.Reset()
+		if status := run(args, nil, &stdout, &stderr); status != 0 {
+			t.Fatalf("run(%+q) = %d; want 0 (stderr %+q)", args, status, stderr.String())
+		}
+		if strings.Contains(stderr.String(), "label 0 ") {
+			t.Errorf("run(%+q) debug output %q shows Nameprep for an ASCII label", args, stderr.String())
+		}
+		if !strings.Contains(stderr.String(), "label 1 step 3 (normalize NFKC): U+0062 U+00FC U+0063 U+0068 U+0065 U+0072\n") {
+			t.Errorf("run(%+q) debug output %q does not show the Nameprep steps of label 1", args, stderr.String())
+		}
+	}
+}
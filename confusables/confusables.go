@@ -0,0 +1,157 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+// Package confusables detects labels that look alike, as described in
+// Unicode Technical Standard #39, Unicode Security Mechanisms.
+//
+// Two strings are confusable if they have the same skeleton, which maps
+// each code point to a prototype it may be mistaken for: CYRILLIC SMALL
+// LETTER A (U+0430) has the Latin "a" as its prototype, so "paypal" written
+// with it is confusable with "paypal". The package also tells whether
+// confusables use the same script, whether a label has a confusable written
+// wholly in another script, and how restrictive the scripts of a label are.
+//
+// The functions work on Unicode labels, such as the output of
+// idna2003.ToUnicode.
+//
+// This package is in beta and has not been extensively tested.
+package confusables
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/DanielOaks/go-idn/idna2008"
+	"github.com/DanielOaks/go-idn/script"
+	"golang.org/x/text/unicode/norm"
+)
+
+type prototype struct {
+	r     rune
+	proto string
+}
+
+// prototypeOf returns the prototype of r, or "" if r is its own prototype.
+func prototypeOf(r rune) string {
+	i := sort.Search(len(prototypes), func(i int) bool { return prototypes[i].r >= r })
+	if i < len(prototypes) && prototypes[i].r == r {
+		return prototypes[i].proto
+	}
+	return ""
+}
+
+// Skeleton returns the skeleton of s, as defined in UTS #39 section 4: s is
+// converted to NFD, its default ignorable code points are removed, each code
+// point is replaced by its prototype, and the result is converted to NFD
+// again. Skeletons are only meant to be compared, not displayed.
+func Skeleton(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		if idna2008.IsDefaultIgnorable(r) {
+			continue
+		}
+		if p := prototypeOf(r); p != "" {
+			b.WriteString(p)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return norm.NFD.String(b.String())
+}
+
+// Confusable returns true if a and b have the same skeleton.
+func Confusable(a, b string) bool {
+	return Skeleton(a) == Skeleton(b)
+}
+
+// SingleScriptConfusable returns true if a and b are confusable and their
+// resolved script sets have a script in common, such as "rn" and "m".
+func SingleScriptConfusable(a, b string) bool {
+	return Confusable(a, b) && resolvedScripts(a).intersects(resolvedScripts(b))
+}
+
+// MixedScriptConfusable returns true if a and b are confusable but are not
+// single-script confusables, such as "paypal" and "p\u0430ypal".
+func MixedScriptConfusable(a, b string) bool {
+	return Confusable(a, b) && !resolvedScripts(a).intersects(resolvedScripts(b))
+}
+
+// WholeScriptConfusable returns true if a and b are mixed-script confusables
+// and neither is a mixed-script string, such as "scope" in Latin and
+// "\u0455\u0441\u043E\u0440\u0435" in Cyrillic.
+func WholeScriptConfusable(a, b string) bool {
+	return MixedScriptConfusable(a, b) && !IsMixedScript(a) && !IsMixedScript(b)
+}
+
+// confusableScripts maps the skeleton of each code point with a prototype,
+// and of each prototype, to the scripts of the code points with that
+// skeleton.
+var confusableScripts = loadConfusableScripts()
+
+func loadConfusableScripts() map[string]scriptSet {
+	confusableScripts := map[string]scriptSet{}
+	add := func(r rune) {
+		if script.IsCommon(r) {
+			return
+		}
+		skel := Skeleton(string(r))
+		set := confusableScripts[skel]
+		if set == nil {
+			set = scriptSet{}
+			confusableScripts[skel] = set
+		}
+		for _, s := range script.Extensions(r) {
+			set[s] = true
+		}
+	}
+	for _, p := range prototypes {
+		add(p.r)
+		if n := []rune(p.proto); len(n) == 1 {
+			add(n[0])
+		}
+	}
+	return confusableScripts
+}
+
+// WholeScriptConfusables returns the scripts, other than those of s, in
+// which s has a whole-script confusable, sorted. For example, "scope" has a
+// whole-script confusable in Cyrillic.
+//
+// The confusables are found code point by code point: a script is returned
+// if each code point of s, except those of the Common and Inherited scripts,
+// is confusable with a code point of that script. Nothing is returned for
+// mixed-script strings.
+func WholeScriptConfusables(s string) []string {
+	own := resolvedScripts(s)
+	if own != nil && len(own) == 0 {
+		return nil
+	}
+
+	var set scriptSet
+	for _, r := range norm.NFD.String(s) {
+		if script.IsCommon(r) || idna2008.IsDefaultIgnorable(r) {
+			continue
+		}
+		candidates := confusableScripts[Skeleton(string(r))]
+		var list []string
+		for c := range candidates {
+			list = append(list, c)
+		}
+		set = set.intersect(list)
+		if len(set) == 0 {
+			return nil
+		}
+	}
+
+	var result []string
+	for c := range set {
+		if own == nil || !own[c] {
+			result = append(result, c)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
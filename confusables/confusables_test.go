@@ -0,0 +1,150 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package confusables
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/DanielOaks/go-idn/idna2003"
+)
+
+func TestTables(t *testing.T) {
+	for i, p := range prototypes {
+		if i > 0 && prototypes[i-1].r >= p.r {
+			t.Fatalf("prototypes not sorted at %U", p.r)
+		}
+	}
+}
+
+var skeletonTests = []struct {
+	in, out string
+}{
+	{"paypal", "paypal"},
+	{"p\u0430ypal", "paypal"},
+	{"rn", "rn"},
+	{"m", "rn"},
+	{"1", "l"},
+	{"pay\u00ADpal", "paypal"}, // soft hyphen is default ignorable
+	{"\u00E9", "e\u0301"},
+}
+
+func TestSkeleton(t *testing.T) {
+	for _, tt := range skeletonTests {
+		if got := Skeleton(tt.in); got != tt.out {
+			t.Errorf("Skeleton(%+q) = %+q; want %+q", tt.in, got, tt.out)
+		}
+	}
+}
+
+var confusableTests = []struct {
+	a, b                      string
+	confusable, single, mixed bool
+	whole                     bool
+}{
+	{"paypal", "p\u0430ypal", true, false, true, false},
+	{"scope", "\u0455\u0441\u043E\u0440\u0435", true, false, true, true},
+	{"rn", "m", true, true, false, false},
+	{"paypal", "paypa1", true, true, false, false},
+	{"paypal", "example", false, false, false, false},
+}
+
+func TestConfusable(t *testing.T) {
+	for _, tt := range confusableTests {
+		if got := Confusable(tt.a, tt.b); got != tt.confusable {
+			t.Errorf("Confusable(%+q, %+q) = %v", tt.a, tt.b, got)
+		}
+		if got := SingleScriptConfusable(tt.a, tt.b); got != tt.single {
+			t.Errorf("SingleScriptConfusable(%+q, %+q) = %v", tt.a, tt.b, got)
+		}
+		if got := MixedScriptConfusable(tt.a, tt.b); got != tt.mixed {
+			t.Errorf("MixedScriptConfusable(%+q, %+q) = %v", tt.a, tt.b, got)
+		}
+		if got := WholeScriptConfusable(tt.a, tt.b); got != tt.whole {
+			t.Errorf("WholeScriptConfusable(%+q, %+q) = %v", tt.a, tt.b, got)
+		}
+	}
+}
+
+func TestIsMixedScript(t *testing.T) {
+	tests := []struct {
+		s     string
+		mixed bool
+	}{
+		{"paypal", false},
+		{"p\u0430ypal", true},
+		{"123", false},
+		{"abc123", false},
+		{"日本ご", false},                // Han with Hiragana
+		{"日本한", false},                // Han with Hangul
+		{"ご한", true},                  // Hiragana with Hangul
+		{"\u0430\u0301\u0431", false}, // Cyrillic with an Inherited mark
+		{"ب،ب", false},                // Arabic comma has Arabic in its Script_Extensions
+	}
+	for _, tt := range tests {
+		if got := IsMixedScript(tt.s); got != tt.mixed {
+			t.Errorf("IsMixedScript(%+q) = %v; want %v", tt.s, got, tt.mixed)
+		}
+	}
+}
+
+func TestWholeScriptConfusables(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []string
+	}{
+		{"scope", []string{"Cyrillic"}},
+		{"\u0455\u0441\u043E\u0440\u0435", []string{"Latin"}},
+		{"paypal", []string{"Cyrillic", "Greek"}},
+		{"p\u0430ypal", nil},
+		{"example", nil},
+	}
+	for _, tt := range tests {
+		got := WholeScriptConfusables(tt.s)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("WholeScriptConfusables(%+q) = %q; want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestRestriction(t *testing.T) {
+	tests := []struct {
+		s    string
+		want RestrictionLevel
+	}{
+		{"paypal", ASCIIOnly},
+		{"PayPal", ASCIIOnly},
+		{"bücher", SingleScript},
+		{"\u0430\u0431\u0432", SingleScript},
+		{"abc日本ご", HighlyRestrictive},
+		{"abc中ㄅ", HighlyRestrictive},
+		{"abcकख", ModeratelyRestrictive},
+		{"p\u0430ypal", MinimallyRestrictive},
+		{"कก", MinimallyRestrictive},
+		{"pay pal", Unrestricted},
+		{"♥", Unrestricted},
+	}
+	for _, tt := range tests {
+		if got := Restriction(tt.s); got != tt.want {
+			t.Errorf("Restriction(%+q) = %v; want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestToUnicodeOutput(t *testing.T) {
+	ace, err := idna2003.ToASCII("p\u0430ypal.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := idna2003.ToUnicode(ace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !MixedScriptConfusable(u, "paypal.com") {
+		t.Errorf("%q is not a mixed-script confusable of paypal.com", u)
+	}
+}
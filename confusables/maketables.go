@@ -0,0 +1,131 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+//go:build ignore
+// +build ignore
+
+// UTS #39 confusables table generator.
+//
+// The data files are read from unicode.org, or from local files if the flags
+// name paths rather than URLs.
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	version       = flag.String("version", unicode.Version, "Unicode version of confusables.txt")
+	confusableURL = flag.String("confusables", "", "URL or path of confusables.txt; defaults to http://www.unicode.org/Public/security/<version>/confusables.txt")
+)
+
+type prototype struct {
+	r     rune
+	proto string
+}
+
+func main() {
+	flag.Parse()
+	if *confusableURL == "" {
+		*confusableURL = "http://www.unicode.org/Public/security/" + *version + "/confusables.txt"
+	}
+
+	prototypes := loadPrototypes()
+
+	fmt.Printf("// This file is automatically generated by running\n")
+	fmt.Printf("// maketables\n")
+	fmt.Printf("// DO NOT EDIT\n\n")
+	fmt.Printf("package confusables\n\n")
+	fmt.Printf("// UnicodeVersion is the Unicode version of the confusables.txt file the\n")
+	fmt.Printf("// prototypes were generated from.\n")
+	fmt.Printf("const UnicodeVersion = %q\n\n", *version)
+	fmt.Printf("// prototypes maps code points to their prototypes, from the confusables.txt\n")
+	fmt.Printf("// file of UTS #39 section 4, sorted by code point.\n")
+	fmt.Printf("var prototypes = []prototype{\n")
+	for _, p := range prototypes {
+		fmt.Printf("\t{0x%04X, %+q},\n", p.r, p.proto)
+	}
+	fmt.Printf("}\n")
+}
+
+// open returns the contents of a URL, or of a local file.
+func open(url string) io.ReadCloser {
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		f, err := os.Open(url)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return f
+	}
+	resp, err := http.Get(url)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != 200 {
+		log.Fatal("bad GET status for ", url, ": ", resp.Status)
+	}
+	return resp.Body
+}
+
+// readFields calls f with the fields of each line of a UCD file that is not
+// blank or a comment.
+func readFields(url string, f func(fields []string)) {
+	r := open(url)
+	defer r.Close()
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimPrefix(s.Text(), "\ufeff")
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		f(fields)
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func loadPrototypes() []prototype {
+	var prototypes []prototype
+	readFields(*confusableURL, func(fields []string) {
+		if len(fields) < 2 {
+			log.Fatalf("bad line in confusables.txt: %q", fields)
+		}
+		var proto string
+		for _, cp := range strings.Fields(fields[1]) {
+			proto += string(parseRune(cp))
+		}
+		prototypes = append(prototypes, prototype{parseRune(fields[0]), proto})
+	})
+	sort.Slice(prototypes, func(i, j int) bool { return prototypes[i].r < prototypes[j].r })
+	return prototypes
+}
+
+func parseRune(s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
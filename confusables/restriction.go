@@ -0,0 +1,119 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package confusables
+
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/DanielOaks/go-idn/idna2008"
+	"github.com/DanielOaks/go-idn/script"
+)
+
+// A RestrictionLevel classifies strings by the scripts they mix, as defined
+// in UTS #39 section 5.2. Lower levels are more restrictive.
+type RestrictionLevel int
+
+// Restriction levels.
+const (
+	ASCIIOnly             RestrictionLevel = iota + 1 // only ASCII code points
+	SingleScript                                      // a single script, with Common and Inherited
+	HighlyRestrictive                                 // Latin with Han and Hiragana and Katakana, Bopomofo or Hangul
+	ModeratelyRestrictive                             // Latin with one other recommended script, except Cyrillic and Greek
+	MinimallyRestrictive                              // any scripts
+	Unrestricted                                      // code points outside the identifier profile
+)
+
+var restrictionNames = map[RestrictionLevel]string{
+	ASCIIOnly:             "ASCII-Only",
+	SingleScript:          "Single Script",
+	HighlyRestrictive:     "Highly Restrictive",
+	ModeratelyRestrictive: "Moderately Restrictive",
+	MinimallyRestrictive:  "Minimally Restrictive",
+	Unrestricted:          "Unrestricted",
+}
+
+func (l RestrictionLevel) String() string {
+	if name, ok := restrictionNames[l]; ok {
+		return name
+	}
+	return fmt.Sprintf("RestrictionLevel(%d)", int(l))
+}
+
+// highlyRestrictive lists the sets of scripts that may be mixed in Highly
+// Restrictive strings.
+var highlyRestrictive = [][]string{
+	{"Latin", "Han", "Hiragana", "Katakana"},
+	{"Latin", "Han", "Bopomofo"},
+	{"Latin", "Han", "Hangul"},
+}
+
+// recommended holds the scripts recommended for identifiers by UAX #31
+// table 5, except Latin, Cyrillic and Greek, which may be mixed with Latin in
+// Moderately Restrictive strings.
+var recommended = map[string]bool{
+	"Arabic": true, "Armenian": true, "Bengali": true, "Bopomofo": true,
+	"Devanagari": true, "Ethiopic": true, "Georgian": true, "Gujarati": true,
+	"Gurmukhi": true, "Han": true, "Hangul": true, "Hebrew": true,
+	"Hiragana": true, "Kannada": true, "Katakana": true, "Khmer": true,
+	"Lao": true, "Malayalam": true, "Myanmar": true, "Oriya": true,
+	"Sinhala": true, "Tamil": true, "Telugu": true, "Thaana": true,
+	"Thai": true, "Tibetan": true,
+}
+
+// Restriction returns the most restrictive level s satisfies.
+//
+// UTS #39 requires the code points of all levels but Unrestricted to be in
+// an identifier profile. The profile used here is that of IDNA2008: code
+// points that are PVALID, CONTEXTJ or CONTEXTO once lowercased.
+func Restriction(s string) RestrictionLevel {
+	ascii := true
+	for _, r := range s {
+		switch idna2008.DerivedProperty(unicode.ToLower(r)) {
+		case idna2008.PVALID, idna2008.CONTEXTJ, idna2008.CONTEXTO:
+		default:
+			return Unrestricted
+		}
+		if r >= 0x80 {
+			ascii = false
+		}
+	}
+
+	switch {
+	case ascii:
+		return ASCIIOnly
+	case !IsMixedScript(s):
+		return SingleScript
+	}
+	for _, set := range highlyRestrictive {
+		if coveredBy(s, set...) {
+			return HighlyRestrictive
+		}
+	}
+	for _, r := range s {
+		for _, sc := range script.Extensions(r) {
+			if recommended[sc] && coveredBy(s, "Latin", sc) {
+				return ModeratelyRestrictive
+			}
+		}
+	}
+	return MinimallyRestrictive
+}
+
+// coveredBy returns true if the Script_Extensions of each code point of s,
+// except those of the Common and Inherited scripts, include one of set.
+func coveredBy(s string, set ...string) bool {
+	for _, r := range s {
+		if script.IsCommon(r) {
+			continue
+		}
+		if len(scriptSet(nil).intersect(set).intersect(script.Extensions(r))) == 0 {
+			return false
+		}
+	}
+	return true
+}
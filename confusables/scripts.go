@@ -0,0 +1,83 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package confusables
+
+import "github.com/DanielOaks/go-idn/script"
+
+// augmented returns the Script_Extensions of r augmented as in UTS #39
+// section 5.1, so that Han can be used with the scripts written with it.
+func augmented(r rune) []string {
+	scx := script.Extensions(r)
+	var extra []string
+	for _, s := range scx {
+		switch s {
+		case "Han":
+			extra = append(extra, "Han_with_Bopomofo", "Japanese", "Korean")
+		case "Hiragana", "Katakana":
+			extra = append(extra, "Japanese")
+		case "Hangul":
+			extra = append(extra, "Korean")
+		case "Bopomofo":
+			extra = append(extra, "Han_with_Bopomofo")
+		}
+	}
+	if extra == nil {
+		return scx
+	}
+	return append(append([]string{}, scx...), extra...)
+}
+
+// A scriptSet is a set of scripts. The nil scriptSet stands for all scripts.
+type scriptSet map[string]bool
+
+// intersect returns the scripts of set that are in list. If set is nil the
+// scripts of list are returned.
+func (set scriptSet) intersect(list []string) scriptSet {
+	result := scriptSet{}
+	for _, s := range list {
+		if set == nil || set[s] {
+			result[s] = true
+		}
+	}
+	return result
+}
+
+func (set scriptSet) intersects(other scriptSet) bool {
+	if set == nil || other == nil {
+		return true
+	}
+	for s := range set {
+		if other[s] {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvedScripts returns the resolved script set of s, as defined in UTS #39
+// section 5.1: the intersection of the augmented Script_Extensions of its
+// code points, ignoring Common and Inherited. It is nil, for all scripts, if
+// s only has Common and Inherited code points, and empty if s mixes scripts.
+func resolvedScripts(s string) scriptSet {
+	var set scriptSet
+	for _, r := range s {
+		if script.IsCommon(r) {
+			continue
+		}
+		set = set.intersect(augmented(r))
+	}
+	return set
+}
+
+// IsMixedScript returns true if s is a mixed-script string, one whose
+// resolved script set is empty. Han may be mixed with the scripts written
+// with it, such as Hiragana and Katakana, and Common and Inherited code
+// points, such as digits and combining marks, are allowed with any script.
+func IsMixedScript(s string) bool {
+	set := resolvedScripts(s)
+	return set != nil && len(set) == 0
+}
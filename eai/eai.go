@@ -0,0 +1,298 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+// Package eai handles internationalized email addresses, as described in
+// RFC 6530, RFC 6531 and RFC 6532.
+//
+// An internationalized address may have a local part in UTF-8 and an
+// internationalized domain name. The domain can always be written in ACE
+// with IDNA, but a local part that is not ASCII can only be delivered by
+// servers supporting the SMTPUTF8 extension of RFC 6531; such addresses have
+// no ASCII form.
+//
+// As RFC 6531 requires, domains are converted with IDNA2008 by default, so
+// that the domain of an address is not changed: "user@straße.de" keeps its
+// ß, and a domain with code points that IDNA2008 disallows, such as
+// uppercase letters outside ASCII, is rejected. Applications that want to
+// map user input first can give a Parser another Converter, such as a UTS
+// #46 profile.
+//
+// Only the address itself, the addr-spec of RFC 5322, is handled: display
+// names, comments and angle brackets are not accepted.
+//
+// This package is in beta and has not been extensively tested.
+package eai
+
+import (
+	"net/netip"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/DanielOaks/go-idn/idna2008"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxLocalPartLength is the limit on the length of a local part, in octets,
+// from RFC 5321 section 4.5.3.1.1.
+const MaxLocalPartLength = 64
+
+// MaxDomainLength is the limit on the length of a domain in ACE, in octets,
+// from RFC 5321 section 4.5.3.1.2.
+const MaxDomainLength = 255
+
+// An Address is an email address split into its local part and domain.
+type Address struct {
+	Local       string // the local part, as given or normalized to NFC
+	Domain      string // the domain in Unicode, or an address literal such as "[192.0.2.1]"
+	ASCIIDomain string // the domain in ACE, or the domain literal
+}
+
+// String returns the address with its domain in Unicode.
+func (a *Address) String() string {
+	return a.Local + "@" + a.Domain
+}
+
+// SMTPUTF8 returns true if the address can only be used with the SMTPUTF8
+// extension of RFC 6531: if its local part is not ASCII.
+func (a *Address) SMTPUTF8() bool {
+	return !isASCII(a.Local)
+}
+
+// ASCII returns the address with its domain in ACE, which can be used
+// without the SMTPUTF8 extension. It returns ErrNotDowngradable if the local
+// part is not ASCII, as there is no ASCII form of such local parts.
+func (a *Address) ASCII() (string, error) {
+	if a.SMTPUTF8() {
+		return "", ErrNotDowngradable
+	}
+	return a.Local + "@" + a.ASCIIDomain, nil
+}
+
+// A DomainConverter converts domain names to ACE and back. It is implemented
+// by *idna2003.Converter and *uts46.Profile.
+type DomainConverter interface {
+	ToASCII(domain string) (string, error)
+	ToUnicode(domain string) (string, error)
+}
+
+// idna2008Converter is the DomainConverter of the idna2008 package.
+type idna2008Converter struct{}
+
+func (idna2008Converter) ToASCII(domain string) (string, error)   { return idna2008.ToASCII(domain) }
+func (idna2008Converter) ToUnicode(domain string) (string, error) { return idna2008.ToUnicode(domain) }
+
+// IDNA2008 is the DomainConverter that converts domains with the idna2008
+// package, without mapping them.
+var IDNA2008 DomainConverter = idna2008Converter{}
+
+// A Parser splits and checks email addresses. A Parser is safe for
+// concurrent use.
+type Parser struct {
+	converter DomainConverter
+	normalize bool
+}
+
+// An Option configures a Parser.
+type Option func(*Parser)
+
+// Converter sets the converter used for domains. It defaults to IDNA2008.
+// A converter that maps domains, such as an idna2003.Converter, changes
+// the domain of the address.
+func Converter(c DomainConverter) Option {
+	return func(p *Parser) { p.converter = c }
+}
+
+// Normalize sets whether local parts are normalized to NFC, as RFC 6532
+// section 3.1 recommends. Otherwise they are kept as given.
+func Normalize(normalize bool) Option {
+	return func(p *Parser) { p.normalize = normalize }
+}
+
+// NewParser returns a Parser with the given options applied.
+func NewParser(opts ...Option) *Parser {
+	p := &Parser{converter: IDNA2008}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+var (
+	// Default is the Parser used by Parse. It does not normalize local
+	// parts.
+	Default = NewParser()
+
+	// NFC is a Parser that normalizes local parts to NFC.
+	NFC = NewParser(Normalize(true))
+)
+
+// Parse parses an address with the Default Parser.
+func Parse(addr string) (*Address, error) {
+	return Default.Parse(addr)
+}
+
+// Parse splits addr at its last '@', checks that the local part is a
+// dot-atom or a quoted string of RFC 5321 section 4.1.2 as extended by RFC
+// 6532 section 3.2, and converts the domain with IDNA. The domain must not
+// end with a dot, and must be at most MaxDomainLength octets in ACE.
+func (p *Parser) Parse(addr string) (*Address, error) {
+	if !utf8.ValidString(addr) {
+		return nil, ErrUTF8
+	}
+	i := strings.LastIndexByte(addr, '@')
+	if i < 0 {
+		return nil, ErrMissingAt
+	}
+	local, domain := addr[:i], addr[i+1:]
+	if local == "" || domain == "" {
+		return nil, ErrEmpty
+	}
+
+	if p.normalize {
+		local = norm.NFC.String(local)
+	}
+	if err := checkLocal(local); err != nil {
+		return nil, err
+	}
+	if len(local) > MaxLocalPartLength {
+		return nil, ErrLocalPartLength
+	}
+
+	a := &Address{Local: local, Domain: domain, ASCIIDomain: domain}
+	if strings.HasPrefix(domain, "[") {
+		// Domain literals, such as IP addresses, are ASCII already.
+		if !isAddressLiteral(domain) {
+			return nil, &DomainError{domain, ErrDomainLiteral}
+		}
+		return a, nil
+	}
+	var err error
+	if a.ASCIIDomain, err = p.converter.ToASCII(domain); err != nil {
+		return nil, &DomainError{domain, err}
+	}
+	if err := checkDomain(a.ASCIIDomain); err != nil {
+		return nil, &DomainError{domain, err}
+	}
+	if a.Domain, err = p.converter.ToUnicode(a.ASCIIDomain); err != nil {
+		return nil, &DomainError{domain, err}
+	}
+	return a, nil
+}
+
+// isAddressLiteral returns true if domain is an IPv4 or IPv6 address literal
+// of RFC 5321 section 4.1.3, such as "[192.0.2.1]" or "[IPv6:2001:db8::1]".
+// General address literals are not accepted, as no tags other than IPv6 are
+// standardized.
+func isAddressLiteral(domain string) bool {
+	if len(domain) < 2 || domain[len(domain)-1] != ']' {
+		return false
+	}
+	lit := domain[1 : len(domain)-1]
+	const tag = "IPv6:"
+	if len(lit) >= len(tag) && strings.EqualFold(lit[:len(tag)], tag) {
+		ip, err := netip.ParseAddr(lit[len(tag):])
+		return err == nil && ip.Is6() && ip.Zone() == ""
+	}
+	ip, err := netip.ParseAddr(lit)
+	return err == nil && ip.Is4()
+}
+
+// checkDomain checks the length and the labels of a domain in ACE, which
+// the DomainConverter may not do.
+func checkDomain(ace string) error {
+	if len(ace) > MaxDomainLength {
+		return ErrDomainLength
+	}
+	for _, label := range strings.Split(ace, ".") {
+		if label == "" {
+			return ErrEmptyLabel
+		}
+	}
+	return nil
+}
+
+// checkLocal checks that local is a dot-atom or a quoted string, allowing
+// the printable code points outside ASCII. As RFC 6532 section 3.1 requires
+// the local part to be in NFC, it may not begin with a combining mark, not
+// even inside the quotes of a quoted string.
+func checkLocal(local string) error {
+	if local[0] == '"' {
+		return checkQuoted(local)
+	}
+	for i, r := range local {
+		switch {
+		case i == 0 && unicode.Is(unicode.M, r):
+			return &Error{i, r, ErrLocalPart}
+		case r == '.':
+			if i == 0 || i == len(local)-1 || local[i-1] == '.' {
+				return &Error{i, r, ErrLocalPart}
+			}
+		case !isAtext(r):
+			return &Error{i, r, ErrLocalPart}
+		}
+	}
+	return nil
+}
+
+// checkQuoted checks that local is a quoted string.
+func checkQuoted(local string) error {
+	escaped := false
+	for i, r := range local {
+		switch {
+		case i == 0:
+		case i == 1 && unicode.Is(unicode.M, r):
+			return &Error{i, r, ErrLocalPart}
+		case escaped:
+			if r < ' ' || r > '~' {
+				return &Error{i, r, ErrLocalPart}
+			}
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			if i != len(local)-1 {
+				return &Error{i, r, ErrLocalPart}
+			}
+			return nil
+		case !isQtext(r):
+			return &Error{i, r, ErrLocalPart}
+		}
+	}
+	// The closing quote is missing.
+	return &Error{0, '"', ErrLocalPart}
+}
+
+// isAtext returns true if r may appear in an atom: if it is atext of RFC
+// 5322 section 3.2.3 or a printable code point outside ASCII.
+func isAtext(r rune) bool {
+	switch {
+	case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		return true
+	case r < utf8.RuneSelf:
+		return strings.ContainsRune("!#$%&'*+-/=?^_`{|}~", r)
+	}
+	return unicode.IsPrint(r)
+}
+
+// isQtext returns true if r may appear unescaped in a quoted string: if it
+// is qtextSMTP of RFC 5321 section 4.1.2 or a printable code point outside
+// ASCII.
+func isQtext(r rune) bool {
+	if r < utf8.RuneSelf {
+		return r >= ' ' && r <= '~' && r != '"' && r != '\\'
+	}
+	return unicode.IsPrint(r)
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,136 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package eai
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/DanielOaks/go-idn/idna2003"
+	"github.com/DanielOaks/go-idn/uts46"
+)
+
+var parseTests = []struct {
+	parser      *Parser
+	in          string
+	local       string
+	domain      string
+	asciiDomain string
+	smtputf8    bool
+}{
+	{Default, "用户@例子.广告", "用户", "例子.广告", "xn--fsqu00a.xn--4rr70v", true},
+	{Default, "user@bücher.example", "user", "bücher.example", "xn--bcher-kva.example", false},
+	{Default, "user@straße.de", "user", "straße.de", "xn--strae-oqa.de", false},
+	{Default, "user@ß.de", "user", "ß.de", "xn--zca.de", false},
+	{Default, "user@EXAMPLE.com", "user", "example.com", "example.com", false},
+	{NewParser(Converter(uts46.Lookup)), "user@B\u00DCcher.example", "user", "bücher.example", "xn--bcher-kva.example", false},
+	{NewParser(Converter(idna2003.Default)), "user@straße.de", "user", "strasse.de", "strasse.de", false},
+	{Default, "user@xn--bcher-kva.example", "user", "bücher.example", "xn--bcher-kva.example", false},
+	{Default, "first.last+tag@example.com", "first.last+tag", "example.com", "example.com", false},
+	{Default, "!#$%&'*+-/=?^_`{|}~@example.com", "!#$%&'*+-/=?^_`{|}~", "example.com", "example.com", false},
+	{Default, `"john doe"@example.com`, `"john doe"`, "example.com", "example.com", false},
+	{Default, `"a\"b@c"@example.com`, `"a\"b@c"`, "example.com", "example.com", false},
+	{Default, `"jöhn dœ"@example.com`, `"jöhn dœ"`, "example.com", "example.com", true},
+	{Default, "user@[192.0.2.1]", "user", "[192.0.2.1]", "[192.0.2.1]", false},
+	{Default, "user@[IPv6:2001:db8::1]", "user", "[IPv6:2001:db8::1]", "[IPv6:2001:db8::1]", false},
+	{Default, "user@[ipv6:::ffff:192.0.2.1]", "user", "[ipv6:::ffff:192.0.2.1]", "[ipv6:::ffff:192.0.2.1]", false},
+	{Default, "cafe\u0301@example.com", "cafe\u0301", "example.com", "example.com", true},
+	{NFC, "cafe\u0301@example.com", "caf\u00E9", "example.com", "example.com", true},
+	{NFC, "Δοκιμή@παράδειγμα.δοκιμή", "Δοκιμή", "παράδειγμα.δοκιμή", "xn--hxajbheg2az3al.xn--jxalpdlp", true},
+}
+
+func TestParse(t *testing.T) {
+	for _, test := range parseTests {
+		a, err := test.parser.Parse(test.in)
+		if err != nil {
+			t.Errorf("Parse(%+q) error = %v", test.in, err)
+			continue
+		}
+		if a.Local != test.local || a.Domain != test.domain || a.ASCIIDomain != test.asciiDomain || a.SMTPUTF8() != test.smtputf8 {
+			t.Errorf("Parse(%+q) = %+q, SMTPUTF8 %v; want %+q, %+q, %+q, %v", test.in, *a, a.SMTPUTF8(), test.local, test.domain, test.asciiDomain, test.smtputf8)
+		}
+		if s := a.String(); s != test.local+"@"+test.domain {
+			t.Errorf("Parse(%+q).String() = %+q", test.in, s)
+		}
+
+		ascii, err := a.ASCII()
+		if test.smtputf8 {
+			if err != ErrNotDowngradable {
+				t.Errorf("Parse(%+q).ASCII() = %+q, %v; want %v", test.in, ascii, err, ErrNotDowngradable)
+			}
+		} else if err != nil || ascii != test.local+"@"+test.asciiDomain {
+			t.Errorf("Parse(%+q).ASCII() = %+q, %v; want %+q", test.in, ascii, err, test.local+"@"+test.asciiDomain)
+		}
+	}
+}
+
+var parseErrorTests = []struct {
+	in     string
+	err    error
+	offset int
+}{
+	{"user.example.com", ErrMissingAt, -1},
+	{"@example.com", ErrEmpty, -1},
+	{"user@", ErrEmpty, -1},
+	{"us\xffer@example.com", ErrUTF8, -1},
+	{"a..b@example.com", ErrLocalPart, 2},
+	{".a@example.com", ErrLocalPart, 0},
+	{"a.@example.com", ErrLocalPart, 1},
+	{"a b@example.com", ErrLocalPart, 1},
+	{"a(b)@example.com", ErrLocalPart, 1},
+	{"ü\u0085@example.com", ErrLocalPart, 2},
+	{`"unterminated@example.com`, ErrLocalPart, 0},
+	{`"a"b"@example.com`, ErrLocalPart, 2},
+	{"\"a\u0007\"@example.com", ErrLocalPart, 2},
+	{strings.Repeat("a", 65) + "@example.com", ErrLocalPartLength, -1},
+	{"user@[not an address]", ErrDomainLiteral, -1},
+	{"user@[]", ErrDomainLiteral, -1},
+	{"user@[192.0.2.1", ErrDomainLiteral, -1},
+	{"user@[192.0.2.256]", ErrDomainLiteral, -1},
+	{"user@[2001:db8::1]", ErrDomainLiteral, -1},
+	{"user@[IPv6:192.0.2.1]", ErrDomainLiteral, -1},
+	{"user@[IPv6:fe80::1%eth0]", ErrDomainLiteral, -1},
+	{"user@[x-tag:content]", ErrDomainLiteral, -1},
+	{strings.Repeat("ü", 33) + "@example.com", ErrLocalPartLength, -1},
+	{"user@" + strings.Repeat("a.", 130) + "com", ErrDomainLength, -1},
+	{"user@example.com.", ErrEmptyLabel, -1},
+	{"\u0301a@example.com", ErrLocalPart, 0},
+	{"\"\u0301a\"@example.com", ErrLocalPart, 1},
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, test := range parseErrorTests {
+		a, err := Parse(test.in)
+		if a != nil || !errors.Is(err, test.err) {
+			t.Errorf("Parse(%+q) = %v, %v; want %v", test.in, a, err, test.err)
+			continue
+		}
+		var e *Error
+		if test.offset >= 0 && (!errors.As(err, &e) || e.Offset != test.offset) {
+			t.Errorf("Parse(%+q) error = %v; want offset %d", test.in, err, test.offset)
+		}
+	}
+
+	for _, in := range []string{
+		"user@under_score.example",
+		"user@example..com",
+		"user@b\u00DCcher.example",
+	} {
+		a, err := Parse(in)
+		var e *DomainError
+		if a != nil || !errors.As(err, &e) {
+			t.Errorf("Parse(%+q) = %v, %v; want *DomainError", in, a, err)
+		}
+	}
+
+	// The domain keeps the errors of the idna2003 package.
+	_, err := NewParser(Converter(idna2003.Default)).Parse("user@under_score.example")
+	if !errors.Is(err, idna2003.ErrNonLDH) {
+		t.Errorf("Parse with idna2003.Default error = %v; want %v", err, idna2003.ErrNonLDH)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package eai
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by Parse and Address.ASCII. ErrLocalPart is wrapped in an
+// *Error that gives the offending code point; use errors.Is to find out why
+// an address was rejected. Domains that cannot be converted are reported in
+// a *DomainError wrapping the error of the DomainConverter, or
+// ErrDomainLength or ErrEmptyLabel.
+var (
+	ErrMissingAt       = errors.New("eai: Address has no @")
+	ErrEmpty           = errors.New("eai: Local part or domain is empty")
+	ErrUTF8            = errors.New("eai: Address is not valid UTF-8")
+	ErrLocalPart       = errors.New("eai: Invalid character in local part")
+	ErrLocalPartLength = errors.New("eai: Local part too long")
+	ErrNotDowngradable = errors.New("eai: Local part is not ASCII")
+	ErrDomainLiteral   = errors.New("eai: Domain literal is not an IPv4 or IPv6 address")
+	ErrDomainLength    = errors.New("eai: Domain longer than 255 octets")
+	ErrEmptyLabel      = errors.New("eai: Domain has an empty label")
+)
+
+// An Error describes a code point of a local part that is not allowed.
+type Error struct {
+	Offset int   // byte offset of Rune in the local part
+	Rune   rune  // the offending rune
+	Err    error // the reason for the error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v: %U at offset %d", e.Err, e.Rune, e.Offset)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// A DomainError describes a domain that could not be converted.
+type DomainError struct {
+	Domain string // the domain as given
+	Err    error  // the reason for the error
+}
+
+func (e *DomainError) Error() string {
+	return fmt.Sprintf("eai: domain %q: %v", e.Domain, e.Err)
+}
+
+func (e *DomainError) Unwrap() error { return e.Err }
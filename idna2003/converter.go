@@ -0,0 +1,124 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package idna2003
+
+import (
+	"unicode/utf8"
+
+	"github.com/DanielOaks/go-idn/script"
+)
+
+// A Converter converts domain names between Unicode and ASCII using the
+// procedures in RFC 3490, with the flags and checks it was created with.
+// A Converter is safe for concurrent use.
+type Converter struct {
+	allowUnassigned   bool
+	useSTD3ASCIIRules bool
+	verifyDNSLength   bool
+	separators        []rune
+	scripts           *script.Policy
+}
+
+// An Option configures a Converter.
+type Option func(*Converter)
+
+// AllowUnassigned sets the AllowUnassigned flag from RFC 3490 section 3.1.
+// Unassigned code points may be allowed in queries, but must not be in
+// stored strings such as registered domain names.
+func AllowUnassigned(allow bool) Option {
+	return func(c *Converter) { c.allowUnassigned = allow }
+}
+
+// UseSTD3ASCIIRules sets the UseSTD3ASCIIRules flag from RFC 3490 section
+// 3.1, which restricts the ASCII code points in a label to letters, digits
+// and hyphens, and forbids hyphens at either end of a label.
+func UseSTD3ASCIIRules(use bool) Option {
+	return func(c *Converter) { c.useSTD3ASCIIRules = use }
+}
+
+// VerifyDNSLength sets whether ToASCII also verifies that the whole domain
+// name, excluding a trailing dot, is no longer than 253 octets. Labels are
+// always limited to 63 octets.
+func VerifyDNSLength(verify bool) Option {
+	return func(c *Converter) { c.verifyDNSLength = verify }
+}
+
+// Separators sets the code points that are recognized as label separators
+// besides U+002E FULL STOP, replacing the other three dots listed in RFC
+// 3490 section 3.1. Separators() with no code points leaves only U+002E
+// FULL STOP. The separators in the output are always U+002E FULL STOP.
+func Separators(separators ...rune) Option {
+	return func(c *Converter) {
+		c.separators = append([]rune{}, separators...)
+	}
+}
+
+// Scripts sets a policy for the scripts of each label, such as
+// script.SingleScript, which is checked after Nameprep. Labels the policy
+// rejects fail with a *LabelError wrapping script.ErrMixedScript or
+// script.ErrNotAllowed. By default the scripts of labels are not checked, as
+// RFC 3490 does not restrict them.
+func Scripts(policy *script.Policy) Option {
+	return func(c *Converter) { c.scripts = policy }
+}
+
+// New returns a Converter with the given options applied. By default STD3
+// ASCII rules are enforced, unassigned code points are not allowed and the
+// separators from RFC 3490 section 3.1 are recognized.
+func New(opts ...Option) *Converter {
+	c := &Converter{
+		useSTD3ASCIIRules: true,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+var (
+	// Default is the Converter used by ToASCII and ToUnicode.
+	Default = New()
+
+	// Lookup is a Converter for domain names used in queries, which may
+	// contain unassigned code points.
+	Lookup = New(AllowUnassigned(true))
+
+	// Registration is a Converter for domain names that will be stored or
+	// registered, which also verifies the length of the whole domain name.
+	Registration = New(VerifyDNSLength(true))
+)
+
+// isSeparator returns true if c is a label separator for this Converter.
+func (c *Converter) isSeparator(r rune) bool {
+	if r == '.' {
+		return true
+	}
+	if c.separators == nil {
+		return isSeparator(r)
+	}
+	for _, s := range c.separators {
+		if r == s {
+			return true
+		}
+	}
+	return false
+}
+
+// split breaks domain into labels at the separators of this Converter.
+func (c *Converter) split(domain string) []string {
+	var labels []string
+	start := 0
+	for i := 0; i < len(domain); {
+		r, size := utf8.DecodeRuneInString(domain[i:])
+		if c.isSeparator(r) {
+			labels = append(labels, domain[start:i])
+			start = i + size
+		}
+		i += size
+	}
+	return append(labels, domain[start:])
+}
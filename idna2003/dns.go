@@ -0,0 +1,298 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package idna2003
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxWireLength is the limit on the length of a domain name in DNS wire
+// format, including the length octets and the root label, from RFC 1035
+// section 2.3.4.
+const MaxWireLength = 255
+
+// LabelToASCII converts a single label to ASCII using the procedure in RFC
+// 3490 section 4.1. Unlike ToASCII, the label is not split at dots or the
+// other label separators, which are treated as any other code point.
+func (c *Converter) LabelToASCII(label string) (string, error) {
+	label = toLowerASCII(label)
+	return c.toASCIIRaw(0, label)
+}
+
+// LabelToUnicode converts a single label to Unicode using the procedure in
+// RFC 3490 section 4.2. Unlike ToUnicode, the label is not split at dots or
+// the other label separators.
+func (c *Converter) LabelToUnicode(label string) (string, error) {
+	label = toLowerASCII(label)
+	return c.toUnicodeRaw(0, label)
+}
+
+// WireToASCII converts a domain name in the wire format of RFC 1035 section
+// 3.1, a sequence of labels each preceded by its length and ended by the
+// empty root label, to ASCII. Each label is converted on its own with the
+// procedure in RFC 3490 section 4.1, so labels may contain dots. The labels
+// of the input may hold any bytes, and are read as UTF-8. Compression
+// pointers are not allowed.
+//
+// If the name cannot be converted, it is returned unchanged with an error.
+func (c *Converter) WireToASCII(name []byte) ([]byte, error) {
+	return c.convertWire(name, c.toASCIIRaw)
+}
+
+// WireToUnicode converts a domain name in wire format to Unicode with the
+// procedure in RFC 3490 section 4.2, like WireToASCII. The labels of the
+// result are encoded in UTF-8, so they must not be longer than 63 octets
+// either.
+func (c *Converter) WireToUnicode(name []byte) ([]byte, error) {
+	return c.convertWire(name, c.toUnicodeRaw)
+}
+
+// convertWire implements WireToASCII and WireToUnicode.
+func (c *Converter) convertWire(name []byte, convert func(int, string) (string, error)) ([]byte, error) {
+	labels, err := ParseWire(name)
+	if err != nil {
+		return name, err
+	}
+	for i, l := range labels {
+		if labels[i], err = convert(i, toLowerASCII(l)); err != nil {
+			return name, err
+		}
+	}
+	out, err := AppendWire(nil, labels)
+	if err != nil {
+		return name, err
+	}
+	return out, nil
+}
+
+// ParseWire returns the labels of a domain name in wire format, without the
+// root label.
+func ParseWire(name []byte) ([]string, error) {
+	if len(name) > MaxWireLength {
+		return nil, ErrDomainLength
+	}
+	var labels []string
+	for i := 0; ; {
+		if i == len(name) {
+			// The root label is missing.
+			return nil, ErrWireFormat
+		}
+		n := int(name[i])
+		if n == 0 {
+			if i+1 != len(name) {
+				return nil, ErrWireFormat
+			}
+			return labels, nil
+		}
+		if n > 63 || i+1+n > len(name) {
+			// Compression pointers and extended label types start with
+			// a length above 63.
+			return nil, ErrWireFormat
+		}
+		labels = append(labels, string(name[i+1:i+1+n]))
+		i += 1 + n
+	}
+}
+
+// AppendWire appends a domain name made of labels, in wire format, to out,
+// adding the root label.
+func AppendWire(out []byte, labels []string) ([]byte, error) {
+	start := len(out)
+	for i, l := range labels {
+		if len(l) == 0 || len(l) > 63 {
+			return out[:start], &LabelError{i, -1, 0, ErrLabelLength}
+		}
+		out = append(out, byte(len(l)))
+		out = append(out, l...)
+	}
+	out = append(out, 0)
+	if len(out)-start > MaxWireLength {
+		return out[:start], ErrDomainLength
+	}
+	return out, nil
+}
+
+// PresentationToASCII converts a domain name in the presentation format of
+// RFC 1035 section 5.1 to ASCII. Labels are separated by dots only, and "\X"
+// and "\DDD" escape a character or give a byte in decimal, so "a\.b.example"
+// has two labels, "a.b" and "example". Each label is converted on its own
+// with the procedure in RFC 3490 section 4.1, and the result is escaped
+// again. A trailing dot is kept.
+//
+// A dot, space or other non-LDH code point in a label is rejected with
+// ErrNonLDH when the UseSTD3ASCIIRules flag is set, as it is for Default,
+// Lookup and Registration, so names such as "a\.b.example." can only be
+// converted by a Converter made with UseSTD3ASCIIRules(false).
+//
+// If the name cannot be converted, it is returned unchanged with an error.
+func (c *Converter) PresentationToASCII(name string) (string, error) {
+	return c.convertPresentation(name, c.toASCIIRaw)
+}
+
+// PresentationToUnicode converts a domain name in presentation format to
+// Unicode with the procedure in RFC 3490 section 4.2, like
+// PresentationToASCII. Printable code points are not escaped in the result.
+func (c *Converter) PresentationToUnicode(name string) (string, error) {
+	return c.convertPresentation(name, c.toUnicodeRaw)
+}
+
+// convertPresentation implements PresentationToASCII and
+// PresentationToUnicode.
+func (c *Converter) convertPresentation(name string, convert func(int, string) (string, error)) (string, error) {
+	labels, absolute, err := ParsePresentation(name)
+	if err != nil {
+		return name, err
+	}
+	n := 1
+	for i, l := range labels {
+		if labels[i], err = convert(i, toLowerASCII(l)); err != nil {
+			return name, err
+		}
+		// The length of the name is that of its ACE form, whichever way
+		// it is converted.
+		ace := labels[i]
+		if c.verifyDNSLength && !isASCII(ace) {
+			if ace, err = c.toASCIIRaw(i, ace); err != nil {
+				return name, err
+			}
+		}
+		n += len(ace) + 1
+	}
+	if c.verifyDNSLength && n > MaxWireLength {
+		return name, ErrDomainLength
+	}
+	return FormatPresentation(labels, absolute), nil
+}
+
+// ParsePresentation returns the labels of a domain name in presentation
+// format, with their escapes replaced, and whether the name is absolute: if
+// it ends with a dot. The root name "." has no labels.
+func ParsePresentation(name string) (labels []string, absolute bool, err error) {
+	if name == "." {
+		return nil, true, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		switch c := name[i]; c {
+		case '.':
+			if b.Len() == 0 {
+				return nil, false, &LabelError{len(labels), -1, 0, ErrLabelLength}
+			}
+			labels = append(labels, b.String())
+			b.Reset()
+		case '\\':
+			r, n, ok := unescape(name[i:])
+			if !ok {
+				return nil, false, &LabelError{len(labels), -1, 0, ErrEscape}
+			}
+			b.WriteByte(r)
+			i += n - 1
+		default:
+			b.WriteByte(c)
+		}
+	}
+	if b.Len() == 0 {
+		if len(labels) == 0 {
+			return nil, false, &LabelError{0, -1, 0, ErrLabelLength}
+		}
+		return labels, true, nil
+	}
+	return append(labels, b.String()), false, nil
+}
+
+// unescape decodes the escape sequence s starts with, and returns the byte
+// it stands for and its length.
+func unescape(s string) (byte, int, bool) {
+	switch {
+	case len(s) < 2:
+		return 0, 0, false
+	case !isDigit(s[1]):
+		return s[1], 2, true
+	case len(s) < 4 || !isDigit(s[2]) || !isDigit(s[3]):
+		return 0, 0, false
+	}
+	n := int(s[1]-'0')*100 + int(s[2]-'0')*10 + int(s[3]-'0')
+	if n > 255 {
+		return 0, 0, false
+	}
+	return byte(n), 4, true
+}
+
+// UnescapeLabel returns a label in presentation format with its "\X" and
+// "\DDD" escapes replaced. Unescaped dots are kept.
+func UnescapeLabel(label string) (string, error) {
+	if strings.IndexByte(label, '\\') < 0 {
+		return label, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(label); i++ {
+		if label[i] != '\\' {
+			b.WriteByte(label[i])
+			continue
+		}
+		r, n, ok := unescape(label[i:])
+		if !ok {
+			return "", ErrEscape
+		}
+		b.WriteByte(r)
+		i += n - 1
+	}
+	return b.String(), nil
+}
+
+// FormatPresentation returns the domain name made of labels in presentation
+// format, with a trailing dot if it is absolute. Each label is escaped with
+// EscapeLabel.
+func FormatPresentation(labels []string, absolute bool) string {
+	if len(labels) == 0 && absolute {
+		return "."
+	}
+	var b strings.Builder
+	for i, l := range labels {
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(EscapeLabel(l))
+	}
+	if absolute {
+		b.WriteByte('.')
+	}
+	return b.String()
+}
+
+// EscapeLabel returns a label in presentation format. Dots and the
+// characters with a special meaning in zone files are escaped with a
+// backslash, and the bytes of spaces, control characters, invalid UTF-8 and
+// other code points that are not printable are given as "\DDD".
+func EscapeLabel(label string) string {
+	var b strings.Builder
+	for i := 0; i < len(label); {
+		r, size := utf8.DecodeRuneInString(label[i:])
+		switch {
+		case strings.ContainsRune(`.'@;()"\$`, r):
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == utf8.RuneError || r <= ' ' || r == 0x7F || !unicode.IsPrint(r):
+			for j := i; j < i+size; j++ {
+				b.WriteByte('\\')
+				b.WriteByte('0' + label[j]/100)
+				b.WriteByte('0' + label[j]/10%10)
+				b.WriteByte('0' + label[j]%10)
+			}
+		default:
+			b.WriteRune(r)
+		}
+		i += size
+	}
+	return b.String()
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
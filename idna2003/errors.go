@@ -0,0 +1,47 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package idna2003
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by ToASCII and ToUnicode, and by the functions for DNS
+// names. Except for ErrDomainLength, ErrWireFormat and the errors of
+// UnescapeLabel they are wrapped in a *LabelError; use errors.Is to find out
+// why a domain name was rejected. Nameprep and Punycode failures wrap the
+// errors of the stringprep and punycode packages instead, such as
+// stringprep.ErrProhibited.
+var (
+	ErrNonLDH       = errors.New("Contains non-LDH ASCII codepoints")
+	ErrHyphen       = errors.New("Contains hyphen at either end of the string")
+	ErrACEPrefix    = errors.New("Label starts with ACE prefix")
+	ErrLabelLength  = errors.New("Label empty or too long")
+	ErrDomainLength = errors.New("Domain name too long")
+	ErrVerification = errors.New("Failed verification step")
+	ErrEscape       = errors.New("Invalid escape sequence")
+	ErrWireFormat   = errors.New("Malformed wire-format name")
+)
+
+// A LabelError describes a label of a domain name that could not be
+// converted.
+type LabelError struct {
+	Label  int   // index of the label in the domain name
+	Offset int   // byte offset of Rune in the label, or -1
+	Rune   rune  // the offending rune, if Offset is not -1
+	Err    error // the reason for the error
+}
+
+func (e *LabelError) Error() string {
+	if e.Offset < 0 {
+		return fmt.Sprintf("idna: label %d: %v", e.Label, e.Err)
+	}
+	return fmt.Sprintf("idna: label %d: %v: %U at offset %d", e.Label, e.Err, e.Rune, e.Offset)
+}
+
+func (e *LabelError) Unwrap() error { return e.Err }
@@ -10,11 +10,11 @@
 package idna2003
 
 import (
-	"errors"
 	"strings"
 
 	"github.com/DanielOaks/go-idn/idna2003/punycode"
 	"github.com/DanielOaks/go-idn/idna2003/stringprep"
+	"github.com/DanielOaks/go-idn/script"
 )
 
 // IDNA section 5
@@ -26,57 +26,90 @@ const (
 // section 4.1. Unassigned characters are not allowed and STD3 ASCII rules are
 // enforced. The input string may be a domain name containing dots.
 func ToASCII(label string) (string, error) {
+	return Default.ToASCII(label)
+}
 
-	label = strings.ToLower(label)
-	o := ""
-	h := ""
+// Converts a Punycode string to Unicode using the procedure in RFC 3490
+// section 4.2. Unassigned characters are not allowed and STD3 ASCII
+// rules are enforced. The input string may be a domain name
+// containing dots.
+//
+// ToUnicode never fails.  If any step fails, then the original input
+// sequence is returned immediately in that step.
+func ToUnicode(label string) (string, error) {
+	return Default.ToUnicode(label)
+}
 
-	for _, cp := range label {
+// ToASCII converts a Unicode string to ASCII using the procedure in RFC 3490
+// section 4.1 with the flags of the Converter. The input string may be a
+// domain name containing dots.
+func (c *Converter) ToASCII(label string) (string, error) {
+	label = toLowerASCII(label)
 
-		if cp == 0x2E /* dot */ || cp == 0x3002 || cp == 0xff0e || cp == 0xff61 {
-			uh, err := toASCIIRaw(h)
-			if err != nil {
-				return label, err
-			}
-			o += uh
-			o += string(cp)
-			h = ""
-		} else {
-			h += string(cp)
+	labels := c.split(label)
+	for i, l := range labels {
+		if l == "" && i > 0 && i == len(labels)-1 {
+			// The empty root label after a trailing dot.
+			break
+		}
+		uh, err := c.toASCIIRaw(i, l)
+		if err != nil {
+			return label, err
 		}
+		labels[i] = uh
 	}
-	uh, err := toASCIIRaw(h)
-	if err != nil {
-		return label, err
+	o := strings.Join(labels, ".")
+
+	if c.verifyDNSLength && len(strings.TrimSuffix(o, ".")) > 253 {
+		return label, ErrDomainLength
 	}
-	o += uh
 	return o, nil
 }
 
-func toASCIIRaw(label string) (string, error) {
+func (c *Converter) toASCIIRaw(index int, label string) (string, error) {
 	original := label
 
 	// Step 1: If the sequence contains any code points outside the ASCII range
 	// (0..7F) then proceed to step 2, otherwise skip to step 3.
 	for i := 0; i < len(label); i++ {
 		if label[i] > 127 {
-			// Step 2: Perform the smake teps specified in [NAMEPREP] and fail if there is an error.
+			// Step 2: Perform the steps specified in [NAMEPREP] and fail if there is an error.
 			// The AllowUnassigned flag is used in [NAMEPREP].
-			// BUG(dan) we should check error value here
-			label, _ = stringprep.Nameprep(label)
+			var err error
+			label, err = c.nameprep(label)
+			if err != nil {
+				return original, &LabelError{index, -1, 0, err}
+			}
 			break
 		}
 	}
 
-	// Step 3: - Verify the absence of non-LDH ASCII code points
-	for _, c := range label {
-		if (c <= 0x2c) || (c >= 0x2e && c <= 0x2f) || (c >= 0x3a && c <= 0x40) || (c >= 0x5b && c <= 0x60) || (c >= 0x7b && c <= 0x7f) {
-			return original, errors.New("Contains non-LDH ASCII codepoints")
+	// Step 3: If the UseSTD3ASCIIRules flag is set, then perform these checks:
+	if c.useSTD3ASCIIRules {
+		// (a) Verify the absence of non-LDH ASCII code points
+		for i, r := range label {
+			if isNonLDH(r) {
+				return original, &LabelError{index, i, r, ErrNonLDH}
+			}
+		}
+		// (b) Verify the absence of leading and trailing hyphen-minus
+		if strings.HasPrefix(label, "-") {
+			return original, &LabelError{index, 0, '-', ErrHyphen}
+		}
+		if strings.HasSuffix(label, "-") {
+			return original, &LabelError{index, len(label) - 1, '-', ErrHyphen}
 		}
-
 	}
-	if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
-		return original, errors.New("Contains hyphen at either end of the string")
+
+	// The script policy of the Converter, if any, is not part of RFC 3490 and
+	// is checked on the prepared label.
+	if c.scripts != nil {
+		if err := c.scripts.Check(label); err != nil {
+			if e, ok := err.(*script.Error); ok {
+				return original, &LabelError{index, e.Offset, e.Rune, e.Err}
+			}
+			return original, &LabelError{index, -1, 0, err}
+		}
 	}
 
 	// Step 4: If the sequence contains any code points outside the ASCII range
@@ -95,7 +128,7 @@ func toASCIIRaw(label string) (string, error) {
 
 		// Step 5 Verify that the sequence does NOT begin with the ACE prefix.
 		if strings.HasPrefix(label, AcePrefix) {
-			return label, errors.New("Label starts with ACE prefix")
+			return label, &LabelError{index, -1, 0, ErrACEPrefix}
 		}
 
 		var err error
@@ -103,7 +136,7 @@ func toASCIIRaw(label string) (string, error) {
 		// Step 6: Encode with punycode
 		label, err = punycode.EncodeString(label)
 		if err != nil {
-			return "", err // delegate err
+			return "", &LabelError{index, -1, 0, err}
 		}
 		// Step 7: Prepend ACE prefix
 		label = AcePrefix + label
@@ -114,46 +147,30 @@ func toASCIIRaw(label string) (string, error) {
 		return label, nil
 	}
 
-	return original, errors.New("label empty or too long")
+	return original, &LabelError{index, -1, 0, ErrLabelLength}
 }
 
-//
-// Converts a Punycode string to Unicode using the procedure in RFC 3490
-// section 4.2. Unassigned characters are not allowed and STD3 ASCII
-// rules are enforced. The input string may be a domain name
-// containing dots.
+// ToUnicode converts a Punycode string to Unicode using the procedure in
+// RFC 3490 section 4.2 with the flags of the Converter. The input string may
+// be a domain name containing dots.
 //
 // ToUnicode never fails.  If any step fails, then the original input
 // sequence is returned immediately in that step.
-func ToUnicode(label string) (string, error) {
-
-	label = strings.ToLower(label)
-	o := ""
-	h := ""
-
-	for _, cp := range label {
+func (c *Converter) ToUnicode(label string) (string, error) {
+	label = toLowerASCII(label)
 
-		if cp == 0x2E /* dot */ || cp == 0x3002 || cp == 0xff0e || cp == 0xff61 {
-			uh, err := toUnicodeRaw(h)
-			if err != nil {
-				return label, err
-			}
-			o += uh
-			o += string(cp)
-			h = ""
-		} else {
-			h += string(cp)
+	labels := c.split(label)
+	for i, l := range labels {
+		uh, err := c.toUnicodeRaw(i, l)
+		if err != nil {
+			return label, err
 		}
+		labels[i] = uh
 	}
-	uh, err := toUnicodeRaw(h)
-	if err != nil {
-		return label, err
-	}
-	o += uh
-	return o, nil
+	return strings.Join(labels, "."), nil
 }
 
-func toUnicodeRaw(label string) (string, error) {
+func (c *Converter) toUnicodeRaw(index int, label string) (string, error) {
 
 	original := label
 
@@ -161,42 +178,77 @@ func toUnicodeRaw(label string) (string, error) {
 	for i := 0; i < len(label); i++ {
 		if label[i] > 127 {
 			// Step 2: Perform the steps specified in [NAMEPREP] and fail if there is an error.
-			// BUG(dan) We should check error value here
-			label, _ = stringprep.Nameprep(label)
+			var err error
+			label, err = c.nameprep(label)
+			if err != nil {
+				return original, &LabelError{index, -1, 0, err}
+			}
 			break
 		}
 	}
 
 	// Step 3: Verify that the sequence begins with the ACE prefix, and save a copy of the sequence.
 	if !strings.HasPrefix(label, AcePrefix) {
-		return label, errors.New("Label doesn't begin with the ACE prefix")
-	} // else
+		return original, nil
+	}
+	saved := label
 
 	// 4. Remove the ACE prefix.
-	label = strings.SplitN(label, AcePrefix, -1)[1]
+	label = label[len(AcePrefix):]
 
 	// 5. Decode the sequence using the decoding algorithm in [PUNYCODE] and fail if there is an error.
-	//fmt.Printf(label+"\n")
 	results, err := punycode.DecodeString(label)
 
 	if err != nil {
-		return original, errors.New("Failed punycode decoding: " + err.Error())
+		return original, &LabelError{index, -1, 0, err}
 	}
 
 	// 6. Apply ToASCII.
-	verification, err := ToASCII(label)
+	verification, err := c.toASCIIRaw(index, results)
 
 	if err != nil {
-		return original, errors.New("Failed ToASCII on the decoded sequence: " + err.Error())
+		return original, err
 	}
 
 	// 7. Verify that the result of step 6 matches the saved copy from step 3,
 	// 	  using a case-insensitive ASCII comparison.
-	if strings.ToLower(verification) == strings.ToLower(original) {
+	if strings.ToLower(verification) == strings.ToLower(saved) {
 		return results, nil
 	}
 
-	return original, errors.New("Failed verification step")
+	return original, &LabelError{index, -1, 0, ErrVerification}
+}
+
+// nameprep applies the Nameprep profile to label, honouring the
+// AllowUnassigned flag of the Converter.
+func (c *Converter) nameprep(label string) (string, error) {
+	var flags stringprep.Flags
+	if c.allowUnassigned {
+		flags |= stringprep.AllowUnassigned
+	}
+	output, err := stringprep.PrepareRunesFlags(stringprep.Profiles["nameprep"], []rune(label), flags)
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
+// toLowerASCII lowercases the ASCII letters in s. Other code points are case
+// folded by Nameprep, which unlike strings.ToLower maps U+0130 to "i\u0307".
+func toLowerASCII(s string) string {
+	b := []byte(s)
+	for i, ch := range b {
+		if 'A' <= ch && ch <= 'Z' {
+			b[i] = ch + 'a' - 'A'
+		}
+	}
+	return string(b)
+}
+
+// Returns true if c is an ASCII code point other than a letter, digit or
+// hyphen, as forbidden by the UseSTD3ASCIIRules flag.
+func isNonLDH(c rune) bool {
+	return (c <= 0x2c) || (c >= 0x2e && c <= 0x2f) || (c >= 0x3a && c <= 0x40) || (c >= 0x5b && c <= 0x60) || (c >= 0x7b && c <= 0x7f)
 }
 
 // Returns true if c is a label separator as defined by section 3.1 in RFC 3490
@@ -0,0 +1,445 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package idna2003
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/DanielOaks/go-idn/idna2003/punycode"
+	"github.com/DanielOaks/go-idn/idna2003/stringprep"
+	"github.com/DanielOaks/go-idn/script"
+)
+
+type convertertestcase struct {
+	Converter *Converter
+	Input     string
+	Output    string
+	Ok        bool
+}
+
+var converterToASCIITests = []convertertestcase{
+	{Default, "bücher.example", "xn--bcher-kva.example", true},
+	{Default, "Bücher.Example", "xn--bcher-kva.example", true},
+	{Default, "bücher。example．com｡", "xn--bcher-kva.example.com.", true},
+	{Default, "under_score.example", "", false},
+	{Default, "-leading.example", "", false},
+	{Default, "a..b", "", false},
+	{New(UseSTD3ASCIIRules(false)), "under_score.example", "under_score.example", true},
+	{New(UseSTD3ASCIIRules(false)), "-leading.example", "-leading.example", true},
+	{Default, "ȡ.example", "", false},
+	{Lookup, "ȡ.example", "xn--6la.example", true},
+	{New(Separators('.')), "bücher。example", "xn--bcherexample-dlb0569n", true},
+	{New(Separators('.', '/')), "bücher/example", "xn--bcher-kva.example", true},
+	{New(Separators()), "bücher。example", "xn--bcherexample-dlb0569n", true},
+	{New(Separators()), "bücher.example", "xn--bcher-kva.example", true},
+	{New(Separators('/')), "bücher.x/example", "xn--bcher-kva.x.example", true},
+	{Registration, longDomain(4), longDomain(4), true},
+	{Registration, longDomain(5), "", false},
+	{Default, longDomain(5), longDomain(5), true},
+	{Default, "p\u0430ypal.example", "xn--pypal-4ve.example", true},
+	{New(Scripts(script.SingleScript)), "p\u0430ypal.example", "", false},
+	{New(Scripts(script.SingleScript)), "bücher.example", "xn--bcher-kva.example", true},
+	{New(Scripts(script.SingleScript)), "日本語かな.example", "", false},
+	{New(Scripts(script.Japanese)), "日本語かな.example", "xn--u8j2c547sncbk91h.example", true},
+}
+
+var converterToUnicodeTests = []convertertestcase{
+	{Default, "xn--bcher-kva.example", "bücher.example", true},
+	{Default, "XN--BCHER-KVA.EXAMPLE", "bücher.example", true},
+	{Default, "xn--bcher-kva。example", "bücher.example", true},
+	{Default, "www.example.com", "www.example.com", true},
+	{Default, "xn--6la.example", "", false},
+	{Lookup, "xn--6la.example", "ȡ.example", true},
+	{Default, "xn--a-1ga", "aö", true},
+	{Default, "xn--bcher-k!a", "", false},
+	{Default, "bücher.example.", "bücher.example.", true},
+	{Default, "xn--bcher-kva", "bücher", true},
+}
+
+// longDomain returns a domain name of n labels of 62 octets, so four labels
+// are 251 octets long and five are 314.
+func longDomain(n int) string {
+	label := "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghij"
+	s := label
+	for i := 1; i < n; i++ {
+		s += "." + label
+	}
+	return s
+}
+
+func TestConverterToASCII(t *testing.T) {
+	for _, test := range converterToASCIITests {
+		output, err := test.Converter.ToASCII(test.Input)
+		if (err == nil) != test.Ok {
+			t.Errorf("ToASCII(%+q) error = %v", test.Input, err)
+			continue
+		}
+		if test.Ok && output != test.Output {
+			t.Errorf("ToASCII(%+q) = %+q, want %+q", test.Input, output, test.Output)
+		}
+	}
+}
+
+func TestConverterToUnicode(t *testing.T) {
+	for _, test := range converterToUnicodeTests {
+		output, err := test.Converter.ToUnicode(test.Input)
+		if (err == nil) != test.Ok {
+			t.Errorf("ToUnicode(%+q) error = %v", test.Input, err)
+			continue
+		}
+		if test.Ok && output != test.Output {
+			t.Errorf("ToUnicode(%+q) = %+q, want %+q", test.Input, output, test.Output)
+		}
+	}
+}
+
+type errortestcase struct {
+	Input  string
+	Err    error
+	Label  int
+	Offset int
+	Rune   rune
+}
+
+var toASCIIErrorTests = []errortestcase{
+	{"www.under_score.example", ErrNonLDH, 1, 5, '_'},
+	{"www.example-", ErrHyphen, 1, 7, '-'},
+	{"-www.example", ErrHyphen, 0, 0, '-'},
+	{"a..b", ErrLabelLength, 1, -1, 0},
+	{"xn--bücher.example", ErrACEPrefix, 0, -1, 0},
+}
+
+func TestToASCIIErrors(t *testing.T) {
+	for _, test := range toASCIIErrorTests {
+		_, err := ToASCII(test.Input)
+		var lerr *LabelError
+		if !errors.As(err, &lerr) {
+			t.Errorf("ToASCII(%+q) error = %v; want *LabelError", test.Input, err)
+			continue
+		}
+		if !errors.Is(err, test.Err) || lerr.Label != test.Label || lerr.Offset != test.Offset || lerr.Rune != test.Rune {
+			t.Errorf("ToASCII(%+q) error = %+v; want %+v", test.Input, *lerr, test)
+		}
+	}
+
+	if _, err := Registration.ToASCII(longDomain(5)); err != ErrDomainLength {
+		t.Errorf("Registration.ToASCII(longDomain(5)) error = %v; want %v", err, ErrDomainLength)
+	}
+}
+
+func TestScriptErrors(t *testing.T) {
+	c := New(Scripts(script.SingleScript))
+	_, err := c.ToASCII("example.p\u0430ypal")
+	var lerr *LabelError
+	if !errors.As(err, &lerr) || !errors.Is(err, script.ErrMixedScript) || lerr.Label != 1 || lerr.Offset != 1 || lerr.Rune != '\u0430' {
+		t.Errorf("ToASCII(%+q) error = %v; want script.ErrMixedScript at offset 1 of label 1", "example.p\u0430ypal", err)
+	}
+	_, err = c.ToUnicode("example.xn--pypal-4ve")
+	if !errors.Is(err, script.ErrMixedScript) {
+		t.Errorf("ToUnicode(%+q) error = %v; want script.ErrMixedScript", "example.xn--pypal-4ve", err)
+	}
+}
+
+func TestToUnicodeErrors(t *testing.T) {
+	_, err := ToUnicode("example.xn--bcher-k!a")
+	var lerr *LabelError
+	if !errors.As(err, &lerr) || lerr.Label != 1 || !errors.Is(err, punycode.ErrBadInput) {
+		t.Errorf("ToUnicode(%+q) error = %v; want punycode.ErrBadInput in label 1", "example.xn--bcher-k!a", err)
+	}
+}
+
+type vectortestcase struct {
+	Input  string
+	Output string
+}
+
+// IDNA2003 conversions; the Punycode vectors are from RFC 3492 section 7.1.
+var toASCIITests = []vectortestcase{
+	{"ليهمابتكلموشعربي؟", "xn--egbpdaj6bu4bxfgehfvwxn"},
+	{"他们为什么不说中文", "xn--ihqwcrb4cv8a8dqg056pqjye"},
+	{"אבג", "xn--4dbcd"},
+	{"ا1ب", "xn--1-ymce"},
+	{"bücher", "xn--bcher-kva"},
+	{"Maße", "masse"},
+	{"foo\u00adbar", "foobar"},
+	{"İstanbul", "xn--istanbul-o0e"},
+	{"Σοφία", "xn--kxae4bpq"},
+	{"℡.example", "tel.example"},
+}
+
+type nameprepErrorTestCase struct {
+	Input string
+	Err   error
+	Table string
+	Rune  rune
+}
+
+// Nameprep failures, from
+// http://tools.ietf.org/html/draft-josefsson-idn-test-vectors-00#section-4
+var nameprepErrorTests = []nameprepErrorTestCase{
+	{"foo\u1680bar", stringprep.ErrProhibited, "C.1.2", 0x1680},
+	{"foo\u0085bar", stringprep.ErrProhibited, "C.2.2", 0x0085},
+	{"foo\u180ebar", stringprep.ErrProhibited, "C.2.2", 0x180e},
+	{"foo\U0001d175bar", stringprep.ErrProhibited, "C.2.2", 0x1d175},
+	{"foo\uf123bar", stringprep.ErrProhibited, "C.3", 0xf123},
+	{"foo\U000f1234bar", stringprep.ErrProhibited, "C.3", 0xf1234},
+	{"foo\U0008fffebar", stringprep.ErrProhibited, "C.4", 0x8fffe},
+	{"foo\ufffdbar", stringprep.ErrProhibited, "C.6", 0xfffd},
+	{"foo\u2ff5bar", stringprep.ErrProhibited, "C.7", 0x2ff5},
+	{"foo\u200ebar", stringprep.ErrProhibited, "C.8", 0x200e},
+	{"foo\u202abar", stringprep.ErrProhibited, "C.8", 0x202a},
+	{"foo\U000e0001bar", stringprep.ErrProhibited, "C.9", 0xe0001},
+	{"foo\U000e0042bar", stringprep.ErrProhibited, "C.9", 0xe0042},
+	{"foo\u0221bar", stringprep.ErrUnassigned, "A.1", 0x0221},
+	{"foo\U000e0002bar", stringprep.ErrUnassigned, "A.1", 0xe0002},
+	{"foo\u05bebar", stringprep.ErrBidi, "D.2", 'f'},
+	{"foo\ufd50bar", stringprep.ErrBidi, "D.2", 'f'},
+	{"\u06271", stringprep.ErrBidi, "D.1", '1'},
+}
+
+func TestToASCII(t *testing.T) {
+	for _, test := range toASCIITests {
+		output, err := ToASCII(test.Input)
+		if err != nil || output != test.Output {
+			t.Errorf("ToASCII(%+q) = %+q, %v; want %+q", test.Input, output, err, test.Output)
+		}
+	}
+}
+
+func TestToUnicode(t *testing.T) {
+	for _, test := range toASCIITests {
+		want, err := stringprep.Nameprep(test.Input)
+		if err != nil {
+			t.Fatalf("Nameprep(%+q) error = %v", test.Input, err)
+		}
+		output, err := ToUnicode(test.Output)
+		if err != nil || output != want {
+			t.Errorf("ToUnicode(%+q) = %+q, %v; want %+q", test.Output, output, err, want)
+		}
+	}
+}
+
+func TestNameprepErrors(t *testing.T) {
+	for _, test := range nameprepErrorTests {
+		domain := "www." + test.Input + ".example"
+		output, err := ToASCII(domain)
+		var lerr *LabelError
+		var serr *stringprep.Error
+		if !errors.As(err, &lerr) || !errors.As(err, &serr) {
+			t.Errorf("ToASCII(%+q) = %+q, %v; want Nameprep error", domain, output, err)
+			continue
+		}
+		if lerr.Label != 1 || !errors.Is(err, test.Err) || serr.Table != test.Table || serr.Rune != test.Rune {
+			t.Errorf("ToASCII(%+q) error = %v; want %v in table %s for %U in label 1", domain, err, test.Err, test.Table, test.Rune)
+		}
+		if output != domain {
+			t.Errorf("ToASCII(%+q) = %+q; want the input back", domain, output)
+		}
+
+		if _, err := ToUnicode(domain); !errors.Is(err, test.Err) {
+			t.Errorf("ToUnicode(%+q) error = %v; want %v", domain, err, test.Err)
+		}
+	}
+
+	// Queries may contain unassigned code points.
+	if output, err := Lookup.ToASCII("fooȡbar"); err != nil || output != "xn--foobar-rnc" {
+		t.Errorf("Lookup.ToASCII(%+q) = %+q, %v; want %+q", "fooȡbar", output, err, "xn--foobar-rnc")
+	}
+}
+
+func TestValidateDomain(t *testing.T) {
+	r := ValidateDomain("Café.例え.テスト.")
+	if !r.Valid() || !r.TrailingDot || len(r.Labels) != 3 {
+		t.Fatalf("ValidateDomain(%+q) = %+v; want valid with 3 labels and a trailing dot", "Café.例え.テスト.", r)
+	}
+	if r.Labels[0].Original != "Café" || r.Labels[0].Prepared != "café" || r.Labels[0].ACE != "xn--caf-dma" {
+		t.Errorf("ValidateDomain label 0 = %+v", r.Labels[0])
+	}
+	if ace, _ := ToASCII("Café.例え.テスト."); r.ACE() != ace {
+		t.Errorf("ValidateDomain ACE = %+q; want %+q", r.ACE(), ace)
+	}
+
+	r = ValidateDomain("a_b-.foo\u1680barȡ..xn--bcher-k!a")
+	if r.Valid() || r.TrailingDot || len(r.Labels) != 4 {
+		t.Fatalf("ValidateDomain = %+v; want 4 invalid labels", r)
+	}
+	violations := [][]error{
+		{ErrNonLDH, ErrHyphen},
+		{stringprep.ErrProhibited, stringprep.ErrUnassigned},
+		{ErrLabelLength},
+		{ErrNonLDH, punycode.ErrBadInput},
+	}
+	for i, want := range violations {
+		got := r.Labels[i].Violations
+		if len(got) != len(want) {
+			t.Errorf("label %d violations = %v; want %v", i, got, want)
+			continue
+		}
+		for j := range want {
+			var lerr *LabelError
+			if !errors.Is(got[j], want[j]) || !errors.As(got[j], &lerr) || lerr.Label != i {
+				t.Errorf("label %d violation %d = %v; want %v", i, j, got[j], want[j])
+			}
+		}
+	}
+	// Offsets are in bytes: U+0221 follows the three bytes of U+1680.
+	var serr *stringprep.Error
+	if v := r.Labels[1].Violations; len(v) == 2 && (!errors.As(v[1], &serr) || serr.Offset != 9 || serr.Rune != '\u0221') {
+		t.Errorf("label 1 violation 1 = %v; want U+0221 at offset 9", v[1])
+	}
+	if r.Labels[1].Prepared != "" || r.Labels[1].ACE != "" {
+		t.Errorf("label 1 = %+v; want no prepared or ACE form", r.Labels[1])
+	}
+
+	r = ValidateDomain(longDomain(5))
+	if r.Valid() || r.Err() != ErrDomainLength {
+		t.Errorf("ValidateDomain(longDomain(5)) error = %v; want %v", r.Err(), ErrDomainLength)
+	}
+}
+
+func TestLabelConversion(t *testing.T) {
+	c := New(UseSTD3ASCIIRules(false))
+	if out, err := c.LabelToASCII("A.Bü"); err != nil || out != "xn--a.b-joa" {
+		t.Errorf("LabelToASCII(%+q) = %+q, %v; want %+q", "A.Bü", out, err, "xn--a.b-joa")
+	}
+	if out, err := c.LabelToUnicode("XN--a.b-joa"); err != nil || out != "a.bü" {
+		t.Errorf("LabelToUnicode(%+q) = %+q, %v; want %+q", "XN--a.b-joa", out, err, "a.bü")
+	}
+	var lerr *LabelError
+	if _, err := Default.LabelToASCII("a.bü"); !errors.As(err, &lerr) || lerr.Err != ErrNonLDH || lerr.Offset != 1 {
+		t.Errorf("Default.LabelToASCII(%+q) error = %v; want ErrNonLDH at offset 1", "a.bü", err)
+	}
+}
+
+var presentationTests = []struct {
+	Converter *Converter
+	Input     string
+	ASCII     string
+	Unicode   string
+}{
+	{Default, "bücher.example", "xn--bcher-kva.example", "bücher.example"},
+	{Default, "Bücher.Example.", "xn--bcher-kva.example.", "bücher.example."},
+	{Default, ".", ".", "."},
+	{Default, `\098\195\188cher.example`, "xn--bcher-kva.example", "bücher.example"},
+	{Default, "bücher。example", "xn--bcherexample-dlb0569n", "bücher。example"},
+	{New(UseSTD3ASCIIRules(false)), `bücher\.x.example.`, `xn--bcher\.x-n2a.example.`, `bücher\.x.example.`},
+	{New(UseSTD3ASCIIRules(false)), `a\ b\(c\).example`, `a\032b\(c\).example`, `a\032b\(c\).example`},
+}
+
+func TestPresentation(t *testing.T) {
+	for _, test := range presentationTests {
+		ascii, err := test.Converter.PresentationToASCII(test.Input)
+		if err != nil || ascii != test.ASCII {
+			t.Errorf("PresentationToASCII(%+q) = %+q, %v; want %+q", test.Input, ascii, err, test.ASCII)
+		}
+		unicode, err := test.Converter.PresentationToUnicode(ascii)
+		if err != nil || unicode != test.Unicode {
+			t.Errorf("PresentationToUnicode(%+q) = %+q, %v; want %+q", ascii, unicode, err, test.Unicode)
+		}
+	}
+
+	errorTests := []struct {
+		input string
+		err   error
+		label int
+	}{
+		{"", ErrLabelLength, 0},
+		{"a..b", ErrLabelLength, 1},
+		{`a.b\25`, ErrEscape, 1},
+		{`a\256.b`, ErrEscape, 0},
+		{`a.b\`, ErrEscape, 1},
+		{"a.b_c", ErrNonLDH, 1},
+		{`a\.b.example.`, ErrNonLDH, 0},
+	}
+	for _, test := range errorTests {
+		out, err := Default.PresentationToASCII(test.input)
+		var lerr *LabelError
+		if !errors.As(err, &lerr) || !errors.Is(err, test.err) || lerr.Label != test.label || out != test.input {
+			t.Errorf("PresentationToASCII(%+q) = %+q, %v; want %v in label %d", test.input, out, err, test.err, test.label)
+		}
+	}
+
+	if _, err := Registration.PresentationToASCII(longDomain(5)); err != ErrDomainLength {
+		t.Errorf("Registration.PresentationToASCII(longDomain(5)) error = %v; want %v", err, ErrDomainLength)
+	}
+
+	// The length limit applies to the ACE form in both directions.
+	long := strings.Repeat("bücher.", 20)
+	if _, err := Registration.PresentationToUnicode(long); err != ErrDomainLength {
+		t.Errorf("Registration.PresentationToUnicode(%+q) error = %v; want %v", long, err, ErrDomainLength)
+	}
+	short := strings.Repeat(strings.Repeat("例", 20)+".", 5)
+	if out, err := Registration.PresentationToUnicode(short); out != short || err != nil {
+		t.Errorf("Registration.PresentationToUnicode(%+q) = %+q, %v; want it unchanged", short, out, err)
+	}
+}
+
+func TestWire(t *testing.T) {
+	unicode := []byte("\x07b\xc3\xbccher\x07example\x00")
+	ascii := []byte("\x0dxn--bcher-kva\x07example\x00")
+	if out, err := Default.WireToASCII(unicode); err != nil || string(out) != string(ascii) {
+		t.Errorf("WireToASCII(%+q) = %+q, %v; want %+q", unicode, out, err, ascii)
+	}
+	if out, err := Default.WireToUnicode(ascii); err != nil || string(out) != string(unicode) {
+		t.Errorf("WireToUnicode(%+q) = %+q, %v; want %+q", ascii, out, err, unicode)
+	}
+	dotted := []byte("\x05a.b\xc3\xbc\x00")
+	if out, err := New(UseSTD3ASCIIRules(false)).WireToASCII(dotted); err != nil || string(out) != "\x0bxn--a.b-joa\x00" {
+		t.Errorf("WireToASCII(%+q) = %+q, %v", dotted, out, err)
+	}
+	if out, err := Default.WireToASCII([]byte{0}); err != nil || string(out) != "\x00" {
+		t.Errorf("WireToASCII(root) = %+q, %v", out, err)
+	}
+
+	for _, name := range []string{"", "\x03abc", "\x03abc\x00\x00", "\x05abc\x00", "\xc0\x0c", "\x03abc\xc0\x0c"} {
+		if out, err := Default.WireToASCII([]byte(name)); err != ErrWireFormat || string(out) != name {
+			t.Errorf("WireToASCII(%+q) = %+q, %v; want %v", name, out, err, ErrWireFormat)
+		}
+	}
+
+	long := []byte(strings.Repeat("\x3f"+strings.Repeat("a", 63), 4) + "\x00")
+	if _, err := Default.WireToASCII(long); err != ErrDomainLength {
+		t.Errorf("WireToASCII(long name) error = %v; want %v", err, ErrDomainLength)
+	}
+	var lerr *LabelError
+	if _, err := AppendWire(nil, []string{"a", strings.Repeat("ü", 32)}); !errors.As(err, &lerr) || lerr.Label != 1 || lerr.Err != ErrLabelLength {
+		t.Errorf("AppendWire(long label) error = %v; want ErrLabelLength in label 1", err)
+	}
+	if labels, err := ParseWire([]byte("\x01a\x02bc\x00")); err != nil || strings.Join(labels, "|") != "a|bc" {
+		t.Errorf("ParseWire = %q, %v", labels, err)
+	}
+}
+
+var escapeLabelTests = []struct {
+	in, out string
+}{
+	{"example", "example"},
+	{"a.b", `a\.b`},
+	{`a"b(c);@$\'`, `a\"b\(c\)\;\@\$\\\'`},
+	{"a b\t\x7f", `a\032b\009\127`},
+	{"bücher", "bücher"},
+	{"a\u200Eb", `a\226\128\142b`},
+	{"a\xffb", `a\255b`},
+}
+
+func TestEscapeLabel(t *testing.T) {
+	for _, test := range escapeLabelTests {
+		if out := EscapeLabel(test.in); out != test.out {
+			t.Errorf("EscapeLabel(%+q) = %+q; want %+q", test.in, out, test.out)
+		}
+		if in, err := UnescapeLabel(test.out); err != nil || in != test.in {
+			t.Errorf("UnescapeLabel(%+q) = %+q, %v; want %+q", test.out, in, err, test.in)
+		}
+	}
+	for _, s := range []string{`a\`, `\25`, `\256`, `\1a1`} {
+		if _, err := UnescapeLabel(s); err != ErrEscape {
+			t.Errorf("UnescapeLabel(%+q) error = %v; want ErrEscape", s, err)
+		}
+	}
+}
@@ -13,6 +13,7 @@ package punycode
 import (
 	"bytes"
 	"errors"
+	"strconv"
 )
 
 const (
@@ -31,6 +32,26 @@ const (
 	MaxRune = '\U0010FFFF'
 )
 
+// Errors returned by Decode, wrapped in an *Error.
+var (
+	ErrBadInput = errors.New("Bad Input")
+	ErrOverflow = errors.New("Overflow")
+	ErrNonASCII = errors.New("Non-ASCII codepoint found in input")
+)
+
+// An Error describes a Punycode sequence that could not be decoded. Use
+// errors.Is to compare it with ErrBadInput, ErrOverflow or ErrNonASCII.
+type Error struct {
+	Offset int   // byte offset in the input where the error was found
+	Err    error // the reason for the error
+}
+
+func (e *Error) Error() string {
+	return "punycode: " + e.Err.Error() + " at offset " + strconv.Itoa(e.Offset)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
 func EncodeString(s string) (string, error) {
 	p, err := Encode([]byte(s))
 	if err != nil {
@@ -151,8 +172,7 @@ func Decode(b []byte) (p []byte, err error) {
 	// Only ASCII allowed in decoding procedure
 	for j := 0; j < len(b); j++ {
 		if b[j] >= 0x80 {
-			err = errors.New("Non-ASCCI codepoint found in b")
-			return
+			return nil, &Error{j, ErrNonASCII}
 		}
 	}
 
@@ -172,7 +192,7 @@ func Decode(b []byte) (p []byte, err error) {
 			var t int
 
 			if pos == len(b) {
-				return nil, errors.New("Bad Input")
+				return nil, &Error{pos, ErrBadInput}
 			}
 
 			// consume a code point, or fail if there was none to consume
@@ -180,9 +200,12 @@ func Decode(b []byte) (p []byte, err error) {
 			pos++
 
 			digit := codepoint2digit(cp)
+			if digit >= Base {
+				return nil, &Error{pos - 1, ErrBadInput}
+			}
 
 			if digit > ((MaxRune - i) / w) {
-				return nil, errors.New("Bad Input")
+				return nil, &Error{pos - 1, ErrOverflow}
 			}
 
 			i = i + digit*w
@@ -206,7 +229,7 @@ func Decode(b []byte) (p []byte, err error) {
 		bias = adapt(i-oldi, oldi == 0, len(result)+1)
 
 		if i/(len(result)+1) > (MaxRune - n) {
-			return nil, errors.New("Overflow")
+			return nil, &Error{pos, ErrOverflow}
 		}
 
 		n = n + i/(len(result)+1)
@@ -247,11 +270,11 @@ func adapt(delta int, first bool, numchars int) (bias int) {
 // base-1, or base if cp does not represent a value.
 func codepoint2digit(r rune) int {
 	switch {
-	case r-48 < 10:
+	case '0' <= r && r <= '9':
 		return int(r - 22)
-	case r-65 < 26:
+	case 'A' <= r && r <= 'Z':
 		return int(r - 65)
-	case r-97 < 26:
+	case 'a' <= r && r <= 'z':
 		return int(r - 97)
 	}
 	return Base
@@ -269,7 +292,6 @@ func digit2codepoint(d int) rune {
 		return rune(d - 26 + '0')
 	}
 	panic("digit2codepoint")
-	return -1
 }
 
 func writeRune(r []rune) []byte {
@@ -22,6 +22,7 @@ Test cases extracted from RFC 3492
 package punycode
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -148,10 +149,37 @@ func TestDecode(t *testing.T) {
 	}
 }
 
+type decodeErrorTestCase struct {
+	punycode string
+	offset   int
+	err      error
+}
+
+var decodeErrorTests = []decodeErrorTestCase{
+	{"bcher-k!a", 7, ErrBadInput},
+	{"bcher-kv", 8, ErrBadInput},
+	{"b\xfccher-kva", 1, ErrNonASCII},
+	{"99999999999", 4, ErrOverflow},
+}
+
+func TestDecodeErrors(t *testing.T) {
+	for _, tt := range decodeErrorTests {
+		_, err := Decode([]byte(tt.punycode))
+		var perr *Error
+		if !errors.As(err, &perr) {
+			t.Errorf("Decode(%q) error = %v; want *Error", tt.punycode, err)
+			continue
+		}
+		if !errors.Is(err, tt.err) || perr.Offset != tt.offset {
+			t.Errorf("Decode(%q) error = %v; want %v at offset %d", tt.punycode, err, tt.err, tt.offset)
+		}
+	}
+}
+
 type hex32 []int
 type hex8 []uint8
 
-func (h hex32) Format(f fmt.State, c int) {
+func (h hex32) Format(f fmt.State, c rune) {
 	fmt.Fprint(f, "[")
 	for i, v := range h {
 		if i > 0 {
@@ -162,7 +190,7 @@ func (h hex32) Format(f fmt.State, c int) {
 	fmt.Fprint(f, "]")
 }
 
-func (h hex8) Format(f fmt.State, c uint8) {
+func (h hex8) Format(f fmt.State, c rune) {
 	fmt.Fprint(f, "[")
 	for i, v := range h {
 		if i > 0 {
@@ -0,0 +1,112 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package stringprep
+
+import "unicode/utf8"
+
+// bidi checks the requirements of RFC 3454 section 6 on a prepared string,
+// which may be added in several pieces.
+type bidi struct {
+	prohibit, ral, l Table
+
+	n           int  // number of runes added
+	containsRAL bool // whether a RandALCat rune was added
+	firstL      int  // offset of the first LCat rune, or -1
+	firstLRune  rune
+	first, last rune
+}
+
+// newBidi returns a bidi checker using the tables of profile, which must
+// have a BIDI_PROHIBIT_TABLE, BIDI_RAL_TABLE and BIDI_L_TABLE step.
+func newBidi(profile Profile) (*bidi, error) {
+	b := &bidi{firstL: -1}
+	doneProhibited := false
+	doneRAL := false
+	doneL := false
+	for _, e := range profile {
+		switch e.Step {
+		case BIDI_PROHIBIT_TABLE:
+			doneProhibited = true
+			b.prohibit = e.Table
+		case BIDI_RAL_TABLE:
+			doneRAL = true
+			b.ral = e.Table
+		case BIDI_L_TABLE:
+			doneL = true
+			b.l = e.Table
+		}
+	}
+	if !doneProhibited || !doneRAL || !doneL {
+		return nil, &Error{Step: BIDI, Err: ErrProfile}
+	}
+	return b, nil
+}
+
+// add checks the next piece of the string for prohibited runes, and
+// records what the final check needs to know about it.
+func (b *bidi) add(s []rune) error {
+	for _, r := range s {
+		if err := b.addRune(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addBytes is like add for a UTF-8 encoded piece of the string.
+func (b *bidi) addBytes(s []byte) error {
+	for len(s) > 0 {
+		r, size := utf8.DecodeRune(s)
+		if err := b.addRune(r); err != nil {
+			return err
+		}
+		s = s[size:]
+	}
+	return nil
+}
+
+func (b *bidi) addRune(r rune) error {
+	if in_table(r, b.prohibit) {
+		return &Error{BIDI_PROHIBIT_TABLE, tableName(b.prohibit), b.n, r, ErrProhibited}
+	}
+	if in_table(r, b.ral) {
+		b.containsRAL = true
+	}
+	if b.firstL == -1 && in_table(r, b.l) {
+		b.firstL = b.n
+		b.firstLRune = r
+	}
+	if b.n == 0 {
+		b.first = r
+	}
+	b.last = r
+	b.n++
+	return nil
+}
+
+// check verifies the requirements on the whole string once it has been
+// added.
+func (b *bidi) check() error {
+	if !b.containsRAL {
+		return nil
+	}
+
+	// RFC 3454 section 6, requirement 2: a string containing RandALCat
+	// characters must not contain any LCat character.
+	if b.firstL != -1 {
+		return &Error{BIDI_L_TABLE, tableName(b.l), b.firstL, b.firstLRune, ErrBidi}
+	}
+
+	// Requirement 3: it must start and end with RandALCat characters.
+	if !in_table(b.first, b.ral) {
+		return &Error{BIDI_RAL_TABLE, tableName(b.ral), 0, b.first, ErrBidi}
+	}
+	if !in_table(b.last, b.ral) {
+		return &Error{BIDI_RAL_TABLE, tableName(b.ral), b.n - 1, b.last, ErrBidi}
+	}
+	return nil
+}
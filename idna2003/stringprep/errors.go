@@ -0,0 +1,64 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package stringprep
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Errors returned when preparing a string, wrapped in an *Error. Use
+// errors.Is to find out why a string was rejected.
+var (
+	ErrProhibited = errors.New("stringprep: Prohibited character")
+	ErrUnassigned = errors.New("stringprep: Unassigned character")
+	ErrBidi       = errors.New("stringprep: BIDI requirements not satisfied")
+	ErrProfile    = errors.New("stringprep: Profile error")
+)
+
+// An Error describes a string rejected by a step of a stringprep profile.
+type Error struct {
+	Step   int    // the failing step, such as PROHIBIT_TABLE
+	Table  string // the RFC 3454 name of the table used by the step, such as "C.2.2"
+	Offset int    // rune offset of Rune in the string as prepared by the earlier steps
+	Rune   rune   // the offending rune
+	Err    error  // the reason for the error
+}
+
+func (e *Error) Error() string {
+	if errors.Is(e.Err, ErrProfile) {
+		// The profile cannot be used this way; no rune is at fault.
+		return fmt.Sprintf("%v in step %d", e.Err, e.Step)
+	}
+	if e.Table == "" {
+		return fmt.Sprintf("%v: %U at offset %d", e.Err, e.Rune, e.Offset)
+	}
+	return fmt.Sprintf("%v: %U at offset %d (table %s)", e.Err, e.Rune, e.Offset, e.Table)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// TableName returns the RFC 3454 name of table, such as "C.2.2", the RFC
+// defining it for the tables of other profiles, such as "RFC 3722", or the
+// empty string if the table is not known.
+func TableName(table Table) string {
+	return tableName(table)
+}
+
+// tableName implements TableName.
+func tableName(table Table) string {
+	if len(table) == 0 {
+		return ""
+	}
+	for key, t := range Tables {
+		if len(t) == len(table) && &t[0] == &table[0] {
+			return strings.Join(strings.Split(key, ""), ".")
+		}
+	}
+	return tableNames[&table[0]]
+}
@@ -1,30 +1,259 @@
-// Copyright 2012 Hannes Baldursson. All rights reserved.
-// Use of this source code is governed by a BSD-style
-// license that can be found in the LICENSE file.
-
-package stringprep
-
-// An Iter iterates over a string or byte slice, while preparing it with a
-// given Profile.
-type Iter struct{}
-
-// Done returns true if there is no more input to process.
-func (i *Iter) Done() bool { return false }
-
-// Next writes p(i.input[i.Pos():n]...) to buffer buf, where n is the largest
-// boundary of i.input such that the result fits in buf. It returns the number 
-// of bytes written to buf. len(buf) should be at least MaxSegmentSize. Done 
-// must be false before calling Next.
-func (i *Iter) Next(buf []byte) int { return -1 }
-
-// Pos returns the byte position at which the next call to Next will commence 
-// processing.
-func (i *Iter) Pos() int { return -1 }
-
-// SetInput initializes i to iterate over src after normalizing it to 
-// Profile p.
-func (i *Iter) SetInput(p *Profile, src []byte) {}
-
-// SetInputString initializes i to iterate over src after normalizing it to 
-// Profile p.
-func (i *Iter) SetInputString(p *Profile, src string) {}
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stringprep
+
+import (
+	"errors"
+	"io"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxSegmentSize is the buffer size that Next needs to prepare any segment of
+// ordinary text. A segment ends at the first NFKC boundary after
+// maxSegmentRunes runes, so only long runs of combining marks need more;
+// Next writes such segments over several calls.
+const MaxSegmentSize = 1024
+
+// maxSegmentRunes is the number of input runes after which Iter ends a
+// segment at the next NFKC boundary.
+const maxSegmentRunes = 16
+
+// An Iter iterates over a string or byte slice, while preparing it with a
+// given Profile. The input is prepared in segments that end at boundaries
+// where NFKC normalization, and so the whole profile, can be applied to
+// each segment separately.
+//
+// The BIDI step of a profile depends on the whole string, so its
+// requirements are only checked after the last segment has been returned by
+// Next. Err must be checked once Done returns true. Profiles with an
+// INSIGNIFICANT_SPACE step cannot be prepared in segments, and make Err
+// return an error wrapping ErrProfile.
+type Iter struct {
+	profile Profile
+	bytes   []byte
+	str     string
+	isBytes bool
+	length  int
+
+	pos        int    // byte position of the next segment in the input
+	pending    bool   // whether out holds a segment that did not fit in the last buffer
+	pendingEnd int    // byte position after the pending segment
+	out        []byte // the prepared segment
+	runes      []rune // scratch space for the runes of a segment
+	n          int    // number of runes prepared so far
+	bidi       *bidi  // nil if the profile has no BIDI step
+	partial    bool   // whether more input follows, so BIDI is not checked at the end
+	err        error
+}
+
+// Done returns true if there is no more input to process.
+func (i *Iter) Done() bool {
+	return i.err != nil || (i.pos >= i.length && !i.pending)
+}
+
+// Err returns the first error found while preparing the input, or nil if
+// there was none. It is a *Error for strings rejected by the profile, or
+// io.ErrShortBuffer if Next was given a buffer too small for a single rune.
+func (i *Iter) Err() error { return i.err }
+
+// Next writes p(i.input[i.Pos():n]...) to buffer buf, where n is the largest
+// boundary of i.input such that the result fits in buf. It returns the number
+// of bytes written to buf. len(buf) should be at least MaxSegmentSize. A
+// segment that does not fit in buf on its own is written in pieces ending
+// between runes, and Pos stays at its start until all of it is written.
+// Done must be false before calling Next.
+func (i *Iter) Next(buf []byte) int {
+	n := 0
+	for !i.Done() {
+		if !i.pending && !i.nextSegment() {
+			break
+		}
+		if len(i.out) > len(buf)-n {
+			if n == 0 {
+				n = copyRunes(buf, i.out)
+				i.out = i.out[n:]
+				if n == 0 {
+					i.err = io.ErrShortBuffer
+				}
+			}
+			break
+		}
+		n += copy(buf[n:], i.out)
+		i.consume()
+	}
+	return n
+}
+
+// copyRunes copies the runes of src that fit in dst, and returns the number
+// of bytes copied.
+func copyRunes(dst, src []byte) int {
+	n := len(dst)
+	if n >= len(src) {
+		return copy(dst, src)
+	}
+	for n > 0 && !utf8.RuneStart(src[n]) {
+		n--
+	}
+	return copy(dst, src[:n])
+}
+
+// consume marks the pending segment as written, checking the BIDI
+// requirements if it was the last one.
+func (i *Iter) consume() {
+	i.pos = i.pendingEnd
+	i.pending = false
+	if i.pos >= i.length && !i.partial && i.bidi != nil {
+		i.err = i.bidi.check()
+	}
+}
+
+// appendAll appends the rest of the prepared input to out.
+func (i *Iter) appendAll(out []byte) ([]byte, error) {
+	for !i.Done() {
+		if !i.pending && !i.nextSegment() {
+			break
+		}
+		out = append(out, i.out...)
+		i.consume()
+	}
+	return out, i.err
+}
+
+// Pos returns the byte position at which the next call to Next will commence
+// processing.
+func (i *Iter) Pos() int { return i.pos }
+
+// SetInput initializes i to iterate over src after normalizing it to
+// Profile p.
+func (i *Iter) SetInput(p *Profile, src []byte) {
+	i.reset(p)
+	i.setInput(src)
+}
+
+// setInput sets the input of i to src, keeping the state of the BIDI
+// checks so that the input can be given in several chunks.
+func (i *Iter) setInput(src []byte) {
+	i.bytes = src
+	i.isBytes = true
+	i.length = len(src)
+	i.pos = 0
+}
+
+// SetInputString initializes i to iterate over src after normalizing it to
+// Profile p.
+func (i *Iter) SetInputString(p *Profile, src string) {
+	i.reset(p)
+	i.str = src
+	i.length = len(src)
+}
+
+func (i *Iter) reset(p *Profile) {
+	*i = Iter{profile: *p, out: i.out[:0], runes: i.runes[:0]}
+	if p.hasStep(INSIGNIFICANT_SPACE) {
+		i.err = &Error{Step: INSIGNIFICANT_SPACE, Err: ErrProfile}
+		return
+	}
+	for _, e := range i.profile {
+		if e.Step == BIDI {
+			i.bidi, i.err = newBidi(i.profile)
+			break
+		}
+	}
+}
+
+// decode returns the rune at byte position pos of the input and its size.
+func (i *Iter) decode(pos int) (rune, int) {
+	if i.isBytes {
+		return utf8.DecodeRune(i.bytes[pos:])
+	}
+	return utf8.DecodeRuneInString(i.str[pos:])
+}
+
+// nextSegment prepares the next segment of the input into i.out. It
+// returns false if the segment was rejected by the profile.
+func (i *Iter) nextSegment() bool {
+	i.runes = i.runes[:0]
+	pos := i.pos
+	for pos < i.length {
+		r, size := i.decode(pos)
+		if len(i.runes) >= maxSegmentRunes && i.boundaryBefore(r) {
+			break
+		}
+		i.runes = append(i.runes, r)
+		pos += size
+	}
+
+	output, err := prepare(i.profile, i.runes, 0, false)
+	if err != nil {
+		var perr *Error
+		if errors.As(err, &perr) {
+			e := *perr
+			e.Offset += i.n
+			err = &e
+		}
+		i.err = err
+		return false
+	}
+	if i.bidi != nil {
+		if err := i.bidi.add(output); err != nil {
+			i.err = err
+			return false
+		}
+	}
+	i.n += len(output)
+
+	i.out = i.out[:0]
+	for _, r := range output {
+		i.out = utf8.AppendRune(i.out, r)
+	}
+	i.pending = true
+	i.pendingEnd = pos
+	return true
+}
+
+// lastBoundary returns the position of the last boundary in b before which
+// the profile can be applied separately. An incomplete UTF-8 sequence at the
+// end of b is never before the boundary.
+func (i *Iter) lastBoundary(b []byte) int {
+	end := len(b)
+	for k := end - 1; k >= 0 && k >= len(b)-utf8.UTFMax; k-- {
+		if utf8.RuneStart(b[k]) {
+			if !utf8.FullRune(b[k:]) {
+				end = k
+			}
+			break
+		}
+	}
+	for end > 0 {
+		r, size := utf8.DecodeLastRune(b[:end])
+		end -= size
+		if i.boundaryBefore(r) {
+			return end
+		}
+	}
+	return 0
+}
+
+// boundaryBefore returns true if the profile can be applied separately to
+// the input before and after r. The mapping steps work on single runes, so
+// this is where NFKC normalization has a boundary before the mapped rune.
+// Runes that are mapped to nothing are never boundaries.
+func (i *Iter) boundaryBefore(r rune) bool {
+	m := []rune{r}
+	for _, e := range i.profile {
+		switch e.Step {
+		case MAP_TABLE:
+			m = map_table(m, e.Table)
+		case NFKC:
+			if len(m) == 0 {
+				return false
+			}
+			return norm.NFKC.PropertiesString(string(m[0])).BoundaryBefore()
+		}
+	}
+	return len(m) > 0
+}
@@ -0,0 +1,97 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package stringprep
+
+// Tables of the LDAP string preparation algorithm in RFC 4518, which are not
+// part of RFC 3454.
+
+// ldapMapToNothing holds the code points mapped to nothing by RFC 4518
+// section 2.2: soft hyphens, joiners, variation selectors, the object
+// replacement character, ZERO WIDTH SPACE and the control code points other
+// than those mapped to SPACE.
+var ldapMapToNothing = namedTable("RFC 4518", Table{
+	TableElement{0x000000, 0x000008, d{}}, /* 0000-0008; [CONTROL CHARACTERS] */
+	TableElement{0x00000E, 0x00001F, d{}}, /* 000E-001F; [CONTROL CHARACTERS] */
+	TableElement{0x00007F, 0x000084, d{}}, /* 007F-0084; [CONTROL CHARACTERS] */
+	TableElement{0x000086, 0x00009F, d{}}, /* 0086-009F; [CONTROL CHARACTERS] */
+	TableElement{0x0000AD, 0x0000AD, d{}}, /* 00AD; SOFT HYPHEN */
+	TableElement{0x00034F, 0x00034F, d{}}, /* 034F; COMBINING GRAPHEME JOINER */
+	TableElement{0x0006DD, 0x0006DD, d{}}, /* 06DD; ARABIC END OF AYAH */
+	TableElement{0x00070F, 0x00070F, d{}}, /* 070F; SYRIAC ABBREVIATION MARK */
+	TableElement{0x001806, 0x001806, d{}}, /* 1806; MONGOLIAN TODO SOFT HYPHEN */
+	TableElement{0x00180B, 0x00180E, d{}}, /* 180B-180E; [MONGOLIAN VARIATION SELECTORS and VOWEL SEPARATOR] */
+	TableElement{0x00200B, 0x00200F, d{}}, /* 200B-200F; [ZERO WIDTH SPACE, JOINERS and MARKS] */
+	TableElement{0x00202A, 0x00202E, d{}}, /* 202A-202E; [BIDI EMBEDDING CONTROLS] */
+	TableElement{0x002060, 0x002063, d{}}, /* 2060-2063; [INVISIBLE OPERATORS] */
+	TableElement{0x00206A, 0x00206F, d{}}, /* 206A-206F; [DEPRECATED FORMAT CHARACTERS] */
+	TableElement{0x00FE00, 0x00FE0F, d{}}, /* FE00-FE0F; [VARIATION SELECTORS] */
+	TableElement{0x00FEFF, 0x00FEFF, d{}}, /* FEFF; ZERO WIDTH NO-BREAK SPACE */
+	TableElement{0x00FFF9, 0x00FFFC, d{}}, /* FFF9-FFFC; [INTERLINEAR ANNOTATION and OBJECT REPLACEMENT] */
+	TableElement{0x01D173, 0x01D17A, d{}}, /* 1D173-1D17A; [MUSICAL SYMBOL FORMAT CHARACTERS] */
+	TableElement{0x0E0001, 0x0E0001, d{}}, /* E0001; LANGUAGE TAG */
+	TableElement{0x0E0020, 0x0E007F, d{}}, /* E0020-E007F; [TAGGING CHARACTERS] */
+})
+
+// ldapMapToSpace holds the code points mapped to SPACE by RFC 4518 section
+// 2.2: the white space control code points and the separators.
+var ldapMapToSpace = namedTable("RFC 4518", Table{
+	TableElement{0x0009, 0x000D, d{0x0020}}, /* 0009-000D; [TAB, LF, VT, FF, CR] */
+	TableElement{0x0085, 0x0085, d{0x0020}}, /* 0085; NEXT LINE */
+	TableElement{0x00A0, 0x00A0, d{0x0020}}, /* 00A0; NO-BREAK SPACE */
+	TableElement{0x1680, 0x1680, d{0x0020}}, /* 1680; OGHAM SPACE MARK */
+	TableElement{0x2000, 0x200A, d{0x0020}}, /* 2000-200A; [SPACES] */
+	TableElement{0x2028, 0x2029, d{0x0020}}, /* 2028-2029; [LINE and PARAGRAPH SEPARATORS] */
+	TableElement{0x202F, 0x202F, d{0x0020}}, /* 202F; NARROW NO-BREAK SPACE */
+	TableElement{0x205F, 0x205F, d{0x0020}}, /* 205F; MEDIUM MATHEMATICAL SPACE */
+	TableElement{0x3000, 0x3000, d{0x0020}}, /* 3000; IDEOGRAPHIC SPACE */
+})
+
+// ldapProhibited holds the REPLACEMENT CHARACTER, which RFC 4518 section 2.4
+// prohibits in addition to tables C.3, C.4, C.5 and C.8.
+var ldapProhibited = namedTable("RFC 4518", Table{
+	TableElement{0xFFFD, 0xFFFD, d{}}, /* FFFD; REPLACEMENT CHARACTER */
+})
+
+// ldapNumericSpace removes the spaces of a numericString, as in RFC 4518
+// section 2.6.2.
+var ldapNumericSpace = namedTable("RFC 4518", Table{
+	TableElement{0x0020, 0x0020, d{}}, /* 0020; SPACE */
+})
+
+// ldapTelephoneSpace removes the spaces and hyphens of a telephoneNumber, as
+// in RFC 4518 section 2.6.3.
+var ldapTelephoneSpace = namedTable("RFC 4518", Table{
+	TableElement{0x0020, 0x0020, d{}}, /* 0020; SPACE */
+	TableElement{0x002D, 0x002D, d{}}, /* 002D; HYPHEN-MINUS */
+	TableElement{0x058A, 0x058A, d{}}, /* 058A; ARMENIAN HYPHEN */
+	TableElement{0x2010, 0x2011, d{}}, /* 2010-2011; HYPHEN, NON-BREAKING HYPHEN */
+	TableElement{0x2212, 0x2212, d{}}, /* 2212; MINUS SIGN */
+	TableElement{0xFE63, 0xFE63, d{}}, /* FE63; SMALL HYPHEN-MINUS */
+	TableElement{0xFF0D, 0xFF0D, d{}}, /* FF0D; FULLWIDTH HYPHEN-MINUS */
+})
+
+// insignificantSpace applies the insignificant space handling of RFC 4518
+// section 2.6.1: the result starts and ends with exactly one SPACE, and each
+// inner run of spaces becomes exactly two. A string of only spaces becomes
+// two spaces.
+func insignificantSpace(s []rune) []rune {
+	out := make([]rune, 1, len(s)+2)
+	out[0] = ' '
+	space := false
+	for _, r := range s {
+		if r == ' ' {
+			space = len(out) > 1
+			continue
+		}
+		if space {
+			out = append(out, ' ', ' ')
+			space = false
+		}
+		out = append(out, r)
+	}
+	return append(out, ' ')
+}
@@ -12,6 +12,16 @@ type ProfileElement struct {
 	Table Table
 }
 
+// hasStep returns true if the profile has a step of the given kind.
+func (p *Profile) hasStep(step int) bool {
+	for _, e := range *p {
+		if e.Step == step {
+			return true
+		}
+	}
+	return false
+}
+
 const (
 	typeMask  = 0xC000 // 11000000 00000000
 	valueMask = 0x3FFF // 00111111 11111111
@@ -0,0 +1,167 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package stringprep
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// tableNames holds the names of the tables that are not in Tables, such as
+// those read from profile files, so that errors can name them too.
+var tableNames = map[*TableElement]string{}
+
+// namedTable records name as the name of table and returns table.
+func namedTable(name string, table Table) Table {
+	tableNames[&table[0]] = name
+	return table
+}
+
+// parseProfile reads a profile from data in the format of the profile files
+// generated by filterRFC3454.pl, such as nodeprep.txt. Each table of the file
+// becomes a step of the profile: mapping tables come first, then NFKC if the
+// file has a @normalize line, the prohibited tables, the BIDI step if the file
+// has a @check-bidi line, and the unassigned table last. The files do not
+// contain tables D.1 and D.2, so the BIDI step uses those of Tables.
+func parseProfile(data string) (Profile, error) {
+	type section struct {
+		name  string
+		kind  string
+		table Table
+	}
+	var (
+		sections  []*section
+		cur       *section
+		normalize bool
+		checkBidi bool
+	)
+
+	s := bufio.NewScanner(strings.NewReader(data))
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "#"):
+			if name := sectionName(line); name != "" {
+				cur = &section{name: name}
+				sections = append(sections, cur)
+			}
+			continue
+		case line == "@normalize;;":
+			normalize = true
+			continue
+		case line == "@check-bidi;;":
+			checkBidi = true
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		if len(fields) != 3 || cur == nil {
+			return nil, fmt.Errorf("stringprep: line %d: malformed entry %q", n, line)
+		}
+		kind := strings.TrimSpace(fields[2])
+		if cur.kind == "" {
+			cur.kind = kind
+		} else if cur.kind != kind {
+			return nil, fmt.Errorf("stringprep: line %d: %s entry in %s table %s", n, kind, cur.kind, cur.name)
+		}
+
+		var e TableElement
+		lo, hi, _ := strings.Cut(fields[0], "..")
+		if hi == "" {
+			hi = lo
+		}
+		var err error
+		if e.Lo, err = parseCodePoint(lo); err != nil {
+			return nil, fmt.Errorf("stringprep: line %d: %v", n, err)
+		}
+		if e.Hi, err = parseCodePoint(hi); err != nil {
+			return nil, fmt.Errorf("stringprep: line %d: %v", n, err)
+		}
+		maps := strings.Fields(fields[1])
+		if len(maps) > MaxMapChars {
+			return nil, fmt.Errorf("stringprep: line %d: mapping longer than %d code points", n, MaxMapChars)
+		}
+		for i, m := range maps {
+			if e.Map[i], err = parseCodePoint(m); err != nil {
+				return nil, fmt.Errorf("stringprep: line %d: %v", n, err)
+			}
+		}
+		cur.table = append(cur.table, e)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	var p Profile
+	add := func(step int, kind string) {
+		for _, sec := range sections {
+			if sec.kind == kind && len(sec.table) > 0 {
+				p = append(p, ProfileElement{step, namedTable(sec.name, sec.table)})
+			}
+		}
+	}
+	add(MAP_TABLE, "MAP")
+	if normalize {
+		p = append(p, ProfileElement{NFKC, nil})
+	}
+	add(PROHIBIT_TABLE, "PROHIBITED")
+	if checkBidi {
+		var c8 Table
+		for _, sec := range sections {
+			if sec.name == "C.8" {
+				c8 = sec.table
+			}
+		}
+		p = append(p,
+			ProfileElement{BIDI, nil},
+			ProfileElement{BIDI_PROHIBIT_TABLE, c8},
+			ProfileElement{BIDI_RAL_TABLE, Tables["D1"]},
+			ProfileElement{BIDI_L_TABLE, Tables["D2"]},
+		)
+	}
+	add(UNASSIGNED_TABLE, "UNASSIGNED")
+	return p, nil
+}
+
+// mustParseProfile is like parseProfile but panics if data cannot be parsed.
+// It is used for the profile files shipped with the package.
+func mustParseProfile(data string) Profile {
+	p, err := parseProfile(data)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// sectionName returns the name of the table started by a comment line of a
+// profile file, such as "C.1.2" or "RFC 3920", or "" if the line does not
+// start a table.
+func sectionName(line string) string {
+	line = strings.Join(strings.Fields(strings.TrimPrefix(line, "#")), " ")
+	if strings.HasPrefix(line, "additional code points from ") {
+		return strings.TrimPrefix(line, "additional code points from ")
+	}
+	if i := strings.Index(line, "code points from Table "); i >= 0 {
+		name := strings.Fields(line[i+len("code points from Table "):])
+		if len(name) > 0 {
+			return name[0]
+		}
+	}
+	return ""
+}
+
+func parseCodePoint(s string) (rune, error) {
+	v, err := strconv.ParseUint(strings.TrimSpace(s), 16, 32)
+	if err != nil || v > 0x10FFFF {
+		return 0, fmt.Errorf("invalid code point %q", s)
+	}
+	return rune(v), nil
+}
@@ -1,9 +1,21 @@
 package stringprep
 
+import _ "embed"
+
 var (
 	// Profiles is a map of the various stringprep profiles we implement.
 	Profiles = map[string]Profile{
-		"nameprep": nameprepProfile,
+		"nameprep":     nameprepProfile,
+		"saslprep":     saslprepProfile,
+		"nodeprep":     nodeprepProfile,
+		"resourceprep": resourceprepProfile,
+		"iscsi":        iscsiProfile,
+		"trace":        traceProfile,
+
+		"ldap":            ldapProfile,
+		"ldap-caseignore": ldapCaseIgnoreProfile,
+		"ldap-numeric":    ldapNumericProfile,
+		"ldap-telephone":  ldapTelephoneProfile,
 	}
 )
 
@@ -30,9 +42,199 @@ var nameprepProfile = Profile{
 
 // Nameprep performs the nameprep stringprep conversion on a string and returns it.
 func Nameprep(input string) (string, error) {
-	output, err := PrepareRunes(Profiles["nameprep"], []rune(input))
+	return nameprepProfile.StringErr(input)
+}
+
+// saslprepProfile - As described in RFC 4013: http://tools.ietf.org/html/rfc4013
+var saslprepProfile = Profile{
+	ProfileElement{MAP_TABLE, mapToSpace(Tables["C12"])},
+	ProfileElement{MAP_TABLE, Tables["B1"]},
+	ProfileElement{NFKC, nil},
+	ProfileElement{PROHIBIT_TABLE, Tables["C12"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C21"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C22"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C3"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C4"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C5"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C6"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C7"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C8"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C9"]},
+	ProfileElement{BIDI, nil},
+	ProfileElement{BIDI_PROHIBIT_TABLE, Tables["C8"]},
+	ProfileElement{BIDI_RAL_TABLE, Tables["D1"]},
+	ProfileElement{BIDI_L_TABLE, Tables["D2"]},
+	ProfileElement{UNASSIGNED_TABLE, Tables["A1"]},
+}
+
+// mapToSpace returns a table that maps the code points of table to SPACE, as
+// SASLprep does with the non-ASCII spaces of table C.1.2. ASCII SPACE itself
+// (table C.1.1) is allowed by SASLprep.
+func mapToSpace(table Table) Table {
+	t := make(Table, len(table))
+	for i, e := range table {
+		t[i] = TableElement{e.Lo, e.Hi, d{0x0020}}
+	}
+	return t
+}
+
+// SASLprep performs the SASLprep stringprep conversion on a string that is
+// stored, such as a password or user name kept by a server, and returns it.
+// Unassigned code points are rejected.
+func SASLprep(input string) (string, error) {
+	return saslprepProfile.StringErr(input)
+}
+
+// SASLprepQuery performs the SASLprep stringprep conversion on a string that
+// is a query, such as the credentials sent by a client, and returns it.
+// Unassigned code points are allowed, as in RFC 4013 section 2.5.
+func SASLprepQuery(input string) (string, error) {
+	output, err := PrepareRunesFlags(saslprepProfile, []rune(input), AllowUnassigned)
 	if err != nil {
 		return "", err
 	}
 	return string(output), nil
 }
+
+var (
+	//go:embed nodeprep.txt
+	nodeprepData string
+
+	//go:embed resourceprep.txt
+	resourceprepData string
+)
+
+// nodeprepProfile - As described in RFC 6122 Appendix A: http://tools.ietf.org/html/rfc6122
+//
+// Read from nodeprep.txt, which adds the characters "&'/:<>@ to the
+// prohibited tables.
+var nodeprepProfile = mustParseProfile(nodeprepData)
+
+// resourceprepProfile - As described in RFC 6122 Appendix B: http://tools.ietf.org/html/rfc6122
+//
+// Read from resourceprep.txt. Unlike Nodeprep it does not case fold with
+// table B.2, and it allows SPACE.
+var resourceprepProfile = mustParseProfile(resourceprepData)
+
+// Nodeprep performs the Nodeprep stringprep conversion on the localpart of an
+// XMPP address and returns it.
+func Nodeprep(input string) (string, error) {
+	return nodeprepProfile.StringErr(input)
+}
+
+// Resourceprep performs the Resourceprep stringprep conversion on the
+// resourcepart of an XMPP address and returns it.
+func Resourceprep(input string) (string, error) {
+	return resourceprepProfile.StringErr(input)
+}
+
+// iscsiProfile - As described in RFC 3722: http://tools.ietf.org/html/rfc3722
+var iscsiProfile = Profile{
+	ProfileElement{MAP_TABLE, Tables["B1"]},
+	ProfileElement{MAP_TABLE, Tables["B2"]},
+	ProfileElement{NFKC, nil},
+	ProfileElement{PROHIBIT_TABLE, Tables["C11"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C12"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C21"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C22"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C3"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C4"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C5"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C6"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C7"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C8"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C9"]},
+	ProfileElement{PROHIBIT_TABLE, iscsiProhibited},
+	ProfileElement{BIDI, nil},
+	ProfileElement{BIDI_PROHIBIT_TABLE, Tables["C8"]},
+	ProfileElement{BIDI_RAL_TABLE, Tables["D1"]},
+	ProfileElement{BIDI_L_TABLE, Tables["D2"]},
+	ProfileElement{UNASSIGNED_TABLE, Tables["A1"]},
+}
+
+// iscsiProhibited holds the code points prohibited by RFC 3722 section 6 in
+// addition to the tables of RFC 3454: the ASCII code points other than
+// letters, digits, '-', '.' and ':', and the ideographic full stop.
+var iscsiProhibited = namedTable("RFC 3722", Table{
+	TableElement{0x0000, 0x002C, d{}}, /* 0000-002C; [ASCII CONTROL CHARACTERS and SPACE through ,] */
+	TableElement{0x002F, 0x002F, d{}}, /* 002F; [ASCII /] */
+	TableElement{0x003B, 0x0040, d{}}, /* 003B-0040; [ASCII ; through @] */
+	TableElement{0x005B, 0x0060, d{}}, /* 005B-0060; [ASCII [ through `] */
+	TableElement{0x007B, 0x007F, d{}}, /* 007B-007F; [ASCII { through DEL] */
+	TableElement{0x3002, 0x3002, d{}}, /* 3002; [IDEOGRAPHIC FULL STOP] */
+})
+
+// ISCSIprep performs the iSCSI stringprep conversion on an iSCSI name and
+// returns it. It does not check the structure of the name.
+func ISCSIprep(input string) (string, error) {
+	return iscsiProfile.StringErr(input)
+}
+
+// traceProfile - As described in RFC 4505: http://tools.ietf.org/html/rfc4505
+var traceProfile = Profile{
+	ProfileElement{PROHIBIT_TABLE, Tables["C21"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C22"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C3"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C4"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C5"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C6"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C8"]},
+	ProfileElement{PROHIBIT_TABLE, Tables["C9"]},
+	ProfileElement{BIDI, nil},
+	ProfileElement{BIDI_PROHIBIT_TABLE, Tables["C8"]},
+	ProfileElement{BIDI_RAL_TABLE, Tables["D1"]},
+	ProfileElement{BIDI_L_TABLE, Tables["D2"]},
+	ProfileElement{UNASSIGNED_TABLE, Tables["A1"]},
+}
+
+// Trace performs the trace stringprep conversion on the trace information of
+// the ANONYMOUS SASL mechanism and returns it. The profile maps nothing, so
+// the result is the input if it is accepted.
+func Trace(input string) (string, error) {
+	return traceProfile.StringErr(input)
+}
+
+// The LDAP profiles - As described in RFC 4518: http://tools.ietf.org/html/rfc4518
+//
+// The profiles differ in the case folding and insignificant character
+// handling of the matching rules they are used for: "ldap" is for
+// caseExactMatch, "ldap-caseignore" for caseIgnoreMatch, "ldap-numeric" for
+// numericStringMatch and "ldap-telephone" for telephoneNumberMatch. The
+// transcoding step is the decoding of the input as UTF-8, and bidirectional
+// characters are ignored, so the profiles have no BIDI step.
+var (
+	ldapProfile           = newLDAPProfile(false, ProfileElement{INSIGNIFICANT_SPACE, nil})
+	ldapCaseIgnoreProfile = newLDAPProfile(true, ProfileElement{INSIGNIFICANT_SPACE, nil})
+	ldapNumericProfile    = newLDAPProfile(true, ProfileElement{MAP_TABLE, ldapNumericSpace})
+	ldapTelephoneProfile  = newLDAPProfile(true, ProfileElement{MAP_TABLE, ldapTelephoneSpace})
+)
+
+// newLDAPProfile returns an LDAP profile, with case folding if fold is set,
+// ending with the given insignificant character handling step.
+func newLDAPProfile(fold bool, insignificant ProfileElement) Profile {
+	p := Profile{
+		ProfileElement{MAP_TABLE, ldapMapToNothing},
+		ProfileElement{MAP_TABLE, ldapMapToSpace},
+	}
+	if fold {
+		p = append(p, ProfileElement{MAP_TABLE, Tables["B2"]})
+	}
+	return append(p,
+		ProfileElement{NFKC, nil},
+		ProfileElement{PROHIBIT_TABLE, Tables["C3"]},
+		ProfileElement{PROHIBIT_TABLE, Tables["C4"]},
+		ProfileElement{PROHIBIT_TABLE, Tables["C5"]},
+		ProfileElement{PROHIBIT_TABLE, Tables["C8"]},
+		ProfileElement{PROHIBIT_TABLE, ldapProhibited},
+		ProfileElement{UNASSIGNED_TABLE, Tables["A1"]},
+		insignificant,
+	)
+}
+
+// LDAPprep prepares an attribute or assertion value for caseExactMatch with
+// the LDAP string preparation algorithm and returns it. The other matching
+// rules use the "ldap-caseignore", "ldap-numeric" and "ldap-telephone"
+// profiles of Profiles.
+func LDAPprep(input string) (string, error) {
+	return ldapProfile.StringErr(input)
+}
@@ -0,0 +1,138 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package stringprep
+
+import "io"
+
+// streamer prepares input that arrives in chunks, keeping the input after
+// the last boundary until more of it is known.
+type streamer struct {
+	it Iter
+	in []byte // input after the last boundary, not yet prepared
+}
+
+func (s *streamer) init(p *Profile) error {
+	s.it.reset(p)
+	s.it.partial = true
+	return s.it.err
+}
+
+// prepare adds data to the input and appends the input up to its last
+// boundary, prepared, to out. If final is set all the input is prepared and
+// the BIDI requirements are checked.
+func (s *streamer) prepare(out, data []byte, final bool) ([]byte, error) {
+	s.in = append(s.in, data...)
+	k := len(s.in)
+	if !final {
+		k = s.it.lastBoundary(s.in)
+	}
+	s.it.partial = !final
+	s.it.setInput(s.in[:k])
+	out, err := s.it.appendAll(out)
+	if err != nil {
+		return out, err
+	}
+	if final && k == 0 && s.it.bidi != nil {
+		if err := s.it.bidi.check(); err != nil {
+			return out, err
+		}
+	}
+	s.in = append(s.in[:0], s.in[k:]...)
+	return out, nil
+}
+
+type writer struct {
+	w   io.Writer
+	s   streamer
+	buf []byte
+	err error
+}
+
+// Writer returns a new writer that implements Write(b) by writing p(b) to w.
+// The returned writer may use an internal buffer to maintain state across
+// Write calls. Calling its Close method writes any buffered data to w.
+//
+// If the data is rejected by the profile, Write or Close returns the error
+// and nothing more is written. As the BIDI requirements depend on the whole
+// data, they are only checked by Close.
+func (p *Profile) Writer(w io.Writer) io.WriteCloser {
+	wr := &writer{w: w}
+	wr.err = wr.s.init(p)
+	return wr
+}
+
+func (w *writer) Write(data []byte) (n int, err error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	if err := w.flush(data, false); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// Close writes any buffered data to the underlying io.Writer.
+func (w *writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	return w.flush(nil, true)
+}
+
+func (w *writer) flush(data []byte, final bool) error {
+	w.buf, w.err = w.s.prepare(w.buf[:0], data, final)
+	if w.err != nil {
+		return w.err
+	}
+	if len(w.buf) > 0 {
+		_, w.err = w.w.Write(w.buf)
+	}
+	return w.err
+}
+
+type reader struct {
+	r      io.Reader
+	s      streamer
+	inbuf  []byte
+	outbuf []byte
+	out    []byte // prepared data not read yet
+	err    error
+}
+
+// Reader returns a new reader that implements Read by reading data from r and
+// returning p(data).
+//
+// If the data is rejected by the profile, Read returns the error. As the
+// BIDI requirements depend on the whole data, they are only checked once r
+// returns io.EOF.
+func (p *Profile) Reader(r io.Reader) io.Reader {
+	rd := &reader{r: r, inbuf: make([]byte, MaxSegmentSize)}
+	rd.err = rd.s.init(p)
+	return rd
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		n, err := r.r.Read(r.inbuf)
+		out, perr := r.s.prepare(r.outbuf[:0], r.inbuf[:n], err == io.EOF)
+		if perr != nil {
+			r.err = perr
+			return 0, perr
+		}
+		r.outbuf = out
+		r.out = out
+		if err != nil {
+			r.err = err
+		}
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
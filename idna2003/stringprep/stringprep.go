@@ -5,13 +5,14 @@
 // This file is part of go-idn
 
 // Package stringprep implements Stringprep as described in RFC 3454,
-// including the Nameprep profile.
+// including the Nameprep, iSCSI, SASLprep, trace, LDAPprep, Nodeprep and
+// Resourceprep profiles.
 //
 // This package is in beta and is still being written and tested.
 package stringprep
 
 import (
-	"errors"
+	"unicode/utf8"
 
 	"golang.org/x/text/unicode/norm"
 )
@@ -26,6 +27,7 @@ const (
 	BIDI_PROHIBIT_TABLE = 6
 	BIDI_RAL_TABLE      = 7
 	BIDI_L_TABLE        = 8
+	INSIGNIFICANT_SPACE = 9 // RFC 4518 section 2.6.1, applied to the whole string
 )
 
 // MaxMapChars is the largest number of runes/bytes a mapping will take up.
@@ -33,38 +35,172 @@ const MaxMapChars = 4
 
 type d [MaxMapChars]rune
 
-/*
-
 // Append returns p(append(out, b...)). The buffer out must be nil, empty or
-// equal to p(out).
-func (p *Profile) Append(out []byte, src ...byte) []byte { return nil }
+// equal to p(out). If the result is rejected by the profile, out is returned
+// unchanged; use AppendErr to find out why.
+func (p *Profile) Append(out []byte, src ...byte) []byte {
+	out, _ = p.AppendErr(out, src...)
+	return out
+}
+
+// AppendErr is like Append, but also returns the error if the result is
+// rejected by the profile.
+func (p *Profile) AppendErr(out []byte, src ...byte) ([]byte, error) {
+	return p.appendErr(out, src, "", false)
+}
 
 // AppendString returns p(append(out, []byte(s))). The buffer out must be nil,
-//empty, or equal to p(out).
-func (p *Profile) AppendString(out []byte, src string) []byte { return nil }
+// empty, or equal to p(out). If the result is rejected by the profile, out
+// is returned unchanged; use AppendStringErr to find out why.
+func (p *Profile) AppendString(out []byte, src string) []byte {
+	out, _ = p.AppendStringErr(out, src)
+	return out
+}
+
+// AppendStringErr is like AppendString, but also returns the error if the
+// result is rejected by the profile.
+func (p *Profile) AppendStringErr(out []byte, src string) ([]byte, error) {
+	return p.appendErr(out, nil, src, true)
+}
+
+// Bytes returns p(b), or nil if b is rejected by the profile.
+func (p *Profile) Bytes(b []byte) []byte {
+	out, _ := p.BytesErr(b)
+	return out
+}
+
+// BytesErr returns p(b), or nil and the error if b is rejected by the
+// profile.
+func (p *Profile) BytesErr(b []byte) ([]byte, error) {
+	out, err := p.AppendErr(nil, b...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// String returns p(s), or "" if s is rejected by the profile.
+func (p *Profile) String(s string) string {
+	out, _ := p.StringErr(s)
+	return out
+}
+
+// StringErr returns p(s), or "" and the error if s is rejected by the
+// profile.
+func (p *Profile) StringErr(s string) (string, error) {
+	out, err := p.AppendStringErr(nil, s)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// appendErr implements AppendErr and AppendStringErr. Only the part of out
+// after its last boundary is prepared again, but the BIDI requirements are
+// checked on the whole result.
+func (p *Profile) appendErr(out []byte, src []byte, str string, isString bool) ([]byte, error) {
+	if p.hasStep(INSIGNIFICANT_SPACE) {
+		// The result depends on the whole string, so it cannot be appended
+		// to an earlier result.
+		if len(out) > 0 {
+			return out, &Error{Step: INSIGNIFICANT_SPACE, Err: ErrProfile}
+		}
+		if !isString {
+			str = string(src)
+		}
+		result, err := PrepareRunes(*p, []rune(str))
+		if err != nil {
+			return out, err
+		}
+		return append(out, string(result)...), nil
+	}
+
+	var it Iter
+	it.reset(p)
+	if it.err != nil {
+		return out, it.err
+	}
 
-// Bytes returns p(b). May return b if p(b) = b.
-func (p *Profile) Bytes(b []byte) []byte { return nil }
+	var first rune
+	if isString {
+		first, _ = utf8.DecodeRuneInString(str)
+	} else {
+		first, _ = utf8.DecodeRune(src)
+	}
+
+	// If src does not start at a boundary, the end of out has to be
+	// prepared again together with it.
+	k := len(out)
+	if k > 0 && (len(src) > 0 || len(str) > 0) && !it.boundaryBefore(first) {
+		k = it.lastBoundary(out)
+	}
+	if it.bidi != nil {
+		if err := it.bidi.addBytes(out[:k]); err != nil {
+			return out, err
+		}
+	}
+	it.n = utf8.RuneCount(out[:k])
+
+	switch {
+	case k < len(out):
+		input := append(append([]byte(nil), out[k:]...), src...)
+		input = append(input, str...)
+		it.setInput(input)
+	case isString:
+		it.str = str
+		it.length = len(str)
+	default:
+		it.setInput(src)
+	}
 
-// Reader returns a new reader that implements Read by reading data from r and
-// returning p(data).
-func (p *Profile) Reader(r io.Reader) io.Reader { return nil }
+	// Appending in place is safe unless the end of out is prepared again,
+	// as out must be returned unchanged on error.
+	dst := out[:k]
+	if k < len(out) {
+		dst = out[:k:k]
+	}
+	result, err := it.appendAll(dst)
+	if err != nil {
+		return out, err
+	}
+	if it.length == 0 && it.bidi != nil {
+		// There was no input to prepare, so the BIDI requirements have not
+		// been checked yet.
+		if err := it.bidi.check(); err != nil {
+			return out, err
+		}
+	}
+	return result, nil
+}
 
-// String returns p(s).
-func (p *Profile) String(s string) string { return "" }
+// Flags modify how PrepareRunesFlags applies a stringprep profile.
+type Flags int
 
-// Writer returns a new writer that implements Write(b) by writing p(b) to w.
-// The returned writer may use an an internal buffer to maintain state across
-// Write calls. Calling its Close method writes any buffered data to w.
-func (p *Profile) Writer(w io.Writer) io.WriteCloser { return nil }
-*/
+const (
+	// AllowUnassigned skips the UNASSIGNED_TABLE steps of a profile, which
+	// RFC 3454 section 7 permits when preparing queries rather than stored
+	// strings.
+	AllowUnassigned Flags = 1 << iota
+)
 
 // PrepareRunes prepares the input rune array according to the stringprep
 // profile, and returns the results as a rune array.
 func PrepareRunes(profile Profile, input []rune) ([]rune, error) {
+	return PrepareRunesFlags(profile, input, 0)
+}
+
+// PrepareRunesFlags prepares the input rune array according to the stringprep
+// profile as modified by flags, and returns the results as a rune array.
+func PrepareRunesFlags(profile Profile, input []rune, flags Flags) ([]rune, error) {
 	output := make([]rune, len(input))
 	copy(output[0:], input[0:])
+	return prepare(profile, output, flags, true)
+}
 
+// prepare applies the steps of profile to input, which it may modify. The
+// BIDI and INSIGNIFICANT_SPACE steps are skipped unless whole is set, so that
+// pieces of a string can be prepared separately.
+func prepare(profile Profile, output []rune, flags Flags, whole bool) ([]rune, error) {
 	for i := 0; i < len(profile); i++ {
 		switch profile[i].Step {
 		case NFKC:
@@ -72,73 +208,36 @@ func PrepareRunes(profile Profile, input []rune) ([]rune, error) {
 			output = []rune(string(norm.NFKC.Bytes([]byte(string(output)))))
 			break
 		case BIDI:
-			doneProhibited := 0
-			doneRAL := 0
-			doneL := 0
-			containsRAL := -1
-			containsL := -1
-			startswithRAL := 0
-			endswithRAL := 0
-
-			for j := 0; j < len(profile); j++ {
-				switch profile[j].Step {
-				case BIDI_PROHIBIT_TABLE:
-					doneProhibited = 1
-					for k := 0; k < len(output); k++ {
-						if in_table(output[k], profile[j].Table) {
-							return nil, errors.New("stringprep: BIDI prohibited table")
-						}
-					}
-
-				case BIDI_RAL_TABLE:
-					doneRAL = 1
-					for k := 0; k < len(output); k++ {
-						if in_table(output[k], profile[j].Table) {
-							containsRAL = j
-							if k == 0 {
-								startswithRAL = 1
-							} else if k == len(output)-1 {
-								endswithRAL = 1
-							}
-						}
-					}
-
-				case BIDI_L_TABLE:
-					doneL = 1
-					for k := 0; k < len(output); k++ {
-						if in_table(output[k], profile[j].Table) {
-							containsL = j
-						}
-					}
-				}
+			if !whole {
+				break
 			}
-
-			if doneProhibited != 1 || doneRAL != 1 || doneL != 1 {
-				return nil, errors.New("stringprep: Profile error")
+			b, err := newBidi(profile)
+			if err != nil {
+				return nil, err
 			}
-
-			if containsRAL != -1 && containsL != -1 {
-				return nil, errors.New("stringprep: BIDI both L and RAL")
+			if err := b.add(output); err != nil {
+				return nil, err
 			}
-
-			if containsRAL != -1 && (startswithRAL+endswithRAL != 2) {
-				return nil, errors.New("stringprep: Contains RAL but does not start and end with RAL characters")
+			if err := b.check(); err != nil {
+				return nil, err
 			}
-
 			break
 		case MAP_TABLE:
 			output = map_table(output, profile[i].Table)
 			break
 		case UNASSIGNED_TABLE:
+			if flags&AllowUnassigned != 0 {
+				break
+			}
 			for k := 0; k < len(output); k++ {
 				if in_table(output[k], profile[i].Table) {
-					return nil, errors.New("stringprep: Unassigned character in input runes")
+					return nil, &Error{UNASSIGNED_TABLE, tableName(profile[i].Table), k, output[k], ErrUnassigned}
 				}
 			}
 		case PROHIBIT_TABLE:
 			for k := 0; k < len(output); k++ {
 				if in_table(output[k], profile[i].Table) {
-					return nil, errors.New("stringprep: Prohibited character, cannot casefold this")
+					return nil, &Error{PROHIBIT_TABLE, tableName(profile[i].Table), k, output[k], ErrProhibited}
 				}
 			}
 			break
@@ -148,8 +247,12 @@ func PrepareRunes(profile Profile, input []rune) ([]rune, error) {
 			break
 		case BIDI_L_TABLE:
 			break
+		case INSIGNIFICANT_SPACE:
+			if whole {
+				output = insignificantSpace(output)
+			}
 		default:
-			return nil, errors.New("stringprep: Profile error")
+			return nil, &Error{Step: profile[i].Step, Err: ErrProfile}
 		}
 	}
 
@@ -6,7 +6,15 @@
 
 package stringprep
 
-import "testing"
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"golang.org/x/text/transform"
+)
 
 type mappingtestcase struct {
 	Mapping string
@@ -114,3 +122,606 @@ func TestBadMapping(t *testing.T) {
 		}
 	}
 }
+
+type errortestcase struct {
+	Mapping string
+	Input   []rune
+	Err     error
+	Step    int
+	Table   string
+	Offset  int
+	Rune    rune
+}
+
+var errorTests = []errortestcase{
+	{"nameprep", []rune{0x0061, 0x1680}, ErrProhibited, PROHIBIT_TABLE, "C.1.2", 1, 0x1680},
+	{"nameprep", []rune{0x0085}, ErrProhibited, PROHIBIT_TABLE, "C.2.2", 0, 0x0085},
+	{"nameprep", []rune{0x0041, 0x200e}, ErrProhibited, PROHIBIT_TABLE, "C.8", 1, 0x200e},
+	{"nameprep", []rune{0x0061, 0x0221}, ErrUnassigned, UNASSIGNED_TABLE, "A.1", 1, 0x0221},
+	{"nameprep", []rune{0x0066, 0x006f, 0x006f, 0x05be, 0x0062, 0x0061, 0x0072}, ErrBidi, BIDI_L_TABLE, "D.2", 0, 0x0066},
+	{"nameprep", []rune{0x0627, 0x0031}, ErrBidi, BIDI_RAL_TABLE, "D.1", 1, 0x0031},
+}
+
+func TestErrors(t *testing.T) {
+	for i, test := range errorTests {
+		_, err := PrepareRunes(Profiles[test.Mapping], test.Input)
+		var perr *Error
+		if !errors.As(err, &perr) {
+			t.Errorf("For test %d got error %v; want *Error", i, err)
+			continue
+		}
+		if !errors.Is(err, test.Err) || perr.Step != test.Step || perr.Table != test.Table ||
+			perr.Offset != test.Offset || perr.Rune != test.Rune {
+			t.Errorf("For test %d got %+v; want %+v", i, *perr, test)
+		}
+	}
+
+	if name := TableName(Tables["B1"]); name != "B.1" {
+		t.Errorf("TableName(Tables[\"B1\"]) = %q; want %q", name, "B.1")
+	}
+	if name := TableName(iscsiProhibited); name != "RFC 3722" {
+		t.Errorf("TableName(iscsiProhibited) = %q; want %q", name, "RFC 3722")
+	}
+}
+
+func TestAllowUnassigned(t *testing.T) {
+	output, err := PrepareRunesFlags(Profiles["nameprep"], []rune{0x0061, 0x0221}, AllowUnassigned)
+	if err != nil || string(output) != "aȡ" {
+		t.Errorf("PrepareRunesFlags(AllowUnassigned) = %+q, %v; want %+q", string(output), err, "aȡ")
+	}
+}
+
+func TestSingleRAL(t *testing.T) {
+	if _, err := PrepareRunes(Profiles["nameprep"], []rune{0x0627}); err != nil {
+		t.Errorf("PrepareRunes(U+0627) error = %v", err)
+	}
+}
+
+// iterPrepare prepares input with an Iter using buffers of size n.
+func iterPrepare(profile Profile, input string, n int) (string, error) {
+	var it Iter
+	it.SetInputString(&profile, input)
+	var out []byte
+	buf := make([]byte, n)
+	for !it.Done() {
+		pos := it.Pos()
+		m := it.Next(buf)
+		if it.Err() == nil && it.Pos() <= pos && m == 0 && !it.Done() {
+			return "", errors.New("Iter made no progress")
+		}
+		out = append(out, buf[:m]...)
+	}
+	return string(out), it.Err()
+}
+
+func TestIter(t *testing.T) {
+	var inputs []string
+	for _, test := range mappingTests {
+		inputs = append(inputs, string(test.Input))
+	}
+	long := ""
+	for _, test := range mappingTests[:8] {
+		long += string(test.Input)
+	}
+	inputs = append(inputs,
+		long+long+long,
+		strings.Repeat("a\u0301", 100),
+		strings.Repeat("\u1100\u1161\u11a8", 100),
+		strings.Repeat("x\u00ad\u0308", 100),
+		strings.Repeat("\u05d0", 40)+"1\u05d1",
+	)
+
+	for _, input := range inputs {
+		want, _ := PrepareRunes(Profiles["nameprep"], []rune(input))
+		for _, n := range []int{MaxSegmentSize, 4096} {
+			got, err := iterPrepare(Profiles["nameprep"], input, n)
+			if err != nil || got != string(want) {
+				t.Errorf("Iter(%+q, %d) = %+q, %v; want %+q", input, n, got, err, string(want))
+			}
+		}
+		var it Iter
+		it.SetInput(&nameprepProfile, []byte(input))
+		buf := make([]byte, MaxSegmentSize)
+		got := it.Next(buf)
+		if got == 0 && len(want) != 0 {
+			t.Errorf("Iter.Next(%+q) wrote nothing", input)
+		}
+	}
+}
+
+func TestIterErrors(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Err    error
+		Offset int
+	}{
+		{strings.Repeat("a", 40) + "\u1680", ErrProhibited, 40},
+		{strings.Repeat("\u00df", 40) + "\u0221", ErrUnassigned, 80},
+		{strings.Repeat("\u05d0", 40) + "a\u05d0", ErrBidi, 40},
+		{strings.Repeat("\u05d0", 40) + "1", ErrBidi, 40},
+	}
+	for _, test := range tests {
+		_, err := iterPrepare(Profiles["nameprep"], test.Input, MaxSegmentSize)
+		var perr *Error
+		if !errors.Is(err, test.Err) || !errors.As(err, &perr) || perr.Offset != test.Offset {
+			t.Errorf("Iter(%+q) error = %v; want %v at offset %d", test.Input, err, test.Err, test.Offset)
+		}
+	}
+
+	if _, err := iterPrepare(Profiles["nameprep"], "\u00e9", 1); err != io.ErrShortBuffer {
+		t.Errorf("Iter with a short buffer error = %v; want %v", err, io.ErrShortBuffer)
+	}
+}
+
+// longRuns are inputs with long runs of runes without an NFKC boundary
+// between them.
+var longRuns = []string{
+	"a" + strings.Repeat("\u00ad", 5000) + "b",
+	"a" + strings.Repeat("\u0301", 3000),
+	strings.Repeat("\u0308", 3000) + "b",
+}
+
+func TestLongRuns(t *testing.T) {
+	p := Profiles["nameprep"]
+	for _, input := range longRuns {
+		want, err := PrepareRunes(p, []rune(input))
+		if err != nil {
+			t.Fatalf("PrepareRunes(%d runes) error = %v", len([]rune(input)), err)
+		}
+
+		if got, err := iterPrepare(p, input, MaxSegmentSize); got != string(want) || err != nil {
+			t.Errorf("Iter(%d runes) = %d bytes, %v; want %d bytes", len([]rune(input)), len(got), err, len(string(want)))
+		}
+		if got, _, err := transform.String(p.NewTransformer(), input); got != string(want) || err != nil {
+			t.Errorf("transform.String(%d runes) = %d bytes, %v; want %d bytes", len([]rune(input)), len(got), err, len(string(want)))
+		}
+		got, err := io.ReadAll(transform.NewReader(strings.NewReader(input), p.NewTransformer()))
+		if string(got) != string(want) || err != nil {
+			t.Errorf("transform.NewReader(%d runes) = %d bytes, %v; want %d bytes", len([]rune(input)), len(got), err, len(string(want)))
+		}
+		if got, err := transformSmall(p.NewTransformer(), input, 64); got != string(want) || err != nil {
+			t.Errorf("Transform(%d runes) with small buffer = %d bytes, %v; want %d bytes", len([]rune(input)), len(got), err, len(string(want)))
+		}
+	}
+}
+
+func TestProfileMethods(t *testing.T) {
+	p := Profiles["nameprep"]
+	var inputs []string
+	for _, test := range mappingTests {
+		inputs = append(inputs, string(test.Input))
+	}
+	inputs = append(inputs, strings.Repeat("a\u0301\u00ad\u0308", 50), "\u1100\u1161\u11a8\u05d0")
+
+	for _, input := range inputs {
+		want, wantErr := PrepareRunes(p, []rune(input))
+
+		if got, err := p.StringErr(input); got != string(want) || (err == nil) != (wantErr == nil) {
+			t.Errorf("StringErr(%+q) = %+q, %v; want %+q, %v", input, got, err, string(want), wantErr)
+		}
+		if got := p.Bytes([]byte(input)); string(got) != string(want) {
+			t.Errorf("Bytes(%+q) = %+q; want %+q", input, got, string(want))
+		}
+
+		// Appending one rune at a time must give the same result, unless a
+		// prefix of the input does not satisfy the BIDI requirements.
+		var out []byte
+		var err error
+		for _, r := range input {
+			if out, err = p.AppendStringErr(out, string(r)); err != nil {
+				break
+			}
+		}
+		if err == nil && string(out) != string(want) {
+			t.Errorf("AppendString(%+q) = %+q, %v; want %+q", input, out, err, string(want))
+		}
+
+		// Streams may be partly written before the BIDI requirements can be
+		// checked, so only the error is compared for rejected input.
+		got, err := io.ReadAll(p.Reader(iotest.OneByteReader(strings.NewReader(input))))
+		if (err == nil) != (wantErr == nil) || (err == nil && string(got) != string(want)) {
+			t.Errorf("Reader(%+q) = %+q, %v; want %+q, %v", input, got, err, string(want), wantErr)
+		}
+
+		var b strings.Builder
+		w := p.Writer(&b)
+		for i := 0; i < len(input) && err == nil; i++ {
+			_, err = w.Write([]byte{input[i]})
+		}
+		if err == nil {
+			err = w.Close()
+		}
+		if (err == nil) != (wantErr == nil) || (err == nil && b.String() != string(want)) {
+			t.Errorf("Writer(%+q) = %+q, %v; want %+q, %v", input, b.String(), err, string(want), wantErr)
+		}
+	}
+}
+
+func TestProfileErrors(t *testing.T) {
+	p := Profiles["nameprep"]
+	for _, test := range badMappingTests {
+		input := string(test.Input)
+		if got, err := p.StringErr(input); err == nil {
+			t.Errorf("StringErr(%+q) = %+q; want error", input, got)
+		}
+		if got := p.String(input); got != "" {
+			t.Errorf("String(%+q) = %+q; want \"\"", input, got)
+		}
+		if got := p.Append([]byte("x"), []byte(input)...); string(got) != "x" {
+			t.Errorf("Append(\"x\", %+q) = %+q; want \"x\"", input, got)
+		}
+	}
+
+	// The BIDI requirements apply to the whole result of Append.
+	out := p.AppendString(nil, "\u05d0")
+	if _, err := p.AppendStringErr(out, "a"); !errors.Is(err, ErrBidi) {
+		t.Errorf("AppendString(%+q, \"a\") error = %v; want %v", out, err, ErrBidi)
+	}
+
+	w := p.Writer(io.Discard)
+	if _, err := w.Write([]byte("\u05d0a")); err != nil {
+		t.Errorf("Writer.Write error = %v; want nil before Close", err)
+	}
+	if err := w.Close(); !errors.Is(err, ErrBidi) {
+		t.Errorf("Writer.Close error = %v; want %v", err, ErrBidi)
+	}
+}
+
+// transformSmall prepares input with t, giving it all input at once but only
+// n bytes of output space at a time.
+func transformSmall(t transform.Transformer, input string, n int) (string, error) {
+	var out []byte
+	dst := make([]byte, n)
+	src := []byte(input)
+	for {
+		nDst, nSrc, err := t.Transform(dst, src, true)
+		out = append(out, dst[:nDst]...)
+		src = src[nSrc:]
+		if err != transform.ErrShortDst {
+			return string(out), err
+		}
+		if nDst == 0 {
+			return string(out), io.ErrShortBuffer
+		}
+	}
+}
+
+func TestTransformer(t *testing.T) {
+	p := Profiles["nameprep"]
+	var inputs []string
+	for _, test := range mappingTests {
+		inputs = append(inputs, string(test.Input))
+	}
+	inputs = append(inputs, strings.Repeat("a\u0301\u00ad\u0308", 50), "\u1100\u1161\u11a8\u05d0", "")
+
+	tr := p.NewTransformer()
+	for _, input := range inputs {
+		want, wantErr := PrepareRunes(p, []rune(input))
+
+		// Output may be written before the BIDI requirements can be checked,
+		// so only the error is compared for rejected input.
+		got, _, err := transform.String(tr, input)
+		if (err == nil) != (wantErr == nil) || (err == nil && got != string(want)) {
+			t.Errorf("transform.String(%+q) = %+q, %v; want %+q, %v", input, got, err, string(want), wantErr)
+		}
+
+		tr.Reset()
+		got, err = transformSmall(tr, input, 64)
+		if (err == nil) != (wantErr == nil) || (err == nil && got != string(want)) {
+			t.Errorf("Transform(%+q) with small buffer = %+q, %v; want %+q, %v", input, got, err, string(want), wantErr)
+		}
+
+		b, err := io.ReadAll(transform.NewReader(iotest.OneByteReader(strings.NewReader(input)), p.NewTransformer()))
+		if (err == nil) != (wantErr == nil) || (err == nil && string(b) != string(want)) {
+			t.Errorf("transform.NewReader(%+q) = %+q, %v; want %+q, %v", input, b, err, string(want), wantErr)
+		}
+
+		if wantErr != nil {
+			continue
+		}
+		chain := transform.Chain(transform.Nop, p.NewTransformer(), transform.Nop)
+		if got, _, err := transform.String(chain, input); got != string(want) || err != nil {
+			t.Errorf("transform.Chain(%+q) = %+q, %v; want %+q", input, got, err, string(want))
+		}
+	}
+}
+
+func TestTransformerErrors(t *testing.T) {
+	p := Profiles["nameprep"]
+	for _, test := range badMappingTests {
+		input := string(test.Input)
+		_, wantErr := PrepareRunes(p, test.Input)
+		_, _, err := transform.String(p.NewTransformer(), input)
+		if err == nil {
+			t.Errorf("transform.String(%+q) error = nil; want %v", input, wantErr)
+			continue
+		}
+		var got, want *Error
+		if !errors.As(err, &got) || !errors.As(wantErr, &want) || *got != *want {
+			t.Errorf("transform.String(%+q) error = %v; want %v", input, err, wantErr)
+		}
+	}
+
+	// The segments before a prohibited rune are written, and the error is
+	// kept until Reset.
+	tr := p.NewTransformer()
+	input := strings.Repeat("a", 40) + "\ue000"
+	dst := make([]byte, 64)
+	nDst, nSrc, err := tr.Transform(dst, []byte(input), true)
+	if !errors.Is(err, ErrProhibited) || nDst == 0 || nSrc != nDst {
+		t.Errorf("Transform(%+q) = %d, %d, %v; want output before the error and %v", input, nDst, nSrc, err, ErrProhibited)
+	}
+	if _, _, err := tr.Transform(dst, []byte("a"), true); !errors.Is(err, ErrProhibited) {
+		t.Errorf("Transform after error = %v; want %v", err, ErrProhibited)
+	}
+	tr.Reset()
+	if nDst, _, err := tr.Transform(dst, []byte("a"), true); err != nil || nDst != 1 {
+		t.Errorf("Transform after Reset = %d, %v; want 1, nil", nDst, err)
+	}
+
+	// The BIDI requirements are only checked at EOF.
+	tr.Reset()
+	if nDst, nSrc, err := tr.Transform(dst, []byte("\u05d0a"), false); err != nil && err != transform.ErrShortSrc {
+		t.Errorf("Transform(\"\\u05d0a\", !atEOF) = %d, %d, %v; want no BIDI error", nDst, nSrc, err)
+	}
+	if _, _, err := transform.String(p.NewTransformer(), "\u05d0a"); !errors.Is(err, ErrBidi) {
+		t.Errorf("transform.String(\"\\u05d0a\") error = %v; want %v", err, ErrBidi)
+	}
+}
+
+// from http://tools.ietf.org/html/rfc4013#section-3
+var saslprepTests = []struct {
+	Input  string
+	Output string
+	Err    error
+}{
+	{"I\u00adX", "IX", nil},
+	{"user", "user", nil},
+	{"USER", "USER", nil},
+	{"\u00aa", "a", nil},
+	{"\u2168", "IX", nil},
+	{"\u0007", "", ErrProhibited},
+	{"\u0627\u0031", "", ErrBidi},
+
+	{"pass word", "pass word", nil},
+	{"pass\u00a0word\u3000", "pass word ", nil},
+	// U+200B is in both C.1.2 and B.1, and is mapped to SPACE first.
+	{"\u200bpass\u2060", " pass", nil},
+	{"\u0627\u0031\u0628", "\u0627\u0031\u0628", nil},
+	{"e\u0301", "\u00e9", nil},
+	{"\ue000", "", ErrProhibited},
+	{"\u0221", "", ErrUnassigned},
+}
+
+func TestSASLprep(t *testing.T) {
+	for _, test := range saslprepTests {
+		got, err := SASLprep(test.Input)
+		if got != test.Output || !errors.Is(err, test.Err) {
+			t.Errorf("SASLprep(%+q) = %+q, %v; want %+q, %v", test.Input, got, err, test.Output, test.Err)
+		}
+		if test.Err == ErrUnassigned {
+			continue
+		}
+		got, err = SASLprepQuery(test.Input)
+		if got != test.Output || !errors.Is(err, test.Err) {
+			t.Errorf("SASLprepQuery(%+q) = %+q, %v; want %+q, %v", test.Input, got, err, test.Output, test.Err)
+		}
+	}
+
+	// Queries may contain unassigned code points.
+	if got, err := SASLprepQuery("a\u0221"); got != "a\u0221" || err != nil {
+		t.Errorf("SASLprepQuery(%+q) = %+q, %v; want %+q, nil", "a\u0221", got, err, "a\u0221")
+	}
+
+	// The profile is available by name.
+	if got, err := PrepareRunes(Profiles["saslprep"], []rune("\u00a0")); string(got) != " " || err != nil {
+		t.Errorf("PrepareRunes(saslprep, %+q) = %+q, %v; want \" \", nil", "\u00a0", string(got), err)
+	}
+}
+
+var xmppTests = []struct {
+	Profile string
+	Input   string
+	Output  string
+	Err     error
+}{
+	// JID localparts
+	{"nodeprep", "juliet", "juliet", nil},
+	{"nodeprep", "Juliet", "juliet", nil},
+	{"nodeprep", "\uff2a\uff55\uff4c\uff49\uff45\uff54", "juliet", nil},
+	{"nodeprep", "Stra\u00dfe", "strasse", nil},
+	{"nodeprep", "\u00c9lise", "\u00e9lise", nil},
+	{"nodeprep", "ju\u00adliet", "juliet", nil},
+	{"nodeprep", "\u0444\u0435\u0434\u044f", "\u0444\u0435\u0434\u044f", nil},
+	{"nodeprep", "mon cher", "", ErrProhibited},
+	{"nodeprep", "juliet@capulet", "", ErrProhibited},
+	{"nodeprep", "r&d", "", ErrProhibited},
+	{"nodeprep", "\"quoted\"", "", ErrProhibited},
+	{"nodeprep", "a/b", "", ErrProhibited},
+	{"nodeprep", "\u0627a", "", ErrBidi},
+	{"nodeprep", "\u0221", "", ErrUnassigned},
+
+	// JID resourceparts
+	{"resourceprep", "balcony", "balcony", nil},
+	{"resourceprep", "Balcony", "Balcony", nil},
+	{"resourceprep", "foo bar", "foo bar", nil},
+	{"resourceprep", "foo@bar/baz", "foo@bar/baz", nil},
+	{"resourceprep", "\u2168", "IX", nil},
+	{"resourceprep", "Stra\u00dfe", "Stra\u00dfe", nil},
+	{"resourceprep", "bal\u200bcony", "balcony", nil},
+	{"resourceprep", "foo\u1680bar", "", ErrProhibited},
+	{"resourceprep", "\u0007", "", ErrProhibited},
+	{"resourceprep", "\u0221", "", ErrUnassigned},
+}
+
+func TestXMPPProfiles(t *testing.T) {
+	prep := map[string]func(string) (string, error){
+		"nodeprep":     Nodeprep,
+		"resourceprep": Resourceprep,
+	}
+	for _, test := range xmppTests {
+		got, err := prep[test.Profile](test.Input)
+		if got != test.Output || !errors.Is(err, test.Err) {
+			t.Errorf("%s(%+q) = %+q, %v; want %+q, %v", test.Profile, test.Input, got, err, test.Output, test.Err)
+		}
+		out, err := PrepareRunes(Profiles[test.Profile], []rune(test.Input))
+		if string(out) != test.Output || !errors.Is(err, test.Err) {
+			t.Errorf("PrepareRunes(%s, %+q) = %+q, %v; want %+q, %v", test.Profile, test.Input, string(out), err, test.Output, test.Err)
+		}
+	}
+
+	// Errors name the tables of the profile files.
+	_, err := Nodeprep("a@b")
+	var perr *Error
+	if !errors.As(err, &perr) || perr.Table != "RFC 3920" || perr.Rune != '@' {
+		t.Errorf("Nodeprep(\"a@b\") error = %v; want table RFC 3920, rune '@'", err)
+	}
+	_, err = Resourceprep("a\u1680")
+	if !errors.As(err, &perr) || perr.Table != "C.1.2" {
+		t.Errorf("Resourceprep(\"a\\u1680\") error = %v; want table C.1.2", err)
+	}
+}
+
+// The tables read from nodeprep.txt must match those generated from RFC 3454.
+func TestProfileFile(t *testing.T) {
+	tables := map[string]Table{}
+	for _, e := range Profiles["nodeprep"] {
+		if len(e.Table) > 0 && e.Step != BIDI_RAL_TABLE && e.Step != BIDI_L_TABLE {
+			tables[tableName(e.Table)] = e.Table
+		}
+	}
+	for _, name := range []string{"A.1", "B.1", "B.2", "C.1.1", "C.1.2", "C.2.1", "C.2.2", "C.3", "C.4", "C.6", "C.7", "C.8", "C.9"} {
+		file, ok := tables[name]
+		if !ok {
+			t.Errorf("nodeprep.txt has no table %s", name)
+			continue
+		}
+		rfc := Tables[strings.ReplaceAll(name, ".", "")]
+		for _, r := range tableEdges(file, rfc) {
+			if in_table(r, file) != in_table(r, rfc) {
+				t.Errorf("table %s: in_table(%U) differs from RFC 3454", name, r)
+			} else if string(map_table([]rune{r}, file)) != string(map_table([]rune{r}, rfc)) {
+				t.Errorf("table %s: mapping of %U differs from RFC 3454", name, r)
+			}
+		}
+	}
+
+	if _, err := parseProfile("0041; 0061; MAP\n"); err == nil {
+		t.Errorf("parseProfile accepted an entry outside a table")
+	}
+	if _, err := parseProfile("# code points from Table C.9\n\nXYZ; ; PROHIBITED\n"); err == nil {
+		t.Errorf("parseProfile accepted an invalid code point")
+	}
+}
+
+// tableEdges returns the code points at and next to the ends of the ranges of
+// the tables, where two tables with different contents must differ.
+func tableEdges(tables ...Table) []rune {
+	var edges []rune
+	for _, table := range tables {
+		for _, e := range table {
+			hi := e.Hi
+			if hi < e.Lo {
+				hi = e.Lo
+			}
+			edges = append(edges, e.Lo-1, e.Lo, hi, hi+1)
+		}
+	}
+	return edges
+}
+
+var traceTests = []struct {
+	Input string
+	Err   error
+}{
+	{"sirhc", nil},
+	{"Chris Newman <chris.newman@example.com>", nil},
+	{"\u00c9lise \u00adX", nil},
+	{"\u0627\u0628", nil},
+	{"line\nbreak", ErrProhibited},
+	{"\u0085", ErrProhibited},
+	{"\ue000", ErrProhibited},
+	{"\u200e", ErrProhibited},
+	{"\u0627a", ErrBidi},
+	{"\u0221", ErrUnassigned},
+}
+
+func TestTrace(t *testing.T) {
+	for _, test := range traceTests {
+		want := test.Input
+		if test.Err != nil {
+			want = ""
+		}
+		if got, err := Trace(test.Input); got != want || !errors.Is(err, test.Err) {
+			t.Errorf("Trace(%+q) = %+q, %v; want %+q, %v", test.Input, got, err, want, test.Err)
+		}
+	}
+}
+
+var ldapTests = []struct {
+	Profile string
+	Input   string
+	Output  string
+	Err     error
+}{
+	// insignificant space handling, from RFC 4518 section 2.6.1
+	{"ldap", "foo bar  ", " foo  bar ", nil},
+	{"ldap", "", "  ", nil},
+	{"ldap", "   ", "  ", nil},
+	{"ldap", "Foo", " Foo ", nil},
+	{"ldap", "\tfoo\r\nbar\u3000", " foo  bar ", nil},
+	{"ldap", "f\u00adoo\u200b\u0000\u2060bar", " foobar ", nil},
+	{"ldap", "\u2168", " IX ", nil},
+	{"ldap", "\u0627a", " \u0627a ", nil},
+	{"ldap", "\ue000", "", ErrProhibited},
+	{"ldap", "\ufffd", "", ErrProhibited},
+	{"ldap", "\u0221", "", ErrUnassigned},
+	{"ldap-caseignore", " Foo  BAR ", " foo  bar ", nil},
+	{"ldap-caseignore", "Stra\u00dfe", " strasse ", nil},
+
+	// numericString and telephoneNumber handling, from RFC 4518
+	// sections 2.6.2 and 2.6.3
+	{"ldap-numeric", " 1 234 567 ", "1234567", nil},
+	{"ldap-numeric", "", "", nil},
+	{"ldap-telephone", "+1 555-0123", "+15550123", nil},
+	{"ldap-telephone", "+1 (555) \u2010 0123\uff0d4", "+1(555)01234", nil},
+	{"ldap-telephone", "ext\u00a0A", "exta", nil},
+}
+
+func TestLDAPprep(t *testing.T) {
+	for _, test := range ldapTests {
+		p := Profiles[test.Profile]
+		got, err := p.StringErr(test.Input)
+		if got != test.Output || !errors.Is(err, test.Err) {
+			t.Errorf("%s(%+q) = %+q, %v; want %+q, %v", test.Profile, test.Input, got, err, test.Output, test.Err)
+		}
+		out, err := PrepareRunes(p, []rune(test.Input))
+		if string(out) != test.Output || !errors.Is(err, test.Err) {
+			t.Errorf("PrepareRunes(%s, %+q) = %+q, %v; want %+q, %v", test.Profile, test.Input, string(out), err, test.Output, test.Err)
+		}
+	}
+	if got, err := LDAPprep("a  b"); got != " a  b " || err != nil {
+		t.Errorf("LDAPprep(\"a  b\") = %+q, %v; want \" a  b \", nil", got, err)
+	}
+
+	// Insignificant space handling needs the whole string.
+	p := Profiles["ldap"]
+	if _, err := p.AppendStringErr([]byte(" a "), "b"); !errors.Is(err, ErrProfile) {
+		t.Errorf("AppendString on ldap profile error = %v; want %v", err, ErrProfile)
+	}
+	var it Iter
+	it.SetInputString(&p, "a")
+	if !it.Done() || !errors.Is(it.Err(), ErrProfile) {
+		t.Errorf("Iter on ldap profile error = %v; want %v", it.Err(), ErrProfile)
+	}
+	if _, _, err := transform.String(p.NewTransformer(), "a"); !errors.Is(err, ErrProfile) {
+		t.Errorf("Transformer on ldap profile error = %v; want %v", err, ErrProfile)
+	}
+	if _, err := p.AppendStringErr([]byte(" a "), "b"); err == nil || err.Error() != "stringprep: Profile error in step 9" {
+		t.Errorf("AppendString on ldap profile error = %v; want \"stringprep: Profile error in step 9\"", err)
+	}
+	p = Profiles["ldap-numeric"]
+	if got, _, err := transform.String(p.NewTransformer(), " 1 2 "); got != "12" || err != nil {
+		t.Errorf("Transformer on ldap-numeric profile = %+q, %v; want \"12\", nil", got, err)
+	}
+}
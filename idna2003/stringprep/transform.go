@@ -0,0 +1,145 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package stringprep
+
+import "golang.org/x/text/transform"
+
+// A Transformer prepares text with a Profile as a transform.Transformer, so
+// that it can be used with transform.Chain, transform.NewReader and the
+// other functions of the transform package.
+//
+// The input is prepared in segments ending at boundaries where NFKC
+// normalization, and so the whole profile, can be applied to each segment
+// separately. The input after the last such boundary is kept by the
+// Transformer until more input is given or atEOF is set, so the buffer of
+// the caller never limits the length of a segment, even for long runs of
+// combining marks or of runes mapped to nothing. Likewise a prepared segment
+// that does not fit in an empty dst is written over several calls.
+//
+// A prohibited or unassigned rune is reported as an *Error when the segment
+// containing it is transformed, after the segments before it have been
+// written. The BIDI requirements depend on the whole text, so they are only
+// checked once all input has been transformed with atEOF set. After an error
+// the Transformer keeps returning it until Reset is called.
+type Transformer struct {
+	profile Profile
+	it      Iter
+	carry   []byte // input after the last boundary, kept until more input is given
+	out     []byte // prepared output that did not fit in dst
+}
+
+// NewTransformer returns a Transformer that prepares text with p.
+func (p *Profile) NewTransformer() *Transformer {
+	t := &Transformer{profile: *p}
+	t.Reset()
+	return t
+}
+
+// Reset resets the state of the Transformer so it can prepare new text.
+func (t *Transformer) Reset() {
+	t.it.reset(&t.profile)
+	t.it.partial = true
+	t.carry = t.carry[:0]
+	t.out = t.out[:0]
+}
+
+// Transform implements the transform.Transformer interface.
+func (t *Transformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	if len(t.out) > 0 {
+		nDst = copy(dst, t.out)
+		t.out = t.out[nDst:]
+		if len(t.out) > 0 {
+			return nDst, 0, transform.ErrShortDst
+		}
+	}
+	n, nSrc, err := t.transformCarry(dst[nDst:], src, atEOF)
+	return nDst + n, nSrc, err
+}
+
+// transformCarry implements Transform for the carried input followed by
+// src. The input after the last boundary is carried to the next call.
+func (t *Transformer) transformCarry(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	input := src
+	if len(t.carry) > 0 {
+		input = append(t.carry, src...)
+	}
+	nDst, n, err := t.transform(dst, input, atEOF)
+	switch err {
+	case nil, transform.ErrShortSrc, transform.ErrShortDst:
+		t.carry = append(t.carry[:0], input[n:]...)
+		if err == transform.ErrShortSrc {
+			err = nil
+		}
+		return nDst, len(src), err
+	}
+	// The carried input was consumed by earlier calls, so only the part of
+	// src before the rejected segment is reported as consumed.
+	nSrc = n - len(t.carry)
+	if nSrc < 0 {
+		nSrc = 0
+	}
+	return nDst, nSrc, err
+}
+
+// transform prepares the segments of src that end at a boundary, or all of
+// src if atEOF is set.
+func (t *Transformer) transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	it := &t.it
+	if it.err != nil {
+		return 0, 0, it.err
+	}
+
+	k := len(src)
+	if !atEOF {
+		k = it.lastBoundary(src)
+	}
+	it.setInput(src[:k])
+
+	for it.pos < it.length {
+		// Keep the state of the BIDI checks so the segment can be prepared
+		// again if it does not fit in dst.
+		var saved bidi
+		if it.bidi != nil {
+			saved = *it.bidi
+		}
+		n := it.n
+
+		if !it.nextSegment() {
+			return nDst, it.pos, it.err
+		}
+		if len(it.out) > len(dst)-nDst {
+			if nDst == 0 {
+				// The segment does not fit even in an empty dst, so the
+				// rest of it is kept and written by the next calls.
+				nDst = copyRunes(dst, it.out)
+				t.out = append(t.out[:0], it.out[nDst:]...)
+				it.pos = it.pendingEnd
+				it.pending = false
+				return nDst, it.pos, transform.ErrShortDst
+			}
+			if it.bidi != nil {
+				*it.bidi = saved
+			}
+			it.n = n
+			it.pending = false
+			return nDst, it.pos, transform.ErrShortDst
+		}
+		nDst += copy(dst[nDst:], it.out)
+		it.pos = it.pendingEnd
+		it.pending = false
+	}
+
+	if k < len(src) {
+		return nDst, k, transform.ErrShortSrc
+	}
+	if atEOF && it.bidi != nil {
+		if it.err = it.bidi.check(); it.err != nil {
+			return nDst, k, it.err
+		}
+	}
+	return nDst, k, nil
+}
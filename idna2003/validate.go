@@ -0,0 +1,157 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package idna2003
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/DanielOaks/go-idn/idna2003/punycode"
+	"github.com/DanielOaks/go-idn/idna2003/stringprep"
+	"github.com/DanielOaks/go-idn/report"
+)
+
+// A LabelReport describes a label of a domain name checked by ValidateDomain.
+// Prepared is the label after Nameprep, or "" if Nameprep failed, and each of
+// the Violations is a *LabelError. The offsets of the stringprep errors of
+// code points rejected by Nameprep are byte offsets in the label.
+type LabelReport = report.LabelReport
+
+// A DomainReport describes a domain name checked by ValidateDomain.
+type DomainReport = report.DomainReport
+
+// ValidateDomain checks a domain name with the Default Converter and reports
+// every problem found in each of its labels.
+func ValidateDomain(domain string) *DomainReport {
+	return Default.ValidateDomain(domain)
+}
+
+// ValidateDomain checks a domain name using the flags of the Converter.
+// Unlike ToASCII it does not stop at the first problem, but reports every
+// problem found in each label. The domain name is always limited to 253
+// octets, excluding a trailing dot.
+func (c *Converter) ValidateDomain(domain string) *DomainReport {
+	r := &DomainReport{}
+
+	labels := c.split(domain)
+	if n := len(labels); n > 1 && labels[n-1] == "" {
+		r.TrailingDot = true
+		labels = labels[:n-1]
+	}
+
+	length := len(labels) - 1
+	for i, l := range labels {
+		lr := c.validateLabel(i, l)
+		r.Labels = append(r.Labels, lr)
+		length += len(lr.ACE)
+	}
+
+	if r.Valid() && length > 253 {
+		r.Violations = append(r.Violations, ErrDomainLength)
+	}
+	return r
+}
+
+// validateLabel checks a label like toASCIIRaw, recording every problem found
+// instead of stopping at the first.
+func (c *Converter) validateLabel(index int, original string) LabelReport {
+	r := LabelReport{Original: original}
+	fail := func(offset int, ch rune, err error) {
+		r.Violations = append(r.Violations, &LabelError{index, offset, ch, err})
+	}
+
+	label := toLowerASCII(original)
+	if !isASCII(label) {
+		prepared, err := c.nameprep(label)
+		if err != nil {
+			c.nameprepViolations(index, label, err, &r)
+		} else {
+			label = prepared
+			r.Prepared = prepared
+		}
+	} else {
+		r.Prepared = label
+	}
+
+	if c.useSTD3ASCIIRules {
+		for i, ch := range label {
+			if isNonLDH(ch) {
+				fail(i, ch, ErrNonLDH)
+			}
+		}
+		if strings.HasPrefix(label, "-") {
+			fail(0, '-', ErrHyphen)
+		}
+		if len(label) > 1 && strings.HasSuffix(label, "-") {
+			fail(len(label)-1, '-', ErrHyphen)
+		}
+	}
+
+	if r.Prepared == "" && label != "" {
+		// Nameprep failed, so there is nothing to encode.
+		return r
+	}
+
+	ace := label
+	if !isASCII(label) {
+		if strings.HasPrefix(label, AcePrefix) {
+			fail(-1, 0, ErrACEPrefix)
+			return r
+		}
+		p, err := punycode.EncodeString(label)
+		if err != nil {
+			fail(-1, 0, err)
+			return r
+		}
+		ace = AcePrefix + p
+	} else if strings.HasPrefix(label, AcePrefix) {
+		// An ACE label must decode to a label that encodes back to it.
+		if _, err := c.toUnicodeRaw(index, label); err != nil {
+			r.Violations = append(r.Violations, err)
+		}
+	}
+
+	if len(ace) < 1 || len(ace) > 63 {
+		fail(-1, 0, ErrLabelLength)
+	}
+
+	if len(r.Violations) == 0 {
+		r.ACE = ace
+	}
+	return r
+}
+
+// nameprepViolations records the problems that made Nameprep fail on label.
+// Code points that are prohibited or unassigned are found by preparing each
+// one on its own, so that all of them are reported; if there are none the
+// error from preparing the whole label, such as a Bidi failure, is recorded.
+// The offsets in the stringprep errors are byte offsets in label, like the
+// offsets of the other violations.
+func (c *Converter) nameprepViolations(index int, label string, err error, r *LabelReport) {
+	n := len(r.Violations)
+	for i, ch := range label {
+		_, cerr := c.nameprep(string(ch))
+		var serr *stringprep.Error
+		if errors.As(cerr, &serr) && !errors.Is(cerr, stringprep.ErrBidi) {
+			e := *serr
+			e.Offset = i
+			r.Violations = append(r.Violations, &LabelError{index, -1, 0, &e})
+		}
+	}
+	if len(r.Violations) == n {
+		r.Violations = append(r.Violations, &LabelError{index, -1, 0, err})
+	}
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] > 127 {
+			return false
+		}
+	}
+	return true
+}
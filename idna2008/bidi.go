@@ -0,0 +1,101 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package idna2008
+
+import (
+	"golang.org/x/text/unicode/bidi"
+)
+
+// bidiClass returns the Bidi_Class of r.
+func bidiClass(r rune) bidi.Class {
+	p, _ := bidi.LookupRune(r)
+	return p.Class()
+}
+
+// IsBidiDomain reports whether any of the labels contains a right-to-left
+// character, making the domain a "Bidi domain name" as defined in RFC 5893
+// section 1.4.
+func IsBidiDomain(labels []string) bool {
+	for _, label := range labels {
+		for _, r := range label {
+			switch bidiClass(r) {
+			case bidi.R, bidi.AL, bidi.AN:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CheckBidiRule reports whether label satisfies the six conditions of the
+// Bidi Rule in RFC 5893 section 2.
+func CheckBidiRule(label []rune) bool {
+	if len(label) == 0 {
+		return true
+	}
+
+	// 1. The first character must be a character with Bidi property L, R or
+	// AL. If it has the R or AL property, it is an RTL label; if it has the
+	// L property, it is an LTR label.
+	var rtl bool
+	switch bidiClass(label[0]) {
+	case bidi.R, bidi.AL:
+		rtl = true
+	case bidi.L:
+		rtl = false
+	default:
+		return false
+	}
+
+	// Trailing NSM characters are skipped for conditions 3 and 6.
+	end := len(label) - 1
+	for end > 0 && bidiClass(label[end]) == bidi.NSM {
+		end--
+	}
+
+	var hasEN, hasAN bool
+	for i, r := range label {
+		c := bidiClass(r)
+		if rtl {
+			// 2. In an RTL label, only characters with the Bidi properties
+			// R, AL, AN, EN, ES, CS, ET, ON, BN or NSM are allowed.
+			switch c {
+			case bidi.R, bidi.AL, bidi.ES, bidi.CS, bidi.ET, bidi.ON, bidi.BN, bidi.NSM:
+			case bidi.AN:
+				hasAN = true
+			case bidi.EN:
+				hasEN = true
+			default:
+				return false
+			}
+			// 3. In an RTL label, the end of the label must be a character
+			// with Bidi property R, AL, EN or AN, followed by zero or more
+			// characters with Bidi property NSM.
+			if i == end && c != bidi.R && c != bidi.AL && c != bidi.EN && c != bidi.AN {
+				return false
+			}
+		} else {
+			// 5. In an LTR label, only characters with the Bidi properties
+			// L, EN, ES, CS, ET, ON, BN or NSM are allowed.
+			switch c {
+			case bidi.L, bidi.EN, bidi.ES, bidi.CS, bidi.ET, bidi.ON, bidi.BN, bidi.NSM:
+			default:
+				return false
+			}
+			// 6. In an LTR label, the end of the label must be a character
+			// with Bidi property L or EN, followed by zero or more characters
+			// with Bidi property NSM.
+			if i == end && c != bidi.L && c != bidi.EN {
+				return false
+			}
+		}
+	}
+
+	// 4. In an RTL label, if an EN is present, no AN may be present, and
+	// vice versa.
+	return !(hasEN && hasAN)
+}
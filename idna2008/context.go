@@ -0,0 +1,123 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package idna2008
+
+import (
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Canonical_Combining_Class of virama characters.
+const cccVirama = 9
+
+// ContextRule reports whether the CONTEXTJ or CONTEXTO code point at
+// label[pos] is allowed in its context, using the rules from RFC 5892
+// appendix A. Code points without a rule are never allowed.
+func ContextRule(label []rune, pos int) bool {
+	switch cp := label[pos]; {
+	case cp == 0x200C:
+		return ruleZWNJ(label, pos)
+	case cp == 0x200D:
+		return ruleZWJ(label, pos)
+	case cp == 0x00B7:
+		return ruleMiddleDot(label, pos)
+	case cp == 0x0375:
+		return ruleGreekKeraia(label, pos)
+	case cp == 0x05F3 || cp == 0x05F4:
+		return ruleHebrewPunctuation(label, pos)
+	case cp == 0x30FB:
+		return ruleKatakanaMiddleDot(label, pos)
+	case 0x0660 <= cp && cp <= 0x0669:
+		return ruleArabicIndicDigits(label, pos)
+	case 0x06F0 <= cp && cp <= 0x06F9:
+		return ruleExtendedArabicIndicDigits(label, pos)
+	}
+	return false
+}
+
+// isVirama reports whether r has a Canonical_Combining_Class of Virama.
+func isVirama(r rune) bool {
+	return norm.NFD.PropertiesString(string(r)).CCC() == cccVirama
+}
+
+// A.1. ZERO WIDTH NON-JOINER
+func ruleZWNJ(label []rune, pos int) bool {
+	if pos > 0 && isVirama(label[pos-1]) {
+		return true
+	}
+
+	// (Joining_Type:{L,D})(Joining_Type:T)*‌(Joining_Type:T)*(Joining_Type:{R,D})
+	before := false
+	for i := pos - 1; i >= 0; i-- {
+		if unicode.Is(joiningT, label[i]) {
+			continue
+		}
+		before = unicode.Is(joiningL, label[i]) || unicode.Is(joiningD, label[i])
+		break
+	}
+	if !before {
+		return false
+	}
+	for i := pos + 1; i < len(label); i++ {
+		if unicode.Is(joiningT, label[i]) {
+			continue
+		}
+		return unicode.Is(joiningR, label[i]) || unicode.Is(joiningD, label[i])
+	}
+	return false
+}
+
+// A.2. ZERO WIDTH JOINER
+func ruleZWJ(label []rune, pos int) bool {
+	return pos > 0 && isVirama(label[pos-1])
+}
+
+// A.3. MIDDLE DOT
+func ruleMiddleDot(label []rune, pos int) bool {
+	return pos > 0 && pos < len(label)-1 && label[pos-1] == 'l' && label[pos+1] == 'l'
+}
+
+// A.4. GREEK LOWER NUMERAL SIGN (KERAIA)
+func ruleGreekKeraia(label []rune, pos int) bool {
+	return pos < len(label)-1 && unicode.Is(unicode.Greek, label[pos+1])
+}
+
+// A.5. HEBREW PUNCTUATION GERESH and A.6. HEBREW PUNCTUATION GERSHAYIM
+func ruleHebrewPunctuation(label []rune, pos int) bool {
+	return pos > 0 && unicode.Is(unicode.Hebrew, label[pos-1])
+}
+
+// A.7. KATAKANA MIDDLE DOT
+func ruleKatakanaMiddleDot(label []rune, pos int) bool {
+	for _, r := range label {
+		if r != 0x30FB && unicode.In(r, unicode.Hiragana, unicode.Katakana, unicode.Han) {
+			return true
+		}
+	}
+	return false
+}
+
+// A.8. ARABIC-INDIC DIGITS
+func ruleArabicIndicDigits(label []rune, pos int) bool {
+	for _, r := range label {
+		if 0x06F0 <= r && r <= 0x06F9 {
+			return false
+		}
+	}
+	return true
+}
+
+// A.9. EXTENDED ARABIC-INDIC DIGITS
+func ruleExtendedArabicIndicDigits(label []rune, pos int) bool {
+	for _, r := range label {
+		if 0x0660 <= r && r <= 0x0669 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,225 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package idna2008
+
+import (
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Property is the IDNA2008 derived property value of a code point, as
+// described in RFC 5892 section 2.
+type Property int
+
+// Derived property values, RFC 5892 section 2.
+const (
+	PVALID     Property = iota // protocol valid
+	CONTEXTJ                   // valid in context, join controls
+	CONTEXTO                   // valid in context, others
+	DISALLOWED                 // never valid in a label
+	UNASSIGNED                 // not assigned in this version of Unicode
+)
+
+var propertyNames = []string{
+	PVALID:     "PVALID",
+	CONTEXTJ:   "CONTEXTJ",
+	CONTEXTO:   "CONTEXTO",
+	DISALLOWED: "DISALLOWED",
+	UNASSIGNED: "UNASSIGNED",
+}
+
+func (p Property) String() string {
+	if p < 0 || int(p) >= len(propertyNames) {
+		return "Property(?)"
+	}
+	return propertyNames[p]
+}
+
+// Exceptions (F), RFC 5892 section 2.6.
+var exceptions = map[rune]Property{
+	// PVALID -- Would otherwise have been DISALLOWED
+	0x00DF: PVALID, // LATIN SMALL LETTER SHARP S
+	0x03C2: PVALID, // GREEK SMALL LETTER FINAL SIGMA
+	0x06FD: PVALID, // ARABIC SIGN SINDHI AMPERSAND
+	0x06FE: PVALID, // ARABIC SIGN SINDHI POSTPOSITION MEN
+	0x0F0B: PVALID, // TIBETAN MARK INTERSYLLABIC TSHEG
+	0x3007: PVALID, // IDEOGRAPHIC NUMBER ZERO
+
+	// CONTEXTO -- Would otherwise have been DISALLOWED
+	0x00B7: CONTEXTO, // MIDDLE DOT
+	0x0375: CONTEXTO, // GREEK LOWER NUMERAL SIGN (KERAIA)
+	0x05F3: CONTEXTO, // HEBREW PUNCTUATION GERESH
+	0x05F4: CONTEXTO, // HEBREW PUNCTUATION GERSHAYIM
+	0x30FB: CONTEXTO, // KATAKANA MIDDLE DOT
+
+	// CONTEXTO -- Would otherwise have been PVALID
+	0x0660: CONTEXTO, // ARABIC-INDIC DIGIT ZERO
+	0x0661: CONTEXTO, // ARABIC-INDIC DIGIT ONE
+	0x0662: CONTEXTO, // ARABIC-INDIC DIGIT TWO
+	0x0663: CONTEXTO, // ARABIC-INDIC DIGIT THREE
+	0x0664: CONTEXTO, // ARABIC-INDIC DIGIT FOUR
+	0x0665: CONTEXTO, // ARABIC-INDIC DIGIT FIVE
+	0x0666: CONTEXTO, // ARABIC-INDIC DIGIT SIX
+	0x0667: CONTEXTO, // ARABIC-INDIC DIGIT SEVEN
+	0x0668: CONTEXTO, // ARABIC-INDIC DIGIT EIGHT
+	0x0669: CONTEXTO, // ARABIC-INDIC DIGIT NINE
+	0x06F0: CONTEXTO, // EXTENDED ARABIC-INDIC DIGIT ZERO
+	0x06F1: CONTEXTO, // EXTENDED ARABIC-INDIC DIGIT ONE
+	0x06F2: CONTEXTO, // EXTENDED ARABIC-INDIC DIGIT TWO
+	0x06F3: CONTEXTO, // EXTENDED ARABIC-INDIC DIGIT THREE
+	0x06F4: CONTEXTO, // EXTENDED ARABIC-INDIC DIGIT FOUR
+	0x06F5: CONTEXTO, // EXTENDED ARABIC-INDIC DIGIT FIVE
+	0x06F6: CONTEXTO, // EXTENDED ARABIC-INDIC DIGIT SIX
+	0x06F7: CONTEXTO, // EXTENDED ARABIC-INDIC DIGIT SEVEN
+	0x06F8: CONTEXTO, // EXTENDED ARABIC-INDIC DIGIT EIGHT
+	0x06F9: CONTEXTO, // EXTENDED ARABIC-INDIC DIGIT NINE
+
+	// DISALLOWED -- Would otherwise have been PVALID
+	0x0640: DISALLOWED, // ARABIC TATWEEL
+	0x07FA: DISALLOWED, // NKO LAJANYALAN
+	0x302E: DISALLOWED, // HANGUL SINGLE DOT TONE MARK
+	0x302F: DISALLOWED, // HANGUL DOUBLE DOT TONE MARK
+	0x3031: DISALLOWED, // VERTICAL KANA REPEAT MARK
+	0x3032: DISALLOWED, // VERTICAL KANA REPEAT WITH VOICED SOUND MARK
+	0x3033: DISALLOWED, // VERTICAL KANA REPEAT MARK UPPER HALF
+	0x3034: DISALLOWED, // VERTICAL KANA REPEAT WITH VOICED SOUND MARK UPPER HALF
+	0x3035: DISALLOWED, // VERTICAL KANA REPEAT MARK LOWER HALF
+	0x303B: DISALLOWED, // VERTICAL IDEOGRAPHIC ITERATION MARK
+}
+
+// backwardCompatible (G), RFC 5892 section 2.7. This category is empty for
+// all Unicode versions published so far.
+var backwardCompatible = map[rune]Property{}
+
+// foldCaser performs the toCaseFold operation used by the Unstable category.
+var foldCaser = cases.Fold()
+
+// DerivedProperty returns the IDNA2008 derived property value of r, computed
+// with the algorithm in RFC 5892 section 3 from the Unicode tables this
+// package is built against.
+func DerivedProperty(r rune) Property {
+	if p, ok := Exception(r); ok {
+		return p
+	}
+	if IsUnassigned(r) {
+		return UNASSIGNED
+	}
+	if isLDH(r) {
+		return PVALID
+	}
+	if unicode.Is(unicode.Join_Control, r) {
+		return CONTEXTJ
+	}
+	if isUnstable(r) {
+		return DISALLOWED
+	}
+	if isIgnorableProperty(r) {
+		return DISALLOWED
+	}
+	if isIgnorableBlock(r) {
+		return DISALLOWED
+	}
+	if IsOldHangulJamo(r) {
+		return DISALLOWED
+	}
+	if isLetterDigit(r) {
+		return PVALID
+	}
+	return DISALLOWED
+}
+
+// Exception returns the derived property that the Exceptions (F) and
+// BackwardCompatible (G) categories, RFC 5892 sections 2.6 and 2.7, give to
+// r, and false if r is in neither. The PRECIS framework in RFC 8264 uses the
+// same categories.
+func Exception(r rune) (Property, bool) {
+	if p, ok := exceptions[r]; ok {
+		return p, true
+	}
+	p, ok := backwardCompatible[r]
+	return p, ok
+}
+
+// LetterDigits (A), RFC 5892 section 2.1.
+func isLetterDigit(r rune) bool {
+	return unicode.In(r, unicode.Ll, unicode.Lu, unicode.Lo, unicode.Nd, unicode.Lm, unicode.Mn, unicode.Mc)
+}
+
+// Unstable (B), RFC 5892 section 2.2.
+func isUnstable(r rune) bool {
+	// CaseFolding.txt folds the Cherokee small letters to the capital
+	// letters for stability, which the x/text caser does not do.
+	if 0x13A0 <= r && r <= 0x13F5 {
+		return false
+	}
+	s := string(r)
+	return norm.NFKC.String(foldCaser.String(norm.NFKC.String(s))) != s
+}
+
+// IgnorableProperties (C), RFC 5892 section 2.3.
+func isIgnorableProperty(r rune) bool {
+	return IsDefaultIgnorable(r) ||
+		unicode.Is(unicode.White_Space, r) ||
+		unicode.Is(unicode.Noncharacter_Code_Point, r)
+}
+
+// IsDefaultIgnorable reports whether r has the Default_Ignorable_Code_Point
+// property, which is derived in DerivedCoreProperties.txt as
+// Other_Default_Ignorable_Code_Point + Cf + Variation_Selector - White_Space
+// - FFF9..FFFB - 13430..1343F - Prepended_Concatenation_Mark.
+func IsDefaultIgnorable(r rune) bool {
+	if unicode.Is(unicode.Other_Default_Ignorable_Code_Point, r) || unicode.Is(unicode.Variation_Selector, r) {
+		return true
+	}
+	if !unicode.Is(unicode.Cf, r) {
+		return false
+	}
+	switch {
+	case unicode.Is(unicode.White_Space, r):
+		return false
+	case 0xFFF9 <= r && r <= 0xFFFB:
+		return false
+	case 0x13430 <= r && r <= 0x1343F:
+		return false
+	case unicode.Is(unicode.Prepended_Concatenation_Mark, r):
+		return false
+	}
+	return true
+}
+
+// IgnorableBlocks (D), RFC 5892 section 2.4.
+func isIgnorableBlock(r rune) bool {
+	return (0x20D0 <= r && r <= 0x20FF) || // Combining Diacritical Marks for Symbols
+		(0x1D100 <= r && r <= 0x1D1FF) || // Musical Symbols
+		(0x1D200 <= r && r <= 0x1D24F) // Ancient Greek Musical Notation
+}
+
+// LDH (E), RFC 5892 section 2.5.
+func isLDH(r rune) bool {
+	return r == '-' || ('0' <= r && r <= '9') || ('a' <= r && r <= 'z')
+}
+
+// IsOldHangulJamo reports whether r is in the OldHangulJamo (I) category,
+// RFC 5892 section 2.9. These are the code points with a
+// Hangul_Syllable_Type of L, V or T.
+func IsOldHangulJamo(r rune) bool {
+	return (0x1100 <= r && r <= 0x115F) || (0xA960 <= r && r <= 0xA97C) || // L
+		(0x1160 <= r && r <= 0x11A7) || (0xD7B0 <= r && r <= 0xD7C6) || // V
+		(0x11A8 <= r && r <= 0x11FF) || (0xD7CB <= r && r <= 0xD7FB) // T
+}
+
+// IsUnassigned reports whether r is in the Unassigned (J) category, RFC 5892
+// section 2.10.
+func IsUnassigned(r rune) bool {
+	if unicode.In(r, unicode.L, unicode.M, unicode.N, unicode.P, unicode.S, unicode.Z,
+		unicode.Cc, unicode.Cf, unicode.Co, unicode.Cs) {
+		return false
+	}
+	return !unicode.Is(unicode.Noncharacter_Code_Point, r)
+}
@@ -0,0 +1,51 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package idna2008
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by ToASCII, ToUnicode and ValidateLabel, and reported by
+// ValidateDomain. Except for ErrDomainLength they are wrapped in a
+// *LabelError; use errors.Is to find out why a domain name was rejected.
+// Punycode failures wrap the errors of the punycode package instead.
+var (
+	ErrEmpty           = errors.New("Label is empty")
+	ErrUTF8            = errors.New("Label is not valid UTF-8")
+	ErrNotNFC          = errors.New("Label is not in Normalization Form C")
+	ErrHyphen          = errors.New("Contains hyphen at either end of the string")
+	ErrReservedHyphens = errors.New("Contains hyphens in the third and fourth positions")
+	ErrCombiningMark   = errors.New("Label begins with a combining mark")
+	ErrContext         = errors.New("Contextual rule not satisfied")
+	ErrUnassigned      = errors.New("Contains UNASSIGNED code point")
+	ErrDisallowed      = errors.New("Contains DISALLOWED code point")
+	ErrNonLDH          = errors.New("Contains non-LDH ASCII codepoint")
+	ErrLabelLength     = errors.New("Label too long")
+	ErrASCIILabel      = errors.New("A-label decodes to an ASCII label")
+	ErrRoundTrip       = errors.New("A-label does not round-trip")
+	ErrBidi            = errors.New("Label does not satisfy the Bidi rule")
+	ErrDomainLength    = errors.New("Domain name too long")
+)
+
+// A LabelError describes a label of a domain name that was rejected.
+type LabelError struct {
+	Label  int   // index of the label in the domain name
+	Offset int   // byte offset of Rune in the label, or -1
+	Rune   rune  // the offending rune, if Offset is not -1
+	Err    error // the reason for the error
+}
+
+func (e *LabelError) Error() string {
+	if e.Offset < 0 {
+		return fmt.Sprintf("idna2008: label %d: %v", e.Label, e.Err)
+	}
+	return fmt.Sprintf("idna2008: label %d: %v: %U at offset %d", e.Label, e.Err, e.Rune, e.Offset)
+}
+
+func (e *LabelError) Unwrap() error { return e.Err }
@@ -0,0 +1,297 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+// Package idna2008 implements IDNA as described in RFC 5890, RFC 5891,
+// RFC 5892 and RFC 5893.
+//
+// Unlike IDNA2003, the protocol performs no mapping: a label is valid only if
+// each of its code points is PVALID, or CONTEXTJ/CONTEXTO with its contextual
+// rule satisfied. The only transformation applied here is folding ASCII
+// letters to lowercase. Applications that need IDNA2003-like mapping of user
+// input should use UTS #46 processing instead.
+//
+// This package is in beta and has not been extensively tested.
+package idna2008
+
+//go:generate sh -c "go run maketables.go > tables.go && gofmt -w tables.go"
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/DanielOaks/go-idn/idna2003/punycode"
+	"golang.org/x/text/unicode/norm"
+)
+
+// RFC 5890 section 2.3.2.5
+const (
+	AcePrefix = "xn--"
+)
+
+// Converts a Unicode string to ASCII using the procedure in RFC 5891
+// section 4. Each label is checked against the IDNA2008 derived properties,
+// contextual rules and the Bidi rule, and non-ASCII labels are converted to
+// A-labels. A-labels in the input are validated and kept. The input string
+// may be a domain name containing dots.
+func ToASCII(label string) (string, error) {
+	labels, err := process(label)
+	if err != nil {
+		return label, err
+	}
+	for i, l := range labels {
+		if !isASCII(l) {
+			labels[i], _ = encode(i, l)
+		}
+	}
+	return strings.Join(labels, "."), nil
+}
+
+// Converts a string containing A-labels to Unicode using the procedure in
+// RFC 5891 section 5. Each A-label is decoded and validated as a U-label;
+// ASCII and Unicode labels are validated and returned unchanged apart from
+// ASCII case folding. The input string may be a domain name containing dots.
+//
+// If any label fails, the original input is returned along with the error.
+func ToUnicode(label string) (string, error) {
+	labels, err := process(label)
+	if err != nil {
+		return label, err
+	}
+	return strings.Join(labels, "."), nil
+}
+
+// process splits the domain into labels, converts A-labels to U-labels,
+// validates every label and applies the Bidi rule when the domain is a Bidi
+// domain name. A trailing dot denoting the root is preserved.
+func process(domain string) ([]string, error) {
+	labels := strings.Split(domain, ".")
+
+	for i, label := range labels {
+		if label == "" && i == len(labels)-1 && i > 0 {
+			// root label
+			break
+		}
+		u, err := toULabel(i, label)
+		if err != nil {
+			return nil, err
+		}
+		labels[i] = u
+	}
+
+	if IsBidiDomain(labels) {
+		for i, label := range labels {
+			if !CheckBidiRule([]rune(label)) {
+				return nil, &LabelError{i, -1, 0, ErrBidi}
+			}
+		}
+	}
+
+	return labels, nil
+}
+
+// toULabel validates a single label and returns it in Unicode form. A-labels
+// are decoded and must round-trip; other ASCII labels must be NR-LDH labels.
+// index is the index of the label in the domain name, for errors.
+func toULabel(index int, label string) (string, error) {
+	if label == "" {
+		return "", &LabelError{index, -1, 0, ErrEmpty}
+	}
+	label = toLowerASCII(label)
+
+	if !isASCII(label) {
+		if v := labelViolations(index, label); len(v) > 0 {
+			return "", v[0]
+		}
+		if _, err := encode(index, label); err != nil {
+			return "", err
+		}
+		return label, nil
+	}
+
+	if len(label) > 63 {
+		return "", &LabelError{index, -1, 0, ErrLabelLength}
+	}
+
+	if !strings.HasPrefix(label, AcePrefix) {
+		if v := ldhViolations(index, label); len(v) > 0 {
+			return "", v[0]
+		}
+		return label, nil
+	}
+
+	// RFC 5891 section 5.3: A-label input must decode to a valid U-label
+	// which encodes back to the same A-label.
+	u, err := punycode.DecodeString(label[len(AcePrefix):])
+	if err != nil {
+		return "", &LabelError{index, -1, 0, err}
+	}
+	if isASCII(u) {
+		return "", &LabelError{index, -1, 0, ErrASCIILabel}
+	}
+	if v := labelViolations(index, u); len(v) > 0 {
+		return "", v[0]
+	}
+	ace, err := encode(index, u)
+	if err != nil {
+		return "", err
+	}
+	if ace != label {
+		return "", &LabelError{index, -1, 0, ErrRoundTrip}
+	}
+	return u, nil
+}
+
+// encode converts a U-label to an A-label and verifies its length.
+func encode(index int, label string) (string, error) {
+	enc, err := punycode.EncodeString(label)
+	if err != nil {
+		return "", &LabelError{index, -1, 0, err}
+	}
+	label = AcePrefix + enc
+
+	// Labels are limited to 63 octets, RFC 5890 section 2.3.2.1.
+	if len(label) > 63 {
+		return "", &LabelError{index, -1, 0, ErrLabelLength}
+	}
+	return label, nil
+}
+
+// ValidateLabel checks that label is a valid U-label according to the
+// protocol rules in RFC 5891 section 4.2. The Bidi rule is not checked as it
+// depends on the other labels in the domain name.
+func ValidateLabel(label string) error {
+	if v := labelViolations(0, label); len(v) > 0 {
+		return v[0]
+	}
+	return nil
+}
+
+// labelViolations returns every problem that makes label an invalid U-label,
+// as checked by ValidateLabel, each a *LabelError for the label at index.
+func labelViolations(index int, label string) []error {
+	if label == "" {
+		return []error{&LabelError{index, -1, 0, ErrEmpty}}
+	}
+	if !utf8.ValidString(label) {
+		return []error{&LabelError{index, -1, 0, ErrUTF8}}
+	}
+
+	var v []error
+
+	// 4.2.1. Input to IDNA Registration: the label must be in NFC.
+	if !norm.NFC.IsNormalString(label) {
+		v = append(v, &LabelError{index, -1, 0, ErrNotNFC})
+	}
+
+	// 4.2.3.1. Hyphen Restrictions
+	v = append(v, hyphenViolations(index, label)...)
+
+	runes := make([]rune, 0, len(label))
+	offsets := make([]int, 0, len(label))
+	for i, r := range label {
+		runes = append(runes, r)
+		offsets = append(offsets, i)
+	}
+
+	// 4.2.3.2. Leading Combining Marks
+	if unicode.Is(unicode.M, runes[0]) {
+		v = append(v, &LabelError{index, 0, runes[0], ErrCombiningMark})
+	}
+
+	// 4.2.2. Rejection of Characters That Are Not Permitted and
+	// 4.2.3.3. Contextual Rules
+	for i, r := range runes {
+		switch DerivedProperty(r) {
+		case PVALID:
+		case CONTEXTJ, CONTEXTO:
+			if !ContextRule(runes, i) {
+				v = append(v, &LabelError{index, offsets[i], r, ErrContext})
+			}
+		case UNASSIGNED:
+			v = append(v, &LabelError{index, offsets[i], r, ErrUnassigned})
+		default:
+			v = append(v, &LabelError{index, offsets[i], r, ErrDisallowed})
+		}
+	}
+
+	return v
+}
+
+// ldhViolations returns every problem that keeps an ASCII label from being
+// an NR-LDH label: code points other than letters, digits and hyphens, and
+// hyphens at either end or in the third and fourth positions.
+func ldhViolations(index int, label string) []error {
+	var v []error
+	for i := 0; i < len(label); i++ {
+		if !isLDH(rune(label[i])) {
+			v = append(v, &LabelError{index, i, rune(label[i]), ErrNonLDH})
+		}
+	}
+	return append(v, hyphenViolations(index, label)...)
+}
+
+// hyphenViolations checks the hyphen restrictions of RFC 5891 section
+// 4.2.3.1.
+func hyphenViolations(index int, label string) []error {
+	var v []error
+	if strings.HasPrefix(label, "-") {
+		v = append(v, &LabelError{index, 0, '-', ErrHyphen})
+	} else if strings.HasSuffix(label, "-") {
+		v = append(v, &LabelError{index, len(label) - 1, '-', ErrHyphen})
+	}
+	if HasReservedHyphens(label) {
+		_, n1 := utf8.DecodeRuneInString(label)
+		_, n2 := utf8.DecodeRuneInString(label[n1:])
+		v = append(v, &LabelError{index, n1 + n2, '-', ErrReservedHyphens})
+	}
+	return v
+}
+
+// HasReservedHyphens returns true if the third and fourth code points of
+// label are hyphens, which RFC 5891 section 4.2.3.1 reserves for tagged
+// labels such as A-labels.
+func HasReservedHyphens(label string) bool {
+	n := 0
+	for _, r := range label {
+		switch n {
+		case 2:
+			if r != '-' {
+				return false
+			}
+		case 3:
+			return r == '-'
+		}
+		n++
+	}
+	return false
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] > 127 {
+			return false
+		}
+	}
+	return true
+}
+
+// toLowerASCII folds the ASCII letters in s to lowercase, leaving every other
+// code point untouched.
+func toLowerASCII(s string) string {
+	for i := 0; i < len(s); i++ {
+		if 'A' <= s[i] && s[i] <= 'Z' {
+			b := []byte(s)
+			for j := i; j < len(b); j++ {
+				if 'A' <= b[j] && b[j] <= 'Z' {
+					b[j] += 'a' - 'A'
+				}
+			}
+			return string(b)
+		}
+	}
+	return s
+}
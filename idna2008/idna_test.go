@@ -0,0 +1,195 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package idna2008
+
+import (
+	"errors"
+	"testing"
+)
+
+type propertytestcase struct {
+	Rune     rune
+	Property Property
+}
+
+// from the derived property tables in RFC 5892 appendix B
+var propertyTests = []propertytestcase{
+	{0x002D, PVALID},     // HYPHEN-MINUS
+	{0x0041, DISALLOWED}, // LATIN CAPITAL LETTER A
+	{0x0061, PVALID},     // LATIN SMALL LETTER A
+	{0x00B7, CONTEXTO},   // MIDDLE DOT
+	{0x00DF, PVALID},     // LATIN SMALL LETTER SHARP S
+	{0x00E9, PVALID},     // LATIN SMALL LETTER E WITH ACUTE
+	{0x0378, UNASSIGNED}, // <reserved>
+	{0x03C2, PVALID},     // GREEK SMALL LETTER FINAL SIGMA
+	{0x0640, DISALLOWED}, // ARABIC TATWEEL
+	{0x0660, CONTEXTO},   // ARABIC-INDIC DIGIT ZERO
+	{0x1100, DISALLOWED}, // HANGUL CHOSEONG KIYEOK
+	{0x200C, CONTEXTJ},   // ZERO WIDTH NON-JOINER
+	{0x200D, CONTEXTJ},   // ZERO WIDTH JOINER
+	{0x20D0, DISALLOWED}, // COMBINING LEFT HARPOON ABOVE
+	{0x2488, DISALLOWED}, // DIGIT ONE FULL STOP
+	{0x3002, DISALLOWED}, // IDEOGRAPHIC FULL STOP
+	{0x3007, PVALID},     // IDEOGRAPHIC NUMBER ZERO
+	{0x30FB, CONTEXTO},   // KATAKANA MIDDLE DOT
+	{0xAC00, PVALID},     // HANGUL SYLLABLE GA
+	{0xFDD0, DISALLOWED}, // <noncharacter>
+	{0xFEFF, DISALLOWED}, // ZERO WIDTH NO-BREAK SPACE
+}
+
+type conversiontestcase struct {
+	Unicode string
+	ASCII   string
+}
+
+var conversionTests = []conversiontestcase{
+	{"example.com", "example.com"},
+	{"bücher.de", "xn--bcher-kva.de"},
+	{"café", "xn--caf-dma"},
+	{"faß.de", "xn--fa-hia.de"},
+	{"例え.テスト", "xn--r8jz45g.xn--zckzah"},
+	{"col·lecció.cat", "xn--collecci-ioa91d.cat"},
+	{"עברית", "xn--5dbqzzl"},
+	{"\u0915\u094d\u200c\u0937", "xn--11b2ezcs70k"},
+	{"\u0628\u200c\u0627", "xn--mgbb899q"},
+	{"example.com.", "example.com."},
+	{"é--x.com", "xn----x-9la.com"},
+}
+
+var badLabelTests = []string{
+	"",
+	"-abc",
+	"abc-",
+	"ab--c",
+	"éb--c",
+	"a_b",
+	"\u00c9cole",       // uppercase non-ASCII is DISALLOWED
+	"e\u0301cole",      // not NFC
+	"\u0301abc",        // leading combining mark
+	"a\u00b7b",         // middle dot outside l·l
+	"\u0378",           // unassigned
+	"a\u200cb",         // ZWNJ without context
+	"\u0660\u06f0",     // mixed Arabic-Indic digits
+	"a\u0640b",         // ARABIC TATWEEL
+	"1.\u05d0",         // Bidi domain with label starting with EN
+	"\u05d0a",          // RTL label containing L
+	"xn--bcher-kva-",   // hyphen at end
+	"xn--abc",          // not valid punycode for a U-label
+	"xn--zca.xn--zca-", // invalid second label
+	"foo..bar",         // empty label
+	"caf\u00e9\x80",    // invalid UTF-8
+}
+
+func TestDerivedProperty(t *testing.T) {
+	for _, test := range propertyTests {
+		if p := DerivedProperty(test.Rune); p != test.Property {
+			t.Errorf("DerivedProperty(%U) = %v; want %v", test.Rune, p, test.Property)
+		}
+	}
+}
+
+func TestToASCII(t *testing.T) {
+	for _, test := range conversionTests {
+		out, err := ToASCII(test.Unicode)
+		if err != nil {
+			t.Errorf("ToASCII(%q) got error %v", test.Unicode, err)
+		} else if out != test.ASCII {
+			t.Errorf("ToASCII(%q) = %q; want %q", test.Unicode, out, test.ASCII)
+		}
+	}
+}
+
+func TestToUnicode(t *testing.T) {
+	for _, test := range conversionTests {
+		out, err := ToUnicode(test.ASCII)
+		if err != nil {
+			t.Errorf("ToUnicode(%q) got error %v", test.ASCII, err)
+		} else if out != test.Unicode {
+			t.Errorf("ToUnicode(%q) = %q; want %q", test.ASCII, out, test.Unicode)
+		}
+	}
+}
+
+func TestCaseFolding(t *testing.T) {
+	out, err := ToASCII("Bücher.DE")
+	if err != nil || out != "xn--bcher-kva.de" {
+		t.Errorf("ToASCII(%q) = %q, %v; want %q", "Bücher.DE", out, err, "xn--bcher-kva.de")
+	}
+}
+
+func TestBadLabels(t *testing.T) {
+	for _, test := range badLabelTests {
+		if out, err := ToASCII(test); err == nil {
+			t.Errorf("ToASCII(%q) = %q; want error", test, out)
+		}
+	}
+}
+
+var labelErrorTests = []struct {
+	in     string
+	err    error
+	label  int
+	offset int
+}{
+	{"", ErrEmpty, 0, -1},
+	{"a.-abc", ErrHyphen, 1, 0},
+	{"abc-", ErrHyphen, 0, 3},
+	{"éb--c", ErrReservedHyphens, 0, 3},
+	{"a.b_c", ErrNonLDH, 1, 1},
+	{"\u00c9cole", ErrDisallowed, 0, 0},
+	{"e\u0301cole", ErrNotNFC, 0, -1},
+	{"\u0301abc", ErrCombiningMark, 0, 0},
+	{"caf\u00e9\u00b7b", ErrContext, 0, 5},
+	{"a\u0378", ErrUnassigned, 0, 1},
+	{"xn--ab-", ErrASCIILabel, 0, -1},
+	{"1.\u05d0", ErrBidi, 0, -1},
+	{"caf\u00e9\x80", ErrUTF8, 0, -1},
+}
+
+func TestLabelErrors(t *testing.T) {
+	for _, test := range labelErrorTests {
+		_, err := ToASCII(test.in)
+		var lerr *LabelError
+		if !errors.As(err, &lerr) || !errors.Is(err, test.err) || lerr.Label != test.label || lerr.Offset != test.offset {
+			t.Errorf("ToASCII(%+q) error = %v; want %v in label %d at offset %d", test.in, err, test.err, test.label, test.offset)
+		}
+	}
+}
+
+func TestValidateDomain(t *testing.T) {
+	for _, test := range conversionTests {
+		r := ValidateDomain(test.Unicode)
+		if !r.Valid() || r.ACE() != test.ASCII {
+			t.Errorf("ValidateDomain(%q) = %q, %v; want %q", test.Unicode, r.ACE(), r.Err(), test.ASCII)
+		}
+	}
+	for _, test := range badLabelTests {
+		if r := ValidateDomain(test); r.Valid() {
+			t.Errorf("ValidateDomain(%q) is valid; want error", test)
+		}
+	}
+
+	r := ValidateDomain("Caf_é-.ok.ab--ÀSS.xn--bcher-kv.")
+	if r.Valid() || !r.TrailingDot || len(r.Labels) != 4 {
+		t.Fatalf("ValidateDomain = %+v; want 4 labels and a trailing dot", r)
+	}
+	for i, want := range []int{2, 0, 2, 1} {
+		if got := len(r.Labels[i].Violations); got != want {
+			t.Errorf("label %d violations = %v; want %d", i, r.Labels[i].Violations, want)
+		}
+	}
+	if r.Labels[1].ACE != "ok" || r.Labels[2].Prepared != "ab--Àss" {
+		t.Errorf("ValidateDomain labels = %+v", r.Labels)
+	}
+
+	// A label containing an RTL character makes the Bidi rule apply to all
+	// of them.
+	r = ValidateDomain("1a.עברית")
+	if r.Valid() || len(r.Labels[0].Violations) != 1 || len(r.Labels[1].Violations) != 0 {
+		t.Errorf("ValidateDomain(%q) = %+v; want a Bidi violation in label 0", "1a.עברית", r)
+	}
+}
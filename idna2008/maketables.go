@@ -0,0 +1,145 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+//go:build ignore
+// +build ignore
+
+// IDNA2008 table generator. Reads the Joining_Type property from the Unicode
+// Character Database, which the CONTEXTJ rule for ZERO WIDTH NON-JOINER in
+// RFC 5892 appendix A.1 depends on.
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var ucdURL = flag.String("ucd", "", "base URL of the Unicode Character Database; defaults to http://www.unicode.org/Public/<unicode.Version>/ucd/")
+
+func main() {
+	flag.Parse()
+	if *ucdURL == "" {
+		*ucdURL = "http://www.unicode.org/Public/" + unicode.Version + "/ucd/"
+	}
+
+	joining := loadJoiningTypes()
+
+	printHeader()
+	printTable("joiningL", "Left_Joining", joining["L"])
+	printTable("joiningD", "Dual_Joining", joining["D"])
+	printTable("joiningR", "Right_Joining", joining["R"])
+	printTable("joiningT", "Transparent", joining["T"])
+}
+
+func printHeader() {
+	fmt.Printf("// This file is automatically generated by running\n")
+	fmt.Printf("// maketables\n")
+	fmt.Printf("// DO NOT EDIT\n\n")
+
+	fmt.Printf("package idna2008\n\n")
+	fmt.Printf("import \"unicode\"\n\n")
+	fmt.Printf("// UnicodeVersion is the Unicode version the tables were generated from.\n")
+	fmt.Printf("const UnicodeVersion = %q\n\n", unicode.Version)
+}
+
+// loadJoiningTypes reads extracted/DerivedJoiningType.txt and returns the
+// code points for each joining type.
+func loadJoiningTypes() map[string][]rune {
+	resp, err := http.Get(*ucdURL + "extracted/DerivedJoiningType.txt")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != 200 {
+		log.Fatal("bad GET status for DerivedJoiningType.txt", resp.Status)
+	}
+	defer resp.Body.Close()
+
+	joining := map[string][]rune{}
+	input := bufio.NewReader(resp.Body)
+	for {
+		line, err := input.ReadString('\n')
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			log.Fatal(err)
+		}
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) != 2 {
+			continue
+		}
+		lo, hi := parseRange(strings.TrimSpace(fields[0]))
+		jt := strings.TrimSpace(fields[1])
+		for r := lo; r <= hi; r++ {
+			joining[jt] = append(joining[jt], r)
+		}
+	}
+	return joining
+}
+
+// parseRange parses a code point or a XXXX..YYYY code point range.
+func parseRange(s string) (lo, hi rune) {
+	bounds := strings.SplitN(s, "..", 2)
+	l, err := strconv.ParseUint(bounds[0], 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	h := l
+	if len(bounds) == 2 {
+		h, err = strconv.ParseUint(bounds[1], 16, 32)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	return rune(l), rune(h)
+}
+
+// printTable prints the runes as a *unicode.RangeTable named name.
+func printTable(name, description string, runes []rune) {
+	sort.Sort(runeSlice(runes))
+
+	var r16, r32 []string
+	for i := 0; i < len(runes); {
+		lo := runes[i]
+		hi := lo
+		for i++; i < len(runes) && runes[i] == hi+1; i++ {
+			hi++
+		}
+		if hi <= 0xFFFF {
+			r16 = append(r16, fmt.Sprintf("\t\t{0x%04X, 0x%04X, 1},\n", lo, hi))
+		} else {
+			r32 = append(r32, fmt.Sprintf("\t\t{0x%05X, 0x%05X, 1},\n", lo, hi))
+		}
+	}
+
+	fmt.Printf("// %s is the set of code points with Joining_Type=%s.\n", name, description)
+	fmt.Printf("var %s = &unicode.RangeTable{\n", name)
+	if len(r16) > 0 {
+		fmt.Printf("\tR16: []unicode.Range16{\n%s\t},\n", strings.Join(r16, ""))
+	}
+	if len(r32) > 0 {
+		fmt.Printf("\tR32: []unicode.Range32{\n%s\t},\n", strings.Join(r32, ""))
+	}
+	fmt.Printf("}\n\n")
+}
+
+type runeSlice []rune
+
+func (s runeSlice) Len() int           { return len(s) }
+func (s runeSlice) Less(i, j int) bool { return s[i] < s[j] }
+func (s runeSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
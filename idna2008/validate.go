@@ -0,0 +1,110 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package idna2008
+
+import (
+	"strings"
+
+	"github.com/DanielOaks/go-idn/idna2003/punycode"
+	"github.com/DanielOaks/go-idn/report"
+)
+
+// A LabelReport describes a label of a domain name checked by ValidateDomain.
+// Prepared is the label as a U-label or NR-LDH label, or "" if it could not
+// be decoded, and each of the Violations is a *LabelError.
+type LabelReport = report.LabelReport
+
+// A DomainReport describes a domain name checked by ValidateDomain.
+type DomainReport = report.DomainReport
+
+// ValidateDomain checks a domain name like ToASCII, but instead of stopping
+// at the first problem it reports every problem found in each label. The
+// Bidi rule is checked for every label of a Bidi domain name, and the domain
+// name is limited to 253 octets, excluding a trailing dot.
+func ValidateDomain(domain string) *DomainReport {
+	r := &DomainReport{}
+
+	labels := strings.Split(domain, ".")
+	if n := len(labels); n > 1 && labels[n-1] == "" {
+		r.TrailingDot = true
+		labels = labels[:n-1]
+	}
+
+	ulabels := make([]string, len(labels))
+	for i, l := range labels {
+		lr := validateLabel(i, l)
+		r.Labels = append(r.Labels, lr)
+		ulabels[i] = lr.Prepared
+	}
+
+	if IsBidiDomain(ulabels) {
+		for i := range r.Labels {
+			l := &r.Labels[i]
+			if l.Prepared != "" && !CheckBidiRule([]rune(l.Prepared)) {
+				l.Violations = append(l.Violations, &LabelError{i, -1, 0, ErrBidi})
+				l.ACE = ""
+			}
+		}
+	}
+
+	if r.Valid() && len(strings.TrimSuffix(r.ACE(), ".")) > 253 {
+		r.Violations = append(r.Violations, ErrDomainLength)
+	}
+	return r
+}
+
+// validateLabel checks a label like toULabel, recording every problem found
+// instead of stopping at the first.
+func validateLabel(index int, original string) LabelReport {
+	r := LabelReport{Original: original}
+	if original == "" {
+		r.Violations = append(r.Violations, &LabelError{index, -1, 0, ErrEmpty})
+		return r
+	}
+	label := toLowerASCII(original)
+
+	switch {
+	case !isASCII(label):
+		r.Prepared = label
+		r.Violations = labelViolations(index, label)
+
+	case !strings.HasPrefix(label, AcePrefix):
+		r.Prepared = label
+		r.Violations = ldhViolations(index, label)
+
+	default:
+		u, err := punycode.DecodeString(label[len(AcePrefix):])
+		if err != nil {
+			r.Violations = append(r.Violations, &LabelError{index, -1, 0, err})
+			return r
+		}
+		if isASCII(u) {
+			r.Violations = append(r.Violations, &LabelError{index, -1, 0, ErrASCIILabel})
+			return r
+		}
+		r.Prepared = u
+		r.Violations = labelViolations(index, u)
+		if ace, err := encode(index, u); err == nil && ace != label {
+			r.Violations = append(r.Violations, &LabelError{index, -1, 0, ErrRoundTrip})
+		}
+	}
+
+	ace := r.Prepared
+	if !isASCII(ace) {
+		var err error
+		if ace, err = encode(index, ace); err != nil {
+			r.Violations = append(r.Violations, err)
+		}
+	} else if len(ace) > 63 {
+		r.Violations = append(r.Violations, &LabelError{index, -1, 0, ErrLabelLength})
+	}
+
+	if len(r.Violations) == 0 {
+		r.ACE = ace
+	}
+	return r
+}
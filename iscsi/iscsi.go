@@ -0,0 +1,163 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+// Package iscsi validates iSCSI names as described in RFC 3720 section
+// 3.2.6 and RFC 3980, after preparing them with the iSCSI stringprep
+// profile from RFC 3722.
+//
+// This package is in beta and has not been extensively tested.
+package iscsi
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/DanielOaks/go-idn/idna2003/stringprep"
+)
+
+// MaxLength is the limit on the length of an iSCSI name, in octets after
+// preparation, from RFC 3720 section 3.2.6.1.
+const MaxLength = 223
+
+// Errors returned by Parse for names that are not rejected by the iSCSI
+// stringprep profile but do not have the structure of an iSCSI name.
+// Failures of the profile return the errors of the stringprep package, such
+// as stringprep.ErrProhibited.
+var (
+	ErrEmpty      = errors.New("Name is empty")
+	ErrLength     = errors.New("Name longer than 223 octets")
+	ErrType       = errors.New("Name does not start with iqn., eui. or naa.")
+	ErrDate       = errors.New("Invalid date in iqn. name")
+	ErrAuthority  = errors.New("Invalid naming authority in iqn. name")
+	ErrIdentifier = errors.New("Invalid hexadecimal identifier")
+)
+
+// A Type is the format of an iSCSI name, given by its first four characters.
+type Type string
+
+// The types of iSCSI names.
+const (
+	IQN Type = "iqn" // iSCSI qualified name, RFC 3720 section 3.2.6.3.1
+	EUI Type = "eui" // IEEE EUI-64 identifier, RFC 3720 section 3.2.6.3.2
+	NAA Type = "naa" // T11 Network Address Authority identifier, RFC 3980
+)
+
+// A Name is a prepared and validated iSCSI name.
+type Name struct {
+	Type      Type
+	Date      string // the year and month of an iqn. name, as "yyyy-mm"
+	Authority string // the reversed domain name of the naming authority of an iqn. name
+	Unique    string // the string after the first ':' of an iqn. name, or the hexadecimal digits of an eui. or naa. name
+	name      string
+}
+
+// Parse prepares s with the iSCSI stringprep profile and checks that the
+// result is an iSCSI name. As the profile folds case, the parts of the
+// returned Name are in lowercase.
+func Parse(s string) (Name, error) {
+	if s == "" {
+		return Name{}, ErrEmpty
+	}
+	p, err := stringprep.ISCSIprep(s)
+	if err != nil {
+		return Name{}, err
+	}
+	if len(p) > MaxLength {
+		return Name{}, ErrLength
+	}
+
+	n := Name{name: p}
+	prefix, rest, ok := strings.Cut(p, ".")
+	if !ok {
+		return Name{}, ErrType
+	}
+	n.Type = Type(prefix)
+	switch n.Type {
+	case IQN:
+		if err := n.parseIQN(rest); err != nil {
+			return Name{}, err
+		}
+	case EUI:
+		if len(rest) != 16 || !isHex(rest) {
+			return Name{}, ErrIdentifier
+		}
+		n.Unique = rest
+	case NAA:
+		if (len(rest) != 16 && len(rest) != 32) || !isHex(rest) {
+			return Name{}, ErrIdentifier
+		}
+		n.Unique = rest
+	default:
+		return Name{}, ErrType
+	}
+	return n, nil
+}
+
+// parseIQN parses the part of an iqn. name after the type.
+func (n *Name) parseIQN(s string) error {
+	s, n.Unique, _ = strings.Cut(s, ":")
+	date, authority, _ := strings.Cut(s, ".")
+
+	if len(date) != 7 || date[4] != '-' || !isDigits(date[:4]) || !isDigits(date[5:]) {
+		return ErrDate
+	}
+	if month := date[5:]; month < "01" || month > "12" {
+		return ErrDate
+	}
+	n.Date = date
+
+	if authority == "" {
+		return ErrAuthority
+	}
+	for _, label := range strings.Split(authority, ".") {
+		if label == "" || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return ErrAuthority
+		}
+	}
+	n.Authority = authority
+	return nil
+}
+
+// Prepare returns s prepared with the iSCSI stringprep profile, or an error
+// if the result is not an iSCSI name. Names that are equal after Prepare
+// refer to the same iSCSI node.
+func Prepare(s string) (string, error) {
+	n, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+	return n.String(), nil
+}
+
+// Validate returns nil if s is an iSCSI name, or the reason it is not.
+func Validate(s string) error {
+	_, err := Parse(s)
+	return err
+}
+
+// String returns the prepared iSCSI name.
+func (n Name) String() string {
+	return n.name
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9') && !('a' <= c && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
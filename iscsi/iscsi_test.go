@@ -0,0 +1,82 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package iscsi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/DanielOaks/go-idn/idna2003/stringprep"
+)
+
+var parseTests = []struct {
+	Input string
+	Name  Name
+	Err   error
+}{
+	// from http://tools.ietf.org/html/rfc3720#section-3.2.6.3
+	{"iqn.2001-04.com.example", Name{IQN, "2001-04", "com.example", "", "iqn.2001-04.com.example"}, nil},
+	{"iqn.2001-04.com.example:storage:diskarrays-sn-a8675309", Name{IQN, "2001-04", "com.example", "storage:diskarrays-sn-a8675309", "iqn.2001-04.com.example:storage:diskarrays-sn-a8675309"}, nil},
+	{"iqn.2001-04.com.example:storage.tape1.sys1.xyz", Name{IQN, "2001-04", "com.example", "storage.tape1.sys1.xyz", "iqn.2001-04.com.example:storage.tape1.sys1.xyz"}, nil},
+	{"iqn.2001-04.com.example.storage:tape1", Name{IQN, "2001-04", "com.example.storage", "tape1", "iqn.2001-04.com.example.storage:tape1"}, nil},
+	{"eui.02004567A425678D", Name{EUI, "", "", "02004567a425678d", "eui.02004567a425678d"}, nil},
+	// from http://tools.ietf.org/html/rfc3980#section-2
+	{"naa.52004567BA64678D", Name{NAA, "", "", "52004567ba64678d", "naa.52004567ba64678d"}, nil},
+	{"naa.62004567BA64678D0123456789ABCDEF", Name{NAA, "", "", "62004567ba64678d0123456789abcdef", "naa.62004567ba64678d0123456789abcdef"}, nil},
+
+	{"IQN.2001-04.COM.Example:Disk", Name{IQN, "2001-04", "com.example", "disk", "iqn.2001-04.com.example:disk"}, nil},
+	{"iqn.2001-04.com.example:d\u00adisk\u00c5", Name{IQN, "2001-04", "com.example", "disk\u00e5", "iqn.2001-04.com.example:disk\u00e5"}, nil},
+	{"iqn.2001-04.com.example:\uff44isk", Name{IQN, "2001-04", "com.example", "disk", "iqn.2001-04.com.example:disk"}, nil},
+
+	{"", Name{}, ErrEmpty},
+	{"iqn.2001-04.com.example:" + strings.Repeat("a", 200), Name{}, ErrLength},
+	{"iscsi.2001-04.com.example", Name{}, ErrType},
+	{"iqn", Name{}, ErrType},
+	{"iqn.2001-4.com.example", Name{}, ErrDate},
+	{"iqn.2001-13.com.example", Name{}, ErrDate},
+	{"iqn.01-04.com.example", Name{}, ErrDate},
+	{"iqn.2001-04", Name{}, ErrAuthority},
+	{"iqn.2001-04.com..example", Name{}, ErrAuthority},
+	{"iqn.2001-04.-com.example", Name{}, ErrAuthority},
+	{"eui.02004567A425678", Name{}, ErrIdentifier},
+	{"eui.02004567A425678G", Name{}, ErrIdentifier},
+	{"naa.52004567BA64678D01", Name{}, ErrIdentifier},
+	{"iqn.2001-04.com.example:disk one", Name{}, stringprep.ErrProhibited},
+	{"iqn.2001-04.com.example:disk/one", Name{}, stringprep.ErrProhibited},
+	{"iqn.2001-04.com.example:disk_one", Name{}, stringprep.ErrProhibited},
+	{"iqn.2001-04.com.example:disk\u3002one", Name{}, stringprep.ErrProhibited},
+	{"iqn.2001-04.com.example:\u0627a", Name{}, stringprep.ErrBidi},
+	{"iqn.2001-04.com.example:\u0221", Name{}, stringprep.ErrUnassigned},
+}
+
+func TestParse(t *testing.T) {
+	for _, test := range parseTests {
+		n, err := Parse(test.Input)
+		if n != test.Name || !errors.Is(err, test.Err) {
+			t.Errorf("Parse(%+q) = %+v, %v; want %+v, %v", test.Input, n, err, test.Name, test.Err)
+		}
+		if err := Validate(test.Input); !errors.Is(err, test.Err) {
+			t.Errorf("Validate(%+q) = %v; want %v", test.Input, err, test.Err)
+		}
+		if p, err := Prepare(test.Input); p != test.Name.String() || !errors.Is(err, test.Err) {
+			t.Errorf("Prepare(%+q) = %+q, %v; want %+q, %v", test.Input, p, err, test.Name.String(), test.Err)
+		}
+	}
+}
+
+func TestProfile(t *testing.T) {
+	// The RFC 3722 exclusions are reported with their table.
+	_, err := stringprep.ISCSIprep("a/b")
+	var perr *stringprep.Error
+	if !errors.As(err, &perr) || perr.Table != "RFC 3722" || perr.Rune != '/' {
+		t.Errorf("ISCSIprep(\"a/b\") error = %v; want table RFC 3722, rune '/'", err)
+	}
+	if got, err := stringprep.ISCSIprep("Example-1.2:3"); got != "example-1.2:3" || err != nil {
+		t.Errorf("ISCSIprep(\"Example-1.2:3\") = %+q, %v; want \"example-1.2:3\", nil", got, err)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package jid
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by Parse and New, wrapped in a *PartError. Failures of the
+// stringprep profiles and of IDNA wrap the errors of the stringprep and
+// idna2003 packages instead, such as stringprep.ErrProhibited.
+var (
+	ErrEmptyPart  = errors.New("Part is empty")
+	ErrPartLength = errors.New("Part longer than 1023 octets")
+)
+
+// A Part names one of the three parts of a JID.
+type Part string
+
+// The parts of a JID, as named in RFC 6122 section 2.
+const (
+	Localpart    Part = "localpart"
+	Domainpart   Part = "domainpart"
+	Resourcepart Part = "resourcepart"
+)
+
+// A PartError describes a part of a JID that could not be prepared.
+type PartError struct {
+	Part Part  // the part that was rejected
+	Err  error // the reason for the error
+}
+
+func (e *PartError) Error() string {
+	return fmt.Sprintf("jid: %s: %v", e.Part, e.Err)
+}
+
+func (e *PartError) Unwrap() error { return e.Err }
@@ -0,0 +1,194 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+// Package jid implements XMPP addresses (JIDs) as described in RFC 6122.
+//
+// A JID has the form localpart@domainpart/resourcepart, where only the
+// domainpart is required. Parse and New prepare each part with the matching
+// profile: Nodeprep for the localpart, IDNA2003 with Nameprep for the
+// domainpart and Resourceprep for the resourcepart. The parts of a JID
+// are always prepared, so JIDs can be compared with ==.
+//
+// This package is in beta and has not been extensively tested.
+package jid
+
+import (
+	"net/netip"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/DanielOaks/go-idn/idna2003"
+	"github.com/DanielOaks/go-idn/idna2003/stringprep"
+)
+
+// maxPartLength is the limit on the length of each part of a JID, in octets
+// after preparation, from RFC 6122 section 2.
+const maxPartLength = 1023
+
+// A JID is an XMPP address whose parts have been prepared. The zero value is
+// not a valid JID.
+type JID struct {
+	Local    string // the localpart, or "" if there is none
+	Domain   string // the domainpart, in Unicode and without a trailing dot
+	Resource string // the resourcepart, or "" if there is none
+}
+
+// Parse splits s into its parts as described in RFC 6122 section 2.1 and
+// prepares them. The resourcepart starts at the first '/', so it may contain
+// '@' and '/'; the localpart ends at the first '@' before it.
+func Parse(s string) (JID, error) {
+	var local, domain, resource string
+	hasLocal, hasResource := false, false
+
+	domain = s
+	if i := strings.IndexByte(domain, '/'); i >= 0 {
+		domain, resource = domain[:i], domain[i+1:]
+		hasResource = true
+	}
+	if i := strings.IndexByte(domain, '@'); i >= 0 {
+		local, domain = domain[:i], domain[i+1:]
+		hasLocal = true
+	}
+
+	if hasLocal && local == "" {
+		return JID{}, &PartError{Localpart, ErrEmptyPart}
+	}
+	if hasResource && resource == "" {
+		return JID{}, &PartError{Resourcepart, ErrEmptyPart}
+	}
+	return New(local, domain, resource)
+}
+
+// MustParse is like Parse but panics if s is not a valid JID. It simplifies
+// the initialization of global variables holding JIDs.
+func MustParse(s string) JID {
+	j, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// New prepares the given parts and returns them as a JID. The localpart and
+// the resourcepart may be empty if the JID has none.
+func New(local, domain, resource string) (JID, error) {
+	var j JID
+	var err error
+	if local != "" {
+		if j.Local, err = stringprep.Nodeprep(local); err != nil {
+			return JID{}, &PartError{Localpart, err}
+		}
+		if err := checkLength(Localpart, j.Local); err != nil {
+			return JID{}, err
+		}
+	}
+	if j.Domain, err = prepareDomain(domain); err != nil {
+		return JID{}, err
+	}
+	if resource != "" {
+		if j.Resource, err = stringprep.Resourceprep(resource); err != nil {
+			return JID{}, &PartError{Resourcepart, err}
+		}
+		if err := checkLength(Resourcepart, j.Resource); err != nil {
+			return JID{}, err
+		}
+	}
+	return j, nil
+}
+
+// prepareDomain prepares a domainpart as described in RFC 6122 section 2.2.
+// IPv4 addresses and IPv6 addresses in square brackets are returned in their
+// canonical form. Domain names are checked with the IDNA2003 ToASCII
+// operation and returned in Unicode, after Nameprep, with any trailing label
+// separator removed.
+func prepareDomain(domain string) (string, error) {
+	if strings.HasPrefix(domain, "[") && strings.HasSuffix(domain, "]") {
+		if ip, err := netip.ParseAddr(domain[1 : len(domain)-1]); err == nil && ip.Is6() && ip.Zone() == "" {
+			return "[" + ip.String() + "]", nil
+		}
+	}
+	if ip, err := netip.ParseAddr(domain); err == nil && ip.Is4() {
+		return ip.String(), nil
+	}
+
+	// Any of the RFC 3490 label separators may end the domain name, but
+	// only once.
+	if r, size := utf8.DecodeLastRuneInString(domain); isSeparator(r) {
+		domain = domain[:len(domain)-size]
+		if r, _ := utf8.DecodeLastRuneInString(domain); isSeparator(r) {
+			label := 0
+			for _, r := range domain {
+				if isSeparator(r) {
+					label++
+				}
+			}
+			return "", &PartError{Domainpart, &idna2003.LabelError{Label: label, Offset: -1, Err: idna2003.ErrLabelLength}}
+		}
+	}
+	if domain == "" {
+		return "", &PartError{Domainpart, ErrEmptyPart}
+	}
+	ace, err := idna2003.ToASCII(domain)
+	if err != nil {
+		return "", &PartError{Domainpart, err}
+	}
+	u, err := idna2003.ToUnicode(ace)
+	if err != nil {
+		return "", &PartError{Domainpart, err}
+	}
+	if err := checkLength(Domainpart, u); err != nil {
+		return "", err
+	}
+	return u, nil
+}
+
+// isSeparator returns true if r is one of the RFC 3490 label separators.
+func isSeparator(r rune) bool {
+	return strings.ContainsRune(".\u3002\uff0e\uff61", r)
+}
+
+func checkLength(part Part, s string) error {
+	if len(s) > maxPartLength {
+		return &PartError{part, ErrPartLength}
+	}
+	return nil
+}
+
+// Bare returns the JID without its resourcepart.
+func (j JID) Bare() JID {
+	j.Resource = ""
+	return j
+}
+
+// IsBare returns true if the JID has no resourcepart.
+func (j JID) IsBare() bool {
+	return j.Resource == ""
+}
+
+// Equal returns true if j and other are the same full JID, including the
+// resourcepart.
+func (j JID) Equal(other JID) bool {
+	return j == other
+}
+
+// BareEqual returns true if j and other have the same localpart and
+// domainpart, ignoring their resourceparts.
+func (j JID) BareEqual(other JID) bool {
+	return j.Bare() == other.Bare()
+}
+
+// String returns the JID in the form localpart@domainpart/resourcepart,
+// leaving out the parts it does not have.
+func (j JID) String() string {
+	s := j.Domain
+	if j.Local != "" {
+		s = j.Local + "@" + s
+	}
+	if j.Resource != "" {
+		s += "/" + j.Resource
+	}
+	return s
+}
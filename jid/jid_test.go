@@ -0,0 +1,138 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package jid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/DanielOaks/go-idn/idna2003"
+	"github.com/DanielOaks/go-idn/idna2003/stringprep"
+)
+
+var parseTests = []struct {
+	Input string
+	JID   JID
+	Err   error
+	Part  Part
+}{
+	{"example.com", JID{"", "example.com", ""}, nil, ""},
+	{"juliet@example.com", JID{"juliet", "example.com", ""}, nil, ""},
+	{"juliet@example.com/balcony", JID{"juliet", "example.com", "balcony"}, nil, ""},
+	{"example.com/balcony", JID{"", "example.com", "balcony"}, nil, ""},
+	{"Juliet@Example.COM/Balcony", JID{"juliet", "example.com", "Balcony"}, nil, ""},
+	{"juliet@example.com./balcony", JID{"juliet", "example.com", "balcony"}, nil, ""},
+	{"juliet@example.com/foo@bar/baz", JID{"juliet", "example.com", "foo@bar/baz"}, nil, ""},
+	{"juliet@example.com/a b", JID{"juliet", "example.com", "a b"}, nil, ""},
+	{"straße@münchen.example", JID{"strasse", "münchen.example", ""}, nil, ""},
+	{"rémi@xn--mnchen-3ya.example", JID{"rémi", "münchen.example", ""}, nil, ""},
+	{"juliet@MÜNCHEN.example", JID{"juliet", "münchen.example", ""}, nil, ""},
+	{"juliet@example。com", JID{"juliet", "example.com", ""}, nil, ""},
+	{"федя@example.com/Ⅸ", JID{"федя", "example.com", "IX"}, nil, ""},
+	{"juliet@192.0.2.1/balcony", JID{"juliet", "192.0.2.1", "balcony"}, nil, ""},
+	{"juliet@[2001:db8::1]/balcony", JID{"juliet", "[2001:db8::1]", "balcony"}, nil, ""},
+	{"juliet@[0::1]", JID{"juliet", "[::1]", ""}, nil, ""},
+	{"juliet@[2001:DB8:0:0::1]", JID{"juliet", "[2001:db8::1]", ""}, nil, ""},
+
+	{"", JID{}, ErrEmptyPart, Domainpart},
+	{"@example.com", JID{}, ErrEmptyPart, Localpart},
+	{"juliet@", JID{}, ErrEmptyPart, Domainpart},
+	{"juliet@example.com/", JID{}, ErrEmptyPart, Resourcepart},
+	{"mon cher@example.com", JID{}, stringprep.ErrProhibited, Localpart},
+	{"a\"b@example.com", JID{}, stringprep.ErrProhibited, Localpart},
+	{"juliet@exa_mple.com", JID{}, idna2003.ErrNonLDH, Domainpart},
+	{"juliet@-example.com", JID{}, idna2003.ErrHyphen, Domainpart},
+	{"juliet@example.com..", JID{}, idna2003.ErrLabelLength, Domainpart},
+	{"juliet@example.com.。", JID{}, idna2003.ErrLabelLength, Domainpart},
+	{"juliet@::1", JID{}, idna2003.ErrNonLDH, Domainpart},
+	{"juliet@[192.0.2.1]", JID{}, idna2003.ErrNonLDH, Domainpart},
+	{"juliet@example.com/\u0007", JID{}, stringprep.ErrProhibited, Resourcepart},
+	{"\u0627a@example.com", JID{}, stringprep.ErrBidi, Localpart},
+	{strings.Repeat("a", 1024) + "@example.com", JID{}, ErrPartLength, Localpart},
+	{"juliet@example.com/" + strings.Repeat("a", 1024), JID{}, ErrPartLength, Resourcepart},
+	{"juliet@" + strings.Repeat(strings.Repeat("a", 63)+".", 16) + "com", JID{}, ErrPartLength, Domainpart},
+}
+
+func TestParse(t *testing.T) {
+	for _, test := range parseTests {
+		j, err := Parse(test.Input)
+		if j != test.JID || !errors.Is(err, test.Err) {
+			t.Errorf("Parse(%+q) = %+v, %v; want %+v, %v", test.Input, j, err, test.JID, test.Err)
+			continue
+		}
+		var perr *PartError
+		if test.Err != nil && (!errors.As(err, &perr) || perr.Part != test.Part) {
+			t.Errorf("Parse(%+q) error = %v; want error in %s", test.Input, err, test.Part)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	j, err := New("Juliet", "Example.com", "Balcony")
+	if err != nil || j.String() != "juliet@example.com/Balcony" {
+		t.Errorf("New = %v, %v; want juliet@example.com/Balcony", j, err)
+	}
+	if _, err := New("juliet", "", ""); !errors.Is(err, ErrEmptyPart) {
+		t.Errorf("New with empty domainpart error = %v; want %v", err, ErrEmptyPart)
+	}
+}
+
+var stringTests = []struct {
+	Input  string
+	Output string
+}{
+	{"example.com", "example.com"},
+	{"Juliet@Example.com", "juliet@example.com"},
+	{"example.com/Balcony", "example.com/Balcony"},
+	{"juliet@example.com./balcony", "juliet@example.com/balcony"},
+}
+
+func TestString(t *testing.T) {
+	for _, test := range stringTests {
+		j := MustParse(test.Input)
+		if got := j.String(); got != test.Output {
+			t.Errorf("Parse(%+q).String() = %+q; want %+q", test.Input, got, test.Output)
+		}
+		if j2 := MustParse(j.String()); j2 != j {
+			t.Errorf("Parse(%+q) does not round-trip: %+v", j.String(), j2)
+		}
+	}
+}
+
+var compareTests = []struct {
+	A, B  string
+	Equal bool
+	Bare  bool
+}{
+	{"juliet@example.com", "juliet@example.com", true, true},
+	{"JULIET@EXAMPLE.COM", "juliet@example.com.", true, true},
+	{"juliet@example.com/balcony", "juliet@example.com/Balcony", false, true},
+	{"juliet@example.com/balcony", "juliet@example.com", false, true},
+	{"juliet@example.com", "romeo@example.com", false, false},
+	{"juliet@münchen.example", "juliet@xn--mnchen-3ya.example", true, true},
+	{"Ｊｕｌｉｅｔ@example.com", "juliet@example.com", true, true},
+	{"juliet@example\u3002", "juliet@example", true, true},
+	{"juliet@example.com\uff61", "juliet@example.com", true, true},
+}
+
+func TestCompare(t *testing.T) {
+	for _, test := range compareTests {
+		a, b := MustParse(test.A), MustParse(test.B)
+		if got := a.Equal(b); got != test.Equal {
+			t.Errorf("%+q.Equal(%+q) = %v; want %v", test.A, test.B, got, test.Equal)
+		}
+		if got := a.BareEqual(b); got != test.Bare {
+			t.Errorf("%+q.BareEqual(%+q) = %v; want %v", test.A, test.B, got, test.Bare)
+		}
+	}
+
+	j := MustParse("juliet@example.com/balcony")
+	if j.IsBare() || !j.Bare().IsBare() || j.Bare().String() != "juliet@example.com" {
+		t.Errorf("Bare(%v) = %v", j, j.Bare())
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package lgr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by Validate, Evaluate and Variants. ErrRepertoire and
+// ErrContext are wrapped in an *Error that gives the offending code point;
+// use errors.Is to find out why a label was rejected.
+var (
+	ErrEmpty           = errors.New("lgr: Label is empty")
+	ErrRepertoire      = errors.New("lgr: Code point not in repertoire")
+	ErrContext         = errors.New("lgr: Context rule not satisfied")
+	ErrTooManyVariants = errors.New("lgr: Too many variant labels")
+)
+
+// An Error describes a code point of a label that is not allowed by a Label
+// Generation Ruleset.
+type Error struct {
+	Offset int    // byte offset of Rune in the label
+	Rune   rune   // the offending rune, or the first rune of a sequence
+	Rule   string // the context rule that was not satisfied, if any
+	Err    error  // the reason for the error
+}
+
+func (e *Error) Error() string {
+	if e.Rule != "" {
+		return fmt.Sprintf("%v: %U at offset %d (rule %s)", e.Err, e.Rune, e.Offset, e.Rule)
+	}
+	return fmt.Sprintf("%v: %U at offset %d", e.Err, e.Rune, e.Offset)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
@@ -0,0 +1,536 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+// Package lgr implements Label Generation Rulesets as described in RFC 7940.
+//
+// A Label Generation Ruleset (LGR) defines the code points a registry allows
+// in labels, the contexts they are allowed in, and their variants: code
+// points that are treated as equivalent to others, such as the Simplified and
+// Traditional forms of a Chinese character. Registering a label usually
+// blocks or reserves its variant labels, so they have to be computed along
+// with their dispositions.
+//
+// Parse reads an LGR in the XML format of RFC 7940. Evaluate finds the
+// disposition of a label, and Variants enumerates its variant labels with
+// their dispositions and A-labels.
+//
+// This package is in beta and has not been extensively tested.
+package lgr
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/DanielOaks/go-idn/idna2003/punycode"
+)
+
+// AcePrefix is the ACE prefix of the A-labels returned by Evaluate and
+// Variants.
+const AcePrefix = "xn--"
+
+// MaxVariants is the largest number of variant labels Variants enumerates.
+const MaxVariants = 10000
+
+// A Disposition is the outcome of evaluating a label. RFC 7940 recommends the
+// values below, but an LGR may define others in its actions.
+type Disposition string
+
+// Dispositions recommended by RFC 7940.
+const (
+	Invalid     Disposition = "invalid"
+	Blocked     Disposition = "blocked"
+	Allocatable Disposition = "allocatable"
+	Activated   Disposition = "activated"
+	Valid       Disposition = "valid"
+)
+
+// OutOfRepertoire is the type of the variants that are not themselves in the
+// repertoire of an LGR. Variant labels using them are blocked by default.
+const OutOfRepertoire = "out-of-repertoire-var"
+
+// Meta holds the metadata of an LGR.
+type Meta struct {
+	Version        string
+	Date           string
+	Languages      []string // language tags, such as "und-Hani"
+	Domains        []string // the domains the LGR applies to, from its "domain" scopes
+	UnicodeVersion string
+	Description    string
+}
+
+// A Char is a code point, or sequence of code points, of the repertoire.
+type Char struct {
+	CP       []rune
+	When     string // the name of a rule that must match, or ""
+	NotWhen  string // the name of a rule that must not match, or ""
+	Tags     []string
+	Variants []Var
+}
+
+// A Range is a range of code points of the repertoire. Ranges have no
+// variants.
+type Range struct {
+	First, Last rune
+	When        string
+	NotWhen     string
+	Tags        []string
+}
+
+// A Var maps a code point or sequence of the repertoire to a variant.
+type Var struct {
+	CP      []rune
+	Type    string // such as "blocked", "allocatable" or "simp"
+	When    string // the name of a rule that must match in the original label, or ""
+	NotWhen string // the name of a rule that must not match in the original label, or ""
+}
+
+// An Action assigns a disposition to the labels that meet all of its
+// conditions.
+type Action struct {
+	Disp         Disposition
+	Match        string   // the name of a rule that must match the label, or ""
+	NotMatch     string   // the name of a rule that must not match the label, or ""
+	AnyVariant   []string // any variant mapping used must have one of these types
+	AllVariants  []string // all variant mappings used must have one of these types
+	OnlyVariants []string // as AllVariants, and every code point must be mapped
+}
+
+// defaultActions are applied after the actions of an LGR, as RFC 7940
+// requires.
+var defaultActions = []Action{
+	{Disp: Blocked, AnyVariant: []string{OutOfRepertoire}},
+	{Disp: Valid},
+}
+
+// An LGR is a Label Generation Ruleset.
+type LGR struct {
+	Meta    Meta
+	Chars   []*Char
+	Ranges  []*Range // sorted by First
+	Actions []Action
+
+	chars   map[string]*Char // Chars by code point or sequence
+	maxSeq  int              // the length of the longest sequence, in runes
+	classes map[string]*class
+	rules   map[string]*op
+}
+
+// A Label is a label evaluated by an LGR.
+type Label struct {
+	Unicode     string
+	ACE         string // the A-label, or the label itself if it is ASCII
+	Disposition Disposition
+	Types       []string // the types of the variant mappings used, sorted
+}
+
+// ParseFile reads an LGR from the named file with Parse.
+func ParseFile(path string) (*LGR, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	l, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return l, nil
+}
+
+// element is an XML element of an LGR.
+type element struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Text     string     `xml:",chardata"`
+	Children []element  `xml:",any"`
+}
+
+func (e *element) attr(name string) string {
+	for _, a := range e.Attrs {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func (e *element) child(name string) *element {
+	for i := range e.Children {
+		if e.Children[i].XMLName.Local == name {
+			return &e.Children[i]
+		}
+	}
+	return nil
+}
+
+// Parse reads an LGR in the XML format of RFC 7940. The rules of the LGR are
+// checked and compiled, and an error is returned if they refer to classes or
+// rules that are not defined, or use a Unicode property other than the
+// general category ("gc"), script ("sc") or canonical combining class
+// ("ccc").
+func Parse(r io.Reader) (*LGR, error) {
+	var root element
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return nil, fmt.Errorf("lgr: %v", err)
+	}
+	if root.XMLName.Local != "lgr" {
+		return nil, fmt.Errorf("lgr: root element is %q, not \"lgr\"", root.XMLName.Local)
+	}
+
+	l := &LGR{chars: map[string]*Char{}}
+	if meta := root.child("meta"); meta != nil {
+		l.parseMeta(meta)
+	}
+	if data := root.child("data"); data != nil {
+		if err := l.parseData(data); err != nil {
+			return nil, err
+		}
+	}
+	rules := root.child("rules")
+	if rules == nil {
+		// The references of the data still have to be checked.
+		rules = &element{}
+	}
+	if err := l.parseRules(rules); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *LGR) parseMeta(meta *element) {
+	for _, e := range meta.Children {
+		text := strings.TrimSpace(e.Text)
+		switch e.XMLName.Local {
+		case "version":
+			l.Meta.Version = text
+		case "date":
+			l.Meta.Date = text
+		case "language":
+			l.Meta.Languages = append(l.Meta.Languages, text)
+		case "scope":
+			if e.attr("type") == "domain" {
+				l.Meta.Domains = append(l.Meta.Domains, text)
+			}
+		case "unicode-version":
+			l.Meta.UnicodeVersion = text
+		case "description":
+			l.Meta.Description = text
+		}
+	}
+}
+
+func (l *LGR) parseData(data *element) error {
+	for _, e := range data.Children {
+		switch e.XMLName.Local {
+		case "char":
+			cp, err := parseCodePoints(e.attr("cp"))
+			if err != nil {
+				return fmt.Errorf("lgr: char: %v", err)
+			}
+			if l.chars[string(cp)] != nil {
+				return fmt.Errorf("lgr: char %04X defined twice", cp)
+			}
+			c := &Char{
+				CP:      cp,
+				When:    e.attr("when"),
+				NotWhen: e.attr("not-when"),
+				Tags:    strings.Fields(e.attr("tag")),
+			}
+			for _, v := range e.Children {
+				if v.XMLName.Local != "var" {
+					continue
+				}
+				vcp, err := parseCodePoints(v.attr("cp"))
+				if err != nil {
+					return fmt.Errorf("lgr: var of %04X: %v", cp, err)
+				}
+				c.Variants = append(c.Variants, Var{vcp, v.attr("type"), v.attr("when"), v.attr("not-when")})
+			}
+			l.Chars = append(l.Chars, c)
+			l.chars[string(cp)] = c
+			if len(cp) > l.maxSeq {
+				l.maxSeq = len(cp)
+			}
+		case "range":
+			first, err := parseCodePoint(e.attr("first-cp"))
+			if err != nil {
+				return fmt.Errorf("lgr: range: %v", err)
+			}
+			last, err := parseCodePoint(e.attr("last-cp"))
+			if err != nil {
+				return fmt.Errorf("lgr: range: %v", err)
+			}
+			if last < first {
+				return fmt.Errorf("lgr: range %04X-%04X is empty", first, last)
+			}
+			l.Ranges = append(l.Ranges, &Range{first, last, e.attr("when"), e.attr("not-when"), strings.Fields(e.attr("tag"))})
+		}
+	}
+	sort.Slice(l.Ranges, func(i, j int) bool { return l.Ranges[i].First < l.Ranges[j].First })
+	return nil
+}
+
+// segment is a code point or sequence of the repertoire found in a label.
+type segment struct {
+	pos, n  int // position and length of the segment in the label, in runes
+	when    string
+	notWhen string
+	vars    []Var
+}
+
+// segments splits a label into code points and sequences of the repertoire,
+// preferring the longest sequence at each position.
+func (l *LGR) segments(label []rune) ([]segment, error) {
+	var segs []segment
+	for i := 0; i < len(label); {
+		seg, ok := l.lookup(label, i)
+		if !ok {
+			return nil, &Error{Offset: len(string(label[:i])), Rune: label[i], Err: ErrRepertoire}
+		}
+		segs = append(segs, seg)
+		i += seg.n
+	}
+	return segs, nil
+}
+
+func (l *LGR) lookup(label []rune, i int) (segment, bool) {
+	for n := l.maxSeq; n > 0; n-- {
+		if i+n > len(label) {
+			continue
+		}
+		if c := l.chars[string(label[i:i+n])]; c != nil {
+			return segment{i, n, c.When, c.NotWhen, c.Variants}, true
+		}
+	}
+	r := label[i]
+	k := sort.Search(len(l.Ranges), func(k int) bool { return l.Ranges[k].Last >= r })
+	if k < len(l.Ranges) && l.Ranges[k].First <= r {
+		rg := l.Ranges[k]
+		return segment{i, 1, rg.When, rg.NotWhen, nil}, true
+	}
+	return segment{}, false
+}
+
+// Validate checks that a label is made of code points and sequences of the
+// repertoire, and that the context rules of each are satisfied.
+func (l *LGR) Validate(label string) error {
+	_, err := l.validate([]rune(label))
+	return err
+}
+
+func (l *LGR) validate(label []rune) ([]segment, error) {
+	if len(label) == 0 {
+		return nil, ErrEmpty
+	}
+	segs, err := l.segments(label)
+	if err != nil {
+		return nil, err
+	}
+	for _, seg := range segs {
+		if rule := l.failedContext(label, seg.pos, seg.n, seg.when, seg.notWhen); rule != "" {
+			return nil, &Error{len(string(label[:seg.pos])), label[seg.pos], rule, ErrContext}
+		}
+	}
+	return segs, nil
+}
+
+// failedContext returns the name of the when or not-when rule that the code
+// points label[pos:pos+n] do not satisfy, or "" if they satisfy both.
+func (l *LGR) failedContext(label []rune, pos, n int, when, notWhen string) string {
+	if when != "" && !l.match(when, label, pos, n) {
+		return when
+	}
+	if notWhen != "" && l.match(notWhen, label, pos, n) {
+		return notWhen
+	}
+	return ""
+}
+
+// mapping is the variant mapping used for a segment of a variant label.
+type mapping struct {
+	cp     []rune
+	typ    string
+	mapped bool // false if the code points of the original label are kept
+}
+
+// Evaluate validates a label and returns its disposition. Labels that are
+// rejected by Validate are Invalid, and the error is returned too.
+func (l *LGR) Evaluate(label string) (Label, error) {
+	runes := []rune(label)
+	segs, err := l.validate(runes)
+	if err != nil {
+		return Label{Unicode: label, ACE: label, Disposition: Invalid}, err
+	}
+	maps := make([]mapping, len(segs))
+	for i, seg := range segs {
+		maps[i] = l.alternatives(runes, seg)[0]
+	}
+	return l.evaluate(runes, maps)
+}
+
+// Variants validates a label and returns all its variant labels, except for
+// the label itself, with their dispositions. Variant mappings whose context
+// rules are not satisfied by the original label are not used.
+// ErrTooManyVariants is returned if there are more than MaxVariants.
+func (l *LGR) Variants(label string) ([]Label, error) {
+	runes := []rune(label)
+	segs, err := l.validate(runes)
+	if err != nil {
+		return nil, err
+	}
+
+	alts := make([][]mapping, len(segs))
+	count := 1
+	for i, seg := range segs {
+		alts[i] = l.alternatives(runes, seg)
+		count *= len(alts[i])
+		if count > MaxVariants+1 {
+			return nil, ErrTooManyVariants
+		}
+	}
+
+	// Enumerate the combinations of alternatives like an odometer, skipping
+	// the first, which is the original label.
+	var labels []Label
+	idx := make([]int, len(segs))
+	maps := make([]mapping, len(segs))
+	for {
+		k := len(idx) - 1
+		for ; k >= 0; k-- {
+			idx[k]++
+			if idx[k] < len(alts[k]) {
+				break
+			}
+			idx[k] = 0
+		}
+		if k < 0 {
+			return labels, nil
+		}
+
+		var v []rune
+		for i, seg := range segs {
+			maps[i] = alts[i][idx[i]]
+			if maps[i].mapped {
+				v = append(v, maps[i].cp...)
+			} else {
+				v = append(v, runes[seg.pos:seg.pos+seg.n]...)
+			}
+		}
+		lbl, err := l.evaluate(v, maps)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, lbl)
+	}
+}
+
+// alternatives returns the mappings that can be used for a segment of the
+// original label. The first keeps the code points of the label, and has the
+// type of their reflexive variant if they have one.
+func (l *LGR) alternatives(label []rune, seg segment) []mapping {
+	orig := label[seg.pos : seg.pos+seg.n]
+	alts := []mapping{{}}
+	for _, v := range seg.vars {
+		if l.failedContext(label, seg.pos, seg.n, v.When, v.NotWhen) != "" {
+			continue
+		}
+		if string(v.CP) == string(orig) {
+			alts[0] = mapping{v.CP, v.Type, true}
+			continue
+		}
+		alts = append(alts, mapping{v.CP, v.Type, true})
+	}
+	return alts
+}
+
+// evaluate applies the actions of the LGR, then the default actions, to a
+// label made with the variant mappings maps.
+func (l *LGR) evaluate(label []rune, maps []mapping) (Label, error) {
+	u := string(label)
+	lbl := Label{Unicode: u, ACE: u}
+	for _, r := range label {
+		if r >= utf8.RuneSelf {
+			p, err := punycode.EncodeString(u)
+			if err != nil {
+				return lbl, err
+			}
+			lbl.ACE = AcePrefix + p
+			break
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, m := range maps {
+		if m.mapped && !seen[m.typ] {
+			seen[m.typ] = true
+			lbl.Types = append(lbl.Types, m.typ)
+		}
+	}
+	sort.Strings(lbl.Types)
+
+	for _, actions := range [][]Action{l.Actions, defaultActions} {
+		for _, a := range actions {
+			if l.triggers(a, label, maps) {
+				lbl.Disposition = a.Disp
+				return lbl, nil
+			}
+		}
+	}
+	return lbl, nil
+}
+
+// triggers returns true if the label made with the variant mappings maps
+// meets all the conditions of an action. The variant conditions are only met
+// if at least one variant mapping is used.
+func (l *LGR) triggers(a Action, label []rune, maps []mapping) bool {
+	if a.Match != "" && !l.match(a.Match, label, -1, 0) {
+		return false
+	}
+	if a.NotMatch != "" && l.match(a.NotMatch, label, -1, 0) {
+		return false
+	}
+	var used, anyN, allN, onlyN int
+	for _, m := range maps {
+		if !m.mapped {
+			continue
+		}
+		used++
+		if contains(a.AnyVariant, m.typ) {
+			anyN++
+		}
+		if contains(a.AllVariants, m.typ) {
+			allN++
+		}
+		if contains(a.OnlyVariants, m.typ) {
+			onlyN++
+		}
+	}
+	if a.AnyVariant != nil && anyN == 0 {
+		return false
+	}
+	if a.AllVariants != nil && (used == 0 || allN < used) {
+		return false
+	}
+	if a.OnlyVariants != nil && (used < len(maps) || onlyN < used) {
+		return false
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
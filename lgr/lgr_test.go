@@ -0,0 +1,276 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package lgr
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testLGR = `<?xml version="1.0" encoding="utf-8"?>
+<lgr xmlns="urn:ietf:params:xml:ns:lgr-1.0">
+  <meta>
+    <version>1</version>
+    <language>und-Hani</language>
+    <scope type="domain">.example</scope>
+    <unicode-version>6.3.0</unicode-version>
+  </meta>
+  <data>
+    <range first-cp="0030" last-cp="0039" tag="digit" />
+    <range first-cp="0061" last-cp="007A" tag="latin" />
+    <char cp="002D" not-when="hyphen-minus-disallowed" />
+    <char cp="00B7" when="catalan-middle-dot" />
+    <char cp="0061 0308">
+      <var cp="00E4" type="out-of-repertoire-var" />
+    </char>
+    <char cp="4E07">
+      <var cp="842C" type="trad" />
+    </char>
+    <char cp="842C">
+      <var cp="4E07" type="simp" />
+    </char>
+    <char cp="53F0">
+      <var cp="81FA" type="trad" />
+      <var cp="98B1" type="trad" />
+    </char>
+    <char cp="81FA">
+      <var cp="53F0" type="simp" />
+      <var cp="98B1" type="blocked" />
+    </char>
+    <char cp="98B1">
+      <var cp="53F0" type="simp" />
+      <var cp="81FA" type="blocked" />
+    </char>
+  </data>
+  <rules>
+    <rule name="hyphen-minus-disallowed">
+      <choice>
+        <rule><start /><anchor /></rule>
+        <rule><anchor /><end /></rule>
+        <rule><anchor /><char cp="002D" /></rule>
+      </choice>
+    </rule>
+    <class name="l">006C</class>
+    <rule name="catalan-middle-dot">
+      <look-behind><class by-ref="l" /></look-behind>
+      <anchor />
+      <look-ahead><class by-ref="l" /></look-ahead>
+    </rule>
+    <rule name="leading-digit">
+      <start />
+      <class from-tag="digit" />
+    </rule>
+    <difference name="consonants">
+      <class from-tag="latin" />
+      <class>0061 0065 0069 006F 0075 0079</class>
+    </difference>
+    <rule name="three-consonants">
+      <class by-ref="consonants" count="3+" />
+    </rule>
+    <action disp="reserved" match="three-consonants" />
+    <action disp="invalid" match="leading-digit" />
+    <action disp="blocked" any-variant="blocked" />
+    <action disp="allocatable" only-variants="simp trad" />
+    <action disp="activated" all-variants="trad" />
+  </rules>
+</lgr>
+`
+
+func parseTestLGR(t *testing.T) *LGR {
+	l, err := Parse(strings.NewReader(testLGR))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
+
+func TestParse(t *testing.T) {
+	l := parseTestLGR(t)
+	want := Meta{
+		Version:        "1",
+		Languages:      []string{"und-Hani"},
+		Domains:        []string{".example"},
+		UnicodeVersion: "6.3.0",
+	}
+	if !reflect.DeepEqual(l.Meta, want) {
+		t.Errorf("Meta = %+v; want %+v", l.Meta, want)
+	}
+	if len(l.Chars) != 8 || len(l.Ranges) != 2 || len(l.Actions) != 5 {
+		t.Errorf("%d chars, %d ranges, %d actions", len(l.Chars), len(l.Ranges), len(l.Actions))
+	}
+}
+
+var validateTests = []struct {
+	label string
+	err   error
+}{
+	{"a-b", nil},
+	{"l·l", nil},
+	{"a\u0308b", nil},
+	{"", ErrEmpty},
+	{"-ab", &Error{0, '-', "hyphen-minus-disallowed", ErrContext}},
+	{"ab-", &Error{2, '-', "hyphen-minus-disallowed", ErrContext}},
+	{"a--b", &Error{1, '-', "hyphen-minus-disallowed", ErrContext}},
+	{"a·l", &Error{1, 0xB7, "catalan-middle-dot", ErrContext}},
+	{"aé", &Error{1, 0xE9, "", ErrRepertoire}},
+	{"\u0308", &Error{0, 0x308, "", ErrRepertoire}},
+	{"ab一", &Error{2, 0x4E00, "", ErrRepertoire}},
+}
+
+func TestValidate(t *testing.T) {
+	l := parseTestLGR(t)
+	for _, tt := range validateTests {
+		err := l.Validate(tt.label)
+		if e, ok := tt.err.(*Error); ok {
+			if got, ok := err.(*Error); !ok || *got != *e {
+				t.Errorf("Validate(%+q) = %v; want %v", tt.label, err, tt.err)
+			}
+			continue
+		}
+		if err != tt.err {
+			t.Errorf("Validate(%+q) = %v; want %v", tt.label, err, tt.err)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	l := parseTestLGR(t)
+	tests := []struct {
+		label string
+		want  Label
+	}{
+		{"abab", Label{"abab", "abab", Valid, nil}},
+		{"abcd", Label{"abcd", "abcd", "reserved", nil}},
+		{"1a", Label{"1a", "1a", Invalid, nil}},
+		{"万", Label{"万", "xn--chq", Valid, nil}},
+	}
+	for _, tt := range tests {
+		got, err := l.Evaluate(tt.label)
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Evaluate(%+q) = %+v, %v; want %+v", tt.label, got, err, tt.want)
+		}
+	}
+
+	got, err := l.Evaluate("-a")
+	if got.Disposition != Invalid || !errors.Is(err, ErrContext) {
+		t.Errorf("Evaluate(%q) = %+v, %v; want invalid", "-a", got, err)
+	}
+}
+
+func TestVariants(t *testing.T) {
+	l := parseTestLGR(t)
+	tests := []struct {
+		label string
+		want  []Label
+	}{
+		{"ab", nil},
+		{"臺", []Label{
+			{"台", "xn--kpr", Allocatable, []string{"simp"}},
+			{"颱", "xn--g25a", Blocked, []string{"blocked"}},
+		}},
+		{"万台", []Label{
+			{"万臺", "xn--chq797j", Activated, []string{"trad"}},
+			{"万颱", "xn--chq333p", Activated, []string{"trad"}},
+			{"萬台", "xn--kpr753i", Activated, []string{"trad"}},
+			{"萬臺", "xn--bc1ax8b", Allocatable, []string{"trad"}},
+			{"萬颱", "xn--ds1a533b", Allocatable, []string{"trad"}},
+		}},
+		{"a\u0308b", []Label{
+			{"äb", "xn--b-zfa", Blocked, []string{OutOfRepertoire}},
+		}},
+	}
+	for _, tt := range tests {
+		got, err := l.Variants(tt.label)
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Variants(%+q) = %+v, %v; want %+v", tt.label, got, err, tt.want)
+		}
+	}
+
+	if _, err := l.Variants("aé"); !errors.Is(err, ErrRepertoire) {
+		t.Errorf("Variants of an invalid label = %v", err)
+	}
+	if _, err := l.Variants(strings.Repeat("台", 10)); err != ErrTooManyVariants {
+		t.Errorf("Variants of a long label = %v; want ErrTooManyVariants", err)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []string{
+		`<lgr><data><char cp="ZZ" /></data></lgr>`,
+		`<lgr><data><char cp="61" /><char cp="61" /></data></lgr>`,
+		`<lgr><data><char cp="61"><var cp="" /></char></data></lgr>`,
+		`<lgr><data><range first-cp="62" last-cp="61" /></data></lgr>`,
+		`<lgr><data><char cp="61" when="missing" /></data></lgr>`,
+		`<lgr><rules><rule name="r"><rule by-ref="r" /></rule></rules></lgr>`,
+		`<lgr><rules><rule name="r"><class by-ref="missing" /></rule></rules></lgr>`,
+		`<lgr><rules><union name="u"><class by-ref="u" /></union></rules></lgr>`,
+		`<lgr><rules><rule name="r"><any count="2:1" /></rule></rules></lgr>`,
+		`<lgr><rules><class name="c" property="bc:L" /></rules></lgr>`,
+		`<lgr><rules><complement name="c"><class>61</class><class>62</class></complement></rules></lgr>`,
+		`<lgr><rules><action match="r" /></rules></lgr>`,
+		`<lgr><rules><action disp="valid" match="r" /></rules></lgr>`,
+		`<lgr><rules><rule name="r"><unknown /></rule></rules></lgr>`,
+		`<data />`,
+		`<lgr>`,
+	}
+	for _, data := range tests {
+		if _, err := Parse(strings.NewReader(data)); err == nil {
+			t.Errorf("Parse(%q) succeeded", data)
+		}
+	}
+}
+
+func TestPropertyClass(t *testing.T) {
+	tests := []struct {
+		prop string
+		r    rune
+		want bool
+	}{
+		{"gc:Mn", 0x308, true},
+		{"gc:Mn", 'a', false},
+		{"sc:Hani", 0x4E07, true},
+		{"sc:Latn", 0x4E07, false},
+		{"sc:Cyrillic", 0x430, true},
+		{"ccc:9", 0x94D, true},
+		{"ccc:9", 0x308, false},
+	}
+	for _, tt := range tests {
+		set, err := propertyClass(tt.prop)
+		if err != nil {
+			t.Errorf("propertyClass(%q): %v", tt.prop, err)
+			continue
+		}
+		if got := set(tt.r); got != tt.want {
+			t.Errorf("propertyClass(%q)(%U) = %v; want %v", tt.prop, tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestParseCount(t *testing.T) {
+	tests := []struct {
+		s        string
+		min, max int
+	}{
+		{"", 1, 1},
+		{"3", 3, 3},
+		{"0+", 0, -1},
+		{"2:5", 2, 5},
+	}
+	for _, tt := range tests {
+		min, max, err := parseCount(tt.s)
+		if err != nil || min != tt.min || max != tt.max {
+			t.Errorf("parseCount(%q) = %d, %d, %v; want %d, %d", tt.s, min, max, err, tt.min, tt.max)
+		}
+	}
+	for _, s := range []string{"x", "-1", "3:2", "1:"} {
+		if _, _, err := parseCount(s); err == nil {
+			t.Errorf("parseCount(%q) succeeded", s)
+		}
+	}
+}
@@ -0,0 +1,571 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package lgr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// A class is a set of code points, defined by a class element or by one of
+// the set operators of the rules section.
+type class struct {
+	def      *element
+	contains func(rune) bool
+	busy     bool // set while the class is being compiled, to detect cycles
+}
+
+// Kinds of op.
+const (
+	opStart  = iota // the start of the label
+	opEnd           // the end of the label
+	opAnchor        // the code points whose context is evaluated
+	opChar          // a code point or sequence
+	opClass         // a code point of a class
+	opAny           // any code point
+	opSeq           // a sequence of ops: a rule, look-behind or look-ahead
+	opRule          // a rule referred to by name
+	opChoice        // one of a list of ops
+)
+
+// An op is a compiled match operator of a rule.
+type op struct {
+	kind     int
+	cp       []rune
+	class    func(rune) bool
+	ref      string
+	ops      []*op
+	min, max int // the number of times the op matches; max is -1 if unbounded
+}
+
+// parseRules reads the classes, rules and actions of an LGR.
+func (l *LGR) parseRules(rules *element) error {
+	l.classes = map[string]*class{}
+	l.rules = map[string]*op{}
+	for i := range rules.Children {
+		e := &rules.Children[i]
+		name := e.attr("name")
+		switch e.XMLName.Local {
+		case "class", "union", "complement", "intersection", "difference", "symmetric-difference":
+			if name == "" {
+				return fmt.Errorf("lgr: %s without a name", e.XMLName.Local)
+			}
+			l.classes[name] = &class{def: e}
+		}
+	}
+
+	for i := range rules.Children {
+		e := &rules.Children[i]
+		switch e.XMLName.Local {
+		case "class", "union", "complement", "intersection", "difference", "symmetric-difference":
+			if _, err := l.namedClass(e.attr("name")); err != nil {
+				return err
+			}
+		case "rule":
+			name := e.attr("name")
+			if name == "" {
+				return fmt.Errorf("lgr: rule without a name")
+			}
+			ops, err := l.compileOps(e.Children)
+			if err != nil {
+				return fmt.Errorf("lgr: rule %s: %v", name, err)
+			}
+			l.rules[name] = &op{kind: opSeq, ops: ops, min: 1, max: 1}
+		case "action":
+			disp := e.attr("disp")
+			if disp == "" {
+				return fmt.Errorf("lgr: action without a disposition")
+			}
+			l.Actions = append(l.Actions, Action{
+				Disp:         Disposition(disp),
+				Match:        e.attr("match"),
+				NotMatch:     e.attr("not-match"),
+				AnyVariant:   typeList(e, "any-variant"),
+				AllVariants:  typeList(e, "all-variants"),
+				OnlyVariants: typeList(e, "only-variants"),
+			})
+		}
+	}
+
+	// Rules may refer to rules defined after them, so the references are
+	// checked once all rules are known.
+	for name, rule := range l.rules {
+		if err := l.checkRefs(rule, map[string]bool{name: true}); err != nil {
+			return fmt.Errorf("lgr: rule %s: %v", name, err)
+		}
+	}
+	refs := func(when, notWhen string) error {
+		for _, name := range []string{when, notWhen} {
+			if name != "" && l.rules[name] == nil {
+				return fmt.Errorf("lgr: undefined rule %q", name)
+			}
+		}
+		return nil
+	}
+	for _, c := range l.Chars {
+		if err := refs(c.When, c.NotWhen); err != nil {
+			return err
+		}
+		for _, v := range c.Variants {
+			if err := refs(v.When, v.NotWhen); err != nil {
+				return err
+			}
+		}
+	}
+	for _, rg := range l.Ranges {
+		if err := refs(rg.When, rg.NotWhen); err != nil {
+			return err
+		}
+	}
+	for _, a := range l.Actions {
+		if err := refs(a.Match, a.NotMatch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// typeList returns the variant types listed in attribute name of e, or nil
+// if e does not have the attribute.
+func typeList(e *element, name string) []string {
+	for _, a := range e.Attrs {
+		if a.Name.Local == name {
+			return append([]string{}, strings.Fields(a.Value)...)
+		}
+	}
+	return nil
+}
+
+// checkRefs checks that the rules o refers to are defined, and that none of
+// the rules in visiting is referred to again.
+func (l *LGR) checkRefs(o *op, visiting map[string]bool) error {
+	if o.kind == opRule {
+		rule := l.rules[o.ref]
+		if rule == nil {
+			return fmt.Errorf("undefined rule %q", o.ref)
+		}
+		if visiting[o.ref] {
+			return fmt.Errorf("rule %q refers to itself", o.ref)
+		}
+		visiting[o.ref] = true
+		defer delete(visiting, o.ref)
+		return l.checkRefs(rule, visiting)
+	}
+	for _, sub := range o.ops {
+		if err := l.checkRefs(sub, visiting); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// namedClass returns the code points of the class or set operator called
+// name, compiling it if needed.
+func (l *LGR) namedClass(name string) (func(rune) bool, error) {
+	c := l.classes[name]
+	if c == nil {
+		return nil, fmt.Errorf("lgr: undefined class %q", name)
+	}
+	if c.contains == nil {
+		if c.busy {
+			return nil, fmt.Errorf("lgr: class %q refers to itself", name)
+		}
+		c.busy = true
+		set, err := l.compileClass(c.def, true)
+		c.busy = false
+		if err != nil {
+			return nil, err
+		}
+		c.contains = set
+	}
+	return c.contains, nil
+}
+
+// compileClass returns the code points of a class element or set operator.
+// The name of a top-level definition is ignored, while any other class with a
+// name refers to its definition.
+func (l *LGR) compileClass(e *element, top bool) (func(rune) bool, error) {
+	local := e.XMLName.Local
+	if ref := e.attr("by-ref"); ref != "" {
+		return l.namedClass(ref)
+	}
+	if name := e.attr("name"); name != "" && !top {
+		return nil, fmt.Errorf("lgr: %s %q defined inside another element", local, name)
+	}
+
+	if local == "class" {
+		switch {
+		case e.attr("from-tag") != "":
+			return l.tagClass(e.attr("from-tag")), nil
+		case e.attr("property") != "":
+			return propertyClass(e.attr("property"))
+		default:
+			return parseClassContent(e.Text)
+		}
+	}
+
+	var sets []func(rune) bool
+	for i := range e.Children {
+		set, err := l.compileClass(&e.Children[i], false)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, set)
+	}
+	arity := map[string]int{"complement": 1, "difference": 2, "symmetric-difference": 2}
+	if n, ok := arity[local]; ok && len(sets) != n {
+		return nil, fmt.Errorf("lgr: %s takes %d classes, not %d", local, n, len(sets))
+	}
+	switch local {
+	case "union":
+		return func(r rune) bool {
+			for _, set := range sets {
+				if set(r) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case "intersection":
+		return func(r rune) bool {
+			for _, set := range sets {
+				if !set(r) {
+					return false
+				}
+			}
+			return len(sets) > 0
+		}, nil
+	case "complement":
+		return func(r rune) bool { return !sets[0](r) }, nil
+	case "difference":
+		return func(r rune) bool { return sets[0](r) && !sets[1](r) }, nil
+	case "symmetric-difference":
+		return func(r rune) bool { return sets[0](r) != sets[1](r) }, nil
+	}
+	return nil, fmt.Errorf("lgr: %s is not a class", local)
+}
+
+// tagClass returns the code points of the repertoire with the given tag.
+// Sequences are not part of any class.
+func (l *LGR) tagClass(tag string) func(rune) bool {
+	set := map[rune]bool{}
+	var ranges []*Range
+	for _, c := range l.Chars {
+		if len(c.CP) == 1 && contains(c.Tags, tag) {
+			set[c.CP[0]] = true
+		}
+	}
+	for _, rg := range l.Ranges {
+		if contains(rg.Tags, tag) {
+			ranges = append(ranges, rg)
+		}
+	}
+	return func(r rune) bool {
+		if set[r] {
+			return true
+		}
+		for _, rg := range ranges {
+			if rg.First <= r && r <= rg.Last {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// parseClassContent parses the code points and ranges listed in a class
+// element, such as "0061 0063-0065".
+func parseClassContent(s string) (func(rune) bool, error) {
+	var ranges [][2]rune
+	for _, f := range strings.Fields(s) {
+		lo, hi, ok := strings.Cut(f, "-")
+		if !ok {
+			hi = lo
+		}
+		first, err := parseCodePoint(lo)
+		if err != nil {
+			return nil, fmt.Errorf("lgr: class: %v", err)
+		}
+		last, err := parseCodePoint(hi)
+		if err != nil {
+			return nil, fmt.Errorf("lgr: class: %v", err)
+		}
+		ranges = append(ranges, [2]rune{first, last})
+	}
+	return func(r rune) bool {
+		for _, rg := range ranges {
+			if rg[0] <= r && r <= rg[1] {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// scripts maps the ISO 15924 codes used by LGRs to the script tables of the
+// unicode package.
+var scripts = map[string]*unicode.RangeTable{
+	"Arab": unicode.Arabic,
+	"Armn": unicode.Armenian,
+	"Beng": unicode.Bengali,
+	"Bopo": unicode.Bopomofo,
+	"Cyrl": unicode.Cyrillic,
+	"Deva": unicode.Devanagari,
+	"Ethi": unicode.Ethiopic,
+	"Geor": unicode.Georgian,
+	"Grek": unicode.Greek,
+	"Gujr": unicode.Gujarati,
+	"Guru": unicode.Gurmukhi,
+	"Hang": unicode.Hangul,
+	"Hani": unicode.Han,
+	"Hebr": unicode.Hebrew,
+	"Hira": unicode.Hiragana,
+	"Kana": unicode.Katakana,
+	"Khmr": unicode.Khmer,
+	"Knda": unicode.Kannada,
+	"Laoo": unicode.Lao,
+	"Latn": unicode.Latin,
+	"Mlym": unicode.Malayalam,
+	"Mong": unicode.Mongolian,
+	"Mymr": unicode.Myanmar,
+	"Orya": unicode.Oriya,
+	"Sinh": unicode.Sinhala,
+	"Taml": unicode.Tamil,
+	"Telu": unicode.Telugu,
+	"Thaa": unicode.Thaana,
+	"Thai": unicode.Thai,
+	"Tibt": unicode.Tibetan,
+	"Zinh": unicode.Inherited,
+	"Zyyy": unicode.Common,
+}
+
+// propertyClass returns the code points with a Unicode property, given as
+// "gc:Mn", "sc:Latn" or "ccc:9".
+func propertyClass(prop string) (func(rune) bool, error) {
+	name, value, _ := strings.Cut(prop, ":")
+	switch name {
+	case "gc", "General_Category":
+		if t := unicode.Categories[value]; t != nil {
+			return func(r rune) bool { return unicode.Is(t, r) }, nil
+		}
+	case "sc", "Script":
+		t := scripts[value]
+		if t == nil {
+			t = unicode.Scripts[value]
+		}
+		if t != nil {
+			return func(r rune) bool { return unicode.Is(t, r) }, nil
+		}
+	case "ccc", "Canonical_Combining_Class":
+		if ccc, err := strconv.ParseUint(value, 10, 8); err == nil {
+			return func(r rune) bool {
+				return norm.NFD.PropertiesString(string(r)).CCC() == uint8(ccc)
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("lgr: unsupported property %q", prop)
+}
+
+// compileOps compiles the match operators of a rule, look-behind, look-ahead
+// or choice.
+func (l *LGR) compileOps(elems []element) ([]*op, error) {
+	var ops []*op
+	for i := range elems {
+		o, err := l.compileOp(&elems[i])
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, o)
+	}
+	return ops, nil
+}
+
+func (l *LGR) compileOp(e *element) (*op, error) {
+	o := &op{}
+	var err error
+	if o.min, o.max, err = parseCount(e.attr("count")); err != nil {
+		return nil, err
+	}
+
+	switch local := e.XMLName.Local; local {
+	case "start":
+		o.kind = opStart
+	case "end":
+		o.kind = opEnd
+	case "anchor":
+		o.kind = opAnchor
+	case "any":
+		o.kind = opAny
+	case "char":
+		o.kind = opChar
+		if o.cp, err = parseCodePoints(e.attr("cp")); err != nil {
+			return nil, err
+		}
+	case "class", "union", "complement", "intersection", "difference", "symmetric-difference":
+		o.kind = opClass
+		if o.class, err = l.compileClass(e, false); err != nil {
+			return nil, err
+		}
+	case "rule":
+		if ref := e.attr("by-ref"); ref != "" {
+			o.kind = opRule
+			o.ref = ref
+			break
+		}
+		fallthrough
+	case "look-behind", "look-ahead":
+		o.kind = opSeq
+		if o.ops, err = l.compileOps(e.Children); err != nil {
+			return nil, err
+		}
+	case "choice":
+		o.kind = opChoice
+		if o.ops, err = l.compileOps(e.Children); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown element %q", local)
+	}
+	return o, nil
+}
+
+// parseCount parses the count attribute of a match operator: "n", "n+" or
+// "n:m". The empty count matches exactly once.
+func parseCount(s string) (min, max int, err error) {
+	if s == "" {
+		return 1, 1, nil
+	}
+	lo, hi, ok := strings.Cut(s, ":")
+	switch {
+	case ok:
+		min, err = strconv.Atoi(lo)
+		if err == nil {
+			max, err = strconv.Atoi(hi)
+		}
+	case strings.HasSuffix(s, "+"):
+		min, err = strconv.Atoi(strings.TrimSuffix(s, "+"))
+		max = -1
+	default:
+		min, err = strconv.Atoi(s)
+		max = min
+	}
+	if err != nil || min < 0 || (max >= 0 && max < min) {
+		return 0, 0, fmt.Errorf("invalid count %q", s)
+	}
+	return min, max, nil
+}
+
+// matcher matches rules against a label. The anchor of a context rule
+// matches the code points label[anchor:anchor+n].
+type matcher struct {
+	l         *LGR
+	label     []rune
+	anchor, n int
+}
+
+// match returns true if the rule called name matches the label at any
+// position. If anchor is -1 the anchor of the rule matches nothing.
+func (l *LGR) match(name string, label []rune, anchor, n int) bool {
+	m := &matcher{l, label, anchor, n}
+	rule := l.rules[name]
+	for pos := 0; pos <= len(label); pos++ {
+		if m.match(rule, pos, func(int) bool { return true }) {
+			return true
+		}
+	}
+	return false
+}
+
+// match matches o, repeated as its count allows, at pos and calls k with the
+// position after each match until k returns true. Longer matches are tried
+// first.
+func (m *matcher) match(o *op, pos int, k func(int) bool) bool {
+	return m.repeat(o, pos, 0, k)
+}
+
+func (m *matcher) repeat(o *op, pos, count int, k func(int) bool) bool {
+	if o.max < 0 || count < o.max {
+		more := m.once(o, pos, func(p int) bool {
+			if p == pos && count >= o.min {
+				// Matching nothing again would never end.
+				return false
+			}
+			return m.repeat(o, p, count+1, k)
+		})
+		if more {
+			return true
+		}
+	}
+	return count >= o.min && k(pos)
+}
+
+func (m *matcher) once(o *op, pos int, k func(int) bool) bool {
+	switch o.kind {
+	case opStart:
+		return pos == 0 && k(pos)
+	case opEnd:
+		return pos == len(m.label) && k(pos)
+	case opAnchor:
+		return pos == m.anchor && k(pos+m.n)
+	case opAny:
+		return pos < len(m.label) && k(pos+1)
+	case opChar:
+		if pos+len(o.cp) > len(m.label) || string(m.label[pos:pos+len(o.cp)]) != string(o.cp) {
+			return false
+		}
+		return k(pos + len(o.cp))
+	case opClass:
+		return pos < len(m.label) && o.class(m.label[pos]) && k(pos+1)
+	case opSeq:
+		return m.seq(o.ops, pos, k)
+	case opRule:
+		return m.seq(m.l.rules[o.ref].ops, pos, k)
+	case opChoice:
+		for _, alt := range o.ops {
+			if m.match(alt, pos, k) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m *matcher) seq(ops []*op, pos int, k func(int) bool) bool {
+	if len(ops) == 0 {
+		return k(pos)
+	}
+	return m.match(ops[0], pos, func(p int) bool { return m.seq(ops[1:], p, k) })
+}
+
+// parseCodePoints parses a code point or a sequence of code points separated
+// by spaces, such as "0061 0308".
+func parseCodePoints(s string) ([]rune, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("missing code point")
+	}
+	cp := make([]rune, len(fields))
+	for i, f := range fields {
+		var err error
+		if cp[i], err = parseCodePoint(f); err != nil {
+			return nil, err
+		}
+	}
+	return cp, nil
+}
+
+func parseCodePoint(s string) (rune, error) {
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil || v > 0x10FFFF {
+		return 0, fmt.Errorf("invalid code point %q", s)
+	}
+	return rune(v), nil
+}
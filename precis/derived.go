@@ -0,0 +1,125 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package precis
+
+import (
+	"unicode"
+
+	"github.com/DanielOaks/go-idn/idna2008"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Property is the PRECIS derived property value of a code point, as
+// described in RFC 8264 section 8.
+type Property int
+
+// Derived property values, RFC 8264 section 8.
+const (
+	PVALID     Property = iota // valid in both string classes
+	CONTEXTJ                   // valid in context, join controls
+	CONTEXTO                   // valid in context, others
+	DISALLOWED                 // valid in neither string class
+	ID_DIS                     // disallowed in IdentifierClass, valid in FreeformClass (FREE_PVAL)
+	UNASSIGNED                 // not assigned in this version of Unicode
+)
+
+var propertyNames = []string{
+	PVALID:     "PVALID",
+	CONTEXTJ:   "CONTEXTJ",
+	CONTEXTO:   "CONTEXTO",
+	DISALLOWED: "DISALLOWED",
+	ID_DIS:     "ID_DIS or FREE_PVAL",
+	UNASSIGNED: "UNASSIGNED",
+}
+
+func (p Property) String() string {
+	if p < 0 || int(p) >= len(propertyNames) {
+		return "Property(?)"
+	}
+	return propertyNames[p]
+}
+
+// DerivedProperty returns the PRECIS derived property value of r, computed
+// with the algorithm in RFC 8264 section 8 from the Unicode tables this
+// package is built against. The categories shared with IDNA2008 are those of
+// the idna2008 package.
+func DerivedProperty(r rune) Property {
+	if p, ok := idna2008.Exception(r); ok {
+		switch p {
+		case idna2008.PVALID:
+			return PVALID
+		case idna2008.CONTEXTO:
+			return CONTEXTO
+		}
+		return DISALLOWED
+	}
+	if idna2008.IsUnassigned(r) {
+		return UNASSIGNED
+	}
+	if isASCII7(r) {
+		return PVALID
+	}
+	if unicode.Is(unicode.Join_Control, r) {
+		return CONTEXTJ
+	}
+	if idna2008.IsOldHangulJamo(r) {
+		return DISALLOWED
+	}
+	if isIgnorableProperty(r) {
+		return DISALLOWED
+	}
+	if unicode.Is(unicode.Cc, r) {
+		return DISALLOWED
+	}
+	if hasCompat(r) {
+		return ID_DIS
+	}
+	if isLetterDigit(r) {
+		return PVALID
+	}
+	if isOtherLetterDigit(r) || unicode.Is(unicode.Zs, r) || isSymbol(r) || isPunctuation(r) {
+		return ID_DIS
+	}
+	return DISALLOWED
+}
+
+// ASCII7 (K), RFC 8264 section 9.11: the printable ASCII code points other
+// than SPACE.
+func isASCII7(r rune) bool {
+	return 0x21 <= r && r <= 0x7E
+}
+
+// PrecisIgnorableProperties (M), RFC 8264 section 9.13.
+func isIgnorableProperty(r rune) bool {
+	return idna2008.IsDefaultIgnorable(r) || unicode.Is(unicode.Noncharacter_Code_Point, r)
+}
+
+// HasCompat (Q), RFC 8264 section 9.17: code points changed by NFKC.
+func hasCompat(r rune) bool {
+	s := string(r)
+	return norm.NFKC.String(s) != s
+}
+
+// LetterDigits (A), RFC 8264 section 9.1.
+func isLetterDigit(r rune) bool {
+	return unicode.In(r, unicode.Ll, unicode.Lu, unicode.Lo, unicode.Nd, unicode.Lm, unicode.Mn, unicode.Mc)
+}
+
+// OtherLetterDigits (R), RFC 8264 section 9.18.
+func isOtherLetterDigit(r rune) bool {
+	return unicode.In(r, unicode.Lt, unicode.Nl, unicode.No, unicode.Me)
+}
+
+// Symbols (O), RFC 8264 section 9.15.
+func isSymbol(r rune) bool {
+	return unicode.In(r, unicode.Sm, unicode.Sc, unicode.Sk, unicode.So)
+}
+
+// Punctuation (P), RFC 8264 section 9.16.
+func isPunctuation(r rune) bool {
+	return unicode.In(r, unicode.Pc, unicode.Pd, unicode.Ps, unicode.Pe, unicode.Pi, unicode.Pf, unicode.Po)
+}
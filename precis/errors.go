@@ -0,0 +1,37 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package precis
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by Enforce and Prepare. ErrDisallowed is wrapped in an
+// *Error that gives the offending code point; use errors.Is to find out why
+// a string was rejected.
+var (
+	ErrDisallowed = errors.New("precis: Disallowed code point")
+	ErrEmpty      = errors.New("precis: String is empty")
+	ErrBidi       = errors.New("precis: Bidi rule not satisfied")
+	ErrUnstable   = errors.New("precis: String does not stabilize")
+)
+
+// An Error describes a code point that is not allowed by the string class of
+// a profile.
+type Error struct {
+	Offset   int      // byte offset of Rune in the string after the rules of the profile
+	Rune     rune     // the offending rune
+	Property Property // the derived property of Rune
+	Err      error    // the reason for the error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v: %U at offset %d (%v)", e.Err, e.Rune, e.Offset, e.Property)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
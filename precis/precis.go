@@ -0,0 +1,294 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+// Package precis implements the PRECIS framework described in RFC 8264,
+// which replaces Stringprep for preparing and comparing internationalized
+// strings, along with the profiles for usernames and passwords in RFC 8265
+// and for nicknames in RFC 8266.
+//
+// A profile is based on one of two string classes: IdentifierClass, which
+// allows letters and digits, and FreeformClass, which also allows spaces,
+// symbols and punctuation. Enforce applies the rules of a profile and checks
+// that the result only contains code points allowed by its class. Prepare
+// only checks the class, and Compare compares two strings after enforcement.
+//
+// The directionality rule of the username profiles is the Bidi rule of RFC
+// 5893, which RFC 8264 refers to, checked with the idna2008 package. The
+// stringprep BIDI step of RFC 3454 is not used, as it allows strings that
+// the Bidi rule rejects.
+//
+// This package is in beta and has not been extensively tested.
+package precis
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/DanielOaks/go-idn/idna2008"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// A Class is one of the PRECIS string classes of RFC 8264 section 4.
+type Class struct {
+	name     string
+	freeform bool // whether ID_DIS code points are allowed
+}
+
+var (
+	// IdentifierClass is the string class for identifiers such as usernames,
+	// RFC 8264 section 4.2.
+	IdentifierClass = &Class{"IdentifierClass", false}
+
+	// FreeformClass is the string class for free-form strings such as
+	// passwords and nicknames, RFC 8264 section 4.3.
+	FreeformClass = &Class{"FreeformClass", true}
+)
+
+func (c *Class) String() string {
+	return c.name
+}
+
+// Allows returns true if the class allows code points with the derived
+// property p, ignoring the contextual rules of CONTEXTJ and CONTEXTO code
+// points.
+func (c *Class) Allows(p Property) bool {
+	switch p {
+	case PVALID, CONTEXTJ, CONTEXTO:
+		return true
+	case ID_DIS:
+		return c.freeform
+	}
+	return false
+}
+
+// Validate checks that s consists only of code points allowed by the class,
+// applying the contextual rules of RFC 5892 appendix A to CONTEXTJ and
+// CONTEXTO code points.
+func (c *Class) Validate(s string) error {
+	runes := []rune(s)
+	offset := 0
+	for i, r := range runes {
+		p := DerivedProperty(r)
+		if !c.Allows(p) || ((p == CONTEXTJ || p == CONTEXTO) && !idna2008.ContextRule(runes, i)) {
+			return &Error{offset, r, p, ErrDisallowed}
+		}
+		offset += utf8.RuneLen(r)
+	}
+	return nil
+}
+
+// A Profile is a PRECIS profile: a string class and the rules of RFC 8264
+// section 5.2 that are applied to strings before they are checked against
+// it. A Profile is safe for concurrent use.
+type Profile struct {
+	class        *Class
+	width        bool
+	additional   []func(string) string
+	caseMap      bool
+	norm         norm.Form
+	bidi         bool
+	compareLower bool
+}
+
+// An Option configures a Profile.
+type Option func(*Profile)
+
+// FoldWidth sets whether fullwidth and halfwidth code points are mapped to
+// their decomposition mappings, the width mapping rule of RFC 8264 section
+// 5.2.1.
+func FoldWidth(fold bool) Option {
+	return func(p *Profile) { p.width = fold }
+}
+
+// AdditionalMapping adds mappings applied after width mapping, as in the
+// additional mapping rule of RFC 8264 section 5.2.2. They are applied in
+// order.
+func AdditionalMapping(mappings ...func(string) string) Option {
+	return func(p *Profile) { p.additional = append(p.additional, mappings...) }
+}
+
+// LowerCase sets whether the Unicode toLowerCase operation is applied, the
+// case mapping rule of RFC 8264 section 5.2.3.
+func LowerCase(lower bool) Option {
+	return func(p *Profile) { p.caseMap = lower }
+}
+
+// Norm sets the normalization form of the normalization rule of RFC 8264
+// section 5.2.4. The default is NFC.
+func Norm(f norm.Form) Option {
+	return func(p *Profile) { p.norm = f }
+}
+
+// BidiRule sets whether strings containing right-to-left code points must
+// satisfy the Bidi rule of RFC 5893, the directionality rule of RFC 8264
+// section 5.2.5.
+func BidiRule(check bool) Option {
+	return func(p *Profile) { p.bidi = check }
+}
+
+// LowerCaseForCompare sets whether Compare also applies the toLowerCase
+// operation, as RFC 8266 requires for nicknames, which keep their case when
+// enforced.
+func LowerCaseForCompare(lower bool) Option {
+	return func(p *Profile) { p.compareLower = lower }
+}
+
+// NewIdentifier returns a profile based on IdentifierClass with the given
+// options applied.
+func NewIdentifier(opts ...Option) *Profile {
+	return newProfile(IdentifierClass, opts)
+}
+
+// NewFreeform returns a profile based on FreeformClass with the given options
+// applied.
+func NewFreeform(opts ...Option) *Profile {
+	return newProfile(FreeformClass, opts)
+}
+
+func newProfile(class *Class, opts []Option) *Profile {
+	p := &Profile{class: class, norm: norm.NFC}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+var (
+	// UsernameCaseMapped is the profile for usernames that are compared
+	// without regard to case, RFC 8265 section 3.3.
+	UsernameCaseMapped = NewIdentifier(FoldWidth(true), LowerCase(true), BidiRule(true))
+
+	// UsernameCasePreserved is the profile for usernames whose case is
+	// significant, RFC 8265 section 3.4.
+	UsernameCasePreserved = NewIdentifier(FoldWidth(true), BidiRule(true))
+
+	// OpaqueString is the profile for passwords and other secure strings,
+	// RFC 8265 section 4.2.
+	OpaqueString = NewFreeform(AdditionalMapping(mapSpaces))
+
+	// Nickname is the profile for nicknames in messaging and text
+	// conferencing, RFC 8266 section 2.
+	Nickname = NewFreeform(AdditionalMapping(mapSpaces, trimSpaces, collapseSpaces),
+		Norm(norm.NFKC), LowerCaseForCompare(true))
+)
+
+// Class returns the string class of the profile.
+func (p *Profile) Class() *Class {
+	return p.class
+}
+
+// maxRuleApplications is the number of times the rules of a profile are
+// applied before a string that keeps changing is rejected, RFC 8264
+// section 7.
+const maxRuleApplications = 4
+
+// Enforce applies the rules of the profile to s and checks that the result
+// is a non-empty string of code points allowed by its class and, if the
+// profile has the directionality rule, satisfies the Bidi rule. The rules are
+// applied again until the string is stable, as RFC 8264 section 7 requires.
+func (p *Profile) Enforce(s string) (string, error) {
+	return p.enforce(s, p.caseMap)
+}
+
+func (p *Profile) enforce(s string, lower bool) (string, error) {
+	for i := 0; ; i++ {
+		if i == maxRuleApplications {
+			return "", ErrUnstable
+		}
+		t := p.applyRules(s, lower)
+		if t == s {
+			break
+		}
+		s = t
+	}
+
+	if s == "" {
+		return "", ErrEmpty
+	}
+	if err := p.class.Validate(s); err != nil {
+		return "", err
+	}
+	if p.bidi && idna2008.IsBidiDomain([]string{s}) && !idna2008.CheckBidiRule([]rune(s)) {
+		return "", ErrBidi
+	}
+	return s, nil
+}
+
+// applyRules applies the rules of RFC 8264 section 7 in order.
+func (p *Profile) applyRules(s string, lower bool) string {
+	if p.width {
+		s = width.Fold.String(s)
+	}
+	for _, m := range p.additional {
+		s = m(s)
+	}
+	if lower {
+		s = cases.Lower(language.Und, cases.HandleFinalSigma(false)).String(s)
+	}
+	return p.norm.String(s)
+}
+
+// Prepare checks that s consists only of code points allowed by the class of
+// the profile, after the width mapping rule if the profile has it, and
+// returns it. Unlike Enforce it applies no other rules, so the result is
+// suitable for further processing but not for comparison.
+func (p *Profile) Prepare(s string) (string, error) {
+	if p.width {
+		s = width.Fold.String(s)
+	}
+	if err := p.class.Validate(s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// Compare enforces the profile on a and b and returns true if both are
+// valid and the results are equal.
+func (p *Profile) Compare(a, b string) bool {
+	lower := p.caseMap || p.compareLower
+	ea, err := p.enforce(a, lower)
+	if err != nil {
+		return false
+	}
+	eb, err := p.enforce(b, lower)
+	if err != nil {
+		return false
+	}
+	return ea == eb
+}
+
+// mapSpaces maps the non-ASCII spaces (Zs) to SPACE, as the OpaqueString and
+// Nickname profiles do.
+func mapSpaces(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r != ' ' && unicode.Is(unicode.Zs, r) {
+			return ' '
+		}
+		return r
+	}, s)
+}
+
+// trimSpaces removes leading and trailing spaces, RFC 8266 section 2.1.
+func trimSpaces(s string) string {
+	return strings.Trim(s, " ")
+}
+
+// collapseSpaces maps each run of spaces to a single space, RFC 8266
+// section 2.1.
+func collapseSpaces(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == ' ' && i > 0 && s[i-1] == ' ' {
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
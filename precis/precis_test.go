@@ -0,0 +1,173 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package precis
+
+import (
+	"errors"
+	"testing"
+)
+
+var propertyTests = []struct {
+	Rune     rune
+	Property Property
+}{
+	{'a', PVALID},
+	{'A', PVALID},
+	{'!', PVALID},
+	{'~', PVALID},
+	{' ', ID_DIS},
+	{'\u00a0', ID_DIS}, // NO-BREAK SPACE, HasCompat
+	{'ß', PVALID},      // LATIN SMALL LETTER SHARP S, exception
+	{'é', PVALID},
+	{'·', CONTEXTO},
+	{'\u200c', CONTEXTJ},
+	{'\u200b', DISALLOWED}, // ZERO WIDTH SPACE, default ignorable
+	{'\u0007', DISALLOWED},
+	{'ᄀ', DISALLOWED},      // HANGUL CHOSEONG KIYEOK, old Hangul jamo
+	{'ǅ', ID_DIS},          // LATIN CAPITAL LETTER D WITH SMALL LETTER Z WITH CARON, HasCompat
+	{'Ⅸ', ID_DIS},          // ROMAN NUMERAL NINE, HasCompat
+	{'ᛮ', ID_DIS},          // RUNIC ARLAUG SYMBOL, Nl
+	{'½', ID_DIS},          // VULGAR FRACTION ONE HALF
+	{'☃', ID_DIS},          // SNOWMAN, So
+	{'¿', ID_DIS},          // INVERTED QUESTION MARK, Po
+	{'\ufdd0', DISALLOWED}, // noncharacter
+	{'\U000e0001', DISALLOWED},
+	{'\U0010fffd', DISALLOWED}, // private use
+	{'\u0378', UNASSIGNED},
+}
+
+func TestDerivedProperty(t *testing.T) {
+	for _, test := range propertyTests {
+		if got := DerivedProperty(test.Rune); got != test.Property {
+			t.Errorf("DerivedProperty(%U) = %v; want %v", test.Rune, got, test.Property)
+		}
+	}
+}
+
+var enforceTests = []struct {
+	Profile *Profile
+	Name    string
+	Input   string
+	Output  string
+	Err     error
+}{
+	// from http://tools.ietf.org/html/rfc8265#section-3.5
+	{UsernameCaseMapped, "UsernameCaseMapped", "juliet@example.com", "juliet@example.com", nil},
+	{UsernameCaseMapped, "UsernameCaseMapped", "fussball", "fussball", nil},
+	{UsernameCaseMapped, "UsernameCaseMapped", "fußball", "fußball", nil},
+	{UsernameCaseMapped, "UsernameCaseMapped", "π", "π", nil},
+	{UsernameCaseMapped, "UsernameCaseMapped", "Σ", "σ", nil},
+	{UsernameCaseMapped, "UsernameCaseMapped", "σ", "σ", nil},
+	{UsernameCaseMapped, "UsernameCaseMapped", "ς", "ς", nil},
+	{UsernameCaseMapped, "UsernameCaseMapped", "foo bar", "", ErrDisallowed},
+	{UsernameCaseMapped, "UsernameCaseMapped", "", "", ErrEmpty},
+	{UsernameCaseMapped, "UsernameCaseMapped", "henryⅣ", "", ErrDisallowed},
+	{UsernameCaseMapped, "UsernameCaseMapped", "Ⅳ", "", ErrDisallowed},
+
+	{UsernameCaseMapped, "UsernameCaseMapped", "Juliet", "juliet", nil},
+	{UsernameCaseMapped, "UsernameCaseMapped", "Ｊｕｌｉｅｔ", "juliet", nil},
+	{UsernameCaseMapped, "UsernameCaseMapped", "Ångström", "ångström", nil},
+	{UsernameCaseMapped, "UsernameCaseMapped", "A\u030angstro\u0308m", "ångström", nil},
+	{UsernameCaseMapped, "UsernameCaseMapped", "\u05d0\u05d1", "\u05d0\u05d1", nil},
+	{UsernameCaseMapped, "UsernameCaseMapped", "\u05d0a", "", ErrBidi},
+	{UsernameCaseMapped, "UsernameCaseMapped", "a\u200cb", "", ErrDisallowed},
+	{UsernameCaseMapped, "UsernameCaseMapped", "a·l", "", ErrDisallowed},
+	{UsernameCaseMapped, "UsernameCaseMapped", "l·l", "l·l", nil},
+
+	{UsernameCasePreserved, "UsernameCasePreserved", "Juliet", "Juliet", nil},
+	{UsernameCasePreserved, "UsernameCasePreserved", "Ｊｕｌｉｅｔ", "Juliet", nil},
+	{UsernameCasePreserved, "UsernameCasePreserved", "Σ", "Σ", nil},
+
+	// from http://tools.ietf.org/html/rfc8265#section-4.3
+	{OpaqueString, "OpaqueString", "correct horse battery staple", "correct horse battery staple", nil},
+	{OpaqueString, "OpaqueString", "Correct Horse Battery Staple", "Correct Horse Battery Staple", nil},
+	{OpaqueString, "OpaqueString", "πßå", "πßå", nil},
+	{OpaqueString, "OpaqueString", "Jack of ♦s", "Jack of ♦s", nil},
+	{OpaqueString, "OpaqueString", "foo\u1680bar", "foo bar", nil},
+	{OpaqueString, "OpaqueString", "", "", ErrEmpty},
+	{OpaqueString, "OpaqueString", "my cat is a \u0009by", "", ErrDisallowed},
+
+	{OpaqueString, "OpaqueString", "ｐａｓｓ", "ｐａｓｓ", nil},
+	{OpaqueString, "OpaqueString", "Ⅳ", "Ⅳ", nil},
+
+	// from http://tools.ietf.org/html/rfc8266#section-4
+	{Nickname, "Nickname", "Foo", "Foo", nil},
+	{Nickname, "Nickname", "foo", "foo", nil},
+	{Nickname, "Nickname", "Foo Bar", "Foo Bar", nil},
+	{Nickname, "Nickname", "foo bar", "foo bar", nil},
+	{Nickname, "Nickname", "Σ", "Σ", nil},
+	{Nickname, "Nickname", "σ", "σ", nil},
+	{Nickname, "Nickname", "ς", "ς", nil},
+	{Nickname, "Nickname", "♚", "♚", nil},
+	{Nickname, "Nickname", "Richard Ⅳ", "Richard IV", nil},
+	{Nickname, "Nickname", "Å", "Å", nil},
+
+	{Nickname, "Nickname", "  Foo \u00a0\u3000 Bar  ", "Foo Bar", nil},
+	{Nickname, "Nickname", "   ", "", ErrEmpty},
+	{Nickname, "Nickname", "foo\tbar", "", ErrDisallowed},
+}
+
+func TestEnforce(t *testing.T) {
+	for _, test := range enforceTests {
+		got, err := test.Profile.Enforce(test.Input)
+		if got != test.Output || !errors.Is(err, test.Err) {
+			t.Errorf("%s.Enforce(%+q) = %+q, %v; want %+q, %v", test.Name, test.Input, got, err, test.Output, test.Err)
+		}
+		if err != nil {
+			continue
+		}
+		// Enforcement is idempotent.
+		if again, err := test.Profile.Enforce(got); again != got || err != nil {
+			t.Errorf("%s.Enforce(%+q) = %+q, %v; want %+q", test.Name, got, again, err, got)
+		}
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	if got, err := UsernameCaseMapped.Prepare("ＪULIET"); got != "JULIET" || err != nil {
+		t.Errorf("UsernameCaseMapped.Prepare = %+q, %v; want \"JULIET\", nil", got, err)
+	}
+	if _, err := UsernameCaseMapped.Prepare("foo bar"); !errors.Is(err, ErrDisallowed) {
+		t.Errorf("UsernameCaseMapped.Prepare(\"foo bar\") error = %v; want %v", err, ErrDisallowed)
+	}
+	if got, err := OpaqueString.Prepare("foo bar"); got != "foo bar" || err != nil {
+		t.Errorf("OpaqueString.Prepare(\"foo bar\") = %+q, %v; want \"foo bar\", nil", got, err)
+	}
+
+	_, err := Nickname.Prepare("ab\u0007")
+	var perr *Error
+	if !errors.As(err, &perr) || perr.Offset != 2 || perr.Rune != 0x7 || perr.Property != DISALLOWED {
+		t.Errorf("Nickname.Prepare(\"ab\\u0007\") error = %v; want U+0007 at offset 2", err)
+	}
+}
+
+var compareTests = []struct {
+	Profile *Profile
+	Name    string
+	A, B    string
+	Equal   bool
+}{
+	{UsernameCaseMapped, "UsernameCaseMapped", "Juliet", "juliet", true},
+	{UsernameCaseMapped, "UsernameCaseMapped", "Ｊuliet", "juliet", true},
+	{UsernameCaseMapped, "UsernameCaseMapped", "juliet", "romeo", false},
+	{UsernameCaseMapped, "UsernameCaseMapped", "foo bar", "foo bar", false},
+	{UsernameCasePreserved, "UsernameCasePreserved", "Juliet", "juliet", false},
+	{OpaqueString, "OpaqueString", "pass word", "pass\u00a0word", true},
+	{OpaqueString, "OpaqueString", "Password", "password", false},
+	{Nickname, "Nickname", "Foo Bar", "  foo   bar ", true},
+	{Nickname, "Nickname", "Richard Ⅳ", "richard iv", true},
+	{Nickname, "Nickname", "Σ", "σ", true},
+	{Nickname, "Nickname", "Foo", "Bar", false},
+}
+
+func TestCompare(t *testing.T) {
+	for _, test := range compareTests {
+		if got := test.Profile.Compare(test.A, test.B); got != test.Equal {
+			t.Errorf("%s.Compare(%+q, %+q) = %v; want %v", test.Name, test.A, test.B, got, test.Equal)
+		}
+	}
+}
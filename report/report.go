@@ -0,0 +1,63 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+// Package report defines the reports made when checking domain names. They
+// are shared by the ValidateDomain functions of the idna2003, idna2008 and
+// uts46 packages, which document what each field holds for them.
+//
+// This package is in beta and has not been extensively tested.
+package report
+
+import "strings"
+
+// A LabelReport describes a label of a checked domain name.
+type LabelReport struct {
+	Original   string  // the label as it appears in the input
+	Prepared   string  // the label in Unicode form, or "" if it could not be prepared or decoded
+	ACE        string  // the label in ACE, or "" if it is invalid
+	Violations []error // every problem found in the label
+}
+
+// A DomainReport describes a checked domain name.
+type DomainReport struct {
+	Labels      []LabelReport // the labels, not including the root label
+	TrailingDot bool          // whether the domain name ends with the dot of the root label
+	Violations  []error       // problems with the whole domain name, such as its length
+}
+
+// Valid returns true if no problems were found in the domain name.
+func (r *DomainReport) Valid() bool {
+	return r.Err() == nil
+}
+
+// Err returns the first problem found in the domain name, or nil if it is
+// valid.
+func (r *DomainReport) Err() error {
+	for _, l := range r.Labels {
+		if len(l.Violations) > 0 {
+			return l.Violations[0]
+		}
+	}
+	if len(r.Violations) > 0 {
+		return r.Violations[0]
+	}
+	return nil
+}
+
+// ACE returns the domain name converted to ASCII, or "" if it is invalid.
+func (r *DomainReport) ACE() string {
+	if !r.Valid() {
+		return ""
+	}
+	labels := make([]string, len(r.Labels))
+	for i, l := range r.Labels {
+		labels[i] = l.ACE
+	}
+	if r.TrailingDot {
+		labels = append(labels, "")
+	}
+	return strings.Join(labels, ".")
+}
@@ -0,0 +1,32 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package report
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDomainReport(t *testing.T) {
+	r := &DomainReport{
+		Labels:      []LabelReport{{ACE: "xn--bcher-kva"}, {ACE: "example"}},
+		TrailingDot: true,
+	}
+	if !r.Valid() || r.Err() != nil || r.ACE() != "xn--bcher-kva.example." {
+		t.Errorf("report = %v, %v, %+q; want valid xn--bcher-kva.example.", r.Valid(), r.Err(), r.ACE())
+	}
+
+	errLabel, errDomain := errors.New("label"), errors.New("domain")
+	r.Violations = []error{errDomain}
+	if r.Valid() || r.Err() != errDomain || r.ACE() != "" {
+		t.Errorf("report = %v, %v, %+q; want %v", r.Valid(), r.Err(), r.ACE(), errDomain)
+	}
+	r.Labels[1].Violations = []error{errLabel}
+	if r.Err() != errLabel {
+		t.Errorf("report error = %v; want the label error first", r.Err())
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package script
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by Policy.Check, wrapped in an *Error that gives the
+// offending code point; use errors.Is to find out why a label was rejected.
+var (
+	ErrNotAllowed  = errors.New("script: Script not allowed")
+	ErrMixedScript = errors.New("script: Label mixes scripts")
+)
+
+// An Error describes a code point of a label that is not allowed by a
+// Policy.
+type Error struct {
+	Offset int   // byte offset of Rune in the label
+	Rune   rune  // the offending rune
+	Err    error // the reason for the error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v: %U at offset %d", e.Err, e.Rune, e.Offset)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
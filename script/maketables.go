@@ -0,0 +1,173 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+//go:build ignore
+// +build ignore
+
+// Script_Extensions table generator.
+//
+// The data files are read from unicode.org, or from local files if the flags
+// name paths rather than URLs.
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	version    = flag.String("version", unicode.Version, "Unicode version of ScriptExtensions.txt and PropertyValueAliases.txt")
+	scxURL     = flag.String("scx", "", "URL or path of ScriptExtensions.txt; defaults to http://www.unicode.org/Public/<version>/ucd/ScriptExtensions.txt")
+	aliasesURL = flag.String("aliases", "", "URL or path of PropertyValueAliases.txt; defaults to http://www.unicode.org/Public/<version>/ucd/PropertyValueAliases.txt")
+)
+
+type extension struct {
+	lo, hi  rune
+	scripts []string
+}
+
+func main() {
+	flag.Parse()
+	if *scxURL == "" {
+		*scxURL = "http://www.unicode.org/Public/" + *version + "/ucd/ScriptExtensions.txt"
+	}
+	if *aliasesURL == "" {
+		*aliasesURL = "http://www.unicode.org/Public/" + *version + "/ucd/PropertyValueAliases.txt"
+	}
+
+	names := loadScriptNames()
+	extensions := loadExtensions(names)
+
+	fmt.Printf("// This file is automatically generated by running\n")
+	fmt.Printf("// maketables\n")
+	fmt.Printf("// DO NOT EDIT\n\n")
+	fmt.Printf("package script\n\n")
+	fmt.Printf("// UnicodeVersion is the Unicode version of the Script_Extensions data. The\n")
+	fmt.Printf("// Script property itself is taken from the unicode package.\n")
+	fmt.Printf("const UnicodeVersion = %q\n\n", *version)
+	fmt.Printf("// scriptExtensions lists the code points whose Script_Extensions property is\n")
+	fmt.Printf("// not just their Script property, sorted by code point.\n")
+	fmt.Printf("var scriptExtensions = []scriptExtension{\n")
+	for _, e := range extensions {
+		fmt.Printf("\t{0x%04X, 0x%04X, %#v},\n", e.lo, e.hi, e.scripts)
+	}
+	fmt.Printf("}\n")
+}
+
+// open returns the contents of a URL, or of a local file.
+func open(url string) io.ReadCloser {
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		f, err := os.Open(url)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return f
+	}
+	resp, err := http.Get(url)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != 200 {
+		log.Fatal("bad GET status for ", url, ": ", resp.Status)
+	}
+	return resp.Body
+}
+
+// readFields calls f with the fields of each line of a UCD file that is not
+// blank or a comment.
+func readFields(url string, f func(fields []string)) {
+	r := open(url)
+	defer r.Close()
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimPrefix(s.Text(), "\ufeff")
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		f(fields)
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadScriptNames returns the long names of the scripts, as used by the
+// unicode package, by their short names.
+func loadScriptNames() map[string]string {
+	names := map[string]string{}
+	readFields(*aliasesURL, func(fields []string) {
+		if len(fields) < 3 || fields[0] != "sc" {
+			return
+		}
+		for _, alias := range append([]string{fields[1]}, fields[3:]...) {
+			names[alias] = fields[2]
+		}
+	})
+	return names
+}
+
+func loadExtensions(names map[string]string) []extension {
+	var extensions []extension
+	readFields(*scxURL, func(fields []string) {
+		if len(fields) < 2 {
+			log.Fatalf("bad line in ScriptExtensions.txt: %q", fields)
+		}
+		var e extension
+		bounds := strings.SplitN(fields[0], "..", 2)
+		e.lo = parseRune(bounds[0])
+		e.hi = e.lo
+		if len(bounds) == 2 {
+			e.hi = parseRune(bounds[1])
+		}
+		for _, short := range strings.Fields(fields[1]) {
+			name, ok := names[short]
+			if !ok {
+				log.Fatalf("unknown script %q", short)
+			}
+			e.scripts = append(e.scripts, name)
+		}
+		sort.Strings(e.scripts)
+		extensions = append(extensions, e)
+	})
+	sort.Slice(extensions, func(i, j int) bool { return extensions[i].lo < extensions[j].lo })
+
+	// Merge adjacent ranges with the same scripts.
+	merged := extensions[:0]
+	for _, e := range extensions {
+		if n := len(merged); n > 0 && merged[n-1].hi+1 == e.lo &&
+			strings.Join(merged[n-1].scripts, " ") == strings.Join(e.scripts, " ") {
+			merged[n-1].hi = e.hi
+			continue
+		}
+		merged = append(merged, e)
+	}
+	return merged
+}
+
+func parseRune(s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
@@ -0,0 +1,164 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package script
+
+import "unicode/utf8"
+
+// A Policy tells which scripts a label may use, and which of them it may
+// mix. By default a label may use any script, but only one, along with the
+// Common and Inherited code points. A Policy is safe for concurrent use.
+type Policy struct {
+	allowed      map[string]bool // nil if every script is allowed
+	combinations []map[string]bool
+}
+
+// An Option configures a Policy.
+type Option func(*Policy)
+
+// Allow restricts the scripts a label may use to the given ones. It may be
+// given several times to allow more scripts. Allow does not let the scripts
+// be mixed: use Combination for that.
+func Allow(scripts ...string) Option {
+	return func(p *Policy) {
+		if p.allowed == nil {
+			p.allowed = map[string]bool{}
+		}
+		for _, s := range scripts {
+			p.allowed[s] = true
+		}
+	}
+}
+
+// Combination allows a label to mix the given scripts, such as "Han",
+// "Hiragana" and "Katakana" for Japanese. It may be given several times to
+// allow several combinations; a label may only use one of them.
+func Combination(scripts ...string) Option {
+	return func(p *Policy) {
+		set := map[string]bool{}
+		for _, s := range scripts {
+			set[s] = true
+		}
+		p.combinations = append(p.combinations, set)
+	}
+}
+
+// NewPolicy returns a Policy with the given options applied.
+func NewPolicy(opts ...Option) *Policy {
+	p := &Policy{}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+var (
+	// SingleScript allows labels of any single script.
+	SingleScript = NewPolicy()
+
+	// Japanese also allows labels mixing Han, Hiragana and Katakana.
+	Japanese = NewPolicy(Combination("Han", "Hiragana", "Katakana"))
+
+	// CJK also allows labels mixing Han with the scripts written with it:
+	// Hiragana and Katakana, Bopomofo, or Hangul.
+	CJK = NewPolicy(
+		Combination("Han", "Hiragana", "Katakana"),
+		Combination("Han", "Bopomofo"),
+		Combination("Han", "Hangul"),
+	)
+
+	// HighlyRestrictive allows the labels of the Highly Restrictive level of
+	// UTS #39 section 5.2: a single script, or Latin mixed with Han and
+	// Hiragana and Katakana, Bopomofo, or Hangul.
+	HighlyRestrictive = NewPolicy(
+		Combination("Latin", "Han", "Hiragana", "Katakana"),
+		Combination("Latin", "Han", "Bopomofo"),
+		Combination("Latin", "Han", "Hangul"),
+	)
+)
+
+// Check checks that label only uses the scripts allowed by the policy, and
+// returns an *Error for the first code point that is not allowed or that
+// mixes scripts the policy does not allow to be mixed.
+func (p *Policy) Check(label string) error {
+	if p.accepts(label) {
+		return nil
+	}
+	for i, r := range label {
+		if isCommon(Extensions(r)) {
+			continue
+		}
+		if p.allowed != nil && !sharesAny(p.allowed, Extensions(r)) {
+			return &Error{i, r, ErrNotAllowed}
+		}
+		if !p.accepts(label[:i+utf8.RuneLen(r)]) {
+			return &Error{i, r, ErrMixedScript}
+		}
+	}
+	// Not reached: the label ends with Common and Inherited code points
+	// after its longest accepted prefix, so it is accepted too.
+	return nil
+}
+
+// accepts returns true if label uses a single allowed script, or one of the
+// combinations of the policy.
+func (p *Policy) accepts(label string) bool {
+	if p.singleScript(label) {
+		return true
+	}
+	for _, set := range p.combinations {
+		if p.coveredBy(label, set) {
+			return true
+		}
+	}
+	return false
+}
+
+// singleScript returns true if the code points of label, other than those
+// of the Common and Inherited scripts, have an allowed script in common.
+func (p *Policy) singleScript(label string) bool {
+	var shared []string
+	first := true
+	for _, r := range label {
+		scx := Extensions(r)
+		if isCommon(scx) {
+			continue
+		}
+		var next []string
+		for _, s := range scx {
+			if (p.allowed == nil || p.allowed[s]) && (first || contains(shared, s)) {
+				next = append(next, s)
+			}
+		}
+		if len(next) == 0 {
+			return false
+		}
+		shared, first = next, false
+	}
+	return true
+}
+
+// coveredBy returns true if each code point of label, other than those of
+// the Common and Inherited scripts, is used by an allowed script of set.
+func (p *Policy) coveredBy(label string, set map[string]bool) bool {
+	for _, r := range label {
+		scx := Extensions(r)
+		if isCommon(scx) {
+			continue
+		}
+		ok := false
+		for _, s := range scx {
+			if set[s] && (p.allowed == nil || p.allowed[s]) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,187 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+// Package script finds the Unicode scripts used in labels and checks them
+// against a policy, to reject labels that mix scripts, such as Latin and
+// Cyrillic, in ways that are rarely legitimate and often used for spoofing.
+//
+// Scripts are given by their long Unicode names, such as "Latin" or "Han",
+// the names used by the unicode package. Code points of the Common and
+// Inherited scripts, such as digits, the hyphen and combining marks, may be
+// used with any script. Code points used by several scripts, according to
+// their Script_Extensions property, count as the script they share with the
+// rest of the label.
+//
+// This package is in beta and has not been extensively tested.
+package script
+
+import (
+	"sort"
+	"unicode"
+)
+
+type scriptExtension struct {
+	lo, hi  rune
+	scripts []string
+}
+
+// scriptRange is a range of code points with the same Script property.
+type scriptRange struct {
+	lo, hi rune
+	name   string
+}
+
+// scriptRanges holds the ranges of the script tables of the unicode package,
+// sorted by code point.
+var scriptRanges = loadScriptRanges()
+
+func loadScriptRanges() []scriptRange {
+	var ranges []scriptRange
+	add := func(lo, hi, stride rune, name string) {
+		if stride == 1 {
+			ranges = append(ranges, scriptRange{lo, hi, name})
+			return
+		}
+		for r := lo; r <= hi; r += stride {
+			ranges = append(ranges, scriptRange{r, r, name})
+		}
+	}
+	for name, table := range unicode.Scripts {
+		for _, r := range table.R16 {
+			add(rune(r.Lo), rune(r.Hi), rune(r.Stride), name)
+		}
+		for _, r := range table.R32 {
+			add(rune(r.Lo), rune(r.Hi), rune(r.Stride), name)
+		}
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+	return ranges
+}
+
+// Of returns the Script property of r, or "Unknown" for unassigned code
+// points.
+func Of(r rune) string {
+	i := sort.Search(len(scriptRanges), func(i int) bool { return scriptRanges[i].hi >= r })
+	if i < len(scriptRanges) && scriptRanges[i].lo <= r {
+		return scriptRanges[i].name
+	}
+	return "Unknown"
+}
+
+// Extensions returns the Script_Extensions property of r: the scripts r is
+// used with. It is the Script property of r for most code points. The
+// returned slice must not be modified.
+func Extensions(r rune) []string {
+	i := sort.Search(len(scriptExtensions), func(i int) bool { return scriptExtensions[i].hi >= r })
+	if i < len(scriptExtensions) && scriptExtensions[i].lo <= r {
+		return scriptExtensions[i].scripts
+	}
+	return []string{Of(r)}
+}
+
+// IsCommon returns true if r may be used with any script: if its
+// Script_Extensions property is Common or Inherited.
+func IsCommon(r rune) bool {
+	return isCommon(Extensions(r))
+}
+
+func isCommon(scx []string) bool {
+	return len(scx) == 1 && (scx[0] == "Common" || scx[0] == "Inherited")
+}
+
+// Scripts returns the scripts used by label, sorted. Common and Inherited
+// are never returned, so a label of digits and hyphens uses no script.
+//
+// A code point used by several scripts counts as the scripts it shares with
+// the code points used by a single script. Otherwise it counts as the
+// scripts it shares with the other such code points, or as all its scripts
+// if there are none. For example the Arabic comma U+060C, used by Arabic,
+// Syriac and other scripts, counts as Arabic in an Arabic label, and as
+// Arabic, Syriac and the others in a label of its own.
+func Scripts(label string) []string {
+	used := map[string]bool{}
+	var pending [][]string
+	for _, r := range label {
+		scx := Extensions(r)
+		switch {
+		case isCommon(scx):
+		case len(scx) == 1:
+			used[scx[0]] = true
+		default:
+			pending = append(pending, scx)
+		}
+	}
+
+	var unresolved [][]string
+	for _, scx := range pending {
+		if !sharesAny(used, scx) {
+			unresolved = append(unresolved, scx)
+		}
+	}
+	if len(unresolved) > 0 {
+		shared := intersection(unresolved)
+		if len(shared) > 0 {
+			for _, s := range shared {
+				used[s] = true
+			}
+		} else {
+			for _, scx := range unresolved {
+				if !sharesAny(used, scx) {
+					for _, s := range scx {
+						used[s] = true
+					}
+				}
+			}
+		}
+	}
+
+	if len(used) == 0 {
+		return nil
+	}
+	scripts := make([]string, 0, len(used))
+	for s := range used {
+		scripts = append(scripts, s)
+	}
+	sort.Strings(scripts)
+	return scripts
+}
+
+// sharesAny returns true if one of list is in set.
+func sharesAny(set map[string]bool, list []string) bool {
+	for _, s := range list {
+		if set[s] {
+			return true
+		}
+	}
+	return false
+}
+
+// intersection returns the scripts found in every list of lists.
+func intersection(lists [][]string) []string {
+	var result []string
+	for _, s := range lists[0] {
+		in := true
+		for _, list := range lists[1:] {
+			if !contains(list, s) {
+				in = false
+				break
+			}
+		}
+		if in {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func contains(list []string, s string) bool {
+	for _, t := range list {
+		if t == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package script
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"unicode"
+)
+
+func TestTables(t *testing.T) {
+	for i, e := range scriptExtensions {
+		if i > 0 && scriptExtensions[i-1].hi >= e.lo {
+			t.Fatalf("scriptExtensions not sorted at %U", e.lo)
+		}
+		for _, s := range e.scripts {
+			if unicode.Scripts[s] == nil {
+				t.Errorf("%U: unknown script %q", e.lo, s)
+			}
+		}
+	}
+}
+
+var ofTests = []struct {
+	in  rune
+	out string
+}{
+	{'a', "Latin"},
+	{'\u0430', "Cyrillic"},
+	{'1', "Common"},
+	{'\u0301', "Inherited"},
+	{'あ', "Hiragana"},
+	{'،', "Common"},
+	{'\U000E0080', "Unknown"},
+}
+
+func TestOf(t *testing.T) {
+	for _, test := range ofTests {
+		if out := Of(test.in); out != test.out {
+			t.Errorf("Of(%U) = %q; want %q", test.in, out, test.out)
+		}
+	}
+}
+
+func TestExtensions(t *testing.T) {
+	if scx := Extensions('a'); !reflect.DeepEqual(scx, []string{"Latin"}) {
+		t.Errorf("Extensions('a') = %q; want [Latin]", scx)
+	}
+	scx := Extensions('ー')
+	if !reflect.DeepEqual(scx, []string{"Hiragana", "Katakana"}) {
+		t.Errorf("Extensions(U+30FC) = %q; want [Hiragana Katakana]", scx)
+	}
+	if !IsCommon('-') || !IsCommon('\u0301') || IsCommon('a') || IsCommon('،') {
+		t.Errorf("IsCommon is wrong")
+	}
+}
+
+var scriptsTests = []struct {
+	in  string
+	out []string
+}{
+	{"", nil},
+	{"123-456", nil},
+	{"example", []string{"Latin"}},
+	{"café", []string{"Latin"}},
+	{"cafe\u0301", []string{"Latin"}},
+	{"p\u0430ypal", []string{"Cyrillic", "Latin"}},
+	{"日本語かな", []string{"Han", "Hiragana"}},
+	{"カー", []string{"Katakana"}},
+	{"ا،", []string{"Arabic"}},
+	{"ー、", []string{"Hiragana", "Katakana"}},
+}
+
+func TestScripts(t *testing.T) {
+	for _, test := range scriptsTests {
+		if out := Scripts(test.in); !reflect.DeepEqual(out, test.out) {
+			t.Errorf("Scripts(%+q) = %q; want %q", test.in, out, test.out)
+		}
+	}
+}
+
+var policyTests = []struct {
+	policy *Policy
+	in     string
+	err    error
+	offset int
+}{
+	{SingleScript, "example", nil, 0},
+	{SingleScript, "bücher-123", nil, 0},
+	{SingleScript, "россия", nil, 0},
+	{SingleScript, "p\u0430ypal", ErrMixedScript, 1},
+	{SingleScript, "\u0430pple", ErrMixedScript, 2},
+	{SingleScript, "ー、", nil, 0},
+	{SingleScript, "日本語かな", ErrMixedScript, 9},
+	{Japanese, "日本語かなカー", nil, 0},
+	{Japanese, "日本한", ErrMixedScript, 6},
+	{Japanese, "abc日本", ErrMixedScript, 3},
+	{CJK, "日本한", nil, 0},
+	{CJK, "か한", ErrMixedScript, 3},
+	{HighlyRestrictive, "abc日本か", nil, 0},
+	{HighlyRestrictive, "abc\u0430", ErrMixedScript, 3},
+	{NewPolicy(Allow("Latin")), "example", nil, 0},
+	{NewPolicy(Allow("Latin")), "ex\u0430mple", ErrNotAllowed, 2},
+	{NewPolicy(Allow("Latin", "Greek")), "αβ", nil, 0},
+	{NewPolicy(Allow("Latin", "Greek")), "aα", ErrMixedScript, 1},
+	{NewPolicy(Allow("Latin", "Greek"), Combination("Latin", "Greek")), "aα", nil, 0},
+	{NewPolicy(Allow("Arabic")), "ا،", nil, 0},
+	{NewPolicy(Allow("Syriac")), "ا،", ErrNotAllowed, 0},
+}
+
+func TestPolicy(t *testing.T) {
+	for _, test := range policyTests {
+		err := test.policy.Check(test.in)
+		if test.err == nil {
+			if err != nil {
+				t.Errorf("Check(%+q) = %v; want nil", test.in, err)
+			}
+			continue
+		}
+		var e *Error
+		if !errors.As(err, &e) || !errors.Is(err, test.err) || e.Offset != test.offset {
+			t.Errorf("Check(%+q) = %v; want %v at offset %d", test.in, err, test.err, test.offset)
+		}
+	}
+}
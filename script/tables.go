@@ -0,0 +1,135 @@
+// This file is automatically generated by running
+// maketables
+// DO NOT EDIT
+
+package script
+
+// UnicodeVersion is the Unicode version of the Script_Extensions data. The
+// Script property itself is taken from the unicode package.
+const UnicodeVersion = "15.0.0"
+
+// scriptExtensions lists the code points whose Script_Extensions property is
+// not just their Script property, sorted by code point.
+var scriptExtensions = []scriptExtension{
+	{0x0342, 0x0342, []string{"Greek"}},
+	{0x0345, 0x0345, []string{"Greek"}},
+	{0x0363, 0x036F, []string{"Latin"}},
+	{0x0483, 0x0483, []string{"Cyrillic", "Old_Permic"}},
+	{0x0484, 0x0484, []string{"Cyrillic", "Glagolitic"}},
+	{0x0485, 0x0486, []string{"Cyrillic", "Latin"}},
+	{0x0487, 0x0487, []string{"Cyrillic", "Glagolitic"}},
+	{0x060C, 0x060C, []string{"Arabic", "Hanifi_Rohingya", "Nko", "Syriac", "Thaana", "Yezidi"}},
+	{0x061B, 0x061B, []string{"Arabic", "Hanifi_Rohingya", "Nko", "Syriac", "Thaana", "Yezidi"}},
+	{0x061C, 0x061C, []string{"Arabic", "Syriac", "Thaana"}},
+	{0x061F, 0x061F, []string{"Adlam", "Arabic", "Hanifi_Rohingya", "Nko", "Syriac", "Thaana", "Yezidi"}},
+	{0x0640, 0x0640, []string{"Adlam", "Arabic", "Hanifi_Rohingya", "Mandaic", "Manichaean", "Old_Uyghur", "Psalter_Pahlavi", "Sogdian", "Syriac"}},
+	{0x064B, 0x0655, []string{"Arabic", "Syriac"}},
+	{0x0660, 0x0669, []string{"Arabic", "Thaana", "Yezidi"}},
+	{0x0670, 0x0670, []string{"Arabic", "Syriac"}},
+	{0x06D4, 0x06D4, []string{"Arabic", "Hanifi_Rohingya"}},
+	{0x0951, 0x0951, []string{"Bengali", "Devanagari", "Grantha", "Gujarati", "Gurmukhi", "Kannada", "Latin", "Malayalam", "Oriya", "Sharada", "Tamil", "Telugu", "Tirhuta"}},
+	{0x0952, 0x0952, []string{"Bengali", "Devanagari", "Grantha", "Gujarati", "Gurmukhi", "Kannada", "Latin", "Malayalam", "Oriya", "Tamil", "Telugu", "Tirhuta"}},
+	{0x0964, 0x0964, []string{"Bengali", "Devanagari", "Dogra", "Grantha", "Gujarati", "Gunjala_Gondi", "Gurmukhi", "Kannada", "Khudawadi", "Mahajani", "Malayalam", "Masaram_Gondi", "Nandinagari", "Oriya", "Sinhala", "Syloti_Nagri", "Takri", "Tamil", "Telugu", "Tirhuta"}},
+	{0x0965, 0x0965, []string{"Bengali", "Devanagari", "Dogra", "Grantha", "Gujarati", "Gunjala_Gondi", "Gurmukhi", "Kannada", "Khudawadi", "Limbu", "Mahajani", "Malayalam", "Masaram_Gondi", "Nandinagari", "Oriya", "Sinhala", "Syloti_Nagri", "Takri", "Tamil", "Telugu", "Tirhuta"}},
+	{0x0966, 0x096F, []string{"Devanagari", "Dogra", "Kaithi", "Mahajani"}},
+	{0x09E6, 0x09EF, []string{"Bengali", "Chakma", "Syloti_Nagri"}},
+	{0x0A66, 0x0A6F, []string{"Gurmukhi", "Multani"}},
+	{0x0AE6, 0x0AEF, []string{"Gujarati", "Khojki"}},
+	{0x0BE6, 0x0BF3, []string{"Grantha", "Tamil"}},
+	{0x0CE6, 0x0CEF, []string{"Kannada", "Nandinagari"}},
+	{0x1040, 0x1049, []string{"Chakma", "Myanmar", "Tai_Le"}},
+	{0x10FB, 0x10FB, []string{"Georgian", "Latin"}},
+	{0x1735, 0x1736, []string{"Buhid", "Hanunoo", "Tagalog", "Tagbanwa"}},
+	{0x1802, 0x1803, []string{"Mongolian", "Phags_Pa"}},
+	{0x1805, 0x1805, []string{"Mongolian", "Phags_Pa"}},
+	{0x1CD0, 0x1CD0, []string{"Bengali", "Devanagari", "Grantha", "Kannada"}},
+	{0x1CD1, 0x1CD1, []string{"Devanagari"}},
+	{0x1CD2, 0x1CD2, []string{"Bengali", "Devanagari", "Grantha", "Kannada"}},
+	{0x1CD3, 0x1CD3, []string{"Devanagari", "Grantha"}},
+	{0x1CD4, 0x1CD4, []string{"Devanagari"}},
+	{0x1CD5, 0x1CD6, []string{"Bengali", "Devanagari"}},
+	{0x1CD7, 0x1CD7, []string{"Devanagari", "Sharada"}},
+	{0x1CD8, 0x1CD8, []string{"Bengali", "Devanagari"}},
+	{0x1CD9, 0x1CD9, []string{"Devanagari", "Sharada"}},
+	{0x1CDA, 0x1CDA, []string{"Devanagari", "Kannada", "Malayalam", "Oriya", "Tamil", "Telugu"}},
+	{0x1CDB, 0x1CDB, []string{"Devanagari"}},
+	{0x1CDC, 0x1CDD, []string{"Devanagari", "Sharada"}},
+	{0x1CDE, 0x1CDF, []string{"Devanagari"}},
+	{0x1CE0, 0x1CE0, []string{"Devanagari", "Sharada"}},
+	{0x1CE1, 0x1CE1, []string{"Bengali", "Devanagari"}},
+	{0x1CE2, 0x1CE8, []string{"Devanagari"}},
+	{0x1CE9, 0x1CE9, []string{"Devanagari", "Nandinagari"}},
+	{0x1CEA, 0x1CEA, []string{"Bengali", "Devanagari"}},
+	{0x1CEB, 0x1CEC, []string{"Devanagari"}},
+	{0x1CED, 0x1CED, []string{"Bengali", "Devanagari"}},
+	{0x1CEE, 0x1CF1, []string{"Devanagari"}},
+	{0x1CF2, 0x1CF2, []string{"Bengali", "Devanagari", "Grantha", "Kannada", "Nandinagari", "Oriya", "Telugu", "Tirhuta"}},
+	{0x1CF3, 0x1CF3, []string{"Devanagari", "Grantha"}},
+	{0x1CF4, 0x1CF4, []string{"Devanagari", "Grantha", "Kannada"}},
+	{0x1CF5, 0x1CF6, []string{"Bengali", "Devanagari"}},
+	{0x1CF7, 0x1CF7, []string{"Bengali"}},
+	{0x1CF8, 0x1CF9, []string{"Devanagari", "Grantha"}},
+	{0x1CFA, 0x1CFA, []string{"Nandinagari"}},
+	{0x1DC0, 0x1DC1, []string{"Greek"}},
+	{0x1DF8, 0x1DF8, []string{"Cyrillic", "Syriac"}},
+	{0x1DFA, 0x1DFA, []string{"Syriac"}},
+	{0x202F, 0x202F, []string{"Latin", "Mongolian"}},
+	{0x20F0, 0x20F0, []string{"Devanagari", "Grantha", "Latin"}},
+	{0x2E43, 0x2E43, []string{"Cyrillic", "Glagolitic"}},
+	{0x3001, 0x3002, []string{"Bopomofo", "Han", "Hangul", "Hiragana", "Katakana", "Yi"}},
+	{0x3003, 0x3003, []string{"Bopomofo", "Han", "Hangul", "Hiragana", "Katakana"}},
+	{0x3006, 0x3006, []string{"Han"}},
+	{0x3008, 0x3011, []string{"Bopomofo", "Han", "Hangul", "Hiragana", "Katakana", "Yi"}},
+	{0x3013, 0x3013, []string{"Bopomofo", "Han", "Hangul", "Hiragana", "Katakana"}},
+	{0x3014, 0x301B, []string{"Bopomofo", "Han", "Hangul", "Hiragana", "Katakana", "Yi"}},
+	{0x301C, 0x301F, []string{"Bopomofo", "Han", "Hangul", "Hiragana", "Katakana"}},
+	{0x302A, 0x302D, []string{"Bopomofo", "Han"}},
+	{0x3030, 0x3030, []string{"Bopomofo", "Han", "Hangul", "Hiragana", "Katakana"}},
+	{0x3031, 0x3035, []string{"Hiragana", "Katakana"}},
+	{0x3037, 0x3037, []string{"Bopomofo", "Han", "Hangul", "Hiragana", "Katakana"}},
+	{0x303C, 0x303D, []string{"Han", "Hiragana", "Katakana"}},
+	{0x303E, 0x303F, []string{"Han"}},
+	{0x3099, 0x309C, []string{"Hiragana", "Katakana"}},
+	{0x30A0, 0x30A0, []string{"Hiragana", "Katakana"}},
+	{0x30FB, 0x30FB, []string{"Bopomofo", "Han", "Hangul", "Hiragana", "Katakana", "Yi"}},
+	{0x30FC, 0x30FC, []string{"Hiragana", "Katakana"}},
+	{0x3190, 0x319F, []string{"Han"}},
+	{0x31C0, 0x31E3, []string{"Han"}},
+	{0x3220, 0x3247, []string{"Han"}},
+	{0x3280, 0x32B0, []string{"Han"}},
+	{0x32C0, 0x32CB, []string{"Han"}},
+	{0x32FF, 0x32FF, []string{"Han"}},
+	{0x3358, 0x3370, []string{"Han"}},
+	{0x337B, 0x337F, []string{"Han"}},
+	{0x33E0, 0x33FE, []string{"Han"}},
+	{0xA66F, 0xA66F, []string{"Cyrillic", "Glagolitic"}},
+	{0xA700, 0xA707, []string{"Han", "Latin"}},
+	{0xA830, 0xA832, []string{"Devanagari", "Dogra", "Gujarati", "Gurmukhi", "Kaithi", "Kannada", "Khojki", "Khudawadi", "Mahajani", "Malayalam", "Modi", "Nandinagari", "Takri", "Tirhuta"}},
+	{0xA833, 0xA835, []string{"Devanagari", "Dogra", "Gujarati", "Gurmukhi", "Kaithi", "Kannada", "Khojki", "Khudawadi", "Mahajani", "Modi", "Nandinagari", "Takri", "Tirhuta"}},
+	{0xA836, 0xA839, []string{"Devanagari", "Dogra", "Gujarati", "Gurmukhi", "Kaithi", "Khojki", "Khudawadi", "Mahajani", "Modi", "Takri", "Tirhuta"}},
+	{0xA8F1, 0xA8F1, []string{"Bengali", "Devanagari"}},
+	{0xA8F3, 0xA8F3, []string{"Devanagari", "Tamil"}},
+	{0xA92E, 0xA92E, []string{"Kayah_Li", "Latin", "Myanmar"}},
+	{0xA9CF, 0xA9CF, []string{"Buginese", "Javanese"}},
+	{0xFD3E, 0xFD3F, []string{"Arabic", "Nko"}},
+	{0xFDF2, 0xFDF2, []string{"Arabic", "Thaana"}},
+	{0xFDFD, 0xFDFD, []string{"Arabic", "Thaana"}},
+	{0xFE45, 0xFE46, []string{"Bopomofo", "Han", "Hangul", "Hiragana", "Katakana"}},
+	{0xFF61, 0xFF65, []string{"Bopomofo", "Han", "Hangul", "Hiragana", "Katakana", "Yi"}},
+	{0xFF70, 0xFF70, []string{"Hiragana", "Katakana"}},
+	{0xFF9E, 0xFF9F, []string{"Hiragana", "Katakana"}},
+	{0x10100, 0x10101, []string{"Cypriot", "Cypro_Minoan", "Linear_B"}},
+	{0x10102, 0x10102, []string{"Cypriot", "Linear_B"}},
+	{0x10107, 0x10133, []string{"Cypriot", "Linear_A", "Linear_B"}},
+	{0x10137, 0x1013F, []string{"Cypriot", "Linear_B"}},
+	{0x102E0, 0x102FB, []string{"Arabic", "Coptic"}},
+	{0x10AF2, 0x10AF2, []string{"Manichaean", "Old_Uyghur"}},
+	{0x11301, 0x11301, []string{"Grantha", "Tamil"}},
+	{0x11303, 0x11303, []string{"Grantha", "Tamil"}},
+	{0x1133B, 0x1133C, []string{"Grantha", "Tamil"}},
+	{0x11FD0, 0x11FD1, []string{"Grantha", "Tamil"}},
+	{0x11FD3, 0x11FD3, []string{"Grantha", "Tamil"}},
+	{0x1BCA0, 0x1BCA3, []string{"Duployan"}},
+	{0x1D360, 0x1D371, []string{"Han"}},
+	{0x1F250, 0x1F251, []string{"Han"}},
+}
@@ -0,0 +1,33 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package tld
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotAllowed is wrapped in an *Error for code points that are not in the
+// table of a TLD.
+var ErrNotAllowed = errors.New("Code point not allowed by TLD table")
+
+// An Error describes the first code point of a domain name that the table
+// of its TLD does not allow.
+type Error struct {
+	TLD    string // the TLD whose table was used
+	Label  int    // index of the label in the domain name
+	Offset int    // byte offset of Rune in the label
+	Pos    int    // rune position of Rune in the label, as reported by libidn
+	Rune   rune   // the offending rune
+	Err    error  // the reason for the error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("tld %s: label %d: %v: %U at offset %d", e.TLD, e.Label, e.Err, e.Rune, e.Offset)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
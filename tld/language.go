@@ -0,0 +1,254 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package tld
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// A Variant is a code point, or sequence of code points, that a registry
+// treats as equivalent to another one. Labels that only differ by variants
+// are usually blocked for, or reserved to, the registrant of the first.
+type Variant struct {
+	To   string // the variant code point or sequence
+	Type string // such as "preferred", "simp" or "blocked"; empty if not given
+}
+
+// A LanguageTable is a table published by a registry in the IANA IDN
+// repository for one language or script. Besides the code points it allows,
+// it may list sequences of code points that are only allowed together and
+// the variants of code points and sequences.
+type LanguageTable struct {
+	Table
+	Language  string               // the language tag, such as "de" or "zh-Hans", if known
+	Sequences []string             // sequences of code points, longest first
+	Variants  map[string][]Variant // the variants of each code point or sequence
+}
+
+// VariantsOf returns the variants of the code point or sequence s.
+func (t *LanguageTable) VariantsOf(s string) []Variant {
+	return t.Variants[s]
+}
+
+// CheckLabel checks that a Unicode label is made of the code points and
+// sequences allowed by the table, and returns an *Error for the first code
+// point that is not.
+func (t *LanguageTable) CheckLabel(label string) error {
+	pos := 0
+	for i := 0; i < len(label); {
+		n := t.sequenceAt(label[i:])
+		if n == 0 {
+			r, size := utf8.DecodeRuneInString(label[i:])
+			if !t.Contains(r) {
+				return &Error{t.Name, 0, i, pos, r, ErrNotAllowed}
+			}
+			n = size
+		}
+		pos += utf8.RuneCountInString(label[i : i+n])
+		i += n
+	}
+	return nil
+}
+
+// sequenceAt returns the length in bytes of the longest sequence of the table
+// that s starts with, or 0 if there is none.
+func (t *LanguageTable) sequenceAt(s string) int {
+	for _, seq := range t.Sequences {
+		if strings.HasPrefix(s, seq) {
+			return len(seq)
+		}
+	}
+	return 0
+}
+
+// add adds the code point or sequence s, and its variants, to the table.
+func (t *LanguageTable) add(s []rune, variants []Variant) {
+	if len(s) == 1 {
+		t.Ranges = append(t.Ranges, Range{s[0], s[0]})
+	} else {
+		t.Sequences = append(t.Sequences, string(s))
+	}
+	for _, v := range variants {
+		if v.To == string(s) {
+			// Some tables list a code point as its own preferred variant.
+			continue
+		}
+		if t.Variants == nil {
+			t.Variants = map[string][]Variant{}
+		}
+		t.Variants[string(s)] = append(t.Variants[string(s)], v)
+	}
+}
+
+// finish sorts the ranges and sequences of the table once it is read.
+func (t *LanguageTable) finish() {
+	t.sort()
+	sort.SliceStable(t.Sequences, func(i, j int) bool { return len(t.Sequences[i]) > len(t.Sequences[j]) })
+}
+
+// ParseLanguageTable reads a table in the text formats accepted by Parse,
+// keeping the variants and sequences of code points it lists.
+//
+// A line may list a sequence of code points separated by spaces, such as
+// "U+0061 U+0308", instead of a single code point or range. The variants of
+// a code point or sequence follow it, in one of two forms:
+//
+//	U+4E7E|U+4E81 U+4E82
+//	U+4E7E;U+4E7E;U+4E81 U+4E82
+//
+// The first form, used by many tables of the IANA repository, lists variants
+// without a type. The second is the format of RFC 3743, which lists the
+// preferred variants and then the other character variants; these are given
+// the types "preferred" and "variant". Variants in a column are separated by
+// spaces or commas. A "Language:" header sets the Language of the table.
+func ParseLanguageTable(r io.Reader) (*LanguageTable, error) {
+	t := &LanguageTable{}
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := s.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if key, value, ok := strings.Cut(line, ":"); ok && !strings.ContainsAny(key, "0123456789") {
+			value = strings.TrimSpace(value)
+			switch strings.ToLower(strings.TrimSpace(key)) {
+			case "name":
+				t.Name = strings.ToLower(value)
+			case "version":
+				t.Version = value
+			case "language":
+				t.Language = value
+			}
+			// Other headers, such as "Unicode:" or "Valid:", are ignored.
+			continue
+		}
+
+		if err := t.parseLine(line); err != nil {
+			return nil, fmt.Errorf("tld: line %d: %v", n, err)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	t.finish()
+	return t, nil
+}
+
+// parseLine parses a line listing a code point, range or sequence and its
+// variants.
+func (t *LanguageTable) parseLine(line string) error {
+	var columns []string
+	var types []string
+	switch {
+	case strings.Contains(line, ";"):
+		columns = strings.Split(line, ";")
+		types = []string{"preferred", "variant"}
+	case strings.Contains(line, "|"):
+		columns = strings.Split(line, "|")
+	default:
+		columns = []string{line}
+	}
+
+	var variants []Variant
+	for i, column := range columns[1:] {
+		typ := ""
+		if i < len(types) {
+			typ = types[i]
+		}
+		for _, f := range strings.FieldsFunc(column, isVariantSeparator) {
+			r, err := parseCodePoint(f)
+			if err != nil {
+				return err
+			}
+			variants = append(variants, Variant{string(r), typ})
+		}
+	}
+
+	fields := strings.Fields(columns[0])
+	switch {
+	case len(fields) == 0:
+		return fmt.Errorf("missing code point")
+	case len(fields) > 1:
+		seq, err := parseSequence(fields)
+		if err != nil {
+			return err
+		}
+		t.add(seq, variants)
+	default:
+		rg, err := parseRange(fields[0])
+		if err != nil {
+			return err
+		}
+		if rg.Lo != rg.Hi {
+			if len(variants) > 0 {
+				return fmt.Errorf("variants given for range %q", fields[0])
+			}
+			t.Ranges = append(t.Ranges, rg)
+			return nil
+		}
+		t.add([]rune{rg.Lo}, variants)
+	}
+	return nil
+}
+
+func isVariantSeparator(r rune) bool {
+	return r == ',' || r == ' ' || r == '\t'
+}
+
+// parseSequence parses a sequence of code points.
+func parseSequence(fields []string) ([]rune, error) {
+	seq := make([]rune, len(fields))
+	for i, f := range fields {
+		var err error
+		if seq[i], err = parseCodePoint(f); err != nil {
+			return nil, err
+		}
+	}
+	return seq, nil
+}
+
+// ParseLanguageTableFile reads a table from the named file. Files that start
+// with '<' are read as Label Generation Rulesets with ParseLGR, and others
+// with ParseLanguageTable. If the file does not name its TLD, the table is
+// named after the file up to its first '.' or '_', so that "no.tld" and
+// "de_de_1.0.txt" name the tables "no" and "de".
+func ParseLanguageTableFile(path string) (*LanguageTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var t *LanguageTable
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("<")) {
+		t, err = ParseLGR(bytes.NewReader(data))
+	} else {
+		t, err = ParseLanguageTable(bytes.NewReader(data))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if t.Name == "" {
+		name := path[strings.LastIndexAny(path, `/\`)+1:]
+		if i := strings.IndexAny(name, "._"); i > 0 {
+			name = name[:i]
+		}
+		t.Name = strings.ToLower(name)
+	}
+	return t, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package tld
+
+import (
+	"io"
+	"strings"
+
+	"github.com/DanielOaks/go-idn/lgr"
+)
+
+// ParseLGR reads the repertoire and variants of a Label Generation Ruleset
+// in the XML format of RFC 7940, as published in the IANA IDN repository.
+// The Name of the table is the first domain the ruleset applies to, and its
+// Language the first language.
+//
+// The context rules and actions of the ruleset are not evaluated, so the
+// table may accept labels that the ruleset does not. Use the lgr package to
+// evaluate them.
+func ParseLGR(r io.Reader) (*LanguageTable, error) {
+	l, err := lgr.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &LanguageTable{}
+	t.Version = l.Meta.Version
+	if len(l.Meta.Languages) > 0 {
+		t.Language = l.Meta.Languages[0]
+	}
+	if len(l.Meta.Domains) > 0 {
+		t.Name = strings.ToLower(strings.Trim(l.Meta.Domains[0], "."))
+	}
+
+	for _, c := range l.Chars {
+		var variants []Variant
+		for _, v := range c.Variants {
+			variants = append(variants, Variant{string(v.CP), v.Type})
+		}
+		t.add(c.CP, variants)
+	}
+	for _, rg := range l.Ranges {
+		t.Ranges = append(t.Ranges, Range{rg.First, rg.Last})
+	}
+
+	t.finish()
+	return t, nil
+}
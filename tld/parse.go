@@ -0,0 +1,104 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package tld
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Parse reads a table of allowed code points. Two text formats are accepted,
+// and may be mixed:
+//
+// The libidn format has "Name:" and "Version:" header lines followed by one
+// code point or range per line, such as "0x00E0" or "0x00E0-0x00E6".
+//
+// The IANA IDN repository format lists one code point or range per line,
+// such as "U+00E0" or "U+00E0-U+00E6". Lines that list variants, such as
+// "U+4E7E|U+4E81" or "U+4E7E;U+4E7E;U+4E81", add their first code point.
+//
+// Ranges may also be written as "00E0..00E6". Everything after a '#' is a
+// comment, and blank lines are ignored. The Name of the returned Table is
+// empty if the data has no "Name:" header.
+//
+// Parse ignores variants and sequences of code points; use
+// ParseLanguageTable to read them too.
+func Parse(r io.Reader) (*Table, error) {
+	lt, err := ParseLanguageTable(r)
+	if err != nil {
+		return nil, err
+	}
+	return &lt.Table, nil
+}
+
+// ParseFile reads a table from the named file with ParseLanguageTableFile,
+// which also accepts Label Generation Rulesets.
+func ParseFile(path string) (*Table, error) {
+	lt, err := ParseLanguageTableFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return &lt.Table, nil
+}
+
+// parseRange parses a code point or a range of code points.
+func parseRange(s string) (Range, error) {
+	lo, hi, ok := strings.Cut(s, "..")
+	if !ok {
+		lo, hi, ok = strings.Cut(s, "-")
+	}
+	if !ok {
+		hi = lo
+	}
+	var rg Range
+	var err error
+	if rg.Lo, err = parseCodePoint(lo); err != nil {
+		return rg, err
+	}
+	if rg.Hi, err = parseCodePoint(hi); err != nil {
+		return rg, err
+	}
+	if rg.Hi < rg.Lo {
+		return rg, fmt.Errorf("invalid range %q", s)
+	}
+	return rg, nil
+}
+
+// parseCodePoint parses a hexadecimal code point, with an optional "U+" or
+// "0x" prefix.
+func parseCodePoint(s string) (rune, error) {
+	s = strings.TrimSpace(s)
+	h := s
+	for _, prefix := range []string{"U+", "u+", "0x", "0X"} {
+		h = strings.TrimPrefix(h, prefix)
+	}
+	v, err := strconv.ParseUint(h, 16, 32)
+	if err != nil || v > 0x10FFFF {
+		return 0, fmt.Errorf("invalid code point %q", s)
+	}
+	return rune(v), nil
+}
+
+// sort sorts the ranges of the table and merges those that overlap or are
+// adjacent, so that Contains can use a binary search.
+func (t *Table) sort() {
+	sort.Slice(t.Ranges, func(i, j int) bool { return t.Ranges[i].Lo < t.Ranges[j].Lo })
+	merged := t.Ranges[:0]
+	for _, rg := range t.Ranges {
+		if n := len(merged); n > 0 && rg.Lo <= merged[n-1].Hi+1 {
+			if rg.Hi > merged[n-1].Hi {
+				merged[n-1].Hi = rg.Hi
+			}
+			continue
+		}
+		merged = append(merged, rg)
+	}
+	t.Ranges = merged
+}
@@ -0,0 +1,179 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+// Package tld checks internationalized domain names against the code points
+// that top-level domain registries allow in them.
+//
+// Each registry publishes the code points it accepts in labels registered
+// under its TLD. A Table holds such a list, and can be read from the text
+// formats used by libidn and by the IANA IDN repository. Tables for a few
+// TLDs are included and registered by default; others can be added with
+// Register. The ASCII letters, digits, hyphen and full stop are always
+// allowed, as in libidn.
+//
+// The language tables of the IANA IDN repository, in the older text formats
+// or as Label Generation Rulesets (RFC 7940), can be read as a LanguageTable,
+// which also holds the variants of the code points.
+//
+// This package is in beta and has not been extensively tested.
+package tld
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/DanielOaks/go-idn/idna2003"
+)
+
+// A Range is an inclusive range of code points.
+type Range struct {
+	Lo, Hi rune
+}
+
+// A Table lists the code points a TLD allows in its labels, besides the
+// ASCII letters, digits and hyphen.
+type Table struct {
+	Name    string  // the TLD, in lowercase and without dots, such as "no"
+	Version string  // the version of the table, if known
+	Ranges  []Range // sorted and non-overlapping
+}
+
+// Contains returns true if the table allows r. The ASCII letters, digits,
+// hyphen and full stop are always allowed.
+func (t *Table) Contains(r rune) bool {
+	if isLDH(r) || r == '.' {
+		return true
+	}
+	i := sort.Search(len(t.Ranges), func(i int) bool { return t.Ranges[i].Hi >= r })
+	return i < len(t.Ranges) && t.Ranges[i].Lo <= r
+}
+
+// CheckLabel checks that every code point of a Unicode label is allowed by
+// the table, and returns an *Error for the first that is not. The label
+// should already be prepared, for example with idna2003.ToUnicode, as upper
+// case letters are not in the tables.
+func (t *Table) CheckLabel(label string) error {
+	pos := 0
+	for i, r := range label {
+		if !t.Contains(r) {
+			return &Error{t.Name, 0, i, pos, r, ErrNotAllowed}
+		}
+		pos++
+	}
+	return nil
+}
+
+var (
+	mu     sync.RWMutex
+	tables = map[string]*Table{}
+)
+
+// Register makes t the table for the TLD named t.Name, replacing any table
+// registered for it before.
+func Register(t *Table) error {
+	if t.Name == "" {
+		return errors.New("tld: table has no name")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	tables[strings.ToLower(t.Name)] = t
+	return nil
+}
+
+// Lookup returns the table registered for tld, which may be given with or
+// without a leading dot and in ASCII compatible encoding.
+func Lookup(tld string) (*Table, bool) {
+	tld = strings.ToLower(strings.TrimPrefix(tld, "."))
+	if u, err := idna2003.ToUnicode(tld); err == nil {
+		tld = u
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	t, ok := tables[tld]
+	return t, ok
+}
+
+// Names returns the TLDs that have a registered table, sorted.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CheckLabel checks a Unicode label against the table registered for tld. A
+// label is always accepted if there is no table for tld.
+func CheckLabel(tld, label string) error {
+	t, ok := Lookup(tld)
+	if !ok {
+		return nil
+	}
+	return t.CheckLabel(label)
+}
+
+// Check prepares a domain name with idna2003.ToASCII and ToUnicode and checks
+// each label against the table registered for the last label of the domain
+// name. The domain name is accepted if there is no table for its TLD. Errors
+// from ToASCII and ToUnicode are returned as they are.
+func Check(domain string) error {
+	a, err := idna2003.ToASCII(domain)
+	if err != nil {
+		return err
+	}
+	u, err := idna2003.ToUnicode(a)
+	if err != nil {
+		return err
+	}
+	labels := strings.Split(strings.TrimSuffix(u, "."), ".")
+	t, ok := Lookup(labels[len(labels)-1])
+	if !ok {
+		return nil
+	}
+	for i, label := range labels {
+		if err := t.CheckLabel(label); err != nil {
+			err.(*Error).Label = i
+			return err
+		}
+	}
+	return nil
+}
+
+func isLDH(r rune) bool {
+	return r == '-' || ('0' <= r && r <= '9') || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+}
+
+//go:embed tables/*.tld
+var defaultTables embed.FS
+
+// The default tables are registered when the package is loaded.
+func init() {
+	files, err := defaultTables.ReadDir("tables")
+	if err != nil {
+		panic(err)
+	}
+	for _, f := range files {
+		data, err := defaultTables.Open("tables/" + f.Name())
+		if err != nil {
+			panic(err)
+		}
+		t, err := Parse(data)
+		data.Close()
+		if err != nil {
+			panic(fmt.Sprintf("tld: default table %s: %v", f.Name(), err))
+		}
+		if err := Register(t); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package tld
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDefaultTables(t *testing.T) {
+	for _, name := range []string{"dk", "fr", "is", "no"} {
+		if _, ok := Lookup(name); !ok {
+			t.Errorf("no default table for %q", name)
+		}
+	}
+	if _, ok := Lookup(".NO"); !ok {
+		t.Errorf("Lookup(%q) failed", ".NO")
+	}
+	if _, ok := Lookup("example"); ok {
+		t.Errorf("Lookup(%q) found a table", "example")
+	}
+}
+
+var checkTests = []struct {
+	domain string
+	err    *Error
+}{
+	{"example.no", nil},
+	{"blåbær.no", nil},
+	{"xn--blbr-roa2a.no", nil},
+	{"čáziv.no", nil},
+	{"ÆRE.NO", nil},
+	{"münchen.de", nil}, // no table
+	{"café.fr", nil},
+	{"þór.is", nil},
+	{"smørrebrød.dk", nil},
+	{"señor.dk", &Error{"dk", 0, 2, 2, 'ñ', ErrNotAllowed}},
+	{"www.ðð.no", &Error{"no", 1, 0, 0, 'ð', ErrNotAllowed}},
+	{"aåð.no", &Error{"no", 0, 3, 2, 'ð', ErrNotAllowed}},
+	{"þór.fr", &Error{"fr", 0, 0, 0, 'þ', ErrNotAllowed}},
+}
+
+func TestCheck(t *testing.T) {
+	for _, tt := range checkTests {
+		err := Check(tt.domain)
+		if tt.err == nil {
+			if err != nil {
+				t.Errorf("Check(%+q) = %v; want nil", tt.domain, err)
+			}
+			continue
+		}
+		var e *Error
+		if !errors.As(err, &e) || *e != *tt.err {
+			t.Errorf("Check(%+q) = %v; want %v", tt.domain, err, tt.err)
+		}
+		if !errors.Is(err, ErrNotAllowed) {
+			t.Errorf("Check(%+q) does not wrap ErrNotAllowed", tt.domain)
+		}
+	}
+}
+
+func TestCheckLabel(t *testing.T) {
+	if err := CheckLabel("no", "blåbær"); err != nil {
+		t.Errorf("CheckLabel: %v", err)
+	}
+	if err := CheckLabel("example", "☃"); err != nil {
+		t.Errorf("CheckLabel without a table: %v", err)
+	}
+	err := CheckLabel("is", "a☃")
+	var e *Error
+	if !errors.As(err, &e) || e.Rune != '☃' || e.Offset != 1 || e.Pos != 1 {
+		t.Errorf("CheckLabel = %v", err)
+	}
+}
+
+var parseTests = []struct {
+	name string
+	data string
+	want Table
+}{
+	{
+		"libidn",
+		"# comment\nName: XX\nVersion: 2.1\n\n0x00E0-0x00E1 # a\n0x00E2\n",
+		Table{"xx", "2.1", []Range{{0xE0, 0xE2}}},
+	},
+	{
+		"iana",
+		"Script: Latin\nVersion: 1\nU+00F8 # LATIN SMALL LETTER O WITH STROKE\nU+00E5\nU+00E6..U+00E7\n",
+		Table{"", "1", []Range{{0xE5, 0xE7}, {0xF8, 0xF8}}},
+	},
+	{
+		"variants",
+		"U+4E7E|U+4E81\nU+4E0A;U+4E0A;U+4E04\nU+4E01..U+4E03\n",
+		Table{"", "", []Range{{0x4E01, 0x4E03}, {0x4E0A, 0x4E0A}, {0x4E7E, 0x4E7E}}},
+	},
+	{
+		"ucd",
+		"00E0..00E6\n00E3\n",
+		Table{"", "", []Range{{0xE0, 0xE6}}},
+	},
+}
+
+func TestParse(t *testing.T) {
+	for _, tt := range parseTests {
+		got, err := Parse(strings.NewReader(tt.data))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(*got, tt.want) {
+			t.Errorf("%s: got %+v; want %+v", tt.name, *got, tt.want)
+		}
+	}
+
+	for _, data := range []string{"0xZZ\n", "U+110000\n", "0x00E6-0x00E0\n"} {
+		if _, err := Parse(strings.NewReader(data)); err == nil {
+			t.Errorf("Parse(%q) succeeded", data)
+		}
+	}
+}
+
+func TestParseFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "xx_latin_1.0.txt")
+	if err := os.WriteFile(path, []byte("U+00E9\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	table, err := ParseFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if table.Name != "xx" {
+		t.Errorf("Name = %q; want %q", table.Name, "xx")
+	}
+	if err := Register(table); err != nil {
+		t.Fatal(err)
+	}
+	if err := Check("café.xx"); err != nil {
+		t.Errorf("Check: %v", err)
+	}
+	if err := Check("naïve.xx"); !errors.Is(err, ErrNotAllowed) {
+		t.Errorf("Check = %v; want ErrNotAllowed", err)
+	}
+}
+
+const jetTable = `# A table in the format of RFC 3743
+Language: zh
+Version: 1
+
+U+4E07;U+4E07;U+842C
+U+842C;U+4E07;U+842C
+U+4E0A|U+4E04, U+4E05
+U+0061 U+0308|U+00E4
+`
+
+func TestParseLanguageTable(t *testing.T) {
+	table, err := ParseLanguageTable(strings.NewReader(jetTable))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if table.Language != "zh" || table.Version != "1" {
+		t.Errorf("Language, Version = %q, %q", table.Language, table.Version)
+	}
+	wantRanges := []Range{{0x4E07, 0x4E07}, {0x4E0A, 0x4E0A}, {0x842C, 0x842C}}
+	if !reflect.DeepEqual(table.Ranges, wantRanges) {
+		t.Errorf("Ranges = %v; want %v", table.Ranges, wantRanges)
+	}
+	if want := []string{"a\u0308"}; !reflect.DeepEqual(table.Sequences, want) {
+		t.Errorf("Sequences = %+q; want %+q", table.Sequences, want)
+	}
+
+	variantTests := []struct {
+		s    string
+		want []Variant
+	}{
+		{"万", []Variant{{"萬", "variant"}}},
+		{"萬", []Variant{{"万", "preferred"}}},
+		{"上", []Variant{{"丄", ""}, {"丅", ""}}},
+		{"a\u0308", []Variant{{"ä", ""}}},
+		{"丄", nil},
+	}
+	for _, tt := range variantTests {
+		if got := table.VariantsOf(tt.s); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("VariantsOf(%+q) = %+q; want %+q", tt.s, got, tt.want)
+		}
+	}
+
+	for _, tt := range []struct {
+		label string
+		err   *Error
+	}{
+		{"万萬x", nil},
+		{"a\u0308上", nil},
+		{"万\u0308", &Error{"", 0, 3, 1, 0x308, ErrNotAllowed}},
+		{"b上丄", &Error{"", 0, 4, 2, 0x4E04, ErrNotAllowed}},
+	} {
+		err := table.CheckLabel(tt.label)
+		if tt.err == nil {
+			if err != nil {
+				t.Errorf("CheckLabel(%+q) = %v", tt.label, err)
+			}
+			continue
+		}
+		if e, ok := err.(*Error); !ok || *e != *tt.err {
+			t.Errorf("CheckLabel(%+q) = %v; want %v", tt.label, err, tt.err)
+		}
+	}
+
+	for _, data := range []string{"U+4E00-U+4E05|U+4E06\n", "U+4E00|U+ZZZZ\n", "U+0061 0xZZ\n"} {
+		if _, err := ParseLanguageTable(strings.NewReader(data)); err == nil {
+			t.Errorf("ParseLanguageTable(%q) succeeded", data)
+		}
+	}
+}
+
+const lgrTable = `<?xml version="1.0" encoding="utf-8"?>
+<lgr xmlns="urn:ietf:params:xml:ns:lgr-1.0">
+  <meta>
+    <version>3</version>
+    <language>und-Hani</language>
+    <scope type="domain">.XX</scope>
+  </meta>
+  <data>
+    <char cp="4E07"><var cp="842C" type="simp"/></char>
+    <char cp="842C"><var cp="4E07" type="trad"/></char>
+    <char cp="0061 0308" />
+    <range first-cp="0061" last-cp="007A" />
+  </data>
+  <rules />
+</lgr>
+`
+
+func TestParseLGR(t *testing.T) {
+	table, err := ParseLGR(strings.NewReader(lgrTable))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if table.Name != "xx" || table.Version != "3" || table.Language != "und-Hani" {
+		t.Errorf("Name, Version, Language = %q, %q, %q", table.Name, table.Version, table.Language)
+	}
+	wantRanges := []Range{{0x61, 0x7A}, {0x4E07, 0x4E07}, {0x842C, 0x842C}}
+	if !reflect.DeepEqual(table.Ranges, wantRanges) {
+		t.Errorf("Ranges = %v; want %v", table.Ranges, wantRanges)
+	}
+	if want := []Variant{{"萬", "simp"}}; !reflect.DeepEqual(table.VariantsOf("万"), want) {
+		t.Errorf("VariantsOf(%+q) = %+q; want %+q", "万", table.VariantsOf("万"), want)
+	}
+	if err := table.CheckLabel("a\u0308萬"); err != nil {
+		t.Errorf("CheckLabel: %v", err)
+	}
+
+	for _, data := range []string{"<lgr><data><char cp=\"ZZ\"/></data></lgr>", "<lgr><data><char cp=\"61\"><var cp=\"\"/></char></data></lgr>", "<lgr>"} {
+		if _, err := ParseLGR(strings.NewReader(data)); err == nil {
+			t.Errorf("ParseLGR(%q) succeeded", data)
+		}
+	}
+}
+
+func TestParseLanguageTableFile(t *testing.T) {
+	dir := t.TempDir()
+	for _, f := range []struct{ name, data string }{
+		{"zh_hani_1.0.txt", jetTable},
+		{"yy.xml", lgrTable},
+	} {
+		if err := os.WriteFile(filepath.Join(dir, f.name), []byte(f.data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	table, err := ParseLanguageTableFile(filepath.Join(dir, "zh_hani_1.0.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if table.Name != "zh" || len(table.Variants) != 4 {
+		t.Errorf("text table: Name = %q, %d variants", table.Name, len(table.Variants))
+	}
+
+	// The scope of the ruleset names the table, not the file.
+	table, err = ParseLanguageTableFile(filepath.Join(dir, "yy.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if table.Name != "xx" || len(table.Variants) != 2 {
+		t.Errorf("LGR: Name = %q, %d variants", table.Name, len(table.Variants))
+	}
+
+	if _, err := ParseLanguageTableFile(filepath.Join(dir, "missing.txt")); err == nil {
+		t.Errorf("ParseLanguageTableFile of a missing file succeeded")
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package uts46
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by ToASCII and ToUnicode, and reported by ValidateDomain.
+// Except for ErrDomainLength they are wrapped in a *LabelError; use
+// errors.Is to find out why a domain name was rejected. Punycode failures
+// wrap the errors of the punycode package instead.
+var (
+	ErrDisallowed      = errors.New("Contains disallowed code point")
+	ErrDeviation       = errors.New("Contains deviation code point")
+	ErrNotNFC          = errors.New("Label is not in Normalization Form C")
+	ErrReservedHyphens = errors.New("Contains hyphens in the third and fourth positions")
+	ErrHyphen          = errors.New("Contains hyphen at either end of the string")
+	ErrACEPrefix       = errors.New("Label starts with ACE prefix")
+	ErrCombiningMark   = errors.New("Label begins with a combining mark")
+	ErrFullStop        = errors.New("Label contains a full stop")
+	ErrContext         = errors.New("Contextual rule not satisfied")
+	ErrBidi            = errors.New("Label does not satisfy the Bidi rule")
+	ErrNonASCIIALabel  = errors.New("A-label contains non-ASCII code points")
+	ErrASCIILabel      = errors.New("A-label decodes to an ASCII label")
+	ErrLabelLength     = errors.New("Label empty or too long")
+	ErrDomainLength    = errors.New("Domain name too long")
+)
+
+// A LabelError describes a label of a domain name that was rejected. The
+// Offset of a code point disallowed by the Map step is in the input the
+// label was mapped from; other offsets are in the label after mapping and
+// normalization.
+type LabelError struct {
+	Label  int   // index of the label in the domain name
+	Offset int   // byte offset of Rune in the label, or -1
+	Rune   rune  // the offending rune, if Offset is not -1
+	Err    error // the reason for the error
+}
+
+func (e *LabelError) Error() string {
+	if e.Offset < 0 {
+		return fmt.Sprintf("uts46: label %d: %v", e.Label, e.Err)
+	}
+	return fmt.Sprintf("uts46: label %d: %v: %U at offset %d", e.Label, e.Err, e.Rune, e.Offset)
+}
+
+func (e *LabelError) Unwrap() error { return e.Err }
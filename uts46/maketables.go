@@ -0,0 +1,172 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+//go:build ignore
+// +build ignore
+
+// UTS #46 IDNA Mapping Table generator.
+//
+// Since Unicode 16.0 the mapping table no longer carries the
+// disallowed_STD3_valid and disallowed_STD3_mapped statuses; they are
+// rederived here from the ASCII code points a valid or mapped entry produces,
+// so that UseSTD3ASCIIRules behaves the same with old and new tables.
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var tableURL = flag.String("url", "", "full URL for IdnaMappingTable.txt; defaults to http://www.unicode.org/Public/idna/<unicode.Version>/IdnaMappingTable.txt")
+
+type entry struct {
+	lo, hi  rune
+	status  string
+	mapping string
+}
+
+var statusNames = map[string]string{
+	"valid":                  "valid",
+	"ignored":                "ignored",
+	"mapped":                 "mapped",
+	"deviation":              "deviation",
+	"disallowed":             "disallowed",
+	"disallowed_STD3_valid":  "disallowedSTD3Valid",
+	"disallowed_STD3_mapped": "disallowedSTD3Mapped",
+}
+
+func main() {
+	flag.Parse()
+	if *tableURL == "" {
+		*tableURL = "http://www.unicode.org/Public/idna/" + unicode.Version + "/IdnaMappingTable.txt"
+	}
+
+	entries := loadTable()
+
+	fmt.Printf("// This file is automatically generated by running\n")
+	fmt.Printf("// maketables\n")
+	fmt.Printf("// DO NOT EDIT\n\n")
+	fmt.Printf("package uts46\n\n")
+	fmt.Printf("// UnicodeVersion is the Unicode version the mapping table was generated from.\n")
+	fmt.Printf("const UnicodeVersion = %q\n\n", unicode.Version)
+	fmt.Printf("// mappingTable is the IDNA Mapping Table from UTS #46 section 5, sorted\n")
+	fmt.Printf("// by code point.\n")
+	fmt.Printf("var mappingTable = []mappingEntry{\n")
+	for _, e := range entries {
+		fmt.Printf("\t{0x%04X, 0x%04X, %s, %+q},\n", e.lo, e.hi, statusNames[e.status], e.mapping)
+	}
+	fmt.Printf("}\n")
+}
+
+func loadTable() []entry {
+	resp, err := http.Get(*tableURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != 200 {
+		log.Fatal("bad GET status for IdnaMappingTable.txt", resp.Status)
+	}
+	defer resp.Body.Close()
+
+	var entries []entry
+	input := bufio.NewReader(resp.Body)
+	for {
+		line, err := input.ReadString('\n')
+		if err != nil && err != io.EOF {
+			log.Fatal(err)
+		}
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) >= 2 {
+			e := parseEntry(fields)
+			if e.status == "valid" && e.lo < 0x80 {
+				// Split ASCII ranges so each code point can be
+				// checked against STD3.
+				for r := e.lo; r <= e.hi; r++ {
+					entries = add(entries, entry{r, r, std3Status(r), ""})
+				}
+				continue
+			}
+			entries = add(entries, e)
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	return entries
+}
+
+func parseEntry(fields []string) entry {
+	var e entry
+	bounds := strings.SplitN(strings.TrimSpace(fields[0]), "..", 2)
+	e.lo = parseRune(bounds[0])
+	e.hi = e.lo
+	if len(bounds) == 2 {
+		e.hi = parseRune(bounds[1])
+	}
+	e.status = strings.TrimSpace(fields[1])
+	if _, ok := statusNames[e.status]; !ok {
+		log.Fatalf("unknown status %q", e.status)
+	}
+	if len(fields) >= 3 {
+		for _, cp := range strings.Fields(fields[2]) {
+			e.mapping += string(parseRune(cp))
+		}
+	}
+
+	// Rederive the STD3 status dropped from the table in Unicode 16.0.
+	if e.status == "mapped" {
+		for _, r := range e.mapping {
+			if r < 0x80 && !isLDH(r) && r != '.' {
+				e.status = "disallowed_STD3_mapped"
+			}
+		}
+	}
+	return e
+}
+
+// add appends e to entries, merging it with the previous entry if they are
+// adjacent and equivalent. Mapped entries are never merged.
+func add(entries []entry, e entry) []entry {
+	if n := len(entries); n > 0 && entries[n-1].hi+1 == e.lo &&
+		entries[n-1].status == e.status && entries[n-1].mapping == e.mapping &&
+		e.status != "mapped" && e.status != "deviation" && e.status != "disallowed_STD3_mapped" {
+		entries[n-1].hi = e.hi
+		return entries
+	}
+	return append(entries, e)
+}
+
+// std3Status returns the status of a valid ASCII code point, rederiving the
+// disallowed_STD3_valid status dropped from the table in Unicode 16.0.
+func std3Status(r rune) string {
+	if !isLDH(r) && r != '.' {
+		return "disallowed_STD3_valid"
+	}
+	return "valid"
+}
+
+func isLDH(r rune) bool {
+	return r == '-' || ('0' <= r && r <= '9') || ('a' <= r && r <= 'z')
+}
+
+func parseRune(s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
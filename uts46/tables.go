@@ -0,0 +1,8474 @@
+// This file is automatically generated by running
+// maketables
+// DO NOT EDIT
+
+package uts46
+
+// UnicodeVersion is the Unicode version the mapping table was generated from.
+const UnicodeVersion = "17.0.0"
+
+// mappingTable is the IDNA Mapping Table from UTS #46 section 5, sorted
+// by code point.
+var mappingTable = []mappingEntry{
+	{0x0000, 0x002C, disallowedSTD3Valid, ""},
+	{0x002D, 0x002E, valid, ""},
+	{0x002F, 0x002F, disallowedSTD3Valid, ""},
+	{0x0030, 0x0039, valid, ""},
+	{0x003A, 0x0040, disallowedSTD3Valid, ""},
+	{0x0041, 0x0041, mapped, "a"},
+	{0x0042, 0x0042, mapped, "b"},
+	{0x0043, 0x0043, mapped, "c"},
+	{0x0044, 0x0044, mapped, "d"},
+	{0x0045, 0x0045, mapped, "e"},
+	{0x0046, 0x0046, mapped, "f"},
+	{0x0047, 0x0047, mapped, "g"},
+	{0x0048, 0x0048, mapped, "h"},
+	{0x0049, 0x0049, mapped, "i"},
+	{0x004A, 0x004A, mapped, "j"},
+	{0x004B, 0x004B, mapped, "k"},
+	{0x004C, 0x004C, mapped, "l"},
+	{0x004D, 0x004D, mapped, "m"},
+	{0x004E, 0x004E, mapped, "n"},
+	{0x004F, 0x004F, mapped, "o"},
+	{0x0050, 0x0050, mapped, "p"},
+	{0x0051, 0x0051, mapped, "q"},
+	{0x0052, 0x0052, mapped, "r"},
+	{0x0053, 0x0053, mapped, "s"},
+	{0x0054, 0x0054, mapped, "t"},
+	{0x0055, 0x0055, mapped, "u"},
+	{0x0056, 0x0056, mapped, "v"},
+	{0x0057, 0x0057, mapped, "w"},
+	{0x0058, 0x0058, mapped, "x"},
+	{0x0059, 0x0059, mapped, "y"},
+	{0x005A, 0x005A, mapped, "z"},
+	{0x005B, 0x0060, disallowedSTD3Valid, ""},
+	{0x0061, 0x007A, valid, ""},
+	{0x007B, 0x007F, disallowedSTD3Valid, ""},
+	{0x0080, 0x009F, disallowed, ""},
+	{0x00A0, 0x00A0, disallowedSTD3Mapped, " "},
+	{0x00A1, 0x00A7, valid, ""},
+	{0x00A8, 0x00A8, disallowedSTD3Mapped, " \u0308"},
+	{0x00A9, 0x00A9, valid, ""},
+	{0x00AA, 0x00AA, mapped, "a"},
+	{0x00AB, 0x00AC, valid, ""},
+	{0x00AD, 0x00AD, ignored, ""},
+	{0x00AE, 0x00AE, valid, ""},
+	{0x00AF, 0x00AF, disallowedSTD3Mapped, " \u0304"},
+	{0x00B0, 0x00B1, valid, ""},
+	{0x00B2, 0x00B2, mapped, "2"},
+	{0x00B3, 0x00B3, mapped, "3"},
+	{0x00B4, 0x00B4, disallowedSTD3Mapped, " \u0301"},
+	{0x00B5, 0x00B5, mapped, "\u03bc"},
+	{0x00B6, 0x00B7, valid, ""},
+	{0x00B8, 0x00B8, disallowedSTD3Mapped, " \u0327"},
+	{0x00B9, 0x00B9, mapped, "1"},
+	{0x00BA, 0x00BA, mapped, "o"},
+	{0x00BB, 0x00BB, valid, ""},
+	{0x00BC, 0x00BC, mapped, "1\u20444"},
+	{0x00BD, 0x00BD, mapped, "1\u20442"},
+	{0x00BE, 0x00BE, mapped, "3\u20444"},
+	{0x00BF, 0x00BF, valid, ""},
+	{0x00C0, 0x00C0, mapped, "\u00e0"},
+	{0x00C1, 0x00C1, mapped, "\u00e1"},
+	{0x00C2, 0x00C2, mapped, "\u00e2"},
+	{0x00C3, 0x00C3, mapped, "\u00e3"},
+	{0x00C4, 0x00C4, mapped, "\u00e4"},
+	{0x00C5, 0x00C5, mapped, "\u00e5"},
+	{0x00C6, 0x00C6, mapped, "\u00e6"},
+	{0x00C7, 0x00C7, mapped, "\u00e7"},
+	{0x00C8, 0x00C8, mapped, "\u00e8"},
+	{0x00C9, 0x00C9, mapped, "\u00e9"},
+	{0x00CA, 0x00CA, mapped, "\u00ea"},
+	{0x00CB, 0x00CB, mapped, "\u00eb"},
+	{0x00CC, 0x00CC, mapped, "\u00ec"},
+	{0x00CD, 0x00CD, mapped, "\u00ed"},
+	{0x00CE, 0x00CE, mapped, "\u00ee"},
+	{0x00CF, 0x00CF, mapped, "\u00ef"},
+	{0x00D0, 0x00D0, mapped, "\u00f0"},
+	{0x00D1, 0x00D1, mapped, "\u00f1"},
+	{0x00D2, 0x00D2, mapped, "\u00f2"},
+	{0x00D3, 0x00D3, mapped, "\u00f3"},
+	{0x00D4, 0x00D4, mapped, "\u00f4"},
+	{0x00D5, 0x00D5, mapped, "\u00f5"},
+	{0x00D6, 0x00D6, mapped, "\u00f6"},
+	{0x00D7, 0x00D7, valid, ""},
+	{0x00D8, 0x00D8, mapped, "\u00f8"},
+	{0x00D9, 0x00D9, mapped, "\u00f9"},
+	{0x00DA, 0x00DA, mapped, "\u00fa"},
+	{0x00DB, 0x00DB, mapped, "\u00fb"},
+	{0x00DC, 0x00DC, mapped, "\u00fc"},
+	{0x00DD, 0x00DD, mapped, "\u00fd"},
+	{0x00DE, 0x00DE, mapped, "\u00fe"},
+	{0x00DF, 0x00DF, deviation, "ss"},
+	{0x00E0, 0x00FF, valid, ""},
+	{0x0100, 0x0100, mapped, "\u0101"},
+	{0x0101, 0x0101, valid, ""},
+	{0x0102, 0x0102, mapped, "\u0103"},
+	{0x0103, 0x0103, valid, ""},
+	{0x0104, 0x0104, mapped, "\u0105"},
+	{0x0105, 0x0105, valid, ""},
+	{0x0106, 0x0106, mapped, "\u0107"},
+	{0x0107, 0x0107, valid, ""},
+	{0x0108, 0x0108, mapped, "\u0109"},
+	{0x0109, 0x0109, valid, ""},
+	{0x010A, 0x010A, mapped, "\u010b"},
+	{0x010B, 0x010B, valid, ""},
+	{0x010C, 0x010C, mapped, "\u010d"},
+	{0x010D, 0x010D, valid, ""},
+	{0x010E, 0x010E, mapped, "\u010f"},
+	{0x010F, 0x010F, valid, ""},
+	{0x0110, 0x0110, mapped, "\u0111"},
+	{0x0111, 0x0111, valid, ""},
+	{0x0112, 0x0112, mapped, "\u0113"},
+	{0x0113, 0x0113, valid, ""},
+	{0x0114, 0x0114, mapped, "\u0115"},
+	{0x0115, 0x0115, valid, ""},
+	{0x0116, 0x0116, mapped, "\u0117"},
+	{0x0117, 0x0117, valid, ""},
+	{0x0118, 0x0118, mapped, "\u0119"},
+	{0x0119, 0x0119, valid, ""},
+	{0x011A, 0x011A, mapped, "\u011b"},
+	{0x011B, 0x011B, valid, ""},
+	{0x011C, 0x011C, mapped, "\u011d"},
+	{0x011D, 0x011D, valid, ""},
+	{0x011E, 0x011E, mapped, "\u011f"},
+	{0x011F, 0x011F, valid, ""},
+	{0x0120, 0x0120, mapped, "\u0121"},
+	{0x0121, 0x0121, valid, ""},
+	{0x0122, 0x0122, mapped, "\u0123"},
+	{0x0123, 0x0123, valid, ""},
+	{0x0124, 0x0124, mapped, "\u0125"},
+	{0x0125, 0x0125, valid, ""},
+	{0x0126, 0x0126, mapped, "\u0127"},
+	{0x0127, 0x0127, valid, ""},
+	{0x0128, 0x0128, mapped, "\u0129"},
+	{0x0129, 0x0129, valid, ""},
+	{0x012A, 0x012A, mapped, "\u012b"},
+	{0x012B, 0x012B, valid, ""},
+	{0x012C, 0x012C, mapped, "\u012d"},
+	{0x012D, 0x012D, valid, ""},
+	{0x012E, 0x012E, mapped, "\u012f"},
+	{0x012F, 0x012F, valid, ""},
+	{0x0130, 0x0130, mapped, "i\u0307"},
+	{0x0131, 0x0131, valid, ""},
+	{0x0132, 0x0132, mapped, "ij"},
+	{0x0133, 0x0133, mapped, "ij"},
+	{0x0134, 0x0134, mapped, "\u0135"},
+	{0x0135, 0x0135, valid, ""},
+	{0x0136, 0x0136, mapped, "\u0137"},
+	{0x0137, 0x0138, valid, ""},
+	{0x0139, 0x0139, mapped, "\u013a"},
+	{0x013A, 0x013A, valid, ""},
+	{0x013B, 0x013B, mapped, "\u013c"},
+	{0x013C, 0x013C, valid, ""},
+	{0x013D, 0x013D, mapped, "\u013e"},
+	{0x013E, 0x013E, valid, ""},
+	{0x013F, 0x013F, mapped, "l\u00b7"},
+	{0x0140, 0x0140, mapped, "l\u00b7"},
+	{0x0141, 0x0141, mapped, "\u0142"},
+	{0x0142, 0x0142, valid, ""},
+	{0x0143, 0x0143, mapped, "\u0144"},
+	{0x0144, 0x0144, valid, ""},
+	{0x0145, 0x0145, mapped, "\u0146"},
+	{0x0146, 0x0146, valid, ""},
+	{0x0147, 0x0147, mapped, "\u0148"},
+	{0x0148, 0x0148, valid, ""},
+	{0x0149, 0x0149, mapped, "\u02bcn"},
+	{0x014A, 0x014A, mapped, "\u014b"},
+	{0x014B, 0x014B, valid, ""},
+	{0x014C, 0x014C, mapped, "\u014d"},
+	{0x014D, 0x014D, valid, ""},
+	{0x014E, 0x014E, mapped, "\u014f"},
+	{0x014F, 0x014F, valid, ""},
+	{0x0150, 0x0150, mapped, "\u0151"},
+	{0x0151, 0x0151, valid, ""},
+	{0x0152, 0x0152, mapped, "\u0153"},
+	{0x0153, 0x0153, valid, ""},
+	{0x0154, 0x0154, mapped, "\u0155"},
+	{0x0155, 0x0155, valid, ""},
+	{0x0156, 0x0156, mapped, "\u0157"},
+	{0x0157, 0x0157, valid, ""},
+	{0x0158, 0x0158, mapped, "\u0159"},
+	{0x0159, 0x0159, valid, ""},
+	{0x015A, 0x015A, mapped, "\u015b"},
+	{0x015B, 0x015B, valid, ""},
+	{0x015C, 0x015C, mapped, "\u015d"},
+	{0x015D, 0x015D, valid, ""},
+	{0x015E, 0x015E, mapped, "\u015f"},
+	{0x015F, 0x015F, valid, ""},
+	{0x0160, 0x0160, mapped, "\u0161"},
+	{0x0161, 0x0161, valid, ""},
+	{0x0162, 0x0162, mapped, "\u0163"},
+	{0x0163, 0x0163, valid, ""},
+	{0x0164, 0x0164, mapped, "\u0165"},
+	{0x0165, 0x0165, valid, ""},
+	{0x0166, 0x0166, mapped, "\u0167"},
+	{0x0167, 0x0167, valid, ""},
+	{0x0168, 0x0168, mapped, "\u0169"},
+	{0x0169, 0x0169, valid, ""},
+	{0x016A, 0x016A, mapped, "\u016b"},
+	{0x016B, 0x016B, valid, ""},
+	{0x016C, 0x016C, mapped, "\u016d"},
+	{0x016D, 0x016D, valid, ""},
+	{0x016E, 0x016E, mapped, "\u016f"},
+	{0x016F, 0x016F, valid, ""},
+	{0x0170, 0x0170, mapped, "\u0171"},
+	{0x0171, 0x0171, valid, ""},
+	{0x0172, 0x0172, mapped, "\u0173"},
+	{0x0173, 0x0173, valid, ""},
+	{0x0174, 0x0174, mapped, "\u0175"},
+	{0x0175, 0x0175, valid, ""},
+	{0x0176, 0x0176, mapped, "\u0177"},
+	{0x0177, 0x0177, valid, ""},
+	{0x0178, 0x0178, mapped, "\u00ff"},
+	{0x0179, 0x0179, mapped, "\u017a"},
+	{0x017A, 0x017A, valid, ""},
+	{0x017B, 0x017B, mapped, "\u017c"},
+	{0x017C, 0x017C, valid, ""},
+	{0x017D, 0x017D, mapped, "\u017e"},
+	{0x017E, 0x017E, valid, ""},
+	{0x017F, 0x017F, mapped, "s"},
+	{0x0180, 0x0180, valid, ""},
+	{0x0181, 0x0181, mapped, "\u0253"},
+	{0x0182, 0x0182, mapped, "\u0183"},
+	{0x0183, 0x0183, valid, ""},
+	{0x0184, 0x0184, mapped, "\u0185"},
+	{0x0185, 0x0185, valid, ""},
+	{0x0186, 0x0186, mapped, "\u0254"},
+	{0x0187, 0x0187, mapped, "\u0188"},
+	{0x0188, 0x0188, valid, ""},
+	{0x0189, 0x0189, mapped, "\u0256"},
+	{0x018A, 0x018A, mapped, "\u0257"},
+	{0x018B, 0x018B, mapped, "\u018c"},
+	{0x018C, 0x018D, valid, ""},
+	{0x018E, 0x018E, mapped, "\u01dd"},
+	{0x018F, 0x018F, mapped, "\u0259"},
+	{0x0190, 0x0190, mapped, "\u025b"},
+	{0x0191, 0x0191, mapped, "\u0192"},
+	{0x0192, 0x0192, valid, ""},
+	{0x0193, 0x0193, mapped, "\u0260"},
+	{0x0194, 0x0194, mapped, "\u0263"},
+	{0x0195, 0x0195, valid, ""},
+	{0x0196, 0x0196, mapped, "\u0269"},
+	{0x0197, 0x0197, mapped, "\u0268"},
+	{0x0198, 0x0198, mapped, "\u0199"},
+	{0x0199, 0x019B, valid, ""},
+	{0x019C, 0x019C, mapped, "\u026f"},
+	{0x019D, 0x019D, mapped, "\u0272"},
+	{0x019E, 0x019E, valid, ""},
+	{0x019F, 0x019F, mapped, "\u0275"},
+	{0x01A0, 0x01A0, mapped, "\u01a1"},
+	{0x01A1, 0x01A1, valid, ""},
+	{0x01A2, 0x01A2, mapped, "\u01a3"},
+	{0x01A3, 0x01A3, valid, ""},
+	{0x01A4, 0x01A4, mapped, "\u01a5"},
+	{0x01A5, 0x01A5, valid, ""},
+	{0x01A6, 0x01A6, mapped, "\u0280"},
+	{0x01A7, 0x01A7, mapped, "\u01a8"},
+	{0x01A8, 0x01A8, valid, ""},
+	{0x01A9, 0x01A9, mapped, "\u0283"},
+	{0x01AA, 0x01AB, valid, ""},
+	{0x01AC, 0x01AC, mapped, "\u01ad"},
+	{0x01AD, 0x01AD, valid, ""},
+	{0x01AE, 0x01AE, mapped, "\u0288"},
+	{0x01AF, 0x01AF, mapped, "\u01b0"},
+	{0x01B0, 0x01B0, valid, ""},
+	{0x01B1, 0x01B1, mapped, "\u028a"},
+	{0x01B2, 0x01B2, mapped, "\u028b"},
+	{0x01B3, 0x01B3, mapped, "\u01b4"},
+	{0x01B4, 0x01B4, valid, ""},
+	{0x01B5, 0x01B5, mapped, "\u01b6"},
+	{0x01B6, 0x01B6, valid, ""},
+	{0x01B7, 0x01B7, mapped, "\u0292"},
+	{0x01B8, 0x01B8, mapped, "\u01b9"},
+	{0x01B9, 0x01BB, valid, ""},
+	{0x01BC, 0x01BC, mapped, "\u01bd"},
+	{0x01BD, 0x01C3, valid, ""},
+	{0x01C4, 0x01C4, mapped, "d\u017e"},
+	{0x01C5, 0x01C5, mapped, "d\u017e"},
+	{0x01C6, 0x01C6, mapped, "d\u017e"},
+	{0x01C7, 0x01C7, mapped, "lj"},
+	{0x01C8, 0x01C8, mapped, "lj"},
+	{0x01C9, 0x01C9, mapped, "lj"},
+	{0x01CA, 0x01CA, mapped, "nj"},
+	{0x01CB, 0x01CB, mapped, "nj"},
+	{0x01CC, 0x01CC, mapped, "nj"},
+	{0x01CD, 0x01CD, mapped, "\u01ce"},
+	{0x01CE, 0x01CE, valid, ""},
+	{0x01CF, 0x01CF, mapped, "\u01d0"},
+	{0x01D0, 0x01D0, valid, ""},
+	{0x01D1, 0x01D1, mapped, "\u01d2"},
+	{0x01D2, 0x01D2, valid, ""},
+	{0x01D3, 0x01D3, mapped, "\u01d4"},
+	{0x01D4, 0x01D4, valid, ""},
+	{0x01D5, 0x01D5, mapped, "\u01d6"},
+	{0x01D6, 0x01D6, valid, ""},
+	{0x01D7, 0x01D7, mapped, "\u01d8"},
+	{0x01D8, 0x01D8, valid, ""},
+	{0x01D9, 0x01D9, mapped, "\u01da"},
+	{0x01DA, 0x01DA, valid, ""},
+	{0x01DB, 0x01DB, mapped, "\u01dc"},
+	{0x01DC, 0x01DD, valid, ""},
+	{0x01DE, 0x01DE, mapped, "\u01df"},
+	{0x01DF, 0x01DF, valid, ""},
+	{0x01E0, 0x01E0, mapped, "\u01e1"},
+	{0x01E1, 0x01E1, valid, ""},
+	{0x01E2, 0x01E2, mapped, "\u01e3"},
+	{0x01E3, 0x01E3, valid, ""},
+	{0x01E4, 0x01E4, mapped, "\u01e5"},
+	{0x01E5, 0x01E5, valid, ""},
+	{0x01E6, 0x01E6, mapped, "\u01e7"},
+	{0x01E7, 0x01E7, valid, ""},
+	{0x01E8, 0x01E8, mapped, "\u01e9"},
+	{0x01E9, 0x01E9, valid, ""},
+	{0x01EA, 0x01EA, mapped, "\u01eb"},
+	{0x01EB, 0x01EB, valid, ""},
+	{0x01EC, 0x01EC, mapped, "\u01ed"},
+	{0x01ED, 0x01ED, valid, ""},
+	{0x01EE, 0x01EE, mapped, "\u01ef"},
+	{0x01EF, 0x01F0, valid, ""},
+	{0x01F1, 0x01F1, mapped, "dz"},
+	{0x01F2, 0x01F2, mapped, "dz"},
+	{0x01F3, 0x01F3, mapped, "dz"},
+	{0x01F4, 0x01F4, mapped, "\u01f5"},
+	{0x01F5, 0x01F5, valid, ""},
+	{0x01F6, 0x01F6, mapped, "\u0195"},
+	{0x01F7, 0x01F7, mapped, "\u01bf"},
+	{0x01F8, 0x01F8, mapped, "\u01f9"},
+	{0x01F9, 0x01F9, valid, ""},
+	{0x01FA, 0x01FA, mapped, "\u01fb"},
+	{0x01FB, 0x01FB, valid, ""},
+	{0x01FC, 0x01FC, mapped, "\u01fd"},
+	{0x01FD, 0x01FD, valid, ""},
+	{0x01FE, 0x01FE, mapped, "\u01ff"},
+	{0x01FF, 0x01FF, valid, ""},
+	{0x0200, 0x0200, mapped, "\u0201"},
+	{0x0201, 0x0201, valid, ""},
+	{0x0202, 0x0202, mapped, "\u0203"},
+	{0x0203, 0x0203, valid, ""},
+	{0x0204, 0x0204, mapped, "\u0205"},
+	{0x0205, 0x0205, valid, ""},
+	{0x0206, 0x0206, mapped, "\u0207"},
+	{0x0207, 0x0207, valid, ""},
+	{0x0208, 0x0208, mapped, "\u0209"},
+	{0x0209, 0x0209, valid, ""},
+	{0x020A, 0x020A, mapped, "\u020b"},
+	{0x020B, 0x020B, valid, ""},
+	{0x020C, 0x020C, mapped, "\u020d"},
+	{0x020D, 0x020D, valid, ""},
+	{0x020E, 0x020E, mapped, "\u020f"},
+	{0x020F, 0x020F, valid, ""},
+	{0x0210, 0x0210, mapped, "\u0211"},
+	{0x0211, 0x0211, valid, ""},
+	{0x0212, 0x0212, mapped, "\u0213"},
+	{0x0213, 0x0213, valid, ""},
+	{0x0214, 0x0214, mapped, "\u0215"},
+	{0x0215, 0x0215, valid, ""},
+	{0x0216, 0x0216, mapped, "\u0217"},
+	{0x0217, 0x0217, valid, ""},
+	{0x0218, 0x0218, mapped, "\u0219"},
+	{0x0219, 0x0219, valid, ""},
+	{0x021A, 0x021A, mapped, "\u021b"},
+	{0x021B, 0x021B, valid, ""},
+	{0x021C, 0x021C, mapped, "\u021d"},
+	{0x021D, 0x021D, valid, ""},
+	{0x021E, 0x021E, mapped, "\u021f"},
+	{0x021F, 0x021F, valid, ""},
+	{0x0220, 0x0220, mapped, "\u019e"},
+	{0x0221, 0x0221, valid, ""},
+	{0x0222, 0x0222, mapped, "\u0223"},
+	{0x0223, 0x0223, valid, ""},
+	{0x0224, 0x0224, mapped, "\u0225"},
+	{0x0225, 0x0225, valid, ""},
+	{0x0226, 0x0226, mapped, "\u0227"},
+	{0x0227, 0x0227, valid, ""},
+	{0x0228, 0x0228, mapped, "\u0229"},
+	{0x0229, 0x0229, valid, ""},
+	{0x022A, 0x022A, mapped, "\u022b"},
+	{0x022B, 0x022B, valid, ""},
+	{0x022C, 0x022C, mapped, "\u022d"},
+	{0x022D, 0x022D, valid, ""},
+	{0x022E, 0x022E, mapped, "\u022f"},
+	{0x022F, 0x022F, valid, ""},
+	{0x0230, 0x0230, mapped, "\u0231"},
+	{0x0231, 0x0231, valid, ""},
+	{0x0232, 0x0232, mapped, "\u0233"},
+	{0x0233, 0x0239, valid, ""},
+	{0x023A, 0x023A, mapped, "\u2c65"},
+	{0x023B, 0x023B, mapped, "\u023c"},
+	{0x023C, 0x023C, valid, ""},
+	{0x023D, 0x023D, mapped, "\u019a"},
+	{0x023E, 0x023E, mapped, "\u2c66"},
+	{0x023F, 0x0240, valid, ""},
+	{0x0241, 0x0241, mapped, "\u0242"},
+	{0x0242, 0x0242, valid, ""},
+	{0x0243, 0x0243, mapped, "\u0180"},
+	{0x0244, 0x0244, mapped, "\u0289"},
+	{0x0245, 0x0245, mapped, "\u028c"},
+	{0x0246, 0x0246, mapped, "\u0247"},
+	{0x0247, 0x0247, valid, ""},
+	{0x0248, 0x0248, mapped, "\u0249"},
+	{0x0249, 0x0249, valid, ""},
+	{0x024A, 0x024A, mapped, "\u024b"},
+	{0x024B, 0x024B, valid, ""},
+	{0x024C, 0x024C, mapped, "\u024d"},
+	{0x024D, 0x024D, valid, ""},
+	{0x024E, 0x024E, mapped, "\u024f"},
+	{0x024F, 0x02AF, valid, ""},
+	{0x02B0, 0x02B0, mapped, "h"},
+	{0x02B1, 0x02B1, mapped, "\u0266"},
+	{0x02B2, 0x02B2, mapped, "j"},
+	{0x02B3, 0x02B3, mapped, "r"},
+	{0x02B4, 0x02B4, mapped, "\u0279"},
+	{0x02B5, 0x02B5, mapped, "\u027b"},
+	{0x02B6, 0x02B6, mapped, "\u0281"},
+	{0x02B7, 0x02B7, mapped, "w"},
+	{0x02B8, 0x02B8, mapped, "y"},
+	{0x02B9, 0x02D7, valid, ""},
+	{0x02D8, 0x02D8, disallowedSTD3Mapped, " \u0306"},
+	{0x02D9, 0x02D9, disallowedSTD3Mapped, " \u0307"},
+	{0x02DA, 0x02DA, disallowedSTD3Mapped, " \u030a"},
+	{0x02DB, 0x02DB, disallowedSTD3Mapped, " \u0328"},
+	{0x02DC, 0x02DC, disallowedSTD3Mapped, " \u0303"},
+	{0x02DD, 0x02DD, disallowedSTD3Mapped, " \u030b"},
+	{0x02DE, 0x02DF, valid, ""},
+	{0x02E0, 0x02E0, mapped, "\u0263"},
+	{0x02E1, 0x02E1, mapped, "l"},
+	{0x02E2, 0x02E2, mapped, "s"},
+	{0x02E3, 0x02E3, mapped, "x"},
+	{0x02E4, 0x02E4, mapped, "\u0295"},
+	{0x02E5, 0x033F, valid, ""},
+	{0x0340, 0x0340, mapped, "\u0300"},
+	{0x0341, 0x0341, mapped, "\u0301"},
+	{0x0342, 0x0342, valid, ""},
+	{0x0343, 0x0343, mapped, "\u0313"},
+	{0x0344, 0x0344, mapped, "\u0308\u0301"},
+	{0x0345, 0x0345, mapped, "\u03b9"},
+	{0x0346, 0x034E, valid, ""},
+	{0x034F, 0x034F, ignored, ""},
+	{0x0350, 0x036F, valid, ""},
+	{0x0370, 0x0370, mapped, "\u0371"},
+	{0x0371, 0x0371, valid, ""},
+	{0x0372, 0x0372, mapped, "\u0373"},
+	{0x0373, 0x0373, valid, ""},
+	{0x0374, 0x0374, mapped, "\u02b9"},
+	{0x0375, 0x0375, valid, ""},
+	{0x0376, 0x0376, mapped, "\u0377"},
+	{0x0377, 0x0377, valid, ""},
+	{0x0378, 0x0379, disallowed, ""},
+	{0x037A, 0x037A, disallowedSTD3Mapped, " \u03b9"},
+	{0x037B, 0x037D, valid, ""},
+	{0x037E, 0x037E, disallowedSTD3Mapped, ";"},
+	{0x037F, 0x037F, mapped, "\u03f3"},
+	{0x0380, 0x0383, disallowed, ""},
+	{0x0384, 0x0384, disallowedSTD3Mapped, " \u0301"},
+	{0x0385, 0x0385, disallowedSTD3Mapped, " \u0308\u0301"},
+	{0x0386, 0x0386, mapped, "\u03ac"},
+	{0x0387, 0x0387, mapped, "\u00b7"},
+	{0x0388, 0x0388, mapped, "\u03ad"},
+	{0x0389, 0x0389, mapped, "\u03ae"},
+	{0x038A, 0x038A, mapped, "\u03af"},
+	{0x038B, 0x038B, disallowed, ""},
+	{0x038C, 0x038C, mapped, "\u03cc"},
+	{0x038D, 0x038D, disallowed, ""},
+	{0x038E, 0x038E, mapped, "\u03cd"},
+	{0x038F, 0x038F, mapped, "\u03ce"},
+	{0x0390, 0x0390, valid, ""},
+	{0x0391, 0x0391, mapped, "\u03b1"},
+	{0x0392, 0x0392, mapped, "\u03b2"},
+	{0x0393, 0x0393, mapped, "\u03b3"},
+	{0x0394, 0x0394, mapped, "\u03b4"},
+	{0x0395, 0x0395, mapped, "\u03b5"},
+	{0x0396, 0x0396, mapped, "\u03b6"},
+	{0x0397, 0x0397, mapped, "\u03b7"},
+	{0x0398, 0x0398, mapped, "\u03b8"},
+	{0x0399, 0x0399, mapped, "\u03b9"},
+	{0x039A, 0x039A, mapped, "\u03ba"},
+	{0x039B, 0x039B, mapped, "\u03bb"},
+	{0x039C, 0x039C, mapped, "\u03bc"},
+	{0x039D, 0x039D, mapped, "\u03bd"},
+	{0x039E, 0x039E, mapped, "\u03be"},
+	{0x039F, 0x039F, mapped, "\u03bf"},
+	{0x03A0, 0x03A0, mapped, "\u03c0"},
+	{0x03A1, 0x03A1, mapped, "\u03c1"},
+	{0x03A2, 0x03A2, disallowed, ""},
+	{0x03A3, 0x03A3, mapped, "\u03c3"},
+	{0x03A4, 0x03A4, mapped, "\u03c4"},
+	{0x03A5, 0x03A5, mapped, "\u03c5"},
+	{0x03A6, 0x03A6, mapped, "\u03c6"},
+	{0x03A7, 0x03A7, mapped, "\u03c7"},
+	{0x03A8, 0x03A8, mapped, "\u03c8"},
+	{0x03A9, 0x03A9, mapped, "\u03c9"},
+	{0x03AA, 0x03AA, mapped, "\u03ca"},
+	{0x03AB, 0x03AB, mapped, "\u03cb"},
+	{0x03AC, 0x03C1, valid, ""},
+	{0x03C2, 0x03C2, deviation, "\u03c3"},
+	{0x03C3, 0x03CE, valid, ""},
+	{0x03CF, 0x03CF, mapped, "\u03d7"},
+	{0x03D0, 0x03D0, mapped, "\u03b2"},
+	{0x03D1, 0x03D1, mapped, "\u03b8"},
+	{0x03D2, 0x03D2, mapped, "\u03c5"},
+	{0x03D3, 0x03D3, mapped, "\u03cd"},
+	{0x03D4, 0x03D4, mapped, "\u03cb"},
+	{0x03D5, 0x03D5, mapped, "\u03c6"},
+	{0x03D6, 0x03D6, mapped, "\u03c0"},
+	{0x03D7, 0x03D7, valid, ""},
+	{0x03D8, 0x03D8, mapped, "\u03d9"},
+	{0x03D9, 0x03D9, valid, ""},
+	{0x03DA, 0x03DA, mapped, "\u03db"},
+	{0x03DB, 0x03DB, valid, ""},
+	{0x03DC, 0x03DC, mapped, "\u03dd"},
+	{0x03DD, 0x03DD, valid, ""},
+	{0x03DE, 0x03DE, mapped, "\u03df"},
+	{0x03DF, 0x03DF, valid, ""},
+	{0x03E0, 0x03E0, mapped, "\u03e1"},
+	{0x03E1, 0x03E1, valid, ""},
+	{0x03E2, 0x03E2, mapped, "\u03e3"},
+	{0x03E3, 0x03E3, valid, ""},
+	{0x03E4, 0x03E4, mapped, "\u03e5"},
+	{0x03E5, 0x03E5, valid, ""},
+	{0x03E6, 0x03E6, mapped, "\u03e7"},
+	{0x03E7, 0x03E7, valid, ""},
+	{0x03E8, 0x03E8, mapped, "\u03e9"},
+	{0x03E9, 0x03E9, valid, ""},
+	{0x03EA, 0x03EA, mapped, "\u03eb"},
+	{0x03EB, 0x03EB, valid, ""},
+	{0x03EC, 0x03EC, mapped, "\u03ed"},
+	{0x03ED, 0x03ED, valid, ""},
+	{0x03EE, 0x03EE, mapped, "\u03ef"},
+	{0x03EF, 0x03EF, valid, ""},
+	{0x03F0, 0x03F0, mapped, "\u03ba"},
+	{0x03F1, 0x03F1, mapped, "\u03c1"},
+	{0x03F2, 0x03F2, mapped, "\u03c3"},
+	{0x03F3, 0x03F3, valid, ""},
+	{0x03F4, 0x03F4, mapped, "\u03b8"},
+	{0x03F5, 0x03F5, mapped, "\u03b5"},
+	{0x03F6, 0x03F6, valid, ""},
+	{0x03F7, 0x03F7, mapped, "\u03f8"},
+	{0x03F8, 0x03F8, valid, ""},
+	{0x03F9, 0x03F9, mapped, "\u03c3"},
+	{0x03FA, 0x03FA, mapped, "\u03fb"},
+	{0x03FB, 0x03FC, valid, ""},
+	{0x03FD, 0x03FD, mapped, "\u037b"},
+	{0x03FE, 0x03FE, mapped, "\u037c"},
+	{0x03FF, 0x03FF, mapped, "\u037d"},
+	{0x0400, 0x0400, mapped, "\u0450"},
+	{0x0401, 0x0401, mapped, "\u0451"},
+	{0x0402, 0x0402, mapped, "\u0452"},
+	{0x0403, 0x0403, mapped, "\u0453"},
+	{0x0404, 0x0404, mapped, "\u0454"},
+	{0x0405, 0x0405, mapped, "\u0455"},
+	{0x0406, 0x0406, mapped, "\u0456"},
+	{0x0407, 0x0407, mapped, "\u0457"},
+	{0x0408, 0x0408, mapped, "\u0458"},
+	{0x0409, 0x0409, mapped, "\u0459"},
+	{0x040A, 0x040A, mapped, "\u045a"},
+	{0x040B, 0x040B, mapped, "\u045b"},
+	{0x040C, 0x040C, mapped, "\u045c"},
+	{0x040D, 0x040D, mapped, "\u045d"},
+	{0x040E, 0x040E, mapped, "\u045e"},
+	{0x040F, 0x040F, mapped, "\u045f"},
+	{0x0410, 0x0410, mapped, "\u0430"},
+	{0x0411, 0x0411, mapped, "\u0431"},
+	{0x0412, 0x0412, mapped, "\u0432"},
+	{0x0413, 0x0413, mapped, "\u0433"},
+	{0x0414, 0x0414, mapped, "\u0434"},
+	{0x0415, 0x0415, mapped, "\u0435"},
+	{0x0416, 0x0416, mapped, "\u0436"},
+	{0x0417, 0x0417, mapped, "\u0437"},
+	{0x0418, 0x0418, mapped, "\u0438"},
+	{0x0419, 0x0419, mapped, "\u0439"},
+	{0x041A, 0x041A, mapped, "\u043a"},
+	{0x041B, 0x041B, mapped, "\u043b"},
+	{0x041C, 0x041C, mapped, "\u043c"},
+	{0x041D, 0x041D, mapped, "\u043d"},
+	{0x041E, 0x041E, mapped, "\u043e"},
+	{0x041F, 0x041F, mapped, "\u043f"},
+	{0x0420, 0x0420, mapped, "\u0440"},
+	{0x0421, 0x0421, mapped, "\u0441"},
+	{0x0422, 0x0422, mapped, "\u0442"},
+	{0x0423, 0x0423, mapped, "\u0443"},
+	{0x0424, 0x0424, mapped, "\u0444"},
+	{0x0425, 0x0425, mapped, "\u0445"},
+	{0x0426, 0x0426, mapped, "\u0446"},
+	{0x0427, 0x0427, mapped, "\u0447"},
+	{0x0428, 0x0428, mapped, "\u0448"},
+	{0x0429, 0x0429, mapped, "\u0449"},
+	{0x042A, 0x042A, mapped, "\u044a"},
+	{0x042B, 0x042B, mapped, "\u044b"},
+	{0x042C, 0x042C, mapped, "\u044c"},
+	{0x042D, 0x042D, mapped, "\u044d"},
+	{0x042E, 0x042E, mapped, "\u044e"},
+	{0x042F, 0x042F, mapped, "\u044f"},
+	{0x0430, 0x045F, valid, ""},
+	{0x0460, 0x0460, mapped, "\u0461"},
+	{0x0461, 0x0461, valid, ""},
+	{0x0462, 0x0462, mapped, "\u0463"},
+	{0x0463, 0x0463, valid, ""},
+	{0x0464, 0x0464, mapped, "\u0465"},
+	{0x0465, 0x0465, valid, ""},
+	{0x0466, 0x0466, mapped, "\u0467"},
+	{0x0467, 0x0467, valid, ""},
+	{0x0468, 0x0468, mapped, "\u0469"},
+	{0x0469, 0x0469, valid, ""},
+	{0x046A, 0x046A, mapped, "\u046b"},
+	{0x046B, 0x046B, valid, ""},
+	{0x046C, 0x046C, mapped, "\u046d"},
+	{0x046D, 0x046D, valid, ""},
+	{0x046E, 0x046E, mapped, "\u046f"},
+	{0x046F, 0x046F, valid, ""},
+	{0x0470, 0x0470, mapped, "\u0471"},
+	{0x0471, 0x0471, valid, ""},
+	{0x0472, 0x0472, mapped, "\u0473"},
+	{0x0473, 0x0473, valid, ""},
+	{0x0474, 0x0474, mapped, "\u0475"},
+	{0x0475, 0x0475, valid, ""},
+	{0x0476, 0x0476, mapped, "\u0477"},
+	{0x0477, 0x0477, valid, ""},
+	{0x0478, 0x0478, mapped, "\u0479"},
+	{0x0479, 0x0479, valid, ""},
+	{0x047A, 0x047A, mapped, "\u047b"},
+	{0x047B, 0x047B, valid, ""},
+	{0x047C, 0x047C, mapped, "\u047d"},
+	{0x047D, 0x047D, valid, ""},
+	{0x047E, 0x047E, mapped, "\u047f"},
+	{0x047F, 0x047F, valid, ""},
+	{0x0480, 0x0480, mapped, "\u0481"},
+	{0x0481, 0x0489, valid, ""},
+	{0x048A, 0x048A, mapped, "\u048b"},
+	{0x048B, 0x048B, valid, ""},
+	{0x048C, 0x048C, mapped, "\u048d"},
+	{0x048D, 0x048D, valid, ""},
+	{0x048E, 0x048E, mapped, "\u048f"},
+	{0x048F, 0x048F, valid, ""},
+	{0x0490, 0x0490, mapped, "\u0491"},
+	{0x0491, 0x0491, valid, ""},
+	{0x0492, 0x0492, mapped, "\u0493"},
+	{0x0493, 0x0493, valid, ""},
+	{0x0494, 0x0494, mapped, "\u0495"},
+	{0x0495, 0x0495, valid, ""},
+	{0x0496, 0x0496, mapped, "\u0497"},
+	{0x0497, 0x0497, valid, ""},
+	{0x0498, 0x0498, mapped, "\u0499"},
+	{0x0499, 0x0499, valid, ""},
+	{0x049A, 0x049A, mapped, "\u049b"},
+	{0x049B, 0x049B, valid, ""},
+	{0x049C, 0x049C, mapped, "\u049d"},
+	{0x049D, 0x049D, valid, ""},
+	{0x049E, 0x049E, mapped, "\u049f"},
+	{0x049F, 0x049F, valid, ""},
+	{0x04A0, 0x04A0, mapped, "\u04a1"},
+	{0x04A1, 0x04A1, valid, ""},
+	{0x04A2, 0x04A2, mapped, "\u04a3"},
+	{0x04A3, 0x04A3, valid, ""},
+	{0x04A4, 0x04A4, mapped, "\u04a5"},
+	{0x04A5, 0x04A5, valid, ""},
+	{0x04A6, 0x04A6, mapped, "\u04a7"},
+	{0x04A7, 0x04A7, valid, ""},
+	{0x04A8, 0x04A8, mapped, "\u04a9"},
+	{0x04A9, 0x04A9, valid, ""},
+	{0x04AA, 0x04AA, mapped, "\u04ab"},
+	{0x04AB, 0x04AB, valid, ""},
+	{0x04AC, 0x04AC, mapped, "\u04ad"},
+	{0x04AD, 0x04AD, valid, ""},
+	{0x04AE, 0x04AE, mapped, "\u04af"},
+	{0x04AF, 0x04AF, valid, ""},
+	{0x04B0, 0x04B0, mapped, "\u04b1"},
+	{0x04B1, 0x04B1, valid, ""},
+	{0x04B2, 0x04B2, mapped, "\u04b3"},
+	{0x04B3, 0x04B3, valid, ""},
+	{0x04B4, 0x04B4, mapped, "\u04b5"},
+	{0x04B5, 0x04B5, valid, ""},
+	{0x04B6, 0x04B6, mapped, "\u04b7"},
+	{0x04B7, 0x04B7, valid, ""},
+	{0x04B8, 0x04B8, mapped, "\u04b9"},
+	{0x04B9, 0x04B9, valid, ""},
+	{0x04BA, 0x04BA, mapped, "\u04bb"},
+	{0x04BB, 0x04BB, valid, ""},
+	{0x04BC, 0x04BC, mapped, "\u04bd"},
+	{0x04BD, 0x04BD, valid, ""},
+	{0x04BE, 0x04BE, mapped, "\u04bf"},
+	{0x04BF, 0x04BF, valid, ""},
+	{0x04C0, 0x04C0, mapped, "\u04cf"},
+	{0x04C1, 0x04C1, mapped, "\u04c2"},
+	{0x04C2, 0x04C2, valid, ""},
+	{0x04C3, 0x04C3, mapped, "\u04c4"},
+	{0x04C4, 0x04C4, valid, ""},
+	{0x04C5, 0x04C5, mapped, "\u04c6"},
+	{0x04C6, 0x04C6, valid, ""},
+	{0x04C7, 0x04C7, mapped, "\u04c8"},
+	{0x04C8, 0x04C8, valid, ""},
+	{0x04C9, 0x04C9, mapped, "\u04ca"},
+	{0x04CA, 0x04CA, valid, ""},
+	{0x04CB, 0x04CB, mapped, "\u04cc"},
+	{0x04CC, 0x04CC, valid, ""},
+	{0x04CD, 0x04CD, mapped, "\u04ce"},
+	{0x04CE, 0x04CF, valid, ""},
+	{0x04D0, 0x04D0, mapped, "\u04d1"},
+	{0x04D1, 0x04D1, valid, ""},
+	{0x04D2, 0x04D2, mapped, "\u04d3"},
+	{0x04D3, 0x04D3, valid, ""},
+	{0x04D4, 0x04D4, mapped, "\u04d5"},
+	{0x04D5, 0x04D5, valid, ""},
+	{0x04D6, 0x04D6, mapped, "\u04d7"},
+	{0x04D7, 0x04D7, valid, ""},
+	{0x04D8, 0x04D8, mapped, "\u04d9"},
+	{0x04D9, 0x04D9, valid, ""},
+	{0x04DA, 0x04DA, mapped, "\u04db"},
+	{0x04DB, 0x04DB, valid, ""},
+	{0x04DC, 0x04DC, mapped, "\u04dd"},
+	{0x04DD, 0x04DD, valid, ""},
+	{0x04DE, 0x04DE, mapped, "\u04df"},
+	{0x04DF, 0x04DF, valid, ""},
+	{0x04E0, 0x04E0, mapped, "\u04e1"},
+	{0x04E1, 0x04E1, valid, ""},
+	{0x04E2, 0x04E2, mapped, "\u04e3"},
+	{0x04E3, 0x04E3, valid, ""},
+	{0x04E4, 0x04E4, mapped, "\u04e5"},
+	{0x04E5, 0x04E5, valid, ""},
+	{0x04E6, 0x04E6, mapped, "\u04e7"},
+	{0x04E7, 0x04E7, valid, ""},
+	{0x04E8, 0x04E8, mapped, "\u04e9"},
+	{0x04E9, 0x04E9, valid, ""},
+	{0x04EA, 0x04EA, mapped, "\u04eb"},
+	{0x04EB, 0x04EB, valid, ""},
+	{0x04EC, 0x04EC, mapped, "\u04ed"},
+	{0x04ED, 0x04ED, valid, ""},
+	{0x04EE, 0x04EE, mapped, "\u04ef"},
+	{0x04EF, 0x04EF, valid, ""},
+	{0x04F0, 0x04F0, mapped, "\u04f1"},
+	{0x04F1, 0x04F1, valid, ""},
+	{0x04F2, 0x04F2, mapped, "\u04f3"},
+	{0x04F3, 0x04F3, valid, ""},
+	{0x04F4, 0x04F4, mapped, "\u04f5"},
+	{0x04F5, 0x04F5, valid, ""},
+	{0x04F6, 0x04F6, mapped, "\u04f7"},
+	{0x04F7, 0x04F7, valid, ""},
+	{0x04F8, 0x04F8, mapped, "\u04f9"},
+	{0x04F9, 0x04F9, valid, ""},
+	{0x04FA, 0x04FA, mapped, "\u04fb"},
+	{0x04FB, 0x04FB, valid, ""},
+	{0x04FC, 0x04FC, mapped, "\u04fd"},
+	{0x04FD, 0x04FD, valid, ""},
+	{0x04FE, 0x04FE, mapped, "\u04ff"},
+	{0x04FF, 0x04FF, valid, ""},
+	{0x0500, 0x0500, mapped, "\u0501"},
+	{0x0501, 0x0501, valid, ""},
+	{0x0502, 0x0502, mapped, "\u0503"},
+	{0x0503, 0x0503, valid, ""},
+	{0x0504, 0x0504, mapped, "\u0505"},
+	{0x0505, 0x0505, valid, ""},
+	{0x0506, 0x0506, mapped, "\u0507"},
+	{0x0507, 0x0507, valid, ""},
+	{0x0508, 0x0508, mapped, "\u0509"},
+	{0x0509, 0x0509, valid, ""},
+	{0x050A, 0x050A, mapped, "\u050b"},
+	{0x050B, 0x050B, valid, ""},
+	{0x050C, 0x050C, mapped, "\u050d"},
+	{0x050D, 0x050D, valid, ""},
+	{0x050E, 0x050E, mapped, "\u050f"},
+	{0x050F, 0x050F, valid, ""},
+	{0x0510, 0x0510, mapped, "\u0511"},
+	{0x0511, 0x0511, valid, ""},
+	{0x0512, 0x0512, mapped, "\u0513"},
+	{0x0513, 0x0513, valid, ""},
+	{0x0514, 0x0514, mapped, "\u0515"},
+	{0x0515, 0x0515, valid, ""},
+	{0x0516, 0x0516, mapped, "\u0517"},
+	{0x0517, 0x0517, valid, ""},
+	{0x0518, 0x0518, mapped, "\u0519"},
+	{0x0519, 0x0519, valid, ""},
+	{0x051A, 0x051A, mapped, "\u051b"},
+	{0x051B, 0x051B, valid, ""},
+	{0x051C, 0x051C, mapped, "\u051d"},
+	{0x051D, 0x051D, valid, ""},
+	{0x051E, 0x051E, mapped, "\u051f"},
+	{0x051F, 0x051F, valid, ""},
+	{0x0520, 0x0520, mapped, "\u0521"},
+	{0x0521, 0x0521, valid, ""},
+	{0x0522, 0x0522, mapped, "\u0523"},
+	{0x0523, 0x0523, valid, ""},
+	{0x0524, 0x0524, mapped, "\u0525"},
+	{0x0525, 0x0525, valid, ""},
+	{0x0526, 0x0526, mapped, "\u0527"},
+	{0x0527, 0x0527, valid, ""},
+	{0x0528, 0x0528, mapped, "\u0529"},
+	{0x0529, 0x0529, valid, ""},
+	{0x052A, 0x052A, mapped, "\u052b"},
+	{0x052B, 0x052B, valid, ""},
+	{0x052C, 0x052C, mapped, "\u052d"},
+	{0x052D, 0x052D, valid, ""},
+	{0x052E, 0x052E, mapped, "\u052f"},
+	{0x052F, 0x052F, valid, ""},
+	{0x0530, 0x0530, disallowed, ""},
+	{0x0531, 0x0531, mapped, "\u0561"},
+	{0x0532, 0x0532, mapped, "\u0562"},
+	{0x0533, 0x0533, mapped, "\u0563"},
+	{0x0534, 0x0534, mapped, "\u0564"},
+	{0x0535, 0x0535, mapped, "\u0565"},
+	{0x0536, 0x0536, mapped, "\u0566"},
+	{0x0537, 0x0537, mapped, "\u0567"},
+	{0x0538, 0x0538, mapped, "\u0568"},
+	{0x0539, 0x0539, mapped, "\u0569"},
+	{0x053A, 0x053A, mapped, "\u056a"},
+	{0x053B, 0x053B, mapped, "\u056b"},
+	{0x053C, 0x053C, mapped, "\u056c"},
+	{0x053D, 0x053D, mapped, "\u056d"},
+	{0x053E, 0x053E, mapped, "\u056e"},
+	{0x053F, 0x053F, mapped, "\u056f"},
+	{0x0540, 0x0540, mapped, "\u0570"},
+	{0x0541, 0x0541, mapped, "\u0571"},
+	{0x0542, 0x0542, mapped, "\u0572"},
+	{0x0543, 0x0543, mapped, "\u0573"},
+	{0x0544, 0x0544, mapped, "\u0574"},
+	{0x0545, 0x0545, mapped, "\u0575"},
+	{0x0546, 0x0546, mapped, "\u0576"},
+	{0x0547, 0x0547, mapped, "\u0577"},
+	{0x0548, 0x0548, mapped, "\u0578"},
+	{0x0549, 0x0549, mapped, "\u0579"},
+	{0x054A, 0x054A, mapped, "\u057a"},
+	{0x054B, 0x054B, mapped, "\u057b"},
+	{0x054C, 0x054C, mapped, "\u057c"},
+	{0x054D, 0x054D, mapped, "\u057d"},
+	{0x054E, 0x054E, mapped, "\u057e"},
+	{0x054F, 0x054F, mapped, "\u057f"},
+	{0x0550, 0x0550, mapped, "\u0580"},
+	{0x0551, 0x0551, mapped, "\u0581"},
+	{0x0552, 0x0552, mapped, "\u0582"},
+	{0x0553, 0x0553, mapped, "\u0583"},
+	{0x0554, 0x0554, mapped, "\u0584"},
+	{0x0555, 0x0555, mapped, "\u0585"},
+	{0x0556, 0x0556, mapped, "\u0586"},
+	{0x0557, 0x0558, disallowed, ""},
+	{0x0559, 0x0586, valid, ""},
+	{0x0587, 0x0587, mapped, "\u0565\u0582"},
+	{0x0588, 0x058A, valid, ""},
+	{0x058B, 0x058C, disallowed, ""},
+	{0x058D, 0x058F, valid, ""},
+	{0x0590, 0x0590, disallowed, ""},
+	{0x0591, 0x05C7, valid, ""},
+	{0x05C8, 0x05CF, disallowed, ""},
+	{0x05D0, 0x05EA, valid, ""},
+	{0x05EB, 0x05EE, disallowed, ""},
+	{0x05EF, 0x05F4, valid, ""},
+	{0x05F5, 0x0605, disallowed, ""},
+	{0x0606, 0x061B, valid, ""},
+	{0x061C, 0x061C, disallowed, ""},
+	{0x061D, 0x0674, valid, ""},
+	{0x0675, 0x0675, mapped, "\u0627\u0674"},
+	{0x0676, 0x0676, mapped, "\u0648\u0674"},
+	{0x0677, 0x0677, mapped, "\u06c7\u0674"},
+	{0x0678, 0x0678, mapped, "\u064a\u0674"},
+	{0x0679, 0x06DC, valid, ""},
+	{0x06DD, 0x06DD, disallowed, ""},
+	{0x06DE, 0x070D, valid, ""},
+	{0x070E, 0x070F, disallowed, ""},
+	{0x0710, 0x074A, valid, ""},
+	{0x074B, 0x074C, disallowed, ""},
+	{0x074D, 0x07B1, valid, ""},
+	{0x07B2, 0x07BF, disallowed, ""},
+	{0x07C0, 0x07FA, valid, ""},
+	{0x07FB, 0x07FC, disallowed, ""},
+	{0x07FD, 0x082D, valid, ""},
+	{0x082E, 0x082F, disallowed, ""},
+	{0x0830, 0x083E, valid, ""},
+	{0x083F, 0x083F, disallowed, ""},
+	{0x0840, 0x085B, valid, ""},
+	{0x085C, 0x085D, disallowed, ""},
+	{0x085E, 0x085E, valid, ""},
+	{0x085F, 0x085F, disallowed, ""},
+	{0x0860, 0x086A, valid, ""},
+	{0x086B, 0x086F, disallowed, ""},
+	{0x0870, 0x088F, valid, ""},
+	{0x0890, 0x0896, disallowed, ""},
+	{0x0897, 0x08E1, valid, ""},
+	{0x08E2, 0x08E2, disallowed, ""},
+	{0x08E3, 0x0957, valid, ""},
+	{0x0958, 0x0958, mapped, "\u0915\u093c"},
+	{0x0959, 0x0959, mapped, "\u0916\u093c"},
+	{0x095A, 0x095A, mapped, "\u0917\u093c"},
+	{0x095B, 0x095B, mapped, "\u091c\u093c"},
+	{0x095C, 0x095C, mapped, "\u0921\u093c"},
+	{0x095D, 0x095D, mapped, "\u0922\u093c"},
+	{0x095E, 0x095E, mapped, "\u092b\u093c"},
+	{0x095F, 0x095F, mapped, "\u092f\u093c"},
+	{0x0960, 0x0983, valid, ""},
+	{0x0984, 0x0984, disallowed, ""},
+	{0x0985, 0x098C, valid, ""},
+	{0x098D, 0x098E, disallowed, ""},
+	{0x098F, 0x0990, valid, ""},
+	{0x0991, 0x0992, disallowed, ""},
+	{0x0993, 0x09A8, valid, ""},
+	{0x09A9, 0x09A9, disallowed, ""},
+	{0x09AA, 0x09B0, valid, ""},
+	{0x09B1, 0x09B1, disallowed, ""},
+	{0x09B2, 0x09B2, valid, ""},
+	{0x09B3, 0x09B5, disallowed, ""},
+	{0x09B6, 0x09B9, valid, ""},
+	{0x09BA, 0x09BB, disallowed, ""},
+	{0x09BC, 0x09C4, valid, ""},
+	{0x09C5, 0x09C6, disallowed, ""},
+	{0x09C7, 0x09C8, valid, ""},
+	{0x09C9, 0x09CA, disallowed, ""},
+	{0x09CB, 0x09CE, valid, ""},
+	{0x09CF, 0x09D6, disallowed, ""},
+	{0x09D7, 0x09D7, valid, ""},
+	{0x09D8, 0x09DB, disallowed, ""},
+	{0x09DC, 0x09DC, mapped, "\u09a1\u09bc"},
+	{0x09DD, 0x09DD, mapped, "\u09a2\u09bc"},
+	{0x09DE, 0x09DE, disallowed, ""},
+	{0x09DF, 0x09DF, mapped, "\u09af\u09bc"},
+	{0x09E0, 0x09E3, valid, ""},
+	{0x09E4, 0x09E5, disallowed, ""},
+	{0x09E6, 0x09FE, valid, ""},
+	{0x09FF, 0x0A00, disallowed, ""},
+	{0x0A01, 0x0A03, valid, ""},
+	{0x0A04, 0x0A04, disallowed, ""},
+	{0x0A05, 0x0A0A, valid, ""},
+	{0x0A0B, 0x0A0E, disallowed, ""},
+	{0x0A0F, 0x0A10, valid, ""},
+	{0x0A11, 0x0A12, disallowed, ""},
+	{0x0A13, 0x0A28, valid, ""},
+	{0x0A29, 0x0A29, disallowed, ""},
+	{0x0A2A, 0x0A30, valid, ""},
+	{0x0A31, 0x0A31, disallowed, ""},
+	{0x0A32, 0x0A32, valid, ""},
+	{0x0A33, 0x0A33, mapped, "\u0a32\u0a3c"},
+	{0x0A34, 0x0A34, disallowed, ""},
+	{0x0A35, 0x0A35, valid, ""},
+	{0x0A36, 0x0A36, mapped, "\u0a38\u0a3c"},
+	{0x0A37, 0x0A37, disallowed, ""},
+	{0x0A38, 0x0A39, valid, ""},
+	{0x0A3A, 0x0A3B, disallowed, ""},
+	{0x0A3C, 0x0A3C, valid, ""},
+	{0x0A3D, 0x0A3D, disallowed, ""},
+	{0x0A3E, 0x0A42, valid, ""},
+	{0x0A43, 0x0A46, disallowed, ""},
+	{0x0A47, 0x0A48, valid, ""},
+	{0x0A49, 0x0A4A, disallowed, ""},
+	{0x0A4B, 0x0A4D, valid, ""},
+	{0x0A4E, 0x0A50, disallowed, ""},
+	{0x0A51, 0x0A51, valid, ""},
+	{0x0A52, 0x0A58, disallowed, ""},
+	{0x0A59, 0x0A59, mapped, "\u0a16\u0a3c"},
+	{0x0A5A, 0x0A5A, mapped, "\u0a17\u0a3c"},
+	{0x0A5B, 0x0A5B, mapped, "\u0a1c\u0a3c"},
+	{0x0A5C, 0x0A5C, valid, ""},
+	{0x0A5D, 0x0A5D, disallowed, ""},
+	{0x0A5E, 0x0A5E, mapped, "\u0a2b\u0a3c"},
+	{0x0A5F, 0x0A65, disallowed, ""},
+	{0x0A66, 0x0A76, valid, ""},
+	{0x0A77, 0x0A80, disallowed, ""},
+	{0x0A81, 0x0A83, valid, ""},
+	{0x0A84, 0x0A84, disallowed, ""},
+	{0x0A85, 0x0A8D, valid, ""},
+	{0x0A8E, 0x0A8E, disallowed, ""},
+	{0x0A8F, 0x0A91, valid, ""},
+	{0x0A92, 0x0A92, disallowed, ""},
+	{0x0A93, 0x0AA8, valid, ""},
+	{0x0AA9, 0x0AA9, disallowed, ""},
+	{0x0AAA, 0x0AB0, valid, ""},
+	{0x0AB1, 0x0AB1, disallowed, ""},
+	{0x0AB2, 0x0AB3, valid, ""},
+	{0x0AB4, 0x0AB4, disallowed, ""},
+	{0x0AB5, 0x0AB9, valid, ""},
+	{0x0ABA, 0x0ABB, disallowed, ""},
+	{0x0ABC, 0x0AC5, valid, ""},
+	{0x0AC6, 0x0AC6, disallowed, ""},
+	{0x0AC7, 0x0AC9, valid, ""},
+	{0x0ACA, 0x0ACA, disallowed, ""},
+	{0x0ACB, 0x0ACD, valid, ""},
+	{0x0ACE, 0x0ACF, disallowed, ""},
+	{0x0AD0, 0x0AD0, valid, ""},
+	{0x0AD1, 0x0ADF, disallowed, ""},
+	{0x0AE0, 0x0AE3, valid, ""},
+	{0x0AE4, 0x0AE5, disallowed, ""},
+	{0x0AE6, 0x0AF1, valid, ""},
+	{0x0AF2, 0x0AF8, disallowed, ""},
+	{0x0AF9, 0x0AFF, valid, ""},
+	{0x0B00, 0x0B00, disallowed, ""},
+	{0x0B01, 0x0B03, valid, ""},
+	{0x0B04, 0x0B04, disallowed, ""},
+	{0x0B05, 0x0B0C, valid, ""},
+	{0x0B0D, 0x0B0E, disallowed, ""},
+	{0x0B0F, 0x0B10, valid, ""},
+	{0x0B11, 0x0B12, disallowed, ""},
+	{0x0B13, 0x0B28, valid, ""},
+	{0x0B29, 0x0B29, disallowed, ""},
+	{0x0B2A, 0x0B30, valid, ""},
+	{0x0B31, 0x0B31, disallowed, ""},
+	{0x0B32, 0x0B33, valid, ""},
+	{0x0B34, 0x0B34, disallowed, ""},
+	{0x0B35, 0x0B39, valid, ""},
+	{0x0B3A, 0x0B3B, disallowed, ""},
+	{0x0B3C, 0x0B44, valid, ""},
+	{0x0B45, 0x0B46, disallowed, ""},
+	{0x0B47, 0x0B48, valid, ""},
+	{0x0B49, 0x0B4A, disallowed, ""},
+	{0x0B4B, 0x0B4D, valid, ""},
+	{0x0B4E, 0x0B54, disallowed, ""},
+	{0x0B55, 0x0B57, valid, ""},
+	{0x0B58, 0x0B5B, disallowed, ""},
+	{0x0B5C, 0x0B5C, mapped, "\u0b21\u0b3c"},
+	{0x0B5D, 0x0B5D, mapped, "\u0b22\u0b3c"},
+	{0x0B5E, 0x0B5E, disallowed, ""},
+	{0x0B5F, 0x0B63, valid, ""},
+	{0x0B64, 0x0B65, disallowed, ""},
+	{0x0B66, 0x0B77, valid, ""},
+	{0x0B78, 0x0B81, disallowed, ""},
+	{0x0B82, 0x0B83, valid, ""},
+	{0x0B84, 0x0B84, disallowed, ""},
+	{0x0B85, 0x0B8A, valid, ""},
+	{0x0B8B, 0x0B8D, disallowed, ""},
+	{0x0B8E, 0x0B90, valid, ""},
+	{0x0B91, 0x0B91, disallowed, ""},
+	{0x0B92, 0x0B95, valid, ""},
+	{0x0B96, 0x0B98, disallowed, ""},
+	{0x0B99, 0x0B9A, valid, ""},
+	{0x0B9B, 0x0B9B, disallowed, ""},
+	{0x0B9C, 0x0B9C, valid, ""},
+	{0x0B9D, 0x0B9D, disallowed, ""},
+	{0x0B9E, 0x0B9F, valid, ""},
+	{0x0BA0, 0x0BA2, disallowed, ""},
+	{0x0BA3, 0x0BA4, valid, ""},
+	{0x0BA5, 0x0BA7, disallowed, ""},
+	{0x0BA8, 0x0BAA, valid, ""},
+	{0x0BAB, 0x0BAD, disallowed, ""},
+	{0x0BAE, 0x0BB9, valid, ""},
+	{0x0BBA, 0x0BBD, disallowed, ""},
+	{0x0BBE, 0x0BC2, valid, ""},
+	{0x0BC3, 0x0BC5, disallowed, ""},
+	{0x0BC6, 0x0BC8, valid, ""},
+	{0x0BC9, 0x0BC9, disallowed, ""},
+	{0x0BCA, 0x0BCD, valid, ""},
+	{0x0BCE, 0x0BCF, disallowed, ""},
+	{0x0BD0, 0x0BD0, valid, ""},
+	{0x0BD1, 0x0BD6, disallowed, ""},
+	{0x0BD7, 0x0BD7, valid, ""},
+	{0x0BD8, 0x0BE5, disallowed, ""},
+	{0x0BE6, 0x0BFA, valid, ""},
+	{0x0BFB, 0x0BFF, disallowed, ""},
+	{0x0C00, 0x0C0C, valid, ""},
+	{0x0C0D, 0x0C0D, disallowed, ""},
+	{0x0C0E, 0x0C10, valid, ""},
+	{0x0C11, 0x0C11, disallowed, ""},
+	{0x0C12, 0x0C28, valid, ""},
+	{0x0C29, 0x0C29, disallowed, ""},
+	{0x0C2A, 0x0C39, valid, ""},
+	{0x0C3A, 0x0C3B, disallowed, ""},
+	{0x0C3C, 0x0C44, valid, ""},
+	{0x0C45, 0x0C45, disallowed, ""},
+	{0x0C46, 0x0C48, valid, ""},
+	{0x0C49, 0x0C49, disallowed, ""},
+	{0x0C4A, 0x0C4D, valid, ""},
+	{0x0C4E, 0x0C54, disallowed, ""},
+	{0x0C55, 0x0C56, valid, ""},
+	{0x0C57, 0x0C57, disallowed, ""},
+	{0x0C58, 0x0C5A, valid, ""},
+	{0x0C5B, 0x0C5B, disallowed, ""},
+	{0x0C5C, 0x0C5D, valid, ""},
+	{0x0C5E, 0x0C5F, disallowed, ""},
+	{0x0C60, 0x0C63, valid, ""},
+	{0x0C64, 0x0C65, disallowed, ""},
+	{0x0C66, 0x0C6F, valid, ""},
+	{0x0C70, 0x0C76, disallowed, ""},
+	{0x0C77, 0x0C8C, valid, ""},
+	{0x0C8D, 0x0C8D, disallowed, ""},
+	{0x0C8E, 0x0C90, valid, ""},
+	{0x0C91, 0x0C91, disallowed, ""},
+	{0x0C92, 0x0CA8, valid, ""},
+	{0x0CA9, 0x0CA9, disallowed, ""},
+	{0x0CAA, 0x0CB3, valid, ""},
+	{0x0CB4, 0x0CB4, disallowed, ""},
+	{0x0CB5, 0x0CB9, valid, ""},
+	{0x0CBA, 0x0CBB, disallowed, ""},
+	{0x0CBC, 0x0CC4, valid, ""},
+	{0x0CC5, 0x0CC5, disallowed, ""},
+	{0x0CC6, 0x0CC8, valid, ""},
+	{0x0CC9, 0x0CC9, disallowed, ""},
+	{0x0CCA, 0x0CCD, valid, ""},
+	{0x0CCE, 0x0CD4, disallowed, ""},
+	{0x0CD5, 0x0CD6, valid, ""},
+	{0x0CD7, 0x0CDB, disallowed, ""},
+	{0x0CDC, 0x0CDE, valid, ""},
+	{0x0CDF, 0x0CDF, disallowed, ""},
+	{0x0CE0, 0x0CE3, valid, ""},
+	{0x0CE4, 0x0CE5, disallowed, ""},
+	{0x0CE6, 0x0CEF, valid, ""},
+	{0x0CF0, 0x0CF0, disallowed, ""},
+	{0x0CF1, 0x0CF3, valid, ""},
+	{0x0CF4, 0x0CFF, disallowed, ""},
+	{0x0D00, 0x0D0C, valid, ""},
+	{0x0D0D, 0x0D0D, disallowed, ""},
+	{0x0D0E, 0x0D10, valid, ""},
+	{0x0D11, 0x0D11, disallowed, ""},
+	{0x0D12, 0x0D44, valid, ""},
+	{0x0D45, 0x0D45, disallowed, ""},
+	{0x0D46, 0x0D48, valid, ""},
+	{0x0D49, 0x0D49, disallowed, ""},
+	{0x0D4A, 0x0D4F, valid, ""},
+	{0x0D50, 0x0D53, disallowed, ""},
+	{0x0D54, 0x0D63, valid, ""},
+	{0x0D64, 0x0D65, disallowed, ""},
+	{0x0D66, 0x0D7F, valid, ""},
+	{0x0D80, 0x0D80, disallowed, ""},
+	{0x0D81, 0x0D83, valid, ""},
+	{0x0D84, 0x0D84, disallowed, ""},
+	{0x0D85, 0x0D96, valid, ""},
+	{0x0D97, 0x0D99, disallowed, ""},
+	{0x0D9A, 0x0DB1, valid, ""},
+	{0x0DB2, 0x0DB2, disallowed, ""},
+	{0x0DB3, 0x0DBB, valid, ""},
+	{0x0DBC, 0x0DBC, disallowed, ""},
+	{0x0DBD, 0x0DBD, valid, ""},
+	{0x0DBE, 0x0DBF, disallowed, ""},
+	{0x0DC0, 0x0DC6, valid, ""},
+	{0x0DC7, 0x0DC9, disallowed, ""},
+	{0x0DCA, 0x0DCA, valid, ""},
+	{0x0DCB, 0x0DCE, disallowed, ""},
+	{0x0DCF, 0x0DD4, valid, ""},
+	{0x0DD5, 0x0DD5, disallowed, ""},
+	{0x0DD6, 0x0DD6, valid, ""},
+	{0x0DD7, 0x0DD7, disallowed, ""},
+	{0x0DD8, 0x0DDF, valid, ""},
+	{0x0DE0, 0x0DE5, disallowed, ""},
+	{0x0DE6, 0x0DEF, valid, ""},
+	{0x0DF0, 0x0DF1, disallowed, ""},
+	{0x0DF2, 0x0DF4, valid, ""},
+	{0x0DF5, 0x0E00, disallowed, ""},
+	{0x0E01, 0x0E32, valid, ""},
+	{0x0E33, 0x0E33, mapped, "\u0e4d\u0e32"},
+	{0x0E34, 0x0E3A, valid, ""},
+	{0x0E3B, 0x0E3E, disallowed, ""},
+	{0x0E3F, 0x0E5B, valid, ""},
+	{0x0E5C, 0x0E80, disallowed, ""},
+	{0x0E81, 0x0E82, valid, ""},
+	{0x0E83, 0x0E83, disallowed, ""},
+	{0x0E84, 0x0E84, valid, ""},
+	{0x0E85, 0x0E85, disallowed, ""},
+	{0x0E86, 0x0E8A, valid, ""},
+	{0x0E8B, 0x0E8B, disallowed, ""},
+	{0x0E8C, 0x0EA3, valid, ""},
+	{0x0EA4, 0x0EA4, disallowed, ""},
+	{0x0EA5, 0x0EA5, valid, ""},
+	{0x0EA6, 0x0EA6, disallowed, ""},
+	{0x0EA7, 0x0EB2, valid, ""},
+	{0x0EB3, 0x0EB3, mapped, "\u0ecd\u0eb2"},
+	{0x0EB4, 0x0EBD, valid, ""},
+	{0x0EBE, 0x0EBF, disallowed, ""},
+	{0x0EC0, 0x0EC4, valid, ""},
+	{0x0EC5, 0x0EC5, disallowed, ""},
+	{0x0EC6, 0x0EC6, valid, ""},
+	{0x0EC7, 0x0EC7, disallowed, ""},
+	{0x0EC8, 0x0ECE, valid, ""},
+	{0x0ECF, 0x0ECF, disallowed, ""},
+	{0x0ED0, 0x0ED9, valid, ""},
+	{0x0EDA, 0x0EDB, disallowed, ""},
+	{0x0EDC, 0x0EDC, mapped, "\u0eab\u0e99"},
+	{0x0EDD, 0x0EDD, mapped, "\u0eab\u0ea1"},
+	{0x0EDE, 0x0EDF, valid, ""},
+	{0x0EE0, 0x0EFF, disallowed, ""},
+	{0x0F00, 0x0F0B, valid, ""},
+	{0x0F0C, 0x0F0C, mapped, "\u0f0b"},
+	{0x0F0D, 0x0F42, valid, ""},
+	{0x0F43, 0x0F43, mapped, "\u0f42\u0fb7"},
+	{0x0F44, 0x0F47, valid, ""},
+	{0x0F48, 0x0F48, disallowed, ""},
+	{0x0F49, 0x0F4C, valid, ""},
+	{0x0F4D, 0x0F4D, mapped, "\u0f4c\u0fb7"},
+	{0x0F4E, 0x0F51, valid, ""},
+	{0x0F52, 0x0F52, mapped, "\u0f51\u0fb7"},
+	{0x0F53, 0x0F56, valid, ""},
+	{0x0F57, 0x0F57, mapped, "\u0f56\u0fb7"},
+	{0x0F58, 0x0F5B, valid, ""},
+	{0x0F5C, 0x0F5C, mapped, "\u0f5b\u0fb7"},
+	{0x0F5D, 0x0F68, valid, ""},
+	{0x0F69, 0x0F69, mapped, "\u0f40\u0fb5"},
+	{0x0F6A, 0x0F6C, valid, ""},
+	{0x0F6D, 0x0F70, disallowed, ""},
+	{0x0F71, 0x0F72, valid, ""},
+	{0x0F73, 0x0F73, mapped, "\u0f71\u0f72"},
+	{0x0F74, 0x0F74, valid, ""},
+	{0x0F75, 0x0F75, mapped, "\u0f71\u0f74"},
+	{0x0F76, 0x0F76, mapped, "\u0fb2\u0f80"},
+	{0x0F77, 0x0F77, mapped, "\u0fb2\u0f71\u0f80"},
+	{0x0F78, 0x0F78, mapped, "\u0fb3\u0f80"},
+	{0x0F79, 0x0F79, mapped, "\u0fb3\u0f71\u0f80"},
+	{0x0F7A, 0x0F80, valid, ""},
+	{0x0F81, 0x0F81, mapped, "\u0f71\u0f80"},
+	{0x0F82, 0x0F92, valid, ""},
+	{0x0F93, 0x0F93, mapped, "\u0f92\u0fb7"},
+	{0x0F94, 0x0F97, valid, ""},
+	{0x0F98, 0x0F98, disallowed, ""},
+	{0x0F99, 0x0F9C, valid, ""},
+	{0x0F9D, 0x0F9D, mapped, "\u0f9c\u0fb7"},
+	{0x0F9E, 0x0FA1, valid, ""},
+	{0x0FA2, 0x0FA2, mapped, "\u0fa1\u0fb7"},
+	{0x0FA3, 0x0FA6, valid, ""},
+	{0x0FA7, 0x0FA7, mapped, "\u0fa6\u0fb7"},
+	{0x0FA8, 0x0FAB, valid, ""},
+	{0x0FAC, 0x0FAC, mapped, "\u0fab\u0fb7"},
+	{0x0FAD, 0x0FB8, valid, ""},
+	{0x0FB9, 0x0FB9, mapped, "\u0f90\u0fb5"},
+	{0x0FBA, 0x0FBC, valid, ""},
+	{0x0FBD, 0x0FBD, disallowed, ""},
+	{0x0FBE, 0x0FCC, valid, ""},
+	{0x0FCD, 0x0FCD, disallowed, ""},
+	{0x0FCE, 0x0FDA, valid, ""},
+	{0x0FDB, 0x0FFF, disallowed, ""},
+	{0x1000, 0x109F, valid, ""},
+	{0x10A0, 0x10A0, mapped, "\u2d00"},
+	{0x10A1, 0x10A1, mapped, "\u2d01"},
+	{0x10A2, 0x10A2, mapped, "\u2d02"},
+	{0x10A3, 0x10A3, mapped, "\u2d03"},
+	{0x10A4, 0x10A4, mapped, "\u2d04"},
+	{0x10A5, 0x10A5, mapped, "\u2d05"},
+	{0x10A6, 0x10A6, mapped, "\u2d06"},
+	{0x10A7, 0x10A7, mapped, "\u2d07"},
+	{0x10A8, 0x10A8, mapped, "\u2d08"},
+	{0x10A9, 0x10A9, mapped, "\u2d09"},
+	{0x10AA, 0x10AA, mapped, "\u2d0a"},
+	{0x10AB, 0x10AB, mapped, "\u2d0b"},
+	{0x10AC, 0x10AC, mapped, "\u2d0c"},
+	{0x10AD, 0x10AD, mapped, "\u2d0d"},
+	{0x10AE, 0x10AE, mapped, "\u2d0e"},
+	{0x10AF, 0x10AF, mapped, "\u2d0f"},
+	{0x10B0, 0x10B0, mapped, "\u2d10"},
+	{0x10B1, 0x10B1, mapped, "\u2d11"},
+	{0x10B2, 0x10B2, mapped, "\u2d12"},
+	{0x10B3, 0x10B3, mapped, "\u2d13"},
+	{0x10B4, 0x10B4, mapped, "\u2d14"},
+	{0x10B5, 0x10B5, mapped, "\u2d15"},
+	{0x10B6, 0x10B6, mapped, "\u2d16"},
+	{0x10B7, 0x10B7, mapped, "\u2d17"},
+	{0x10B8, 0x10B8, mapped, "\u2d18"},
+	{0x10B9, 0x10B9, mapped, "\u2d19"},
+	{0x10BA, 0x10BA, mapped, "\u2d1a"},
+	{0x10BB, 0x10BB, mapped, "\u2d1b"},
+	{0x10BC, 0x10BC, mapped, "\u2d1c"},
+	{0x10BD, 0x10BD, mapped, "\u2d1d"},
+	{0x10BE, 0x10BE, mapped, "\u2d1e"},
+	{0x10BF, 0x10BF, mapped, "\u2d1f"},
+	{0x10C0, 0x10C0, mapped, "\u2d20"},
+	{0x10C1, 0x10C1, mapped, "\u2d21"},
+	{0x10C2, 0x10C2, mapped, "\u2d22"},
+	{0x10C3, 0x10C3, mapped, "\u2d23"},
+	{0x10C4, 0x10C4, mapped, "\u2d24"},
+	{0x10C5, 0x10C5, mapped, "\u2d25"},
+	{0x10C6, 0x10C6, disallowed, ""},
+	{0x10C7, 0x10C7, mapped, "\u2d27"},
+	{0x10C8, 0x10CC, disallowed, ""},
+	{0x10CD, 0x10CD, mapped, "\u2d2d"},
+	{0x10CE, 0x10CF, disallowed, ""},
+	{0x10D0, 0x10FB, valid, ""},
+	{0x10FC, 0x10FC, mapped, "\u10dc"},
+	{0x10FD, 0x115E, valid, ""},
+	{0x115F, 0x1160, ignored, ""},
+	{0x1161, 0x1248, valid, ""},
+	{0x1249, 0x1249, disallowed, ""},
+	{0x124A, 0x124D, valid, ""},
+	{0x124E, 0x124F, disallowed, ""},
+	{0x1250, 0x1256, valid, ""},
+	{0x1257, 0x1257, disallowed, ""},
+	{0x1258, 0x1258, valid, ""},
+	{0x1259, 0x1259, disallowed, ""},
+	{0x125A, 0x125D, valid, ""},
+	{0x125E, 0x125F, disallowed, ""},
+	{0x1260, 0x1288, valid, ""},
+	{0x1289, 0x1289, disallowed, ""},
+	{0x128A, 0x128D, valid, ""},
+	{0x128E, 0x128F, disallowed, ""},
+	{0x1290, 0x12B0, valid, ""},
+	{0x12B1, 0x12B1, disallowed, ""},
+	{0x12B2, 0x12B5, valid, ""},
+	{0x12B6, 0x12B7, disallowed, ""},
+	{0x12B8, 0x12BE, valid, ""},
+	{0x12BF, 0x12BF, disallowed, ""},
+	{0x12C0, 0x12C0, valid, ""},
+	{0x12C1, 0x12C1, disallowed, ""},
+	{0x12C2, 0x12C5, valid, ""},
+	{0x12C6, 0x12C7, disallowed, ""},
+	{0x12C8, 0x12D6, valid, ""},
+	{0x12D7, 0x12D7, disallowed, ""},
+	{0x12D8, 0x1310, valid, ""},
+	{0x1311, 0x1311, disallowed, ""},
+	{0x1312, 0x1315, valid, ""},
+	{0x1316, 0x1317, disallowed, ""},
+	{0x1318, 0x135A, valid, ""},
+	{0x135B, 0x135C, disallowed, ""},
+	{0x135D, 0x137C, valid, ""},
+	{0x137D, 0x137F, disallowed, ""},
+	{0x1380, 0x1399, valid, ""},
+	{0x139A, 0x139F, disallowed, ""},
+	{0x13A0, 0x13F5, valid, ""},
+	{0x13F6, 0x13F7, disallowed, ""},
+	{0x13F8, 0x13F8, mapped, "\u13f0"},
+	{0x13F9, 0x13F9, mapped, "\u13f1"},
+	{0x13FA, 0x13FA, mapped, "\u13f2"},
+	{0x13FB, 0x13FB, mapped, "\u13f3"},
+	{0x13FC, 0x13FC, mapped, "\u13f4"},
+	{0x13FD, 0x13FD, mapped, "\u13f5"},
+	{0x13FE, 0x13FF, disallowed, ""},
+	{0x1400, 0x167F, valid, ""},
+	{0x1680, 0x1680, disallowed, ""},
+	{0x1681, 0x169C, valid, ""},
+	{0x169D, 0x169F, disallowed, ""},
+	{0x16A0, 0x16F8, valid, ""},
+	{0x16F9, 0x16FF, disallowed, ""},
+	{0x1700, 0x1715, valid, ""},
+	{0x1716, 0x171E, disallowed, ""},
+	{0x171F, 0x1736, valid, ""},
+	{0x1737, 0x173F, disallowed, ""},
+	{0x1740, 0x1753, valid, ""},
+	{0x1754, 0x175F, disallowed, ""},
+	{0x1760, 0x176C, valid, ""},
+	{0x176D, 0x176D, disallowed, ""},
+	{0x176E, 0x1770, valid, ""},
+	{0x1771, 0x1771, disallowed, ""},
+	{0x1772, 0x1773, valid, ""},
+	{0x1774, 0x177F, disallowed, ""},
+	{0x1780, 0x17B3, valid, ""},
+	{0x17B4, 0x17B5, ignored, ""},
+	{0x17B6, 0x17DD, valid, ""},
+	{0x17DE, 0x17DF, disallowed, ""},
+	{0x17E0, 0x17E9, valid, ""},
+	{0x17EA, 0x17EF, disallowed, ""},
+	{0x17F0, 0x17F9, valid, ""},
+	{0x17FA, 0x17FF, disallowed, ""},
+	{0x1800, 0x180A, valid, ""},
+	{0x180B, 0x180F, ignored, ""},
+	{0x1810, 0x1819, valid, ""},
+	{0x181A, 0x181F, disallowed, ""},
+	{0x1820, 0x1878, valid, ""},
+	{0x1879, 0x187F, disallowed, ""},
+	{0x1880, 0x18AA, valid, ""},
+	{0x18AB, 0x18AF, disallowed, ""},
+	{0x18B0, 0x18F5, valid, ""},
+	{0x18F6, 0x18FF, disallowed, ""},
+	{0x1900, 0x191E, valid, ""},
+	{0x191F, 0x191F, disallowed, ""},
+	{0x1920, 0x192B, valid, ""},
+	{0x192C, 0x192F, disallowed, ""},
+	{0x1930, 0x193B, valid, ""},
+	{0x193C, 0x193F, disallowed, ""},
+	{0x1940, 0x1940, valid, ""},
+	{0x1941, 0x1943, disallowed, ""},
+	{0x1944, 0x196D, valid, ""},
+	{0x196E, 0x196F, disallowed, ""},
+	{0x1970, 0x1974, valid, ""},
+	{0x1975, 0x197F, disallowed, ""},
+	{0x1980, 0x19AB, valid, ""},
+	{0x19AC, 0x19AF, disallowed, ""},
+	{0x19B0, 0x19C9, valid, ""},
+	{0x19CA, 0x19CF, disallowed, ""},
+	{0x19D0, 0x19DA, valid, ""},
+	{0x19DB, 0x19DD, disallowed, ""},
+	{0x19DE, 0x1A1B, valid, ""},
+	{0x1A1C, 0x1A1D, disallowed, ""},
+	{0x1A1E, 0x1A5E, valid, ""},
+	{0x1A5F, 0x1A5F, disallowed, ""},
+	{0x1A60, 0x1A7C, valid, ""},
+	{0x1A7D, 0x1A7E, disallowed, ""},
+	{0x1A7F, 0x1A89, valid, ""},
+	{0x1A8A, 0x1A8F, disallowed, ""},
+	{0x1A90, 0x1A99, valid, ""},
+	{0x1A9A, 0x1A9F, disallowed, ""},
+	{0x1AA0, 0x1AAD, valid, ""},
+	{0x1AAE, 0x1AAF, disallowed, ""},
+	{0x1AB0, 0x1ADD, valid, ""},
+	{0x1ADE, 0x1ADF, disallowed, ""},
+	{0x1AE0, 0x1AEB, valid, ""},
+	{0x1AEC, 0x1AFF, disallowed, ""},
+	{0x1B00, 0x1B4C, valid, ""},
+	{0x1B4D, 0x1B4D, disallowed, ""},
+	{0x1B4E, 0x1BF3, valid, ""},
+	{0x1BF4, 0x1BFB, disallowed, ""},
+	{0x1BFC, 0x1C37, valid, ""},
+	{0x1C38, 0x1C3A, disallowed, ""},
+	{0x1C3B, 0x1C49, valid, ""},
+	{0x1C4A, 0x1C4C, disallowed, ""},
+	{0x1C4D, 0x1C7F, valid, ""},
+	{0x1C80, 0x1C80, mapped, "\u0432"},
+	{0x1C81, 0x1C81, mapped, "\u0434"},
+	{0x1C82, 0x1C82, mapped, "\u043e"},
+	{0x1C83, 0x1C83, mapped, "\u0441"},
+	{0x1C84, 0x1C84, mapped, "\u0442"},
+	{0x1C85, 0x1C85, mapped, "\u0442"},
+	{0x1C86, 0x1C86, mapped, "\u044a"},
+	{0x1C87, 0x1C87, mapped, "\u0463"},
+	{0x1C88, 0x1C88, mapped, "\ua64b"},
+	{0x1C89, 0x1C89, mapped, "\u1c8a"},
+	{0x1C8A, 0x1C8A, valid, ""},
+	{0x1C8B, 0x1C8F, disallowed, ""},
+	{0x1C90, 0x1C90, mapped, "\u10d0"},
+	{0x1C91, 0x1C91, mapped, "\u10d1"},
+	{0x1C92, 0x1C92, mapped, "\u10d2"},
+	{0x1C93, 0x1C93, mapped, "\u10d3"},
+	{0x1C94, 0x1C94, mapped, "\u10d4"},
+	{0x1C95, 0x1C95, mapped, "\u10d5"},
+	{0x1C96, 0x1C96, mapped, "\u10d6"},
+	{0x1C97, 0x1C97, mapped, "\u10d7"},
+	{0x1C98, 0x1C98, mapped, "\u10d8"},
+	{0x1C99, 0x1C99, mapped, "\u10d9"},
+	{0x1C9A, 0x1C9A, mapped, "\u10da"},
+	{0x1C9B, 0x1C9B, mapped, "\u10db"},
+	{0x1C9C, 0x1C9C, mapped, "\u10dc"},
+	{0x1C9D, 0x1C9D, mapped, "\u10dd"},
+	{0x1C9E, 0x1C9E, mapped, "\u10de"},
+	{0x1C9F, 0x1C9F, mapped, "\u10df"},
+	{0x1CA0, 0x1CA0, mapped, "\u10e0"},
+	{0x1CA1, 0x1CA1, mapped, "\u10e1"},
+	{0x1CA2, 0x1CA2, mapped, "\u10e2"},
+	{0x1CA3, 0x1CA3, mapped, "\u10e3"},
+	{0x1CA4, 0x1CA4, mapped, "\u10e4"},
+	{0x1CA5, 0x1CA5, mapped, "\u10e5"},
+	{0x1CA6, 0x1CA6, mapped, "\u10e6"},
+	{0x1CA7, 0x1CA7, mapped, "\u10e7"},
+	{0x1CA8, 0x1CA8, mapped, "\u10e8"},
+	{0x1CA9, 0x1CA9, mapped, "\u10e9"},
+	{0x1CAA, 0x1CAA, mapped, "\u10ea"},
+	{0x1CAB, 0x1CAB, mapped, "\u10eb"},
+	{0x1CAC, 0x1CAC, mapped, "\u10ec"},
+	{0x1CAD, 0x1CAD, mapped, "\u10ed"},
+	{0x1CAE, 0x1CAE, mapped, "\u10ee"},
+	{0x1CAF, 0x1CAF, mapped, "\u10ef"},
+	{0x1CB0, 0x1CB0, mapped, "\u10f0"},
+	{0x1CB1, 0x1CB1, mapped, "\u10f1"},
+	{0x1CB2, 0x1CB2, mapped, "\u10f2"},
+	{0x1CB3, 0x1CB3, mapped, "\u10f3"},
+	{0x1CB4, 0x1CB4, mapped, "\u10f4"},
+	{0x1CB5, 0x1CB5, mapped, "\u10f5"},
+	{0x1CB6, 0x1CB6, mapped, "\u10f6"},
+	{0x1CB7, 0x1CB7, mapped, "\u10f7"},
+	{0x1CB8, 0x1CB8, mapped, "\u10f8"},
+	{0x1CB9, 0x1CB9, mapped, "\u10f9"},
+	{0x1CBA, 0x1CBA, mapped, "\u10fa"},
+	{0x1CBB, 0x1CBC, disallowed, ""},
+	{0x1CBD, 0x1CBD, mapped, "\u10fd"},
+	{0x1CBE, 0x1CBE, mapped, "\u10fe"},
+	{0x1CBF, 0x1CBF, mapped, "\u10ff"},
+	{0x1CC0, 0x1CC7, valid, ""},
+	{0x1CC8, 0x1CCF, disallowed, ""},
+	{0x1CD0, 0x1CFA, valid, ""},
+	{0x1CFB, 0x1CFF, disallowed, ""},
+	{0x1D00, 0x1D2B, valid, ""},
+	{0x1D2C, 0x1D2C, mapped, "a"},
+	{0x1D2D, 0x1D2D, mapped, "\u00e6"},
+	{0x1D2E, 0x1D2E, mapped, "b"},
+	{0x1D2F, 0x1D2F, valid, ""},
+	{0x1D30, 0x1D30, mapped, "d"},
+	{0x1D31, 0x1D31, mapped, "e"},
+	{0x1D32, 0x1D32, mapped, "\u01dd"},
+	{0x1D33, 0x1D33, mapped, "g"},
+	{0x1D34, 0x1D34, mapped, "h"},
+	{0x1D35, 0x1D35, mapped, "i"},
+	{0x1D36, 0x1D36, mapped, "j"},
+	{0x1D37, 0x1D37, mapped, "k"},
+	{0x1D38, 0x1D38, mapped, "l"},
+	{0x1D39, 0x1D39, mapped, "m"},
+	{0x1D3A, 0x1D3A, mapped, "n"},
+	{0x1D3B, 0x1D3B, valid, ""},
+	{0x1D3C, 0x1D3C, mapped, "o"},
+	{0x1D3D, 0x1D3D, mapped, "\u0223"},
+	{0x1D3E, 0x1D3E, mapped, "p"},
+	{0x1D3F, 0x1D3F, mapped, "r"},
+	{0x1D40, 0x1D40, mapped, "t"},
+	{0x1D41, 0x1D41, mapped, "u"},
+	{0x1D42, 0x1D42, mapped, "w"},
+	{0x1D43, 0x1D43, mapped, "a"},
+	{0x1D44, 0x1D44, mapped, "\u0250"},
+	{0x1D45, 0x1D45, mapped, "\u0251"},
+	{0x1D46, 0x1D46, mapped, "\u1d02"},
+	{0x1D47, 0x1D47, mapped, "b"},
+	{0x1D48, 0x1D48, mapped, "d"},
+	{0x1D49, 0x1D49, mapped, "e"},
+	{0x1D4A, 0x1D4A, mapped, "\u0259"},
+	{0x1D4B, 0x1D4B, mapped, "\u025b"},
+	{0x1D4C, 0x1D4C, mapped, "\u025c"},
+	{0x1D4D, 0x1D4D, mapped, "g"},
+	{0x1D4E, 0x1D4E, valid, ""},
+	{0x1D4F, 0x1D4F, mapped, "k"},
+	{0x1D50, 0x1D50, mapped, "m"},
+	{0x1D51, 0x1D51, mapped, "\u014b"},
+	{0x1D52, 0x1D52, mapped, "o"},
+	{0x1D53, 0x1D53, mapped, "\u0254"},
+	{0x1D54, 0x1D54, mapped, "\u1d16"},
+	{0x1D55, 0x1D55, mapped, "\u1d17"},
+	{0x1D56, 0x1D56, mapped, "p"},
+	{0x1D57, 0x1D57, mapped, "t"},
+	{0x1D58, 0x1D58, mapped, "u"},
+	{0x1D59, 0x1D59, mapped, "\u1d1d"},
+	{0x1D5A, 0x1D5A, mapped, "\u026f"},
+	{0x1D5B, 0x1D5B, mapped, "v"},
+	{0x1D5C, 0x1D5C, mapped, "\u1d25"},
+	{0x1D5D, 0x1D5D, mapped, "\u03b2"},
+	{0x1D5E, 0x1D5E, mapped, "\u03b3"},
+	{0x1D5F, 0x1D5F, mapped, "\u03b4"},
+	{0x1D60, 0x1D60, mapped, "\u03c6"},
+	{0x1D61, 0x1D61, mapped, "\u03c7"},
+	{0x1D62, 0x1D62, mapped, "i"},
+	{0x1D63, 0x1D63, mapped, "r"},
+	{0x1D64, 0x1D64, mapped, "u"},
+	{0x1D65, 0x1D65, mapped, "v"},
+	{0x1D66, 0x1D66, mapped, "\u03b2"},
+	{0x1D67, 0x1D67, mapped, "\u03b3"},
+	{0x1D68, 0x1D68, mapped, "\u03c1"},
+	{0x1D69, 0x1D69, mapped, "\u03c6"},
+	{0x1D6A, 0x1D6A, mapped, "\u03c7"},
+	{0x1D6B, 0x1D77, valid, ""},
+	{0x1D78, 0x1D78, mapped, "\u043d"},
+	{0x1D79, 0x1D9A, valid, ""},
+	{0x1D9B, 0x1D9B, mapped, "\u0252"},
+	{0x1D9C, 0x1D9C, mapped, "c"},
+	{0x1D9D, 0x1D9D, mapped, "\u0255"},
+	{0x1D9E, 0x1D9E, mapped, "\u00f0"},
+	{0x1D9F, 0x1D9F, mapped, "\u025c"},
+	{0x1DA0, 0x1DA0, mapped, "f"},
+	{0x1DA1, 0x1DA1, mapped, "\u025f"},
+	{0x1DA2, 0x1DA2, mapped, "\u0261"},
+	{0x1DA3, 0x1DA3, mapped, "\u0265"},
+	{0x1DA4, 0x1DA4, mapped, "\u0268"},
+	{0x1DA5, 0x1DA5, mapped, "\u0269"},
+	{0x1DA6, 0x1DA6, mapped, "\u026a"},
+	{0x1DA7, 0x1DA7, mapped, "\u1d7b"},
+	{0x1DA8, 0x1DA8, mapped, "\u029d"},
+	{0x1DA9, 0x1DA9, mapped, "\u026d"},
+	{0x1DAA, 0x1DAA, mapped, "\u1d85"},
+	{0x1DAB, 0x1DAB, mapped, "\u029f"},
+	{0x1DAC, 0x1DAC, mapped, "\u0271"},
+	{0x1DAD, 0x1DAD, mapped, "\u0270"},
+	{0x1DAE, 0x1DAE, mapped, "\u0272"},
+	{0x1DAF, 0x1DAF, mapped, "\u0273"},
+	{0x1DB0, 0x1DB0, mapped, "\u0274"},
+	{0x1DB1, 0x1DB1, mapped, "\u0275"},
+	{0x1DB2, 0x1DB2, mapped, "\u0278"},
+	{0x1DB3, 0x1DB3, mapped, "\u0282"},
+	{0x1DB4, 0x1DB4, mapped, "\u0283"},
+	{0x1DB5, 0x1DB5, mapped, "\u01ab"},
+	{0x1DB6, 0x1DB6, mapped, "\u0289"},
+	{0x1DB7, 0x1DB7, mapped, "\u028a"},
+	{0x1DB8, 0x1DB8, mapped, "\u1d1c"},
+	{0x1DB9, 0x1DB9, mapped, "\u028b"},
+	{0x1DBA, 0x1DBA, mapped, "\u028c"},
+	{0x1DBB, 0x1DBB, mapped, "z"},
+	{0x1DBC, 0x1DBC, mapped, "\u0290"},
+	{0x1DBD, 0x1DBD, mapped, "\u0291"},
+	{0x1DBE, 0x1DBE, mapped, "\u0292"},
+	{0x1DBF, 0x1DBF, mapped, "\u03b8"},
+	{0x1DC0, 0x1DFF, valid, ""},
+	{0x1E00, 0x1E00, mapped, "\u1e01"},
+	{0x1E01, 0x1E01, valid, ""},
+	{0x1E02, 0x1E02, mapped, "\u1e03"},
+	{0x1E03, 0x1E03, valid, ""},
+	{0x1E04, 0x1E04, mapped, "\u1e05"},
+	{0x1E05, 0x1E05, valid, ""},
+	{0x1E06, 0x1E06, mapped, "\u1e07"},
+	{0x1E07, 0x1E07, valid, ""},
+	{0x1E08, 0x1E08, mapped, "\u1e09"},
+	{0x1E09, 0x1E09, valid, ""},
+	{0x1E0A, 0x1E0A, mapped, "\u1e0b"},
+	{0x1E0B, 0x1E0B, valid, ""},
+	{0x1E0C, 0x1E0C, mapped, "\u1e0d"},
+	{0x1E0D, 0x1E0D, valid, ""},
+	{0x1E0E, 0x1E0E, mapped, "\u1e0f"},
+	{0x1E0F, 0x1E0F, valid, ""},
+	{0x1E10, 0x1E10, mapped, "\u1e11"},
+	{0x1E11, 0x1E11, valid, ""},
+	{0x1E12, 0x1E12, mapped, "\u1e13"},
+	{0x1E13, 0x1E13, valid, ""},
+	{0x1E14, 0x1E14, mapped, "\u1e15"},
+	{0x1E15, 0x1E15, valid, ""},
+	{0x1E16, 0x1E16, mapped, "\u1e17"},
+	{0x1E17, 0x1E17, valid, ""},
+	{0x1E18, 0x1E18, mapped, "\u1e19"},
+	{0x1E19, 0x1E19, valid, ""},
+	{0x1E1A, 0x1E1A, mapped, "\u1e1b"},
+	{0x1E1B, 0x1E1B, valid, ""},
+	{0x1E1C, 0x1E1C, mapped, "\u1e1d"},
+	{0x1E1D, 0x1E1D, valid, ""},
+	{0x1E1E, 0x1E1E, mapped, "\u1e1f"},
+	{0x1E1F, 0x1E1F, valid, ""},
+	{0x1E20, 0x1E20, mapped, "\u1e21"},
+	{0x1E21, 0x1E21, valid, ""},
+	{0x1E22, 0x1E22, mapped, "\u1e23"},
+	{0x1E23, 0x1E23, valid, ""},
+	{0x1E24, 0x1E24, mapped, "\u1e25"},
+	{0x1E25, 0x1E25, valid, ""},
+	{0x1E26, 0x1E26, mapped, "\u1e27"},
+	{0x1E27, 0x1E27, valid, ""},
+	{0x1E28, 0x1E28, mapped, "\u1e29"},
+	{0x1E29, 0x1E29, valid, ""},
+	{0x1E2A, 0x1E2A, mapped, "\u1e2b"},
+	{0x1E2B, 0x1E2B, valid, ""},
+	{0x1E2C, 0x1E2C, mapped, "\u1e2d"},
+	{0x1E2D, 0x1E2D, valid, ""},
+	{0x1E2E, 0x1E2E, mapped, "\u1e2f"},
+	{0x1E2F, 0x1E2F, valid, ""},
+	{0x1E30, 0x1E30, mapped, "\u1e31"},
+	{0x1E31, 0x1E31, valid, ""},
+	{0x1E32, 0x1E32, mapped, "\u1e33"},
+	{0x1E33, 0x1E33, valid, ""},
+	{0x1E34, 0x1E34, mapped, "\u1e35"},
+	{0x1E35, 0x1E35, valid, ""},
+	{0x1E36, 0x1E36, mapped, "\u1e37"},
+	{0x1E37, 0x1E37, valid, ""},
+	{0x1E38, 0x1E38, mapped, "\u1e39"},
+	{0x1E39, 0x1E39, valid, ""},
+	{0x1E3A, 0x1E3A, mapped, "\u1e3b"},
+	{0x1E3B, 0x1E3B, valid, ""},
+	{0x1E3C, 0x1E3C, mapped, "\u1e3d"},
+	{0x1E3D, 0x1E3D, valid, ""},
+	{0x1E3E, 0x1E3E, mapped, "\u1e3f"},
+	{0x1E3F, 0x1E3F, valid, ""},
+	{0x1E40, 0x1E40, mapped, "\u1e41"},
+	{0x1E41, 0x1E41, valid, ""},
+	{0x1E42, 0x1E42, mapped, "\u1e43"},
+	{0x1E43, 0x1E43, valid, ""},
+	{0x1E44, 0x1E44, mapped, "\u1e45"},
+	{0x1E45, 0x1E45, valid, ""},
+	{0x1E46, 0x1E46, mapped, "\u1e47"},
+	{0x1E47, 0x1E47, valid, ""},
+	{0x1E48, 0x1E48, mapped, "\u1e49"},
+	{0x1E49, 0x1E49, valid, ""},
+	{0x1E4A, 0x1E4A, mapped, "\u1e4b"},
+	{0x1E4B, 0x1E4B, valid, ""},
+	{0x1E4C, 0x1E4C, mapped, "\u1e4d"},
+	{0x1E4D, 0x1E4D, valid, ""},
+	{0x1E4E, 0x1E4E, mapped, "\u1e4f"},
+	{0x1E4F, 0x1E4F, valid, ""},
+	{0x1E50, 0x1E50, mapped, "\u1e51"},
+	{0x1E51, 0x1E51, valid, ""},
+	{0x1E52, 0x1E52, mapped, "\u1e53"},
+	{0x1E53, 0x1E53, valid, ""},
+	{0x1E54, 0x1E54, mapped, "\u1e55"},
+	{0x1E55, 0x1E55, valid, ""},
+	{0x1E56, 0x1E56, mapped, "\u1e57"},
+	{0x1E57, 0x1E57, valid, ""},
+	{0x1E58, 0x1E58, mapped, "\u1e59"},
+	{0x1E59, 0x1E59, valid, ""},
+	{0x1E5A, 0x1E5A, mapped, "\u1e5b"},
+	{0x1E5B, 0x1E5B, valid, ""},
+	{0x1E5C, 0x1E5C, mapped, "\u1e5d"},
+	{0x1E5D, 0x1E5D, valid, ""},
+	{0x1E5E, 0x1E5E, mapped, "\u1e5f"},
+	{0x1E5F, 0x1E5F, valid, ""},
+	{0x1E60, 0x1E60, mapped, "\u1e61"},
+	{0x1E61, 0x1E61, valid, ""},
+	{0x1E62, 0x1E62, mapped, "\u1e63"},
+	{0x1E63, 0x1E63, valid, ""},
+	{0x1E64, 0x1E64, mapped, "\u1e65"},
+	{0x1E65, 0x1E65, valid, ""},
+	{0x1E66, 0x1E66, mapped, "\u1e67"},
+	{0x1E67, 0x1E67, valid, ""},
+	{0x1E68, 0x1E68, mapped, "\u1e69"},
+	{0x1E69, 0x1E69, valid, ""},
+	{0x1E6A, 0x1E6A, mapped, "\u1e6b"},
+	{0x1E6B, 0x1E6B, valid, ""},
+	{0x1E6C, 0x1E6C, mapped, "\u1e6d"},
+	{0x1E6D, 0x1E6D, valid, ""},
+	{0x1E6E, 0x1E6E, mapped, "\u1e6f"},
+	{0x1E6F, 0x1E6F, valid, ""},
+	{0x1E70, 0x1E70, mapped, "\u1e71"},
+	{0x1E71, 0x1E71, valid, ""},
+	{0x1E72, 0x1E72, mapped, "\u1e73"},
+	{0x1E73, 0x1E73, valid, ""},
+	{0x1E74, 0x1E74, mapped, "\u1e75"},
+	{0x1E75, 0x1E75, valid, ""},
+	{0x1E76, 0x1E76, mapped, "\u1e77"},
+	{0x1E77, 0x1E77, valid, ""},
+	{0x1E78, 0x1E78, mapped, "\u1e79"},
+	{0x1E79, 0x1E79, valid, ""},
+	{0x1E7A, 0x1E7A, mapped, "\u1e7b"},
+	{0x1E7B, 0x1E7B, valid, ""},
+	{0x1E7C, 0x1E7C, mapped, "\u1e7d"},
+	{0x1E7D, 0x1E7D, valid, ""},
+	{0x1E7E, 0x1E7E, mapped, "\u1e7f"},
+	{0x1E7F, 0x1E7F, valid, ""},
+	{0x1E80, 0x1E80, mapped, "\u1e81"},
+	{0x1E81, 0x1E81, valid, ""},
+	{0x1E82, 0x1E82, mapped, "\u1e83"},
+	{0x1E83, 0x1E83, valid, ""},
+	{0x1E84, 0x1E84, mapped, "\u1e85"},
+	{0x1E85, 0x1E85, valid, ""},
+	{0x1E86, 0x1E86, mapped, "\u1e87"},
+	{0x1E87, 0x1E87, valid, ""},
+	{0x1E88, 0x1E88, mapped, "\u1e89"},
+	{0x1E89, 0x1E89, valid, ""},
+	{0x1E8A, 0x1E8A, mapped, "\u1e8b"},
+	{0x1E8B, 0x1E8B, valid, ""},
+	{0x1E8C, 0x1E8C, mapped, "\u1e8d"},
+	{0x1E8D, 0x1E8D, valid, ""},
+	{0x1E8E, 0x1E8E, mapped, "\u1e8f"},
+	{0x1E8F, 0x1E8F, valid, ""},
+	{0x1E90, 0x1E90, mapped, "\u1e91"},
+	{0x1E91, 0x1E91, valid, ""},
+	{0x1E92, 0x1E92, mapped, "\u1e93"},
+	{0x1E93, 0x1E93, valid, ""},
+	{0x1E94, 0x1E94, mapped, "\u1e95"},
+	{0x1E95, 0x1E99, valid, ""},
+	{0x1E9A, 0x1E9A, mapped, "a\u02be"},
+	{0x1E9B, 0x1E9B, mapped, "\u1e61"},
+	{0x1E9C, 0x1E9D, valid, ""},
+	{0x1E9E, 0x1E9E, mapped, "\u00df"},
+	{0x1E9F, 0x1E9F, valid, ""},
+	{0x1EA0, 0x1EA0, mapped, "\u1ea1"},
+	{0x1EA1, 0x1EA1, valid, ""},
+	{0x1EA2, 0x1EA2, mapped, "\u1ea3"},
+	{0x1EA3, 0x1EA3, valid, ""},
+	{0x1EA4, 0x1EA4, mapped, "\u1ea5"},
+	{0x1EA5, 0x1EA5, valid, ""},
+	{0x1EA6, 0x1EA6, mapped, "\u1ea7"},
+	{0x1EA7, 0x1EA7, valid, ""},
+	{0x1EA8, 0x1EA8, mapped, "\u1ea9"},
+	{0x1EA9, 0x1EA9, valid, ""},
+	{0x1EAA, 0x1EAA, mapped, "\u1eab"},
+	{0x1EAB, 0x1EAB, valid, ""},
+	{0x1EAC, 0x1EAC, mapped, "\u1ead"},
+	{0x1EAD, 0x1EAD, valid, ""},
+	{0x1EAE, 0x1EAE, mapped, "\u1eaf"},
+	{0x1EAF, 0x1EAF, valid, ""},
+	{0x1EB0, 0x1EB0, mapped, "\u1eb1"},
+	{0x1EB1, 0x1EB1, valid, ""},
+	{0x1EB2, 0x1EB2, mapped, "\u1eb3"},
+	{0x1EB3, 0x1EB3, valid, ""},
+	{0x1EB4, 0x1EB4, mapped, "\u1eb5"},
+	{0x1EB5, 0x1EB5, valid, ""},
+	{0x1EB6, 0x1EB6, mapped, "\u1eb7"},
+	{0x1EB7, 0x1EB7, valid, ""},
+	{0x1EB8, 0x1EB8, mapped, "\u1eb9"},
+	{0x1EB9, 0x1EB9, valid, ""},
+	{0x1EBA, 0x1EBA, mapped, "\u1ebb"},
+	{0x1EBB, 0x1EBB, valid, ""},
+	{0x1EBC, 0x1EBC, mapped, "\u1ebd"},
+	{0x1EBD, 0x1EBD, valid, ""},
+	{0x1EBE, 0x1EBE, mapped, "\u1ebf"},
+	{0x1EBF, 0x1EBF, valid, ""},
+	{0x1EC0, 0x1EC0, mapped, "\u1ec1"},
+	{0x1EC1, 0x1EC1, valid, ""},
+	{0x1EC2, 0x1EC2, mapped, "\u1ec3"},
+	{0x1EC3, 0x1EC3, valid, ""},
+	{0x1EC4, 0x1EC4, mapped, "\u1ec5"},
+	{0x1EC5, 0x1EC5, valid, ""},
+	{0x1EC6, 0x1EC6, mapped, "\u1ec7"},
+	{0x1EC7, 0x1EC7, valid, ""},
+	{0x1EC8, 0x1EC8, mapped, "\u1ec9"},
+	{0x1EC9, 0x1EC9, valid, ""},
+	{0x1ECA, 0x1ECA, mapped, "\u1ecb"},
+	{0x1ECB, 0x1ECB, valid, ""},
+	{0x1ECC, 0x1ECC, mapped, "\u1ecd"},
+	{0x1ECD, 0x1ECD, valid, ""},
+	{0x1ECE, 0x1ECE, mapped, "\u1ecf"},
+	{0x1ECF, 0x1ECF, valid, ""},
+	{0x1ED0, 0x1ED0, mapped, "\u1ed1"},
+	{0x1ED1, 0x1ED1, valid, ""},
+	{0x1ED2, 0x1ED2, mapped, "\u1ed3"},
+	{0x1ED3, 0x1ED3, valid, ""},
+	{0x1ED4, 0x1ED4, mapped, "\u1ed5"},
+	{0x1ED5, 0x1ED5, valid, ""},
+	{0x1ED6, 0x1ED6, mapped, "\u1ed7"},
+	{0x1ED7, 0x1ED7, valid, ""},
+	{0x1ED8, 0x1ED8, mapped, "\u1ed9"},
+	{0x1ED9, 0x1ED9, valid, ""},
+	{0x1EDA, 0x1EDA, mapped, "\u1edb"},
+	{0x1EDB, 0x1EDB, valid, ""},
+	{0x1EDC, 0x1EDC, mapped, "\u1edd"},
+	{0x1EDD, 0x1EDD, valid, ""},
+	{0x1EDE, 0x1EDE, mapped, "\u1edf"},
+	{0x1EDF, 0x1EDF, valid, ""},
+	{0x1EE0, 0x1EE0, mapped, "\u1ee1"},
+	{0x1EE1, 0x1EE1, valid, ""},
+	{0x1EE2, 0x1EE2, mapped, "\u1ee3"},
+	{0x1EE3, 0x1EE3, valid, ""},
+	{0x1EE4, 0x1EE4, mapped, "\u1ee5"},
+	{0x1EE5, 0x1EE5, valid, ""},
+	{0x1EE6, 0x1EE6, mapped, "\u1ee7"},
+	{0x1EE7, 0x1EE7, valid, ""},
+	{0x1EE8, 0x1EE8, mapped, "\u1ee9"},
+	{0x1EE9, 0x1EE9, valid, ""},
+	{0x1EEA, 0x1EEA, mapped, "\u1eeb"},
+	{0x1EEB, 0x1EEB, valid, ""},
+	{0x1EEC, 0x1EEC, mapped, "\u1eed"},
+	{0x1EED, 0x1EED, valid, ""},
+	{0x1EEE, 0x1EEE, mapped, "\u1eef"},
+	{0x1EEF, 0x1EEF, valid, ""},
+	{0x1EF0, 0x1EF0, mapped, "\u1ef1"},
+	{0x1EF1, 0x1EF1, valid, ""},
+	{0x1EF2, 0x1EF2, mapped, "\u1ef3"},
+	{0x1EF3, 0x1EF3, valid, ""},
+	{0x1EF4, 0x1EF4, mapped, "\u1ef5"},
+	{0x1EF5, 0x1EF5, valid, ""},
+	{0x1EF6, 0x1EF6, mapped, "\u1ef7"},
+	{0x1EF7, 0x1EF7, valid, ""},
+	{0x1EF8, 0x1EF8, mapped, "\u1ef9"},
+	{0x1EF9, 0x1EF9, valid, ""},
+	{0x1EFA, 0x1EFA, mapped, "\u1efb"},
+	{0x1EFB, 0x1EFB, valid, ""},
+	{0x1EFC, 0x1EFC, mapped, "\u1efd"},
+	{0x1EFD, 0x1EFD, valid, ""},
+	{0x1EFE, 0x1EFE, mapped, "\u1eff"},
+	{0x1EFF, 0x1F07, valid, ""},
+	{0x1F08, 0x1F08, mapped, "\u1f00"},
+	{0x1F09, 0x1F09, mapped, "\u1f01"},
+	{0x1F0A, 0x1F0A, mapped, "\u1f02"},
+	{0x1F0B, 0x1F0B, mapped, "\u1f03"},
+	{0x1F0C, 0x1F0C, mapped, "\u1f04"},
+	{0x1F0D, 0x1F0D, mapped, "\u1f05"},
+	{0x1F0E, 0x1F0E, mapped, "\u1f06"},
+	{0x1F0F, 0x1F0F, mapped, "\u1f07"},
+	{0x1F10, 0x1F15, valid, ""},
+	{0x1F16, 0x1F17, disallowed, ""},
+	{0x1F18, 0x1F18, mapped, "\u1f10"},
+	{0x1F19, 0x1F19, mapped, "\u1f11"},
+	{0x1F1A, 0x1F1A, mapped, "\u1f12"},
+	{0x1F1B, 0x1F1B, mapped, "\u1f13"},
+	{0x1F1C, 0x1F1C, mapped, "\u1f14"},
+	{0x1F1D, 0x1F1D, mapped, "\u1f15"},
+	{0x1F1E, 0x1F1F, disallowed, ""},
+	{0x1F20, 0x1F27, valid, ""},
+	{0x1F28, 0x1F28, mapped, "\u1f20"},
+	{0x1F29, 0x1F29, mapped, "\u1f21"},
+	{0x1F2A, 0x1F2A, mapped, "\u1f22"},
+	{0x1F2B, 0x1F2B, mapped, "\u1f23"},
+	{0x1F2C, 0x1F2C, mapped, "\u1f24"},
+	{0x1F2D, 0x1F2D, mapped, "\u1f25"},
+	{0x1F2E, 0x1F2E, mapped, "\u1f26"},
+	{0x1F2F, 0x1F2F, mapped, "\u1f27"},
+	{0x1F30, 0x1F37, valid, ""},
+	{0x1F38, 0x1F38, mapped, "\u1f30"},
+	{0x1F39, 0x1F39, mapped, "\u1f31"},
+	{0x1F3A, 0x1F3A, mapped, "\u1f32"},
+	{0x1F3B, 0x1F3B, mapped, "\u1f33"},
+	{0x1F3C, 0x1F3C, mapped, "\u1f34"},
+	{0x1F3D, 0x1F3D, mapped, "\u1f35"},
+	{0x1F3E, 0x1F3E, mapped, "\u1f36"},
+	{0x1F3F, 0x1F3F, mapped, "\u1f37"},
+	{0x1F40, 0x1F45, valid, ""},
+	{0x1F46, 0x1F47, disallowed, ""},
+	{0x1F48, 0x1F48, mapped, "\u1f40"},
+	{0x1F49, 0x1F49, mapped, "\u1f41"},
+	{0x1F4A, 0x1F4A, mapped, "\u1f42"},
+	{0x1F4B, 0x1F4B, mapped, "\u1f43"},
+	{0x1F4C, 0x1F4C, mapped, "\u1f44"},
+	{0x1F4D, 0x1F4D, mapped, "\u1f45"},
+	{0x1F4E, 0x1F4F, disallowed, ""},
+	{0x1F50, 0x1F57, valid, ""},
+	{0x1F58, 0x1F58, disallowed, ""},
+	{0x1F59, 0x1F59, mapped, "\u1f51"},
+	{0x1F5A, 0x1F5A, disallowed, ""},
+	{0x1F5B, 0x1F5B, mapped, "\u1f53"},
+	{0x1F5C, 0x1F5C, disallowed, ""},
+	{0x1F5D, 0x1F5D, mapped, "\u1f55"},
+	{0x1F5E, 0x1F5E, disallowed, ""},
+	{0x1F5F, 0x1F5F, mapped, "\u1f57"},
+	{0x1F60, 0x1F67, valid, ""},
+	{0x1F68, 0x1F68, mapped, "\u1f60"},
+	{0x1F69, 0x1F69, mapped, "\u1f61"},
+	{0x1F6A, 0x1F6A, mapped, "\u1f62"},
+	{0x1F6B, 0x1F6B, mapped, "\u1f63"},
+	{0x1F6C, 0x1F6C, mapped, "\u1f64"},
+	{0x1F6D, 0x1F6D, mapped, "\u1f65"},
+	{0x1F6E, 0x1F6E, mapped, "\u1f66"},
+	{0x1F6F, 0x1F6F, mapped, "\u1f67"},
+	{0x1F70, 0x1F70, valid, ""},
+	{0x1F71, 0x1F71, mapped, "\u03ac"},
+	{0x1F72, 0x1F72, valid, ""},
+	{0x1F73, 0x1F73, mapped, "\u03ad"},
+	{0x1F74, 0x1F74, valid, ""},
+	{0x1F75, 0x1F75, mapped, "\u03ae"},
+	{0x1F76, 0x1F76, valid, ""},
+	{0x1F77, 0x1F77, mapped, "\u03af"},
+	{0x1F78, 0x1F78, valid, ""},
+	{0x1F79, 0x1F79, mapped, "\u03cc"},
+	{0x1F7A, 0x1F7A, valid, ""},
+	{0x1F7B, 0x1F7B, mapped, "\u03cd"},
+	{0x1F7C, 0x1F7C, valid, ""},
+	{0x1F7D, 0x1F7D, mapped, "\u03ce"},
+	{0x1F7E, 0x1F7F, disallowed, ""},
+	{0x1F80, 0x1F80, mapped, "\u1f00\u03b9"},
+	{0x1F81, 0x1F81, mapped, "\u1f01\u03b9"},
+	{0x1F82, 0x1F82, mapped, "\u1f02\u03b9"},
+	{0x1F83, 0x1F83, mapped, "\u1f03\u03b9"},
+	{0x1F84, 0x1F84, mapped, "\u1f04\u03b9"},
+	{0x1F85, 0x1F85, mapped, "\u1f05\u03b9"},
+	{0x1F86, 0x1F86, mapped, "\u1f06\u03b9"},
+	{0x1F87, 0x1F87, mapped, "\u1f07\u03b9"},
+	{0x1F88, 0x1F88, mapped, "\u1f00\u03b9"},
+	{0x1F89, 0x1F89, mapped, "\u1f01\u03b9"},
+	{0x1F8A, 0x1F8A, mapped, "\u1f02\u03b9"},
+	{0x1F8B, 0x1F8B, mapped, "\u1f03\u03b9"},
+	{0x1F8C, 0x1F8C, mapped, "\u1f04\u03b9"},
+	{0x1F8D, 0x1F8D, mapped, "\u1f05\u03b9"},
+	{0x1F8E, 0x1F8E, mapped, "\u1f06\u03b9"},
+	{0x1F8F, 0x1F8F, mapped, "\u1f07\u03b9"},
+	{0x1F90, 0x1F90, mapped, "\u1f20\u03b9"},
+	{0x1F91, 0x1F91, mapped, "\u1f21\u03b9"},
+	{0x1F92, 0x1F92, mapped, "\u1f22\u03b9"},
+	{0x1F93, 0x1F93, mapped, "\u1f23\u03b9"},
+	{0x1F94, 0x1F94, mapped, "\u1f24\u03b9"},
+	{0x1F95, 0x1F95, mapped, "\u1f25\u03b9"},
+	{0x1F96, 0x1F96, mapped, "\u1f26\u03b9"},
+	{0x1F97, 0x1F97, mapped, "\u1f27\u03b9"},
+	{0x1F98, 0x1F98, mapped, "\u1f20\u03b9"},
+	{0x1F99, 0x1F99, mapped, "\u1f21\u03b9"},
+	{0x1F9A, 0x1F9A, mapped, "\u1f22\u03b9"},
+	{0x1F9B, 0x1F9B, mapped, "\u1f23\u03b9"},
+	{0x1F9C, 0x1F9C, mapped, "\u1f24\u03b9"},
+	{0x1F9D, 0x1F9D, mapped, "\u1f25\u03b9"},
+	{0x1F9E, 0x1F9E, mapped, "\u1f26\u03b9"},
+	{0x1F9F, 0x1F9F, mapped, "\u1f27\u03b9"},
+	{0x1FA0, 0x1FA0, mapped, "\u1f60\u03b9"},
+	{0x1FA1, 0x1FA1, mapped, "\u1f61\u03b9"},
+	{0x1FA2, 0x1FA2, mapped, "\u1f62\u03b9"},
+	{0x1FA3, 0x1FA3, mapped, "\u1f63\u03b9"},
+	{0x1FA4, 0x1FA4, mapped, "\u1f64\u03b9"},
+	{0x1FA5, 0x1FA5, mapped, "\u1f65\u03b9"},
+	{0x1FA6, 0x1FA6, mapped, "\u1f66\u03b9"},
+	{0x1FA7, 0x1FA7, mapped, "\u1f67\u03b9"},
+	{0x1FA8, 0x1FA8, mapped, "\u1f60\u03b9"},
+	{0x1FA9, 0x1FA9, mapped, "\u1f61\u03b9"},
+	{0x1FAA, 0x1FAA, mapped, "\u1f62\u03b9"},
+	{0x1FAB, 0x1FAB, mapped, "\u1f63\u03b9"},
+	{0x1FAC, 0x1FAC, mapped, "\u1f64\u03b9"},
+	{0x1FAD, 0x1FAD, mapped, "\u1f65\u03b9"},
+	{0x1FAE, 0x1FAE, mapped, "\u1f66\u03b9"},
+	{0x1FAF, 0x1FAF, mapped, "\u1f67\u03b9"},
+	{0x1FB0, 0x1FB1, valid, ""},
+	{0x1FB2, 0x1FB2, mapped, "\u1f70\u03b9"},
+	{0x1FB3, 0x1FB3, mapped, "\u03b1\u03b9"},
+	{0x1FB4, 0x1FB4, mapped, "\u03ac\u03b9"},
+	{0x1FB5, 0x1FB5, disallowed, ""},
+	{0x1FB6, 0x1FB6, valid, ""},
+	{0x1FB7, 0x1FB7, mapped, "\u1fb6\u03b9"},
+	{0x1FB8, 0x1FB8, mapped, "\u1fb0"},
+	{0x1FB9, 0x1FB9, mapped, "\u1fb1"},
+	{0x1FBA, 0x1FBA, mapped, "\u1f70"},
+	{0x1FBB, 0x1FBB, mapped, "\u03ac"},
+	{0x1FBC, 0x1FBC, mapped, "\u03b1\u03b9"},
+	{0x1FBD, 0x1FBD, disallowedSTD3Mapped, " \u0313"},
+	{0x1FBE, 0x1FBE, mapped, "\u03b9"},
+	{0x1FBF, 0x1FBF, disallowedSTD3Mapped, " \u0313"},
+	{0x1FC0, 0x1FC0, disallowedSTD3Mapped, " \u0342"},
+	{0x1FC1, 0x1FC1, disallowedSTD3Mapped, " \u0308\u0342"},
+	{0x1FC2, 0x1FC2, mapped, "\u1f74\u03b9"},
+	{0x1FC3, 0x1FC3, mapped, "\u03b7\u03b9"},
+	{0x1FC4, 0x1FC4, mapped, "\u03ae\u03b9"},
+	{0x1FC5, 0x1FC5, disallowed, ""},
+	{0x1FC6, 0x1FC6, valid, ""},
+	{0x1FC7, 0x1FC7, mapped, "\u1fc6\u03b9"},
+	{0x1FC8, 0x1FC8, mapped, "\u1f72"},
+	{0x1FC9, 0x1FC9, mapped, "\u03ad"},
+	{0x1FCA, 0x1FCA, mapped, "\u1f74"},
+	{0x1FCB, 0x1FCB, mapped, "\u03ae"},
+	{0x1FCC, 0x1FCC, mapped, "\u03b7\u03b9"},
+	{0x1FCD, 0x1FCD, disallowedSTD3Mapped, " \u0313\u0300"},
+	{0x1FCE, 0x1FCE, disallowedSTD3Mapped, " \u0313\u0301"},
+	{0x1FCF, 0x1FCF, disallowedSTD3Mapped, " \u0313\u0342"},
+	{0x1FD0, 0x1FD2, valid, ""},
+	{0x1FD3, 0x1FD3, mapped, "\u0390"},
+	{0x1FD4, 0x1FD5, disallowed, ""},
+	{0x1FD6, 0x1FD7, valid, ""},
+	{0x1FD8, 0x1FD8, mapped, "\u1fd0"},
+	{0x1FD9, 0x1FD9, mapped, "\u1fd1"},
+	{0x1FDA, 0x1FDA, mapped, "\u1f76"},
+	{0x1FDB, 0x1FDB, mapped, "\u03af"},
+	{0x1FDC, 0x1FDC, disallowed, ""},
+	{0x1FDD, 0x1FDD, disallowedSTD3Mapped, " \u0314\u0300"},
+	{0x1FDE, 0x1FDE, disallowedSTD3Mapped, " \u0314\u0301"},
+	{0x1FDF, 0x1FDF, disallowedSTD3Mapped, " \u0314\u0342"},
+	{0x1FE0, 0x1FE2, valid, ""},
+	{0x1FE3, 0x1FE3, mapped, "\u03b0"},
+	{0x1FE4, 0x1FE7, valid, ""},
+	{0x1FE8, 0x1FE8, mapped, "\u1fe0"},
+	{0x1FE9, 0x1FE9, mapped, "\u1fe1"},
+	{0x1FEA, 0x1FEA, mapped, "\u1f7a"},
+	{0x1FEB, 0x1FEB, mapped, "\u03cd"},
+	{0x1FEC, 0x1FEC, mapped, "\u1fe5"},
+	{0x1FED, 0x1FED, disallowedSTD3Mapped, " \u0308\u0300"},
+	{0x1FEE, 0x1FEE, disallowedSTD3Mapped, " \u0308\u0301"},
+	{0x1FEF, 0x1FEF, disallowedSTD3Mapped, "`"},
+	{0x1FF0, 0x1FF1, disallowed, ""},
+	{0x1FF2, 0x1FF2, mapped, "\u1f7c\u03b9"},
+	{0x1FF3, 0x1FF3, mapped, "\u03c9\u03b9"},
+	{0x1FF4, 0x1FF4, mapped, "\u03ce\u03b9"},
+	{0x1FF5, 0x1FF5, disallowed, ""},
+	{0x1FF6, 0x1FF6, valid, ""},
+	{0x1FF7, 0x1FF7, mapped, "\u1ff6\u03b9"},
+	{0x1FF8, 0x1FF8, mapped, "\u1f78"},
+	{0x1FF9, 0x1FF9, mapped, "\u03cc"},
+	{0x1FFA, 0x1FFA, mapped, "\u1f7c"},
+	{0x1FFB, 0x1FFB, mapped, "\u03ce"},
+	{0x1FFC, 0x1FFC, mapped, "\u03c9\u03b9"},
+	{0x1FFD, 0x1FFD, disallowedSTD3Mapped, " \u0301"},
+	{0x1FFE, 0x1FFE, disallowedSTD3Mapped, " \u0314"},
+	{0x1FFF, 0x1FFF, disallowed, ""},
+	{0x2000, 0x2000, disallowedSTD3Mapped, " "},
+	{0x2001, 0x2001, disallowedSTD3Mapped, " "},
+	{0x2002, 0x2002, disallowedSTD3Mapped, " "},
+	{0x2003, 0x2003, disallowedSTD3Mapped, " "},
+	{0x2004, 0x2004, disallowedSTD3Mapped, " "},
+	{0x2005, 0x2005, disallowedSTD3Mapped, " "},
+	{0x2006, 0x2006, disallowedSTD3Mapped, " "},
+	{0x2007, 0x2007, disallowedSTD3Mapped, " "},
+	{0x2008, 0x2008, disallowedSTD3Mapped, " "},
+	{0x2009, 0x2009, disallowedSTD3Mapped, " "},
+	{0x200A, 0x200A, disallowedSTD3Mapped, " "},
+	{0x200B, 0x200B, ignored, ""},
+	{0x200C, 0x200C, deviation, ""},
+	{0x200D, 0x200D, deviation, ""},
+	{0x200E, 0x200F, disallowed, ""},
+	{0x2010, 0x2010, valid, ""},
+	{0x2011, 0x2011, mapped, "\u2010"},
+	{0x2012, 0x2016, valid, ""},
+	{0x2017, 0x2017, disallowedSTD3Mapped, " \u0333"},
+	{0x2018, 0x2023, valid, ""},
+	{0x2024, 0x2026, disallowed, ""},
+	{0x2027, 0x2027, valid, ""},
+	{0x2028, 0x202E, disallowed, ""},
+	{0x202F, 0x202F, disallowedSTD3Mapped, " "},
+	{0x2030, 0x2032, valid, ""},
+	{0x2033, 0x2033, mapped, "\u2032\u2032"},
+	{0x2034, 0x2034, mapped, "\u2032\u2032\u2032"},
+	{0x2035, 0x2035, valid, ""},
+	{0x2036, 0x2036, mapped, "\u2035\u2035"},
+	{0x2037, 0x2037, mapped, "\u2035\u2035\u2035"},
+	{0x2038, 0x203B, valid, ""},
+	{0x203C, 0x203C, disallowedSTD3Mapped, "!!"},
+	{0x203D, 0x203D, valid, ""},
+	{0x203E, 0x203E, disallowedSTD3Mapped, " \u0305"},
+	{0x203F, 0x2046, valid, ""},
+	{0x2047, 0x2047, disallowedSTD3Mapped, "??"},
+	{0x2048, 0x2048, disallowedSTD3Mapped, "?!"},
+	{0x2049, 0x2049, disallowedSTD3Mapped, "!?"},
+	{0x204A, 0x2056, valid, ""},
+	{0x2057, 0x2057, mapped, "\u2032\u2032\u2032\u2032"},
+	{0x2058, 0x205E, valid, ""},
+	{0x205F, 0x205F, disallowedSTD3Mapped, " "},
+	{0x2060, 0x2064, ignored, ""},
+	{0x2065, 0x2069, disallowed, ""},
+	{0x206A, 0x206F, ignored, ""},
+	{0x2070, 0x2070, mapped, "0"},
+	{0x2071, 0x2071, mapped, "i"},
+	{0x2072, 0x2073, disallowed, ""},
+	{0x2074, 0x2074, mapped, "4"},
+	{0x2075, 0x2075, mapped, "5"},
+	{0x2076, 0x2076, mapped, "6"},
+	{0x2077, 0x2077, mapped, "7"},
+	{0x2078, 0x2078, mapped, "8"},
+	{0x2079, 0x2079, mapped, "9"},
+	{0x207A, 0x207A, disallowedSTD3Mapped, "+"},
+	{0x207B, 0x207B, mapped, "\u2212"},
+	{0x207C, 0x207C, disallowedSTD3Mapped, "="},
+	{0x207D, 0x207D, disallowedSTD3Mapped, "("},
+	{0x207E, 0x207E, disallowedSTD3Mapped, ")"},
+	{0x207F, 0x207F, mapped, "n"},
+	{0x2080, 0x2080, mapped, "0"},
+	{0x2081, 0x2081, mapped, "1"},
+	{0x2082, 0x2082, mapped, "2"},
+	{0x2083, 0x2083, mapped, "3"},
+	{0x2084, 0x2084, mapped, "4"},
+	{0x2085, 0x2085, mapped, "5"},
+	{0x2086, 0x2086, mapped, "6"},
+	{0x2087, 0x2087, mapped, "7"},
+	{0x2088, 0x2088, mapped, "8"},
+	{0x2089, 0x2089, mapped, "9"},
+	{0x208A, 0x208A, disallowedSTD3Mapped, "+"},
+	{0x208B, 0x208B, mapped, "\u2212"},
+	{0x208C, 0x208C, disallowedSTD3Mapped, "="},
+	{0x208D, 0x208D, disallowedSTD3Mapped, "("},
+	{0x208E, 0x208E, disallowedSTD3Mapped, ")"},
+	{0x208F, 0x208F, disallowed, ""},
+	{0x2090, 0x2090, mapped, "a"},
+	{0x2091, 0x2091, mapped, "e"},
+	{0x2092, 0x2092, mapped, "o"},
+	{0x2093, 0x2093, mapped, "x"},
+	{0x2094, 0x2094, mapped, "\u0259"},
+	{0x2095, 0x2095, mapped, "h"},
+	{0x2096, 0x2096, mapped, "k"},
+	{0x2097, 0x2097, mapped, "l"},
+	{0x2098, 0x2098, mapped, "m"},
+	{0x2099, 0x2099, mapped, "n"},
+	{0x209A, 0x209A, mapped, "p"},
+	{0x209B, 0x209B, mapped, "s"},
+	{0x209C, 0x209C, mapped, "t"},
+	{0x209D, 0x209F, disallowed, ""},
+	{0x20A0, 0x20A7, valid, ""},
+	{0x20A8, 0x20A8, mapped, "rs"},
+	{0x20A9, 0x20C1, valid, ""},
+	{0x20C2, 0x20CF, disallowed, ""},
+	{0x20D0, 0x20F0, valid, ""},
+	{0x20F1, 0x20FF, disallowed, ""},
+	{0x2100, 0x2100, disallowedSTD3Mapped, "a/c"},
+	{0x2101, 0x2101, disallowedSTD3Mapped, "a/s"},
+	{0x2102, 0x2102, mapped, "c"},
+	{0x2103, 0x2103, mapped, "\u00b0c"},
+	{0x2104, 0x2104, valid, ""},
+	{0x2105, 0x2105, disallowedSTD3Mapped, "c/o"},
+	{0x2106, 0x2106, disallowedSTD3Mapped, "c/u"},
+	{0x2107, 0x2107, mapped, "\u025b"},
+	{0x2108, 0x2108, valid, ""},
+	{0x2109, 0x2109, mapped, "\u00b0f"},
+	{0x210A, 0x210A, mapped, "g"},
+	{0x210B, 0x210B, mapped, "h"},
+	{0x210C, 0x210C, mapped, "h"},
+	{0x210D, 0x210D, mapped, "h"},
+	{0x210E, 0x210E, mapped, "h"},
+	{0x210F, 0x210F, mapped, "\u0127"},
+	{0x2110, 0x2110, mapped, "i"},
+	{0x2111, 0x2111, mapped, "i"},
+	{0x2112, 0x2112, mapped, "l"},
+	{0x2113, 0x2113, mapped, "l"},
+	{0x2114, 0x2114, valid, ""},
+	{0x2115, 0x2115, mapped, "n"},
+	{0x2116, 0x2116, mapped, "no"},
+	{0x2117, 0x2118, valid, ""},
+	{0x2119, 0x2119, mapped, "p"},
+	{0x211A, 0x211A, mapped, "q"},
+	{0x211B, 0x211B, mapped, "r"},
+	{0x211C, 0x211C, mapped, "r"},
+	{0x211D, 0x211D, mapped, "r"},
+	{0x211E, 0x211F, valid, ""},
+	{0x2120, 0x2120, mapped, "sm"},
+	{0x2121, 0x2121, mapped, "tel"},
+	{0x2122, 0x2122, mapped, "tm"},
+	{0x2123, 0x2123, valid, ""},
+	{0x2124, 0x2124, mapped, "z"},
+	{0x2125, 0x2125, valid, ""},
+	{0x2126, 0x2126, mapped, "\u03c9"},
+	{0x2127, 0x2127, valid, ""},
+	{0x2128, 0x2128, mapped, "z"},
+	{0x2129, 0x2129, valid, ""},
+	{0x212A, 0x212A, mapped, "k"},
+	{0x212B, 0x212B, mapped, "\u00e5"},
+	{0x212C, 0x212C, mapped, "b"},
+	{0x212D, 0x212D, mapped, "c"},
+	{0x212E, 0x212E, valid, ""},
+	{0x212F, 0x212F, mapped, "e"},
+	{0x2130, 0x2130, mapped, "e"},
+	{0x2131, 0x2131, mapped, "f"},
+	{0x2132, 0x2132, mapped, "\u214e"},
+	{0x2133, 0x2133, mapped, "m"},
+	{0x2134, 0x2134, mapped, "o"},
+	{0x2135, 0x2135, mapped, "\u05d0"},
+	{0x2136, 0x2136, mapped, "\u05d1"},
+	{0x2137, 0x2137, mapped, "\u05d2"},
+	{0x2138, 0x2138, mapped, "\u05d3"},
+	{0x2139, 0x2139, mapped, "i"},
+	{0x213A, 0x213A, valid, ""},
+	{0x213B, 0x213B, mapped, "fax"},
+	{0x213C, 0x213C, mapped, "\u03c0"},
+	{0x213D, 0x213D, mapped, "\u03b3"},
+	{0x213E, 0x213E, mapped, "\u03b3"},
+	{0x213F, 0x213F, mapped, "\u03c0"},
+	{0x2140, 0x2140, mapped, "\u2211"},
+	{0x2141, 0x2144, valid, ""},
+	{0x2145, 0x2145, mapped, "d"},
+	{0x2146, 0x2146, mapped, "d"},
+	{0x2147, 0x2147, mapped, "e"},
+	{0x2148, 0x2148, mapped, "i"},
+	{0x2149, 0x2149, mapped, "j"},
+	{0x214A, 0x214F, valid, ""},
+	{0x2150, 0x2150, mapped, "1\u20447"},
+	{0x2151, 0x2151, mapped, "1\u20449"},
+	{0x2152, 0x2152, mapped, "1\u204410"},
+	{0x2153, 0x2153, mapped, "1\u20443"},
+	{0x2154, 0x2154, mapped, "2\u20443"},
+	{0x2155, 0x2155, mapped, "1\u20445"},
+	{0x2156, 0x2156, mapped, "2\u20445"},
+	{0x2157, 0x2157, mapped, "3\u20445"},
+	{0x2158, 0x2158, mapped, "4\u20445"},
+	{0x2159, 0x2159, mapped, "1\u20446"},
+	{0x215A, 0x215A, mapped, "5\u20446"},
+	{0x215B, 0x215B, mapped, "1\u20448"},
+	{0x215C, 0x215C, mapped, "3\u20448"},
+	{0x215D, 0x215D, mapped, "5\u20448"},
+	{0x215E, 0x215E, mapped, "7\u20448"},
+	{0x215F, 0x215F, mapped, "1\u2044"},
+	{0x2160, 0x2160, mapped, "i"},
+	{0x2161, 0x2161, mapped, "ii"},
+	{0x2162, 0x2162, mapped, "iii"},
+	{0x2163, 0x2163, mapped, "iv"},
+	{0x2164, 0x2164, mapped, "v"},
+	{0x2165, 0x2165, mapped, "vi"},
+	{0x2166, 0x2166, mapped, "vii"},
+	{0x2167, 0x2167, mapped, "viii"},
+	{0x2168, 0x2168, mapped, "ix"},
+	{0x2169, 0x2169, mapped, "x"},
+	{0x216A, 0x216A, mapped, "xi"},
+	{0x216B, 0x216B, mapped, "xii"},
+	{0x216C, 0x216C, mapped, "l"},
+	{0x216D, 0x216D, mapped, "c"},
+	{0x216E, 0x216E, mapped, "d"},
+	{0x216F, 0x216F, mapped, "m"},
+	{0x2170, 0x2170, mapped, "i"},
+	{0x2171, 0x2171, mapped, "ii"},
+	{0x2172, 0x2172, mapped, "iii"},
+	{0x2173, 0x2173, mapped, "iv"},
+	{0x2174, 0x2174, mapped, "v"},
+	{0x2175, 0x2175, mapped, "vi"},
+	{0x2176, 0x2176, mapped, "vii"},
+	{0x2177, 0x2177, mapped, "viii"},
+	{0x2178, 0x2178, mapped, "ix"},
+	{0x2179, 0x2179, mapped, "x"},
+	{0x217A, 0x217A, mapped, "xi"},
+	{0x217B, 0x217B, mapped, "xii"},
+	{0x217C, 0x217C, mapped, "l"},
+	{0x217D, 0x217D, mapped, "c"},
+	{0x217E, 0x217E, mapped, "d"},
+	{0x217F, 0x217F, mapped, "m"},
+	{0x2180, 0x2182, valid, ""},
+	{0x2183, 0x2183, mapped, "\u2184"},
+	{0x2184, 0x2188, valid, ""},
+	{0x2189, 0x2189, mapped, "0\u20443"},
+	{0x218A, 0x218B, valid, ""},
+	{0x218C, 0x218F, disallowed, ""},
+	{0x2190, 0x222B, valid, ""},
+	{0x222C, 0x222C, mapped, "\u222b\u222b"},
+	{0x222D, 0x222D, mapped, "\u222b\u222b\u222b"},
+	{0x222E, 0x222E, valid, ""},
+	{0x222F, 0x222F, mapped, "\u222e\u222e"},
+	{0x2230, 0x2230, mapped, "\u222e\u222e\u222e"},
+	{0x2231, 0x2328, valid, ""},
+	{0x2329, 0x2329, mapped, "\u3008"},
+	{0x232A, 0x232A, mapped, "\u3009"},
+	{0x232B, 0x2429, valid, ""},
+	{0x242A, 0x243F, disallowed, ""},
+	{0x2440, 0x244A, valid, ""},
+	{0x244B, 0x245F, disallowed, ""},
+	{0x2460, 0x2460, mapped, "1"},
+	{0x2461, 0x2461, mapped, "2"},
+	{0x2462, 0x2462, mapped, "3"},
+	{0x2463, 0x2463, mapped, "4"},
+	{0x2464, 0x2464, mapped, "5"},
+	{0x2465, 0x2465, mapped, "6"},
+	{0x2466, 0x2466, mapped, "7"},
+	{0x2467, 0x2467, mapped, "8"},
+	{0x2468, 0x2468, mapped, "9"},
+	{0x2469, 0x2469, mapped, "10"},
+	{0x246A, 0x246A, mapped, "11"},
+	{0x246B, 0x246B, mapped, "12"},
+	{0x246C, 0x246C, mapped, "13"},
+	{0x246D, 0x246D, mapped, "14"},
+	{0x246E, 0x246E, mapped, "15"},
+	{0x246F, 0x246F, mapped, "16"},
+	{0x2470, 0x2470, mapped, "17"},
+	{0x2471, 0x2471, mapped, "18"},
+	{0x2472, 0x2472, mapped, "19"},
+	{0x2473, 0x2473, mapped, "20"},
+	{0x2474, 0x2474, disallowedSTD3Mapped, "(1)"},
+	{0x2475, 0x2475, disallowedSTD3Mapped, "(2)"},
+	{0x2476, 0x2476, disallowedSTD3Mapped, "(3)"},
+	{0x2477, 0x2477, disallowedSTD3Mapped, "(4)"},
+	{0x2478, 0x2478, disallowedSTD3Mapped, "(5)"},
+	{0x2479, 0x2479, disallowedSTD3Mapped, "(6)"},
+	{0x247A, 0x247A, disallowedSTD3Mapped, "(7)"},
+	{0x247B, 0x247B, disallowedSTD3Mapped, "(8)"},
+	{0x247C, 0x247C, disallowedSTD3Mapped, "(9)"},
+	{0x247D, 0x247D, disallowedSTD3Mapped, "(10)"},
+	{0x247E, 0x247E, disallowedSTD3Mapped, "(11)"},
+	{0x247F, 0x247F, disallowedSTD3Mapped, "(12)"},
+	{0x2480, 0x2480, disallowedSTD3Mapped, "(13)"},
+	{0x2481, 0x2481, disallowedSTD3Mapped, "(14)"},
+	{0x2482, 0x2482, disallowedSTD3Mapped, "(15)"},
+	{0x2483, 0x2483, disallowedSTD3Mapped, "(16)"},
+	{0x2484, 0x2484, disallowedSTD3Mapped, "(17)"},
+	{0x2485, 0x2485, disallowedSTD3Mapped, "(18)"},
+	{0x2486, 0x2486, disallowedSTD3Mapped, "(19)"},
+	{0x2487, 0x2487, disallowedSTD3Mapped, "(20)"},
+	{0x2488, 0x249B, disallowed, ""},
+	{0x249C, 0x249C, disallowedSTD3Mapped, "(a)"},
+	{0x249D, 0x249D, disallowedSTD3Mapped, "(b)"},
+	{0x249E, 0x249E, disallowedSTD3Mapped, "(c)"},
+	{0x249F, 0x249F, disallowedSTD3Mapped, "(d)"},
+	{0x24A0, 0x24A0, disallowedSTD3Mapped, "(e)"},
+	{0x24A1, 0x24A1, disallowedSTD3Mapped, "(f)"},
+	{0x24A2, 0x24A2, disallowedSTD3Mapped, "(g)"},
+	{0x24A3, 0x24A3, disallowedSTD3Mapped, "(h)"},
+	{0x24A4, 0x24A4, disallowedSTD3Mapped, "(i)"},
+	{0x24A5, 0x24A5, disallowedSTD3Mapped, "(j)"},
+	{0x24A6, 0x24A6, disallowedSTD3Mapped, "(k)"},
+	{0x24A7, 0x24A7, disallowedSTD3Mapped, "(l)"},
+	{0x24A8, 0x24A8, disallowedSTD3Mapped, "(m)"},
+	{0x24A9, 0x24A9, disallowedSTD3Mapped, "(n)"},
+	{0x24AA, 0x24AA, disallowedSTD3Mapped, "(o)"},
+	{0x24AB, 0x24AB, disallowedSTD3Mapped, "(p)"},
+	{0x24AC, 0x24AC, disallowedSTD3Mapped, "(q)"},
+	{0x24AD, 0x24AD, disallowedSTD3Mapped, "(r)"},
+	{0x24AE, 0x24AE, disallowedSTD3Mapped, "(s)"},
+	{0x24AF, 0x24AF, disallowedSTD3Mapped, "(t)"},
+	{0x24B0, 0x24B0, disallowedSTD3Mapped, "(u)"},
+	{0x24B1, 0x24B1, disallowedSTD3Mapped, "(v)"},
+	{0x24B2, 0x24B2, disallowedSTD3Mapped, "(w)"},
+	{0x24B3, 0x24B3, disallowedSTD3Mapped, "(x)"},
+	{0x24B4, 0x24B4, disallowedSTD3Mapped, "(y)"},
+	{0x24B5, 0x24B5, disallowedSTD3Mapped, "(z)"},
+	{0x24B6, 0x24B6, mapped, "a"},
+	{0x24B7, 0x24B7, mapped, "b"},
+	{0x24B8, 0x24B8, mapped, "c"},
+	{0x24B9, 0x24B9, mapped, "d"},
+	{0x24BA, 0x24BA, mapped, "e"},
+	{0x24BB, 0x24BB, mapped, "f"},
+	{0x24BC, 0x24BC, mapped, "g"},
+	{0x24BD, 0x24BD, mapped, "h"},
+	{0x24BE, 0x24BE, mapped, "i"},
+	{0x24BF, 0x24BF, mapped, "j"},
+	{0x24C0, 0x24C0, mapped, "k"},
+	{0x24C1, 0x24C1, mapped, "l"},
+	{0x24C2, 0x24C2, mapped, "m"},
+	{0x24C3, 0x24C3, mapped, "n"},
+	{0x24C4, 0x24C4, mapped, "o"},
+	{0x24C5, 0x24C5, mapped, "p"},
+	{0x24C6, 0x24C6, mapped, "q"},
+	{0x24C7, 0x24C7, mapped, "r"},
+	{0x24C8, 0x24C8, mapped, "s"},
+	{0x24C9, 0x24C9, mapped, "t"},
+	{0x24CA, 0x24CA, mapped, "u"},
+	{0x24CB, 0x24CB, mapped, "v"},
+	{0x24CC, 0x24CC, mapped, "w"},
+	{0x24CD, 0x24CD, mapped, "x"},
+	{0x24CE, 0x24CE, mapped, "y"},
+	{0x24CF, 0x24CF, mapped, "z"},
+	{0x24D0, 0x24D0, mapped, "a"},
+	{0x24D1, 0x24D1, mapped, "b"},
+	{0x24D2, 0x24D2, mapped, "c"},
+	{0x24D3, 0x24D3, mapped, "d"},
+	{0x24D4, 0x24D4, mapped, "e"},
+	{0x24D5, 0x24D5, mapped, "f"},
+	{0x24D6, 0x24D6, mapped, "g"},
+	{0x24D7, 0x24D7, mapped, "h"},
+	{0x24D8, 0x24D8, mapped, "i"},
+	{0x24D9, 0x24D9, mapped, "j"},
+	{0x24DA, 0x24DA, mapped, "k"},
+	{0x24DB, 0x24DB, mapped, "l"},
+	{0x24DC, 0x24DC, mapped, "m"},
+	{0x24DD, 0x24DD, mapped, "n"},
+	{0x24DE, 0x24DE, mapped, "o"},
+	{0x24DF, 0x24DF, mapped, "p"},
+	{0x24E0, 0x24E0, mapped, "q"},
+	{0x24E1, 0x24E1, mapped, "r"},
+	{0x24E2, 0x24E2, mapped, "s"},
+	{0x24E3, 0x24E3, mapped, "t"},
+	{0x24E4, 0x24E4, mapped, "u"},
+	{0x24E5, 0x24E5, mapped, "v"},
+	{0x24E6, 0x24E6, mapped, "w"},
+	{0x24E7, 0x24E7, mapped, "x"},
+	{0x24E8, 0x24E8, mapped, "y"},
+	{0x24E9, 0x24E9, mapped, "z"},
+	{0x24EA, 0x24EA, mapped, "0"},
+	{0x24EB, 0x2A0B, valid, ""},
+	{0x2A0C, 0x2A0C, mapped, "\u222b\u222b\u222b\u222b"},
+	{0x2A0D, 0x2A73, valid, ""},
+	{0x2A74, 0x2A74, disallowedSTD3Mapped, "::="},
+	{0x2A75, 0x2A75, disallowedSTD3Mapped, "=="},
+	{0x2A76, 0x2A76, disallowedSTD3Mapped, "==="},
+	{0x2A77, 0x2ADB, valid, ""},
+	{0x2ADC, 0x2ADC, mapped, "\u2add\u0338"},
+	{0x2ADD, 0x2B73, valid, ""},
+	{0x2B74, 0x2B75, disallowed, ""},
+	{0x2B76, 0x2BFF, valid, ""},
+	{0x2C00, 0x2C00, mapped, "\u2c30"},
+	{0x2C01, 0x2C01, mapped, "\u2c31"},
+	{0x2C02, 0x2C02, mapped, "\u2c32"},
+	{0x2C03, 0x2C03, mapped, "\u2c33"},
+	{0x2C04, 0x2C04, mapped, "\u2c34"},
+	{0x2C05, 0x2C05, mapped, "\u2c35"},
+	{0x2C06, 0x2C06, mapped, "\u2c36"},
+	{0x2C07, 0x2C07, mapped, "\u2c37"},
+	{0x2C08, 0x2C08, mapped, "\u2c38"},
+	{0x2C09, 0x2C09, mapped, "\u2c39"},
+	{0x2C0A, 0x2C0A, mapped, "\u2c3a"},
+	{0x2C0B, 0x2C0B, mapped, "\u2c3b"},
+	{0x2C0C, 0x2C0C, mapped, "\u2c3c"},
+	{0x2C0D, 0x2C0D, mapped, "\u2c3d"},
+	{0x2C0E, 0x2C0E, mapped, "\u2c3e"},
+	{0x2C0F, 0x2C0F, mapped, "\u2c3f"},
+	{0x2C10, 0x2C10, mapped, "\u2c40"},
+	{0x2C11, 0x2C11, mapped, "\u2c41"},
+	{0x2C12, 0x2C12, mapped, "\u2c42"},
+	{0x2C13, 0x2C13, mapped, "\u2c43"},
+	{0x2C14, 0x2C14, mapped, "\u2c44"},
+	{0x2C15, 0x2C15, mapped, "\u2c45"},
+	{0x2C16, 0x2C16, mapped, "\u2c46"},
+	{0x2C17, 0x2C17, mapped, "\u2c47"},
+	{0x2C18, 0x2C18, mapped, "\u2c48"},
+	{0x2C19, 0x2C19, mapped, "\u2c49"},
+	{0x2C1A, 0x2C1A, mapped, "\u2c4a"},
+	{0x2C1B, 0x2C1B, mapped, "\u2c4b"},
+	{0x2C1C, 0x2C1C, mapped, "\u2c4c"},
+	{0x2C1D, 0x2C1D, mapped, "\u2c4d"},
+	{0x2C1E, 0x2C1E, mapped, "\u2c4e"},
+	{0x2C1F, 0x2C1F, mapped, "\u2c4f"},
+	{0x2C20, 0x2C20, mapped, "\u2c50"},
+	{0x2C21, 0x2C21, mapped, "\u2c51"},
+	{0x2C22, 0x2C22, mapped, "\u2c52"},
+	{0x2C23, 0x2C23, mapped, "\u2c53"},
+	{0x2C24, 0x2C24, mapped, "\u2c54"},
+	{0x2C25, 0x2C25, mapped, "\u2c55"},
+	{0x2C26, 0x2C26, mapped, "\u2c56"},
+	{0x2C27, 0x2C27, mapped, "\u2c57"},
+	{0x2C28, 0x2C28, mapped, "\u2c58"},
+	{0x2C29, 0x2C29, mapped, "\u2c59"},
+	{0x2C2A, 0x2C2A, mapped, "\u2c5a"},
+	{0x2C2B, 0x2C2B, mapped, "\u2c5b"},
+	{0x2C2C, 0x2C2C, mapped, "\u2c5c"},
+	{0x2C2D, 0x2C2D, mapped, "\u2c5d"},
+	{0x2C2E, 0x2C2E, mapped, "\u2c5e"},
+	{0x2C2F, 0x2C2F, mapped, "\u2c5f"},
+	{0x2C30, 0x2C5F, valid, ""},
+	{0x2C60, 0x2C60, mapped, "\u2c61"},
+	{0x2C61, 0x2C61, valid, ""},
+	{0x2C62, 0x2C62, mapped, "\u026b"},
+	{0x2C63, 0x2C63, mapped, "\u1d7d"},
+	{0x2C64, 0x2C64, mapped, "\u027d"},
+	{0x2C65, 0x2C66, valid, ""},
+	{0x2C67, 0x2C67, mapped, "\u2c68"},
+	{0x2C68, 0x2C68, valid, ""},
+	{0x2C69, 0x2C69, mapped, "\u2c6a"},
+	{0x2C6A, 0x2C6A, valid, ""},
+	{0x2C6B, 0x2C6B, mapped, "\u2c6c"},
+	{0x2C6C, 0x2C6C, valid, ""},
+	{0x2C6D, 0x2C6D, mapped, "\u0251"},
+	{0x2C6E, 0x2C6E, mapped, "\u0271"},
+	{0x2C6F, 0x2C6F, mapped, "\u0250"},
+	{0x2C70, 0x2C70, mapped, "\u0252"},
+	{0x2C71, 0x2C71, valid, ""},
+	{0x2C72, 0x2C72, mapped, "\u2c73"},
+	{0x2C73, 0x2C74, valid, ""},
+	{0x2C75, 0x2C75, mapped, "\u2c76"},
+	{0x2C76, 0x2C7B, valid, ""},
+	{0x2C7C, 0x2C7C, mapped, "j"},
+	{0x2C7D, 0x2C7D, mapped, "v"},
+	{0x2C7E, 0x2C7E, mapped, "\u023f"},
+	{0x2C7F, 0x2C7F, mapped, "\u0240"},
+	{0x2C80, 0x2C80, mapped, "\u2c81"},
+	{0x2C81, 0x2C81, valid, ""},
+	{0x2C82, 0x2C82, mapped, "\u2c83"},
+	{0x2C83, 0x2C83, valid, ""},
+	{0x2C84, 0x2C84, mapped, "\u2c85"},
+	{0x2C85, 0x2C85, valid, ""},
+	{0x2C86, 0x2C86, mapped, "\u2c87"},
+	{0x2C87, 0x2C87, valid, ""},
+	{0x2C88, 0x2C88, mapped, "\u2c89"},
+	{0x2C89, 0x2C89, valid, ""},
+	{0x2C8A, 0x2C8A, mapped, "\u2c8b"},
+	{0x2C8B, 0x2C8B, valid, ""},
+	{0x2C8C, 0x2C8C, mapped, "\u2c8d"},
+	{0x2C8D, 0x2C8D, valid, ""},
+	{0x2C8E, 0x2C8E, mapped, "\u2c8f"},
+	{0x2C8F, 0x2C8F, valid, ""},
+	{0x2C90, 0x2C90, mapped, "\u2c91"},
+	{0x2C91, 0x2C91, valid, ""},
+	{0x2C92, 0x2C92, mapped, "\u2c93"},
+	{0x2C93, 0x2C93, valid, ""},
+	{0x2C94, 0x2C94, mapped, "\u2c95"},
+	{0x2C95, 0x2C95, valid, ""},
+	{0x2C96, 0x2C96, mapped, "\u2c97"},
+	{0x2C97, 0x2C97, valid, ""},
+	{0x2C98, 0x2C98, mapped, "\u2c99"},
+	{0x2C99, 0x2C99, valid, ""},
+	{0x2C9A, 0x2C9A, mapped, "\u2c9b"},
+	{0x2C9B, 0x2C9B, valid, ""},
+	{0x2C9C, 0x2C9C, mapped, "\u2c9d"},
+	{0x2C9D, 0x2C9D, valid, ""},
+	{0x2C9E, 0x2C9E, mapped, "\u2c9f"},
+	{0x2C9F, 0x2C9F, valid, ""},
+	{0x2CA0, 0x2CA0, mapped, "\u2ca1"},
+	{0x2CA1, 0x2CA1, valid, ""},
+	{0x2CA2, 0x2CA2, mapped, "\u2ca3"},
+	{0x2CA3, 0x2CA3, valid, ""},
+	{0x2CA4, 0x2CA4, mapped, "\u2ca5"},
+	{0x2CA5, 0x2CA5, valid, ""},
+	{0x2CA6, 0x2CA6, mapped, "\u2ca7"},
+	{0x2CA7, 0x2CA7, valid, ""},
+	{0x2CA8, 0x2CA8, mapped, "\u2ca9"},
+	{0x2CA9, 0x2CA9, valid, ""},
+	{0x2CAA, 0x2CAA, mapped, "\u2cab"},
+	{0x2CAB, 0x2CAB, valid, ""},
+	{0x2CAC, 0x2CAC, mapped, "\u2cad"},
+	{0x2CAD, 0x2CAD, valid, ""},
+	{0x2CAE, 0x2CAE, mapped, "\u2caf"},
+	{0x2CAF, 0x2CAF, valid, ""},
+	{0x2CB0, 0x2CB0, mapped, "\u2cb1"},
+	{0x2CB1, 0x2CB1, valid, ""},
+	{0x2CB2, 0x2CB2, mapped, "\u2cb3"},
+	{0x2CB3, 0x2CB3, valid, ""},
+	{0x2CB4, 0x2CB4, mapped, "\u2cb5"},
+	{0x2CB5, 0x2CB5, valid, ""},
+	{0x2CB6, 0x2CB6, mapped, "\u2cb7"},
+	{0x2CB7, 0x2CB7, valid, ""},
+	{0x2CB8, 0x2CB8, mapped, "\u2cb9"},
+	{0x2CB9, 0x2CB9, valid, ""},
+	{0x2CBA, 0x2CBA, mapped, "\u2cbb"},
+	{0x2CBB, 0x2CBB, valid, ""},
+	{0x2CBC, 0x2CBC, mapped, "\u2cbd"},
+	{0x2CBD, 0x2CBD, valid, ""},
+	{0x2CBE, 0x2CBE, mapped, "\u2cbf"},
+	{0x2CBF, 0x2CBF, valid, ""},
+	{0x2CC0, 0x2CC0, mapped, "\u2cc1"},
+	{0x2CC1, 0x2CC1, valid, ""},
+	{0x2CC2, 0x2CC2, mapped, "\u2cc3"},
+	{0x2CC3, 0x2CC3, valid, ""},
+	{0x2CC4, 0x2CC4, mapped, "\u2cc5"},
+	{0x2CC5, 0x2CC5, valid, ""},
+	{0x2CC6, 0x2CC6, mapped, "\u2cc7"},
+	{0x2CC7, 0x2CC7, valid, ""},
+	{0x2CC8, 0x2CC8, mapped, "\u2cc9"},
+	{0x2CC9, 0x2CC9, valid, ""},
+	{0x2CCA, 0x2CCA, mapped, "\u2ccb"},
+	{0x2CCB, 0x2CCB, valid, ""},
+	{0x2CCC, 0x2CCC, mapped, "\u2ccd"},
+	{0x2CCD, 0x2CCD, valid, ""},
+	{0x2CCE, 0x2CCE, mapped, "\u2ccf"},
+	{0x2CCF, 0x2CCF, valid, ""},
+	{0x2CD0, 0x2CD0, mapped, "\u2cd1"},
+	{0x2CD1, 0x2CD1, valid, ""},
+	{0x2CD2, 0x2CD2, mapped, "\u2cd3"},
+	{0x2CD3, 0x2CD3, valid, ""},
+	{0x2CD4, 0x2CD4, mapped, "\u2cd5"},
+	{0x2CD5, 0x2CD5, valid, ""},
+	{0x2CD6, 0x2CD6, mapped, "\u2cd7"},
+	{0x2CD7, 0x2CD7, valid, ""},
+	{0x2CD8, 0x2CD8, mapped, "\u2cd9"},
+	{0x2CD9, 0x2CD9, valid, ""},
+	{0x2CDA, 0x2CDA, mapped, "\u2cdb"},
+	{0x2CDB, 0x2CDB, valid, ""},
+	{0x2CDC, 0x2CDC, mapped, "\u2cdd"},
+	{0x2CDD, 0x2CDD, valid, ""},
+	{0x2CDE, 0x2CDE, mapped, "\u2cdf"},
+	{0x2CDF, 0x2CDF, valid, ""},
+	{0x2CE0, 0x2CE0, mapped, "\u2ce1"},
+	{0x2CE1, 0x2CE1, valid, ""},
+	{0x2CE2, 0x2CE2, mapped, "\u2ce3"},
+	{0x2CE3, 0x2CEA, valid, ""},
+	{0x2CEB, 0x2CEB, mapped, "\u2cec"},
+	{0x2CEC, 0x2CEC, valid, ""},
+	{0x2CED, 0x2CED, mapped, "\u2cee"},
+	{0x2CEE, 0x2CF1, valid, ""},
+	{0x2CF2, 0x2CF2, mapped, "\u2cf3"},
+	{0x2CF3, 0x2CF3, valid, ""},
+	{0x2CF4, 0x2CF8, disallowed, ""},
+	{0x2CF9, 0x2D25, valid, ""},
+	{0x2D26, 0x2D26, disallowed, ""},
+	{0x2D27, 0x2D27, valid, ""},
+	{0x2D28, 0x2D2C, disallowed, ""},
+	{0x2D2D, 0x2D2D, valid, ""},
+	{0x2D2E, 0x2D2F, disallowed, ""},
+	{0x2D30, 0x2D67, valid, ""},
+	{0x2D68, 0x2D6E, disallowed, ""},
+	{0x2D6F, 0x2D6F, mapped, "\u2d61"},
+	{0x2D70, 0x2D70, valid, ""},
+	{0x2D71, 0x2D7E, disallowed, ""},
+	{0x2D7F, 0x2D96, valid, ""},
+	{0x2D97, 0x2D9F, disallowed, ""},
+	{0x2DA0, 0x2DA6, valid, ""},
+	{0x2DA7, 0x2DA7, disallowed, ""},
+	{0x2DA8, 0x2DAE, valid, ""},
+	{0x2DAF, 0x2DAF, disallowed, ""},
+	{0x2DB0, 0x2DB6, valid, ""},
+	{0x2DB7, 0x2DB7, disallowed, ""},
+	{0x2DB8, 0x2DBE, valid, ""},
+	{0x2DBF, 0x2DBF, disallowed, ""},
+	{0x2DC0, 0x2DC6, valid, ""},
+	{0x2DC7, 0x2DC7, disallowed, ""},
+	{0x2DC8, 0x2DCE, valid, ""},
+	{0x2DCF, 0x2DCF, disallowed, ""},
+	{0x2DD0, 0x2DD6, valid, ""},
+	{0x2DD7, 0x2DD7, disallowed, ""},
+	{0x2DD8, 0x2DDE, valid, ""},
+	{0x2DDF, 0x2DDF, disallowed, ""},
+	{0x2DE0, 0x2E5D, valid, ""},
+	{0x2E5E, 0x2E7F, disallowed, ""},
+	{0x2E80, 0x2E99, valid, ""},
+	{0x2E9A, 0x2E9A, disallowed, ""},
+	{0x2E9B, 0x2E9E, valid, ""},
+	{0x2E9F, 0x2E9F, mapped, "\u6bcd"},
+	{0x2EA0, 0x2EF2, valid, ""},
+	{0x2EF3, 0x2EF3, mapped, "\u9f9f"},
+	{0x2EF4, 0x2EFF, disallowed, ""},
+	{0x2F00, 0x2F00, mapped, "\u4e00"},
+	{0x2F01, 0x2F01, mapped, "\u4e28"},
+	{0x2F02, 0x2F02, mapped, "\u4e36"},
+	{0x2F03, 0x2F03, mapped, "\u4e3f"},
+	{0x2F04, 0x2F04, mapped, "\u4e59"},
+	{0x2F05, 0x2F05, mapped, "\u4e85"},
+	{0x2F06, 0x2F06, mapped, "\u4e8c"},
+	{0x2F07, 0x2F07, mapped, "\u4ea0"},
+	{0x2F08, 0x2F08, mapped, "\u4eba"},
+	{0x2F09, 0x2F09, mapped, "\u513f"},
+	{0x2F0A, 0x2F0A, mapped, "\u5165"},
+	{0x2F0B, 0x2F0B, mapped, "\u516b"},
+	{0x2F0C, 0x2F0C, mapped, "\u5182"},
+	{0x2F0D, 0x2F0D, mapped, "\u5196"},
+	{0x2F0E, 0x2F0E, mapped, "\u51ab"},
+	{0x2F0F, 0x2F0F, mapped, "\u51e0"},
+	{0x2F10, 0x2F10, mapped, "\u51f5"},
+	{0x2F11, 0x2F11, mapped, "\u5200"},
+	{0x2F12, 0x2F12, mapped, "\u529b"},
+	{0x2F13, 0x2F13, mapped, "\u52f9"},
+	{0x2F14, 0x2F14, mapped, "\u5315"},
+	{0x2F15, 0x2F15, mapped, "\u531a"},
+	{0x2F16, 0x2F16, mapped, "\u5338"},
+	{0x2F17, 0x2F17, mapped, "\u5341"},
+	{0x2F18, 0x2F18, mapped, "\u535c"},
+	{0x2F19, 0x2F19, mapped, "\u5369"},
+	{0x2F1A, 0x2F1A, mapped, "\u5382"},
+	{0x2F1B, 0x2F1B, mapped, "\u53b6"},
+	{0x2F1C, 0x2F1C, mapped, "\u53c8"},
+	{0x2F1D, 0x2F1D, mapped, "\u53e3"},
+	{0x2F1E, 0x2F1E, mapped, "\u56d7"},
+	{0x2F1F, 0x2F1F, mapped, "\u571f"},
+	{0x2F20, 0x2F20, mapped, "\u58eb"},
+	{0x2F21, 0x2F21, mapped, "\u5902"},
+	{0x2F22, 0x2F22, mapped, "\u590a"},
+	{0x2F23, 0x2F23, mapped, "\u5915"},
+	{0x2F24, 0x2F24, mapped, "\u5927"},
+	{0x2F25, 0x2F25, mapped, "\u5973"},
+	{0x2F26, 0x2F26, mapped, "\u5b50"},
+	{0x2F27, 0x2F27, mapped, "\u5b80"},
+	{0x2F28, 0x2F28, mapped, "\u5bf8"},
+	{0x2F29, 0x2F29, mapped, "\u5c0f"},
+	{0x2F2A, 0x2F2A, mapped, "\u5c22"},
+	{0x2F2B, 0x2F2B, mapped, "\u5c38"},
+	{0x2F2C, 0x2F2C, mapped, "\u5c6e"},
+	{0x2F2D, 0x2F2D, mapped, "\u5c71"},
+	{0x2F2E, 0x2F2E, mapped, "\u5ddb"},
+	{0x2F2F, 0x2F2F, mapped, "\u5de5"},
+	{0x2F30, 0x2F30, mapped, "\u5df1"},
+	{0x2F31, 0x2F31, mapped, "\u5dfe"},
+	{0x2F32, 0x2F32, mapped, "\u5e72"},
+	{0x2F33, 0x2F33, mapped, "\u5e7a"},
+	{0x2F34, 0x2F34, mapped, "\u5e7f"},
+	{0x2F35, 0x2F35, mapped, "\u5ef4"},
+	{0x2F36, 0x2F36, mapped, "\u5efe"},
+	{0x2F37, 0x2F37, mapped, "\u5f0b"},
+	{0x2F38, 0x2F38, mapped, "\u5f13"},
+	{0x2F39, 0x2F39, mapped, "\u5f50"},
+	{0x2F3A, 0x2F3A, mapped, "\u5f61"},
+	{0x2F3B, 0x2F3B, mapped, "\u5f73"},
+	{0x2F3C, 0x2F3C, mapped, "\u5fc3"},
+	{0x2F3D, 0x2F3D, mapped, "\u6208"},
+	{0x2F3E, 0x2F3E, mapped, "\u6236"},
+	{0x2F3F, 0x2F3F, mapped, "\u624b"},
+	{0x2F40, 0x2F40, mapped, "\u652f"},
+	{0x2F41, 0x2F41, mapped, "\u6534"},
+	{0x2F42, 0x2F42, mapped, "\u6587"},
+	{0x2F43, 0x2F43, mapped, "\u6597"},
+	{0x2F44, 0x2F44, mapped, "\u65a4"},
+	{0x2F45, 0x2F45, mapped, "\u65b9"},
+	{0x2F46, 0x2F46, mapped, "\u65e0"},
+	{0x2F47, 0x2F47, mapped, "\u65e5"},
+	{0x2F48, 0x2F48, mapped, "\u66f0"},
+	{0x2F49, 0x2F49, mapped, "\u6708"},
+	{0x2F4A, 0x2F4A, mapped, "\u6728"},
+	{0x2F4B, 0x2F4B, mapped, "\u6b20"},
+	{0x2F4C, 0x2F4C, mapped, "\u6b62"},
+	{0x2F4D, 0x2F4D, mapped, "\u6b79"},
+	{0x2F4E, 0x2F4E, mapped, "\u6bb3"},
+	{0x2F4F, 0x2F4F, mapped, "\u6bcb"},
+	{0x2F50, 0x2F50, mapped, "\u6bd4"},
+	{0x2F51, 0x2F51, mapped, "\u6bdb"},
+	{0x2F52, 0x2F52, mapped, "\u6c0f"},
+	{0x2F53, 0x2F53, mapped, "\u6c14"},
+	{0x2F54, 0x2F54, mapped, "\u6c34"},
+	{0x2F55, 0x2F55, mapped, "\u706b"},
+	{0x2F56, 0x2F56, mapped, "\u722a"},
+	{0x2F57, 0x2F57, mapped, "\u7236"},
+	{0x2F58, 0x2F58, mapped, "\u723b"},
+	{0x2F59, 0x2F59, mapped, "\u723f"},
+	{0x2F5A, 0x2F5A, mapped, "\u7247"},
+	{0x2F5B, 0x2F5B, mapped, "\u7259"},
+	{0x2F5C, 0x2F5C, mapped, "\u725b"},
+	{0x2F5D, 0x2F5D, mapped, "\u72ac"},
+	{0x2F5E, 0x2F5E, mapped, "\u7384"},
+	{0x2F5F, 0x2F5F, mapped, "\u7389"},
+	{0x2F60, 0x2F60, mapped, "\u74dc"},
+	{0x2F61, 0x2F61, mapped, "\u74e6"},
+	{0x2F62, 0x2F62, mapped, "\u7518"},
+	{0x2F63, 0x2F63, mapped, "\u751f"},
+	{0x2F64, 0x2F64, mapped, "\u7528"},
+	{0x2F65, 0x2F65, mapped, "\u7530"},
+	{0x2F66, 0x2F66, mapped, "\u758b"},
+	{0x2F67, 0x2F67, mapped, "\u7592"},
+	{0x2F68, 0x2F68, mapped, "\u7676"},
+	{0x2F69, 0x2F69, mapped, "\u767d"},
+	{0x2F6A, 0x2F6A, mapped, "\u76ae"},
+	{0x2F6B, 0x2F6B, mapped, "\u76bf"},
+	{0x2F6C, 0x2F6C, mapped, "\u76ee"},
+	{0x2F6D, 0x2F6D, mapped, "\u77db"},
+	{0x2F6E, 0x2F6E, mapped, "\u77e2"},
+	{0x2F6F, 0x2F6F, mapped, "\u77f3"},
+	{0x2F70, 0x2F70, mapped, "\u793a"},
+	{0x2F71, 0x2F71, mapped, "\u79b8"},
+	{0x2F72, 0x2F72, mapped, "\u79be"},
+	{0x2F73, 0x2F73, mapped, "\u7a74"},
+	{0x2F74, 0x2F74, mapped, "\u7acb"},
+	{0x2F75, 0x2F75, mapped, "\u7af9"},
+	{0x2F76, 0x2F76, mapped, "\u7c73"},
+	{0x2F77, 0x2F77, mapped, "\u7cf8"},
+	{0x2F78, 0x2F78, mapped, "\u7f36"},
+	{0x2F79, 0x2F79, mapped, "\u7f51"},
+	{0x2F7A, 0x2F7A, mapped, "\u7f8a"},
+	{0x2F7B, 0x2F7B, mapped, "\u7fbd"},
+	{0x2F7C, 0x2F7C, mapped, "\u8001"},
+	{0x2F7D, 0x2F7D, mapped, "\u800c"},
+	{0x2F7E, 0x2F7E, mapped, "\u8012"},
+	{0x2F7F, 0x2F7F, mapped, "\u8033"},
+	{0x2F80, 0x2F80, mapped, "\u807f"},
+	{0x2F81, 0x2F81, mapped, "\u8089"},
+	{0x2F82, 0x2F82, mapped, "\u81e3"},
+	{0x2F83, 0x2F83, mapped, "\u81ea"},
+	{0x2F84, 0x2F84, mapped, "\u81f3"},
+	{0x2F85, 0x2F85, mapped, "\u81fc"},
+	{0x2F86, 0x2F86, mapped, "\u820c"},
+	{0x2F87, 0x2F87, mapped, "\u821b"},
+	{0x2F88, 0x2F88, mapped, "\u821f"},
+	{0x2F89, 0x2F89, mapped, "\u826e"},
+	{0x2F8A, 0x2F8A, mapped, "\u8272"},
+	{0x2F8B, 0x2F8B, mapped, "\u8278"},
+	{0x2F8C, 0x2F8C, mapped, "\u864d"},
+	{0x2F8D, 0x2F8D, mapped, "\u866b"},
+	{0x2F8E, 0x2F8E, mapped, "\u8840"},
+	{0x2F8F, 0x2F8F, mapped, "\u884c"},
+	{0x2F90, 0x2F90, mapped, "\u8863"},
+	{0x2F91, 0x2F91, mapped, "\u897e"},
+	{0x2F92, 0x2F92, mapped, "\u898b"},
+	{0x2F93, 0x2F93, mapped, "\u89d2"},
+	{0x2F94, 0x2F94, mapped, "\u8a00"},
+	{0x2F95, 0x2F95, mapped, "\u8c37"},
+	{0x2F96, 0x2F96, mapped, "\u8c46"},
+	{0x2F97, 0x2F97, mapped, "\u8c55"},
+	{0x2F98, 0x2F98, mapped, "\u8c78"},
+	{0x2F99, 0x2F99, mapped, "\u8c9d"},
+	{0x2F9A, 0x2F9A, mapped, "\u8d64"},
+	{0x2F9B, 0x2F9B, mapped, "\u8d70"},
+	{0x2F9C, 0x2F9C, mapped, "\u8db3"},
+	{0x2F9D, 0x2F9D, mapped, "\u8eab"},
+	{0x2F9E, 0x2F9E, mapped, "\u8eca"},
+	{0x2F9F, 0x2F9F, mapped, "\u8f9b"},
+	{0x2FA0, 0x2FA0, mapped, "\u8fb0"},
+	{0x2FA1, 0x2FA1, mapped, "\u8fb5"},
+	{0x2FA2, 0x2FA2, mapped, "\u9091"},
+	{0x2FA3, 0x2FA3, mapped, "\u9149"},
+	{0x2FA4, 0x2FA4, mapped, "\u91c6"},
+	{0x2FA5, 0x2FA5, mapped, "\u91cc"},
+	{0x2FA6, 0x2FA6, mapped, "\u91d1"},
+	{0x2FA7, 0x2FA7, mapped, "\u9577"},
+	{0x2FA8, 0x2FA8, mapped, "\u9580"},
+	{0x2FA9, 0x2FA9, mapped, "\u961c"},
+	{0x2FAA, 0x2FAA, mapped, "\u96b6"},
+	{0x2FAB, 0x2FAB, mapped, "\u96b9"},
+	{0x2FAC, 0x2FAC, mapped, "\u96e8"},
+	{0x2FAD, 0x2FAD, mapped, "\u9751"},
+	{0x2FAE, 0x2FAE, mapped, "\u975e"},
+	{0x2FAF, 0x2FAF, mapped, "\u9762"},
+	{0x2FB0, 0x2FB0, mapped, "\u9769"},
+	{0x2FB1, 0x2FB1, mapped, "\u97cb"},
+	{0x2FB2, 0x2FB2, mapped, "\u97ed"},
+	{0x2FB3, 0x2FB3, mapped, "\u97f3"},
+	{0x2FB4, 0x2FB4, mapped, "\u9801"},
+	{0x2FB5, 0x2FB5, mapped, "\u98a8"},
+	{0x2FB6, 0x2FB6, mapped, "\u98db"},
+	{0x2FB7, 0x2FB7, mapped, "\u98df"},
+	{0x2FB8, 0x2FB8, mapped, "\u9996"},
+	{0x2FB9, 0x2FB9, mapped, "\u9999"},
+	{0x2FBA, 0x2FBA, mapped, "\u99ac"},
+	{0x2FBB, 0x2FBB, mapped, "\u9aa8"},
+	{0x2FBC, 0x2FBC, mapped, "\u9ad8"},
+	{0x2FBD, 0x2FBD, mapped, "\u9adf"},
+	{0x2FBE, 0x2FBE, mapped, "\u9b25"},
+	{0x2FBF, 0x2FBF, mapped, "\u9b2f"},
+	{0x2FC0, 0x2FC0, mapped, "\u9b32"},
+	{0x2FC1, 0x2FC1, mapped, "\u9b3c"},
+	{0x2FC2, 0x2FC2, mapped, "\u9b5a"},
+	{0x2FC3, 0x2FC3, mapped, "\u9ce5"},
+	{0x2FC4, 0x2FC4, mapped, "\u9e75"},
+	{0x2FC5, 0x2FC5, mapped, "\u9e7f"},
+	{0x2FC6, 0x2FC6, mapped, "\u9ea5"},
+	{0x2FC7, 0x2FC7, mapped, "\u9ebb"},
+	{0x2FC8, 0x2FC8, mapped, "\u9ec3"},
+	{0x2FC9, 0x2FC9, mapped, "\u9ecd"},
+	{0x2FCA, 0x2FCA, mapped, "\u9ed1"},
+	{0x2FCB, 0x2FCB, mapped, "\u9ef9"},
+	{0x2FCC, 0x2FCC, mapped, "\u9efd"},
+	{0x2FCD, 0x2FCD, mapped, "\u9f0e"},
+	{0x2FCE, 0x2FCE, mapped, "\u9f13"},
+	{0x2FCF, 0x2FCF, mapped, "\u9f20"},
+	{0x2FD0, 0x2FD0, mapped, "\u9f3b"},
+	{0x2FD1, 0x2FD1, mapped, "\u9f4a"},
+	{0x2FD2, 0x2FD2, mapped, "\u9f52"},
+	{0x2FD3, 0x2FD3, mapped, "\u9f8d"},
+	{0x2FD4, 0x2FD4, mapped, "\u9f9c"},
+	{0x2FD5, 0x2FD5, mapped, "\u9fa0"},
+	{0x2FD6, 0x2FFF, disallowed, ""},
+	{0x3000, 0x3000, disallowedSTD3Mapped, " "},
+	{0x3001, 0x3001, valid, ""},
+	{0x3002, 0x3002, mapped, "."},
+	{0x3003, 0x3035, valid, ""},
+	{0x3036, 0x3036, mapped, "\u3012"},
+	{0x3037, 0x3037, valid, ""},
+	{0x3038, 0x3038, mapped, "\u5341"},
+	{0x3039, 0x3039, mapped, "\u5344"},
+	{0x303A, 0x303A, mapped, "\u5345"},
+	{0x303B, 0x303F, valid, ""},
+	{0x3040, 0x3040, disallowed, ""},
+	{0x3041, 0x3096, valid, ""},
+	{0x3097, 0x3098, disallowed, ""},
+	{0x3099, 0x309A, valid, ""},
+	{0x309B, 0x309B, disallowedSTD3Mapped, " \u3099"},
+	{0x309C, 0x309C, disallowedSTD3Mapped, " \u309a"},
+	{0x309D, 0x309E, valid, ""},
+	{0x309F, 0x309F, mapped, "\u3088\u308a"},
+	{0x30A0, 0x30FE, valid, ""},
+	{0x30FF, 0x30FF, mapped, "\u30b3\u30c8"},
+	{0x3100, 0x3104, disallowed, ""},
+	{0x3105, 0x312F, valid, ""},
+	{0x3130, 0x3130, disallowed, ""},
+	{0x3131, 0x3131, mapped, "\u1100"},
+	{0x3132, 0x3132, mapped, "\u1101"},
+	{0x3133, 0x3133, mapped, "\u11aa"},
+	{0x3134, 0x3134, mapped, "\u1102"},
+	{0x3135, 0x3135, mapped, "\u11ac"},
+	{0x3136, 0x3136, mapped, "\u11ad"},
+	{0x3137, 0x3137, mapped, "\u1103"},
+	{0x3138, 0x3138, mapped, "\u1104"},
+	{0x3139, 0x3139, mapped, "\u1105"},
+	{0x313A, 0x313A, mapped, "\u11b0"},
+	{0x313B, 0x313B, mapped, "\u11b1"},
+	{0x313C, 0x313C, mapped, "\u11b2"},
+	{0x313D, 0x313D, mapped, "\u11b3"},
+	{0x313E, 0x313E, mapped, "\u11b4"},
+	{0x313F, 0x313F, mapped, "\u11b5"},
+	{0x3140, 0x3140, mapped, "\u111a"},
+	{0x3141, 0x3141, mapped, "\u1106"},
+	{0x3142, 0x3142, mapped, "\u1107"},
+	{0x3143, 0x3143, mapped, "\u1108"},
+	{0x3144, 0x3144, mapped, "\u1121"},
+	{0x3145, 0x3145, mapped, "\u1109"},
+	{0x3146, 0x3146, mapped, "\u110a"},
+	{0x3147, 0x3147, mapped, "\u110b"},
+	{0x3148, 0x3148, mapped, "\u110c"},
+	{0x3149, 0x3149, mapped, "\u110d"},
+	{0x314A, 0x314A, mapped, "\u110e"},
+	{0x314B, 0x314B, mapped, "\u110f"},
+	{0x314C, 0x314C, mapped, "\u1110"},
+	{0x314D, 0x314D, mapped, "\u1111"},
+	{0x314E, 0x314E, mapped, "\u1112"},
+	{0x314F, 0x314F, mapped, "\u1161"},
+	{0x3150, 0x3150, mapped, "\u1162"},
+	{0x3151, 0x3151, mapped, "\u1163"},
+	{0x3152, 0x3152, mapped, "\u1164"},
+	{0x3153, 0x3153, mapped, "\u1165"},
+	{0x3154, 0x3154, mapped, "\u1166"},
+	{0x3155, 0x3155, mapped, "\u1167"},
+	{0x3156, 0x3156, mapped, "\u1168"},
+	{0x3157, 0x3157, mapped, "\u1169"},
+	{0x3158, 0x3158, mapped, "\u116a"},
+	{0x3159, 0x3159, mapped, "\u116b"},
+	{0x315A, 0x315A, mapped, "\u116c"},
+	{0x315B, 0x315B, mapped, "\u116d"},
+	{0x315C, 0x315C, mapped, "\u116e"},
+	{0x315D, 0x315D, mapped, "\u116f"},
+	{0x315E, 0x315E, mapped, "\u1170"},
+	{0x315F, 0x315F, mapped, "\u1171"},
+	{0x3160, 0x3160, mapped, "\u1172"},
+	{0x3161, 0x3161, mapped, "\u1173"},
+	{0x3162, 0x3162, mapped, "\u1174"},
+	{0x3163, 0x3163, mapped, "\u1175"},
+	{0x3164, 0x3164, ignored, ""},
+	{0x3165, 0x3165, mapped, "\u1114"},
+	{0x3166, 0x3166, mapped, "\u1115"},
+	{0x3167, 0x3167, mapped, "\u11c7"},
+	{0x3168, 0x3168, mapped, "\u11c8"},
+	{0x3169, 0x3169, mapped, "\u11cc"},
+	{0x316A, 0x316A, mapped, "\u11ce"},
+	{0x316B, 0x316B, mapped, "\u11d3"},
+	{0x316C, 0x316C, mapped, "\u11d7"},
+	{0x316D, 0x316D, mapped, "\u11d9"},
+	{0x316E, 0x316E, mapped, "\u111c"},
+	{0x316F, 0x316F, mapped, "\u11dd"},
+	{0x3170, 0x3170, mapped, "\u11df"},
+	{0x3171, 0x3171, mapped, "\u111d"},
+	{0x3172, 0x3172, mapped, "\u111e"},
+	{0x3173, 0x3173, mapped, "\u1120"},
+	{0x3174, 0x3174, mapped, "\u1122"},
+	{0x3175, 0x3175, mapped, "\u1123"},
+	{0x3176, 0x3176, mapped, "\u1127"},
+	{0x3177, 0x3177, mapped, "\u1129"},
+	{0x3178, 0x3178, mapped, "\u112b"},
+	{0x3179, 0x3179, mapped, "\u112c"},
+	{0x317A, 0x317A, mapped, "\u112d"},
+	{0x317B, 0x317B, mapped, "\u112e"},
+	{0x317C, 0x317C, mapped, "\u112f"},
+	{0x317D, 0x317D, mapped, "\u1132"},
+	{0x317E, 0x317E, mapped, "\u1136"},
+	{0x317F, 0x317F, mapped, "\u1140"},
+	{0x3180, 0x3180, mapped, "\u1147"},
+	{0x3181, 0x3181, mapped, "\u114c"},
+	{0x3182, 0x3182, mapped, "\u11f1"},
+	{0x3183, 0x3183, mapped, "\u11f2"},
+	{0x3184, 0x3184, mapped, "\u1157"},
+	{0x3185, 0x3185, mapped, "\u1158"},
+	{0x3186, 0x3186, mapped, "\u1159"},
+	{0x3187, 0x3187, mapped, "\u1184"},
+	{0x3188, 0x3188, mapped, "\u1185"},
+	{0x3189, 0x3189, mapped, "\u1188"},
+	{0x318A, 0x318A, mapped, "\u1191"},
+	{0x318B, 0x318B, mapped, "\u1192"},
+	{0x318C, 0x318C, mapped, "\u1194"},
+	{0x318D, 0x318D, mapped, "\u119e"},
+	{0x318E, 0x318E, mapped, "\u11a1"},
+	{0x318F, 0x318F, disallowed, ""},
+	{0x3190, 0x3191, valid, ""},
+	{0x3192, 0x3192, mapped, "\u4e00"},
+	{0x3193, 0x3193, mapped, "\u4e8c"},
+	{0x3194, 0x3194, mapped, "\u4e09"},
+	{0x3195, 0x3195, mapped, "\u56db"},
+	{0x3196, 0x3196, mapped, "\u4e0a"},
+	{0x3197, 0x3197, mapped, "\u4e2d"},
+	{0x3198, 0x3198, mapped, "\u4e0b"},
+	{0x3199, 0x3199, mapped, "\u7532"},
+	{0x319A, 0x319A, mapped, "\u4e59"},
+	{0x319B, 0x319B, mapped, "\u4e19"},
+	{0x319C, 0x319C, mapped, "\u4e01"},
+	{0x319D, 0x319D, mapped, "\u5929"},
+	{0x319E, 0x319E, mapped, "\u5730"},
+	{0x319F, 0x319F, mapped, "\u4eba"},
+	{0x31A0, 0x31E5, valid, ""},
+	{0x31E6, 0x31EF, disallowed, ""},
+	{0x31F0, 0x31FF, valid, ""},
+	{0x3200, 0x3200, disallowedSTD3Mapped, "(\u1100)"},
+	{0x3201, 0x3201, disallowedSTD3Mapped, "(\u1102)"},
+	{0x3202, 0x3202, disallowedSTD3Mapped, "(\u1103)"},
+	{0x3203, 0x3203, disallowedSTD3Mapped, "(\u1105)"},
+	{0x3204, 0x3204, disallowedSTD3Mapped, "(\u1106)"},
+	{0x3205, 0x3205, disallowedSTD3Mapped, "(\u1107)"},
+	{0x3206, 0x3206, disallowedSTD3Mapped, "(\u1109)"},
+	{0x3207, 0x3207, disallowedSTD3Mapped, "(\u110b)"},
+	{0x3208, 0x3208, disallowedSTD3Mapped, "(\u110c)"},
+	{0x3209, 0x3209, disallowedSTD3Mapped, "(\u110e)"},
+	{0x320A, 0x320A, disallowedSTD3Mapped, "(\u110f)"},
+	{0x320B, 0x320B, disallowedSTD3Mapped, "(\u1110)"},
+	{0x320C, 0x320C, disallowedSTD3Mapped, "(\u1111)"},
+	{0x320D, 0x320D, disallowedSTD3Mapped, "(\u1112)"},
+	{0x320E, 0x320E, disallowedSTD3Mapped, "(\uac00)"},
+	{0x320F, 0x320F, disallowedSTD3Mapped, "(\ub098)"},
+	{0x3210, 0x3210, disallowedSTD3Mapped, "(\ub2e4)"},
+	{0x3211, 0x3211, disallowedSTD3Mapped, "(\ub77c)"},
+	{0x3212, 0x3212, disallowedSTD3Mapped, "(\ub9c8)"},
+	{0x3213, 0x3213, disallowedSTD3Mapped, "(\ubc14)"},
+	{0x3214, 0x3214, disallowedSTD3Mapped, "(\uc0ac)"},
+	{0x3215, 0x3215, disallowedSTD3Mapped, "(\uc544)"},
+	{0x3216, 0x3216, disallowedSTD3Mapped, "(\uc790)"},
+	{0x3217, 0x3217, disallowedSTD3Mapped, "(\ucc28)"},
+	{0x3218, 0x3218, disallowedSTD3Mapped, "(\uce74)"},
+	{0x3219, 0x3219, disallowedSTD3Mapped, "(\ud0c0)"},
+	{0x321A, 0x321A, disallowedSTD3Mapped, "(\ud30c)"},
+	{0x321B, 0x321B, disallowedSTD3Mapped, "(\ud558)"},
+	{0x321C, 0x321C, disallowedSTD3Mapped, "(\uc8fc)"},
+	{0x321D, 0x321D, disallowedSTD3Mapped, "(\uc624\uc804)"},
+	{0x321E, 0x321E, disallowedSTD3Mapped, "(\uc624\ud6c4)"},
+	{0x321F, 0x321F, disallowed, ""},
+	{0x3220, 0x3220, disallowedSTD3Mapped, "(\u4e00)"},
+	{0x3221, 0x3221, disallowedSTD3Mapped, "(\u4e8c)"},
+	{0x3222, 0x3222, disallowedSTD3Mapped, "(\u4e09)"},
+	{0x3223, 0x3223, disallowedSTD3Mapped, "(\u56db)"},
+	{0x3224, 0x3224, disallowedSTD3Mapped, "(\u4e94)"},
+	{0x3225, 0x3225, disallowedSTD3Mapped, "(\u516d)"},
+	{0x3226, 0x3226, disallowedSTD3Mapped, "(\u4e03)"},
+	{0x3227, 0x3227, disallowedSTD3Mapped, "(\u516b)"},
+	{0x3228, 0x3228, disallowedSTD3Mapped, "(\u4e5d)"},
+	{0x3229, 0x3229, disallowedSTD3Mapped, "(\u5341)"},
+	{0x322A, 0x322A, disallowedSTD3Mapped, "(\u6708)"},
+	{0x322B, 0x322B, disallowedSTD3Mapped, "(\u706b)"},
+	{0x322C, 0x322C, disallowedSTD3Mapped, "(\u6c34)"},
+	{0x322D, 0x322D, disallowedSTD3Mapped, "(\u6728)"},
+	{0x322E, 0x322E, disallowedSTD3Mapped, "(\u91d1)"},
+	{0x322F, 0x322F, disallowedSTD3Mapped, "(\u571f)"},
+	{0x3230, 0x3230, disallowedSTD3Mapped, "(\u65e5)"},
+	{0x3231, 0x3231, disallowedSTD3Mapped, "(\u682a)"},
+	{0x3232, 0x3232, disallowedSTD3Mapped, "(\u6709)"},
+	{0x3233, 0x3233, disallowedSTD3Mapped, "(\u793e)"},
+	{0x3234, 0x3234, disallowedSTD3Mapped, "(\u540d)"},
+	{0x3235, 0x3235, disallowedSTD3Mapped, "(\u7279)"},
+	{0x3236, 0x3236, disallowedSTD3Mapped, "(\u8ca1)"},
+	{0x3237, 0x3237, disallowedSTD3Mapped, "(\u795d)"},
+	{0x3238, 0x3238, disallowedSTD3Mapped, "(\u52b4)"},
+	{0x3239, 0x3239, disallowedSTD3Mapped, "(\u4ee3)"},
+	{0x323A, 0x323A, disallowedSTD3Mapped, "(\u547c)"},
+	{0x323B, 0x323B, disallowedSTD3Mapped, "(\u5b66)"},
+	{0x323C, 0x323C, disallowedSTD3Mapped, "(\u76e3)"},
+	{0x323D, 0x323D, disallowedSTD3Mapped, "(\u4f01)"},
+	{0x323E, 0x323E, disallowedSTD3Mapped, "(\u8cc7)"},
+	{0x323F, 0x323F, disallowedSTD3Mapped, "(\u5354)"},
+	{0x3240, 0x3240, disallowedSTD3Mapped, "(\u796d)"},
+	{0x3241, 0x3241, disallowedSTD3Mapped, "(\u4f11)"},
+	{0x3242, 0x3242, disallowedSTD3Mapped, "(\u81ea)"},
+	{0x3243, 0x3243, disallowedSTD3Mapped, "(\u81f3)"},
+	{0x3244, 0x3244, mapped, "\u554f"},
+	{0x3245, 0x3245, mapped, "\u5e7c"},
+	{0x3246, 0x3246, mapped, "\u6587"},
+	{0x3247, 0x3247, mapped, "\u7b8f"},
+	{0x3248, 0x324F, valid, ""},
+	{0x3250, 0x3250, mapped, "pte"},
+	{0x3251, 0x3251, mapped, "21"},
+	{0x3252, 0x3252, mapped, "22"},
+	{0x3253, 0x3253, mapped, "23"},
+	{0x3254, 0x3254, mapped, "24"},
+	{0x3255, 0x3255, mapped, "25"},
+	{0x3256, 0x3256, mapped, "26"},
+	{0x3257, 0x3257, mapped, "27"},
+	{0x3258, 0x3258, mapped, "28"},
+	{0x3259, 0x3259, mapped, "29"},
+	{0x325A, 0x325A, mapped, "30"},
+	{0x325B, 0x325B, mapped, "31"},
+	{0x325C, 0x325C, mapped, "32"},
+	{0x325D, 0x325D, mapped, "33"},
+	{0x325E, 0x325E, mapped, "34"},
+	{0x325F, 0x325F, mapped, "35"},
+	{0x3260, 0x3260, mapped, "\u1100"},
+	{0x3261, 0x3261, mapped, "\u1102"},
+	{0x3262, 0x3262, mapped, "\u1103"},
+	{0x3263, 0x3263, mapped, "\u1105"},
+	{0x3264, 0x3264, mapped, "\u1106"},
+	{0x3265, 0x3265, mapped, "\u1107"},
+	{0x3266, 0x3266, mapped, "\u1109"},
+	{0x3267, 0x3267, mapped, "\u110b"},
+	{0x3268, 0x3268, mapped, "\u110c"},
+	{0x3269, 0x3269, mapped, "\u110e"},
+	{0x326A, 0x326A, mapped, "\u110f"},
+	{0x326B, 0x326B, mapped, "\u1110"},
+	{0x326C, 0x326C, mapped, "\u1111"},
+	{0x326D, 0x326D, mapped, "\u1112"},
+	{0x326E, 0x326E, mapped, "\uac00"},
+	{0x326F, 0x326F, mapped, "\ub098"},
+	{0x3270, 0x3270, mapped, "\ub2e4"},
+	{0x3271, 0x3271, mapped, "\ub77c"},
+	{0x3272, 0x3272, mapped, "\ub9c8"},
+	{0x3273, 0x3273, mapped, "\ubc14"},
+	{0x3274, 0x3274, mapped, "\uc0ac"},
+	{0x3275, 0x3275, mapped, "\uc544"},
+	{0x3276, 0x3276, mapped, "\uc790"},
+	{0x3277, 0x3277, mapped, "\ucc28"},
+	{0x3278, 0x3278, mapped, "\uce74"},
+	{0x3279, 0x3279, mapped, "\ud0c0"},
+	{0x327A, 0x327A, mapped, "\ud30c"},
+	{0x327B, 0x327B, mapped, "\ud558"},
+	{0x327C, 0x327C, mapped, "\ucc38\uace0"},
+	{0x327D, 0x327D, mapped, "\uc8fc\uc758"},
+	{0x327E, 0x327E, mapped, "\uc6b0"},
+	{0x327F, 0x327F, valid, ""},
+	{0x3280, 0x3280, mapped, "\u4e00"},
+	{0x3281, 0x3281, mapped, "\u4e8c"},
+	{0x3282, 0x3282, mapped, "\u4e09"},
+	{0x3283, 0x3283, mapped, "\u56db"},
+	{0x3284, 0x3284, mapped, "\u4e94"},
+	{0x3285, 0x3285, mapped, "\u516d"},
+	{0x3286, 0x3286, mapped, "\u4e03"},
+	{0x3287, 0x3287, mapped, "\u516b"},
+	{0x3288, 0x3288, mapped, "\u4e5d"},
+	{0x3289, 0x3289, mapped, "\u5341"},
+	{0x328A, 0x328A, mapped, "\u6708"},
+	{0x328B, 0x328B, mapped, "\u706b"},
+	{0x328C, 0x328C, mapped, "\u6c34"},
+	{0x328D, 0x328D, mapped, "\u6728"},
+	{0x328E, 0x328E, mapped, "\u91d1"},
+	{0x328F, 0x328F, mapped, "\u571f"},
+	{0x3290, 0x3290, mapped, "\u65e5"},
+	{0x3291, 0x3291, mapped, "\u682a"},
+	{0x3292, 0x3292, mapped, "\u6709"},
+	{0x3293, 0x3293, mapped, "\u793e"},
+	{0x3294, 0x3294, mapped, "\u540d"},
+	{0x3295, 0x3295, mapped, "\u7279"},
+	{0x3296, 0x3296, mapped, "\u8ca1"},
+	{0x3297, 0x3297, mapped, "\u795d"},
+	{0x3298, 0x3298, mapped, "\u52b4"},
+	{0x3299, 0x3299, mapped, "\u79d8"},
+	{0x329A, 0x329A, mapped, "\u7537"},
+	{0x329B, 0x329B, mapped, "\u5973"},
+	{0x329C, 0x329C, mapped, "\u9069"},
+	{0x329D, 0x329D, mapped, "\u512a"},
+	{0x329E, 0x329E, mapped, "\u5370"},
+	{0x329F, 0x329F, mapped, "\u6ce8"},
+	{0x32A0, 0x32A0, mapped, "\u9805"},
+	{0x32A1, 0x32A1, mapped, "\u4f11"},
+	{0x32A2, 0x32A2, mapped, "\u5199"},
+	{0x32A3, 0x32A3, mapped, "\u6b63"},
+	{0x32A4, 0x32A4, mapped, "\u4e0a"},
+	{0x32A5, 0x32A5, mapped, "\u4e2d"},
+	{0x32A6, 0x32A6, mapped, "\u4e0b"},
+	{0x32A7, 0x32A7, mapped, "\u5de6"},
+	{0x32A8, 0x32A8, mapped, "\u53f3"},
+	{0x32A9, 0x32A9, mapped, "\u533b"},
+	{0x32AA, 0x32AA, mapped, "\u5b97"},
+	{0x32AB, 0x32AB, mapped, "\u5b66"},
+	{0x32AC, 0x32AC, mapped, "\u76e3"},
+	{0x32AD, 0x32AD, mapped, "\u4f01"},
+	{0x32AE, 0x32AE, mapped, "\u8cc7"},
+	{0x32AF, 0x32AF, mapped, "\u5354"},
+	{0x32B0, 0x32B0, mapped, "\u591c"},
+	{0x32B1, 0x32B1, mapped, "36"},
+	{0x32B2, 0x32B2, mapped, "37"},
+	{0x32B3, 0x32B3, mapped, "38"},
+	{0x32B4, 0x32B4, mapped, "39"},
+	{0x32B5, 0x32B5, mapped, "40"},
+	{0x32B6, 0x32B6, mapped, "41"},
+	{0x32B7, 0x32B7, mapped, "42"},
+	{0x32B8, 0x32B8, mapped, "43"},
+	{0x32B9, 0x32B9, mapped, "44"},
+	{0x32BA, 0x32BA, mapped, "45"},
+	{0x32BB, 0x32BB, mapped, "46"},
+	{0x32BC, 0x32BC, mapped, "47"},
+	{0x32BD, 0x32BD, mapped, "48"},
+	{0x32BE, 0x32BE, mapped, "49"},
+	{0x32BF, 0x32BF, mapped, "50"},
+	{0x32C0, 0x32C0, mapped, "1\u6708"},
+	{0x32C1, 0x32C1, mapped, "2\u6708"},
+	{0x32C2, 0x32C2, mapped, "3\u6708"},
+	{0x32C3, 0x32C3, mapped, "4\u6708"},
+	{0x32C4, 0x32C4, mapped, "5\u6708"},
+	{0x32C5, 0x32C5, mapped, "6\u6708"},
+	{0x32C6, 0x32C6, mapped, "7\u6708"},
+	{0x32C7, 0x32C7, mapped, "8\u6708"},
+	{0x32C8, 0x32C8, mapped, "9\u6708"},
+	{0x32C9, 0x32C9, mapped, "10\u6708"},
+	{0x32CA, 0x32CA, mapped, "11\u6708"},
+	{0x32CB, 0x32CB, mapped, "12\u6708"},
+	{0x32CC, 0x32CC, mapped, "hg"},
+	{0x32CD, 0x32CD, mapped, "erg"},
+	{0x32CE, 0x32CE, mapped, "ev"},
+	{0x32CF, 0x32CF, mapped, "ltd"},
+	{0x32D0, 0x32D0, mapped, "\u30a2"},
+	{0x32D1, 0x32D1, mapped, "\u30a4"},
+	{0x32D2, 0x32D2, mapped, "\u30a6"},
+	{0x32D3, 0x32D3, mapped, "\u30a8"},
+	{0x32D4, 0x32D4, mapped, "\u30aa"},
+	{0x32D5, 0x32D5, mapped, "\u30ab"},
+	{0x32D6, 0x32D6, mapped, "\u30ad"},
+	{0x32D7, 0x32D7, mapped, "\u30af"},
+	{0x32D8, 0x32D8, mapped, "\u30b1"},
+	{0x32D9, 0x32D9, mapped, "\u30b3"},
+	{0x32DA, 0x32DA, mapped, "\u30b5"},
+	{0x32DB, 0x32DB, mapped, "\u30b7"},
+	{0x32DC, 0x32DC, mapped, "\u30b9"},
+	{0x32DD, 0x32DD, mapped, "\u30bb"},
+	{0x32DE, 0x32DE, mapped, "\u30bd"},
+	{0x32DF, 0x32DF, mapped, "\u30bf"},
+	{0x32E0, 0x32E0, mapped, "\u30c1"},
+	{0x32E1, 0x32E1, mapped, "\u30c4"},
+	{0x32E2, 0x32E2, mapped, "\u30c6"},
+	{0x32E3, 0x32E3, mapped, "\u30c8"},
+	{0x32E4, 0x32E4, mapped, "\u30ca"},
+	{0x32E5, 0x32E5, mapped, "\u30cb"},
+	{0x32E6, 0x32E6, mapped, "\u30cc"},
+	{0x32E7, 0x32E7, mapped, "\u30cd"},
+	{0x32E8, 0x32E8, mapped, "\u30ce"},
+	{0x32E9, 0x32E9, mapped, "\u30cf"},
+	{0x32EA, 0x32EA, mapped, "\u30d2"},
+	{0x32EB, 0x32EB, mapped, "\u30d5"},
+	{0x32EC, 0x32EC, mapped, "\u30d8"},
+	{0x32ED, 0x32ED, mapped, "\u30db"},
+	{0x32EE, 0x32EE, mapped, "\u30de"},
+	{0x32EF, 0x32EF, mapped, "\u30df"},
+	{0x32F0, 0x32F0, mapped, "\u30e0"},
+	{0x32F1, 0x32F1, mapped, "\u30e1"},
+	{0x32F2, 0x32F2, mapped, "\u30e2"},
+	{0x32F3, 0x32F3, mapped, "\u30e4"},
+	{0x32F4, 0x32F4, mapped, "\u30e6"},
+	{0x32F5, 0x32F5, mapped, "\u30e8"},
+	{0x32F6, 0x32F6, mapped, "\u30e9"},
+	{0x32F7, 0x32F7, mapped, "\u30ea"},
+	{0x32F8, 0x32F8, mapped, "\u30eb"},
+	{0x32F9, 0x32F9, mapped, "\u30ec"},
+	{0x32FA, 0x32FA, mapped, "\u30ed"},
+	{0x32FB, 0x32FB, mapped, "\u30ef"},
+	{0x32FC, 0x32FC, mapped, "\u30f0"},
+	{0x32FD, 0x32FD, mapped, "\u30f1"},
+	{0x32FE, 0x32FE, mapped, "\u30f2"},
+	{0x32FF, 0x32FF, mapped, "\u4ee4\u548c"},
+	{0x3300, 0x3300, mapped, "\u30a2\u30d1\u30fc\u30c8"},
+	{0x3301, 0x3301, mapped, "\u30a2\u30eb\u30d5\u30a1"},
+	{0x3302, 0x3302, mapped, "\u30a2\u30f3\u30da\u30a2"},
+	{0x3303, 0x3303, mapped, "\u30a2\u30fc\u30eb"},
+	{0x3304, 0x3304, mapped, "\u30a4\u30cb\u30f3\u30b0"},
+	{0x3305, 0x3305, mapped, "\u30a4\u30f3\u30c1"},
+	{0x3306, 0x3306, mapped, "\u30a6\u30a9\u30f3"},
+	{0x3307, 0x3307, mapped, "\u30a8\u30b9\u30af\u30fc\u30c9"},
+	{0x3308, 0x3308, mapped, "\u30a8\u30fc\u30ab\u30fc"},
+	{0x3309, 0x3309, mapped, "\u30aa\u30f3\u30b9"},
+	{0x330A, 0x330A, mapped, "\u30aa\u30fc\u30e0"},
+	{0x330B, 0x330B, mapped, "\u30ab\u30a4\u30ea"},
+	{0x330C, 0x330C, mapped, "\u30ab\u30e9\u30c3\u30c8"},
+	{0x330D, 0x330D, mapped, "\u30ab\u30ed\u30ea\u30fc"},
+	{0x330E, 0x330E, mapped, "\u30ac\u30ed\u30f3"},
+	{0x330F, 0x330F, mapped, "\u30ac\u30f3\u30de"},
+	{0x3310, 0x3310, mapped, "\u30ae\u30ac"},
+	{0x3311, 0x3311, mapped, "\u30ae\u30cb\u30fc"},
+	{0x3312, 0x3312, mapped, "\u30ad\u30e5\u30ea\u30fc"},
+	{0x3313, 0x3313, mapped, "\u30ae\u30eb\u30c0\u30fc"},
+	{0x3314, 0x3314, mapped, "\u30ad\u30ed"},
+	{0x3315, 0x3315, mapped, "\u30ad\u30ed\u30b0\u30e9\u30e0"},
+	{0x3316, 0x3316, mapped, "\u30ad\u30ed\u30e1\u30fc\u30c8\u30eb"},
+	{0x3317, 0x3317, mapped, "\u30ad\u30ed\u30ef\u30c3\u30c8"},
+	{0x3318, 0x3318, mapped, "\u30b0\u30e9\u30e0"},
+	{0x3319, 0x3319, mapped, "\u30b0\u30e9\u30e0\u30c8\u30f3"},
+	{0x331A, 0x331A, mapped, "\u30af\u30eb\u30bc\u30a4\u30ed"},
+	{0x331B, 0x331B, mapped, "\u30af\u30ed\u30fc\u30cd"},
+	{0x331C, 0x331C, mapped, "\u30b1\u30fc\u30b9"},
+	{0x331D, 0x331D, mapped, "\u30b3\u30eb\u30ca"},
+	{0x331E, 0x331E, mapped, "\u30b3\u30fc\u30dd"},
+	{0x331F, 0x331F, mapped, "\u30b5\u30a4\u30af\u30eb"},
+	{0x3320, 0x3320, mapped, "\u30b5\u30f3\u30c1\u30fc\u30e0"},
+	{0x3321, 0x3321, mapped, "\u30b7\u30ea\u30f3\u30b0"},
+	{0x3322, 0x3322, mapped, "\u30bb\u30f3\u30c1"},
+	{0x3323, 0x3323, mapped, "\u30bb\u30f3\u30c8"},
+	{0x3324, 0x3324, mapped, "\u30c0\u30fc\u30b9"},
+	{0x3325, 0x3325, mapped, "\u30c7\u30b7"},
+	{0x3326, 0x3326, mapped, "\u30c9\u30eb"},
+	{0x3327, 0x3327, mapped, "\u30c8\u30f3"},
+	{0x3328, 0x3328, mapped, "\u30ca\u30ce"},
+	{0x3329, 0x3329, mapped, "\u30ce\u30c3\u30c8"},
+	{0x332A, 0x332A, mapped, "\u30cf\u30a4\u30c4"},
+	{0x332B, 0x332B, mapped, "\u30d1\u30fc\u30bb\u30f3\u30c8"},
+	{0x332C, 0x332C, mapped, "\u30d1\u30fc\u30c4"},
+	{0x332D, 0x332D, mapped, "\u30d0\u30fc\u30ec\u30eb"},
+	{0x332E, 0x332E, mapped, "\u30d4\u30a2\u30b9\u30c8\u30eb"},
+	{0x332F, 0x332F, mapped, "\u30d4\u30af\u30eb"},
+	{0x3330, 0x3330, mapped, "\u30d4\u30b3"},
+	{0x3331, 0x3331, mapped, "\u30d3\u30eb"},
+	{0x3332, 0x3332, mapped, "\u30d5\u30a1\u30e9\u30c3\u30c9"},
+	{0x3333, 0x3333, mapped, "\u30d5\u30a3\u30fc\u30c8"},
+	{0x3334, 0x3334, mapped, "\u30d6\u30c3\u30b7\u30a7\u30eb"},
+	{0x3335, 0x3335, mapped, "\u30d5\u30e9\u30f3"},
+	{0x3336, 0x3336, mapped, "\u30d8\u30af\u30bf\u30fc\u30eb"},
+	{0x3337, 0x3337, mapped, "\u30da\u30bd"},
+	{0x3338, 0x3338, mapped, "\u30da\u30cb\u30d2"},
+	{0x3339, 0x3339, mapped, "\u30d8\u30eb\u30c4"},
+	{0x333A, 0x333A, mapped, "\u30da\u30f3\u30b9"},
+	{0x333B, 0x333B, mapped, "\u30da\u30fc\u30b8"},
+	{0x333C, 0x333C, mapped, "\u30d9\u30fc\u30bf"},
+	{0x333D, 0x333D, mapped, "\u30dd\u30a4\u30f3\u30c8"},
+	{0x333E, 0x333E, mapped, "\u30dc\u30eb\u30c8"},
+	{0x333F, 0x333F, mapped, "\u30db\u30f3"},
+	{0x3340, 0x3340, mapped, "\u30dd\u30f3\u30c9"},
+	{0x3341, 0x3341, mapped, "\u30db\u30fc\u30eb"},
+	{0x3342, 0x3342, mapped, "\u30db\u30fc\u30f3"},
+	{0x3343, 0x3343, mapped, "\u30de\u30a4\u30af\u30ed"},
+	{0x3344, 0x3344, mapped, "\u30de\u30a4\u30eb"},
+	{0x3345, 0x3345, mapped, "\u30de\u30c3\u30cf"},
+	{0x3346, 0x3346, mapped, "\u30de\u30eb\u30af"},
+	{0x3347, 0x3347, mapped, "\u30de\u30f3\u30b7\u30e7\u30f3"},
+	{0x3348, 0x3348, mapped, "\u30df\u30af\u30ed\u30f3"},
+	{0x3349, 0x3349, mapped, "\u30df\u30ea"},
+	{0x334A, 0x334A, mapped, "\u30df\u30ea\u30d0\u30fc\u30eb"},
+	{0x334B, 0x334B, mapped, "\u30e1\u30ac"},
+	{0x334C, 0x334C, mapped, "\u30e1\u30ac\u30c8\u30f3"},
+	{0x334D, 0x334D, mapped, "\u30e1\u30fc\u30c8\u30eb"},
+	{0x334E, 0x334E, mapped, "\u30e4\u30fc\u30c9"},
+	{0x334F, 0x334F, mapped, "\u30e4\u30fc\u30eb"},
+	{0x3350, 0x3350, mapped, "\u30e6\u30a2\u30f3"},
+	{0x3351, 0x3351, mapped, "\u30ea\u30c3\u30c8\u30eb"},
+	{0x3352, 0x3352, mapped, "\u30ea\u30e9"},
+	{0x3353, 0x3353, mapped, "\u30eb\u30d4\u30fc"},
+	{0x3354, 0x3354, mapped, "\u30eb\u30fc\u30d6\u30eb"},
+	{0x3355, 0x3355, mapped, "\u30ec\u30e0"},
+	{0x3356, 0x3356, mapped, "\u30ec\u30f3\u30c8\u30b2\u30f3"},
+	{0x3357, 0x3357, mapped, "\u30ef\u30c3\u30c8"},
+	{0x3358, 0x3358, mapped, "0\u70b9"},
+	{0x3359, 0x3359, mapped, "1\u70b9"},
+	{0x335A, 0x335A, mapped, "2\u70b9"},
+	{0x335B, 0x335B, mapped, "3\u70b9"},
+	{0x335C, 0x335C, mapped, "4\u70b9"},
+	{0x335D, 0x335D, mapped, "5\u70b9"},
+	{0x335E, 0x335E, mapped, "6\u70b9"},
+	{0x335F, 0x335F, mapped, "7\u70b9"},
+	{0x3360, 0x3360, mapped, "8\u70b9"},
+	{0x3361, 0x3361, mapped, "9\u70b9"},
+	{0x3362, 0x3362, mapped, "10\u70b9"},
+	{0x3363, 0x3363, mapped, "11\u70b9"},
+	{0x3364, 0x3364, mapped, "12\u70b9"},
+	{0x3365, 0x3365, mapped, "13\u70b9"},
+	{0x3366, 0x3366, mapped, "14\u70b9"},
+	{0x3367, 0x3367, mapped, "15\u70b9"},
+	{0x3368, 0x3368, mapped, "16\u70b9"},
+	{0x3369, 0x3369, mapped, "17\u70b9"},
+	{0x336A, 0x336A, mapped, "18\u70b9"},
+	{0x336B, 0x336B, mapped, "19\u70b9"},
+	{0x336C, 0x336C, mapped, "20\u70b9"},
+	{0x336D, 0x336D, mapped, "21\u70b9"},
+	{0x336E, 0x336E, mapped, "22\u70b9"},
+	{0x336F, 0x336F, mapped, "23\u70b9"},
+	{0x3370, 0x3370, mapped, "24\u70b9"},
+	{0x3371, 0x3371, mapped, "hpa"},
+	{0x3372, 0x3372, mapped, "da"},
+	{0x3373, 0x3373, mapped, "au"},
+	{0x3374, 0x3374, mapped, "bar"},
+	{0x3375, 0x3375, mapped, "ov"},
+	{0x3376, 0x3376, mapped, "pc"},
+	{0x3377, 0x3377, mapped, "dm"},
+	{0x3378, 0x3378, mapped, "dm2"},
+	{0x3379, 0x3379, mapped, "dm3"},
+	{0x337A, 0x337A, mapped, "iu"},
+	{0x337B, 0x337B, mapped, "\u5e73\u6210"},
+	{0x337C, 0x337C, mapped, "\u662d\u548c"},
+	{0x337D, 0x337D, mapped, "\u5927\u6b63"},
+	{0x337E, 0x337E, mapped, "\u660e\u6cbb"},
+	{0x337F, 0x337F, mapped, "\u682a\u5f0f\u4f1a\u793e"},
+	{0x3380, 0x3380, mapped, "pa"},
+	{0x3381, 0x3381, mapped, "na"},
+	{0x3382, 0x3382, mapped, "\u03bca"},
+	{0x3383, 0x3383, mapped, "ma"},
+	{0x3384, 0x3384, mapped, "ka"},
+	{0x3385, 0x3385, mapped, "kb"},
+	{0x3386, 0x3386, mapped, "mb"},
+	{0x3387, 0x3387, mapped, "gb"},
+	{0x3388, 0x3388, mapped, "cal"},
+	{0x3389, 0x3389, mapped, "kcal"},
+	{0x338A, 0x338A, mapped, "pf"},
+	{0x338B, 0x338B, mapped, "nf"},
+	{0x338C, 0x338C, mapped, "\u03bcf"},
+	{0x338D, 0x338D, mapped, "\u03bcg"},
+	{0x338E, 0x338E, mapped, "mg"},
+	{0x338F, 0x338F, mapped, "kg"},
+	{0x3390, 0x3390, mapped, "hz"},
+	{0x3391, 0x3391, mapped, "khz"},
+	{0x3392, 0x3392, mapped, "mhz"},
+	{0x3393, 0x3393, mapped, "ghz"},
+	{0x3394, 0x3394, mapped, "thz"},
+	{0x3395, 0x3395, mapped, "\u03bcl"},
+	{0x3396, 0x3396, mapped, "ml"},
+	{0x3397, 0x3397, mapped, "dl"},
+	{0x3398, 0x3398, mapped, "kl"},
+	{0x3399, 0x3399, mapped, "fm"},
+	{0x339A, 0x339A, mapped, "nm"},
+	{0x339B, 0x339B, mapped, "\u03bcm"},
+	{0x339C, 0x339C, mapped, "mm"},
+	{0x339D, 0x339D, mapped, "cm"},
+	{0x339E, 0x339E, mapped, "km"},
+	{0x339F, 0x339F, mapped, "mm2"},
+	{0x33A0, 0x33A0, mapped, "cm2"},
+	{0x33A1, 0x33A1, mapped, "m2"},
+	{0x33A2, 0x33A2, mapped, "km2"},
+	{0x33A3, 0x33A3, mapped, "mm3"},
+	{0x33A4, 0x33A4, mapped, "cm3"},
+	{0x33A5, 0x33A5, mapped, "m3"},
+	{0x33A6, 0x33A6, mapped, "km3"},
+	{0x33A7, 0x33A7, mapped, "m\u2215s"},
+	{0x33A8, 0x33A8, mapped, "m\u2215s2"},
+	{0x33A9, 0x33A9, mapped, "pa"},
+	{0x33AA, 0x33AA, mapped, "kpa"},
+	{0x33AB, 0x33AB, mapped, "mpa"},
+	{0x33AC, 0x33AC, mapped, "gpa"},
+	{0x33AD, 0x33AD, mapped, "rad"},
+	{0x33AE, 0x33AE, mapped, "rad\u2215s"},
+	{0x33AF, 0x33AF, mapped, "rad\u2215s2"},
+	{0x33B0, 0x33B0, mapped, "ps"},
+	{0x33B1, 0x33B1, mapped, "ns"},
+	{0x33B2, 0x33B2, mapped, "\u03bcs"},
+	{0x33B3, 0x33B3, mapped, "ms"},
+	{0x33B4, 0x33B4, mapped, "pv"},
+	{0x33B5, 0x33B5, mapped, "nv"},
+	{0x33B6, 0x33B6, mapped, "\u03bcv"},
+	{0x33B7, 0x33B7, mapped, "mv"},
+	{0x33B8, 0x33B8, mapped, "kv"},
+	{0x33B9, 0x33B9, mapped, "mv"},
+	{0x33BA, 0x33BA, mapped, "pw"},
+	{0x33BB, 0x33BB, mapped, "nw"},
+	{0x33BC, 0x33BC, mapped, "\u03bcw"},
+	{0x33BD, 0x33BD, mapped, "mw"},
+	{0x33BE, 0x33BE, mapped, "kw"},
+	{0x33BF, 0x33BF, mapped, "mw"},
+	{0x33C0, 0x33C0, mapped, "k\u03c9"},
+	{0x33C1, 0x33C1, mapped, "m\u03c9"},
+	{0x33C2, 0x33C2, disallowed, ""},
+	{0x33C3, 0x33C3, mapped, "bq"},
+	{0x33C4, 0x33C4, mapped, "cc"},
+	{0x33C5, 0x33C5, mapped, "cd"},
+	{0x33C6, 0x33C6, mapped, "c\u2215kg"},
+	{0x33C7, 0x33C7, disallowed, ""},
+	{0x33C8, 0x33C8, mapped, "db"},
+	{0x33C9, 0x33C9, mapped, "gy"},
+	{0x33CA, 0x33CA, mapped, "ha"},
+	{0x33CB, 0x33CB, mapped, "hp"},
+	{0x33CC, 0x33CC, mapped, "in"},
+	{0x33CD, 0x33CD, mapped, "kk"},
+	{0x33CE, 0x33CE, mapped, "km"},
+	{0x33CF, 0x33CF, mapped, "kt"},
+	{0x33D0, 0x33D0, mapped, "lm"},
+	{0x33D1, 0x33D1, mapped, "ln"},
+	{0x33D2, 0x33D2, mapped, "log"},
+	{0x33D3, 0x33D3, mapped, "lx"},
+	{0x33D4, 0x33D4, mapped, "mb"},
+	{0x33D5, 0x33D5, mapped, "mil"},
+	{0x33D6, 0x33D6, mapped, "mol"},
+	{0x33D7, 0x33D7, mapped, "ph"},
+	{0x33D8, 0x33D8, disallowed, ""},
+	{0x33D9, 0x33D9, mapped, "ppm"},
+	{0x33DA, 0x33DA, mapped, "pr"},
+	{0x33DB, 0x33DB, mapped, "sr"},
+	{0x33DC, 0x33DC, mapped, "sv"},
+	{0x33DD, 0x33DD, mapped, "wb"},
+	{0x33DE, 0x33DE, mapped, "v\u2215m"},
+	{0x33DF, 0x33DF, mapped, "a\u2215m"},
+	{0x33E0, 0x33E0, mapped, "1\u65e5"},
+	{0x33E1, 0x33E1, mapped, "2\u65e5"},
+	{0x33E2, 0x33E2, mapped, "3\u65e5"},
+	{0x33E3, 0x33E3, mapped, "4\u65e5"},
+	{0x33E4, 0x33E4, mapped, "5\u65e5"},
+	{0x33E5, 0x33E5, mapped, "6\u65e5"},
+	{0x33E6, 0x33E6, mapped, "7\u65e5"},
+	{0x33E7, 0x33E7, mapped, "8\u65e5"},
+	{0x33E8, 0x33E8, mapped, "9\u65e5"},
+	{0x33E9, 0x33E9, mapped, "10\u65e5"},
+	{0x33EA, 0x33EA, mapped, "11\u65e5"},
+	{0x33EB, 0x33EB, mapped, "12\u65e5"},
+	{0x33EC, 0x33EC, mapped, "13\u65e5"},
+	{0x33ED, 0x33ED, mapped, "14\u65e5"},
+	{0x33EE, 0x33EE, mapped, "15\u65e5"},
+	{0x33EF, 0x33EF, mapped, "16\u65e5"},
+	{0x33F0, 0x33F0, mapped, "17\u65e5"},
+	{0x33F1, 0x33F1, mapped, "18\u65e5"},
+	{0x33F2, 0x33F2, mapped, "19\u65e5"},
+	{0x33F3, 0x33F3, mapped, "20\u65e5"},
+	{0x33F4, 0x33F4, mapped, "21\u65e5"},
+	{0x33F5, 0x33F5, mapped, "22\u65e5"},
+	{0x33F6, 0x33F6, mapped, "23\u65e5"},
+	{0x33F7, 0x33F7, mapped, "24\u65e5"},
+	{0x33F8, 0x33F8, mapped, "25\u65e5"},
+	{0x33F9, 0x33F9, mapped, "26\u65e5"},
+	{0x33FA, 0x33FA, mapped, "27\u65e5"},
+	{0x33FB, 0x33FB, mapped, "28\u65e5"},
+	{0x33FC, 0x33FC, mapped, "29\u65e5"},
+	{0x33FD, 0x33FD, mapped, "30\u65e5"},
+	{0x33FE, 0x33FE, mapped, "31\u65e5"},
+	{0x33FF, 0x33FF, mapped, "gal"},
+	{0x3400, 0xA48C, valid, ""},
+	{0xA48D, 0xA48F, disallowed, ""},
+	{0xA490, 0xA4C6, valid, ""},
+	{0xA4C7, 0xA4CF, disallowed, ""},
+	{0xA4D0, 0xA62B, valid, ""},
+	{0xA62C, 0xA63F, disallowed, ""},
+	{0xA640, 0xA640, mapped, "\ua641"},
+	{0xA641, 0xA641, valid, ""},
+	{0xA642, 0xA642, mapped, "\ua643"},
+	{0xA643, 0xA643, valid, ""},
+	{0xA644, 0xA644, mapped, "\ua645"},
+	{0xA645, 0xA645, valid, ""},
+	{0xA646, 0xA646, mapped, "\ua647"},
+	{0xA647, 0xA647, valid, ""},
+	{0xA648, 0xA648, mapped, "\ua649"},
+	{0xA649, 0xA649, valid, ""},
+	{0xA64A, 0xA64A, mapped, "\ua64b"},
+	{0xA64B, 0xA64B, valid, ""},
+	{0xA64C, 0xA64C, mapped, "\ua64d"},
+	{0xA64D, 0xA64D, valid, ""},
+	{0xA64E, 0xA64E, mapped, "\ua64f"},
+	{0xA64F, 0xA64F, valid, ""},
+	{0xA650, 0xA650, mapped, "\ua651"},
+	{0xA651, 0xA651, valid, ""},
+	{0xA652, 0xA652, mapped, "\ua653"},
+	{0xA653, 0xA653, valid, ""},
+	{0xA654, 0xA654, mapped, "\ua655"},
+	{0xA655, 0xA655, valid, ""},
+	{0xA656, 0xA656, mapped, "\ua657"},
+	{0xA657, 0xA657, valid, ""},
+	{0xA658, 0xA658, mapped, "\ua659"},
+	{0xA659, 0xA659, valid, ""},
+	{0xA65A, 0xA65A, mapped, "\ua65b"},
+	{0xA65B, 0xA65B, valid, ""},
+	{0xA65C, 0xA65C, mapped, "\ua65d"},
+	{0xA65D, 0xA65D, valid, ""},
+	{0xA65E, 0xA65E, mapped, "\ua65f"},
+	{0xA65F, 0xA65F, valid, ""},
+	{0xA660, 0xA660, mapped, "\ua661"},
+	{0xA661, 0xA661, valid, ""},
+	{0xA662, 0xA662, mapped, "\ua663"},
+	{0xA663, 0xA663, valid, ""},
+	{0xA664, 0xA664, mapped, "\ua665"},
+	{0xA665, 0xA665, valid, ""},
+	{0xA666, 0xA666, mapped, "\ua667"},
+	{0xA667, 0xA667, valid, ""},
+	{0xA668, 0xA668, mapped, "\ua669"},
+	{0xA669, 0xA669, valid, ""},
+	{0xA66A, 0xA66A, mapped, "\ua66b"},
+	{0xA66B, 0xA66B, valid, ""},
+	{0xA66C, 0xA66C, mapped, "\ua66d"},
+	{0xA66D, 0xA67F, valid, ""},
+	{0xA680, 0xA680, mapped, "\ua681"},
+	{0xA681, 0xA681, valid, ""},
+	{0xA682, 0xA682, mapped, "\ua683"},
+	{0xA683, 0xA683, valid, ""},
+	{0xA684, 0xA684, mapped, "\ua685"},
+	{0xA685, 0xA685, valid, ""},
+	{0xA686, 0xA686, mapped, "\ua687"},
+	{0xA687, 0xA687, valid, ""},
+	{0xA688, 0xA688, mapped, "\ua689"},
+	{0xA689, 0xA689, valid, ""},
+	{0xA68A, 0xA68A, mapped, "\ua68b"},
+	{0xA68B, 0xA68B, valid, ""},
+	{0xA68C, 0xA68C, mapped, "\ua68d"},
+	{0xA68D, 0xA68D, valid, ""},
+	{0xA68E, 0xA68E, mapped, "\ua68f"},
+	{0xA68F, 0xA68F, valid, ""},
+	{0xA690, 0xA690, mapped, "\ua691"},
+	{0xA691, 0xA691, valid, ""},
+	{0xA692, 0xA692, mapped, "\ua693"},
+	{0xA693, 0xA693, valid, ""},
+	{0xA694, 0xA694, mapped, "\ua695"},
+	{0xA695, 0xA695, valid, ""},
+	{0xA696, 0xA696, mapped, "\ua697"},
+	{0xA697, 0xA697, valid, ""},
+	{0xA698, 0xA698, mapped, "\ua699"},
+	{0xA699, 0xA699, valid, ""},
+	{0xA69A, 0xA69A, mapped, "\ua69b"},
+	{0xA69B, 0xA69B, valid, ""},
+	{0xA69C, 0xA69C, mapped, "\u044a"},
+	{0xA69D, 0xA69D, mapped, "\u044c"},
+	{0xA69E, 0xA6F7, valid, ""},
+	{0xA6F8, 0xA6FF, disallowed, ""},
+	{0xA700, 0xA721, valid, ""},
+	{0xA722, 0xA722, mapped, "\ua723"},
+	{0xA723, 0xA723, valid, ""},
+	{0xA724, 0xA724, mapped, "\ua725"},
+	{0xA725, 0xA725, valid, ""},
+	{0xA726, 0xA726, mapped, "\ua727"},
+	{0xA727, 0xA727, valid, ""},
+	{0xA728, 0xA728, mapped, "\ua729"},
+	{0xA729, 0xA729, valid, ""},
+	{0xA72A, 0xA72A, mapped, "\ua72b"},
+	{0xA72B, 0xA72B, valid, ""},
+	{0xA72C, 0xA72C, mapped, "\ua72d"},
+	{0xA72D, 0xA72D, valid, ""},
+	{0xA72E, 0xA72E, mapped, "\ua72f"},
+	{0xA72F, 0xA731, valid, ""},
+	{0xA732, 0xA732, mapped, "\ua733"},
+	{0xA733, 0xA733, valid, ""},
+	{0xA734, 0xA734, mapped, "\ua735"},
+	{0xA735, 0xA735, valid, ""},
+	{0xA736, 0xA736, mapped, "\ua737"},
+	{0xA737, 0xA737, valid, ""},
+	{0xA738, 0xA738, mapped, "\ua739"},
+	{0xA739, 0xA739, valid, ""},
+	{0xA73A, 0xA73A, mapped, "\ua73b"},
+	{0xA73B, 0xA73B, valid, ""},
+	{0xA73C, 0xA73C, mapped, "\ua73d"},
+	{0xA73D, 0xA73D, valid, ""},
+	{0xA73E, 0xA73E, mapped, "\ua73f"},
+	{0xA73F, 0xA73F, valid, ""},
+	{0xA740, 0xA740, mapped, "\ua741"},
+	{0xA741, 0xA741, valid, ""},
+	{0xA742, 0xA742, mapped, "\ua743"},
+	{0xA743, 0xA743, valid, ""},
+	{0xA744, 0xA744, mapped, "\ua745"},
+	{0xA745, 0xA745, valid, ""},
+	{0xA746, 0xA746, mapped, "\ua747"},
+	{0xA747, 0xA747, valid, ""},
+	{0xA748, 0xA748, mapped, "\ua749"},
+	{0xA749, 0xA749, valid, ""},
+	{0xA74A, 0xA74A, mapped, "\ua74b"},
+	{0xA74B, 0xA74B, valid, ""},
+	{0xA74C, 0xA74C, mapped, "\ua74d"},
+	{0xA74D, 0xA74D, valid, ""},
+	{0xA74E, 0xA74E, mapped, "\ua74f"},
+	{0xA74F, 0xA74F, valid, ""},
+	{0xA750, 0xA750, mapped, "\ua751"},
+	{0xA751, 0xA751, valid, ""},
+	{0xA752, 0xA752, mapped, "\ua753"},
+	{0xA753, 0xA753, valid, ""},
+	{0xA754, 0xA754, mapped, "\ua755"},
+	{0xA755, 0xA755, valid, ""},
+	{0xA756, 0xA756, mapped, "\ua757"},
+	{0xA757, 0xA757, valid, ""},
+	{0xA758, 0xA758, mapped, "\ua759"},
+	{0xA759, 0xA759, valid, ""},
+	{0xA75A, 0xA75A, mapped, "\ua75b"},
+	{0xA75B, 0xA75B, valid, ""},
+	{0xA75C, 0xA75C, mapped, "\ua75d"},
+	{0xA75D, 0xA75D, valid, ""},
+	{0xA75E, 0xA75E, mapped, "\ua75f"},
+	{0xA75F, 0xA75F, valid, ""},
+	{0xA760, 0xA760, mapped, "\ua761"},
+	{0xA761, 0xA761, valid, ""},
+	{0xA762, 0xA762, mapped, "\ua763"},
+	{0xA763, 0xA763, valid, ""},
+	{0xA764, 0xA764, mapped, "\ua765"},
+	{0xA765, 0xA765, valid, ""},
+	{0xA766, 0xA766, mapped, "\ua767"},
+	{0xA767, 0xA767, valid, ""},
+	{0xA768, 0xA768, mapped, "\ua769"},
+	{0xA769, 0xA769, valid, ""},
+	{0xA76A, 0xA76A, mapped, "\ua76b"},
+	{0xA76B, 0xA76B, valid, ""},
+	{0xA76C, 0xA76C, mapped, "\ua76d"},
+	{0xA76D, 0xA76D, valid, ""},
+	{0xA76E, 0xA76E, mapped, "\ua76f"},
+	{0xA76F, 0xA76F, valid, ""},
+	{0xA770, 0xA770, mapped, "\ua76f"},
+	{0xA771, 0xA778, valid, ""},
+	{0xA779, 0xA779, mapped, "\ua77a"},
+	{0xA77A, 0xA77A, valid, ""},
+	{0xA77B, 0xA77B, mapped, "\ua77c"},
+	{0xA77C, 0xA77C, valid, ""},
+	{0xA77D, 0xA77D, mapped, "\u1d79"},
+	{0xA77E, 0xA77E, mapped, "\ua77f"},
+	{0xA77F, 0xA77F, valid, ""},
+	{0xA780, 0xA780, mapped, "\ua781"},
+	{0xA781, 0xA781, valid, ""},
+	{0xA782, 0xA782, mapped, "\ua783"},
+	{0xA783, 0xA783, valid, ""},
+	{0xA784, 0xA784, mapped, "\ua785"},
+	{0xA785, 0xA785, valid, ""},
+	{0xA786, 0xA786, mapped, "\ua787"},
+	{0xA787, 0xA78A, valid, ""},
+	{0xA78B, 0xA78B, mapped, "\ua78c"},
+	{0xA78C, 0xA78C, valid, ""},
+	{0xA78D, 0xA78D, mapped, "\u0265"},
+	{0xA78E, 0xA78F, valid, ""},
+	{0xA790, 0xA790, mapped, "\ua791"},
+	{0xA791, 0xA791, valid, ""},
+	{0xA792, 0xA792, mapped, "\ua793"},
+	{0xA793, 0xA795, valid, ""},
+	{0xA796, 0xA796, mapped, "\ua797"},
+	{0xA797, 0xA797, valid, ""},
+	{0xA798, 0xA798, mapped, "\ua799"},
+	{0xA799, 0xA799, valid, ""},
+	{0xA79A, 0xA79A, mapped, "\ua79b"},
+	{0xA79B, 0xA79B, valid, ""},
+	{0xA79C, 0xA79C, mapped, "\ua79d"},
+	{0xA79D, 0xA79D, valid, ""},
+	{0xA79E, 0xA79E, mapped, "\ua79f"},
+	{0xA79F, 0xA79F, valid, ""},
+	{0xA7A0, 0xA7A0, mapped, "\ua7a1"},
+	{0xA7A1, 0xA7A1, valid, ""},
+	{0xA7A2, 0xA7A2, mapped, "\ua7a3"},
+	{0xA7A3, 0xA7A3, valid, ""},
+	{0xA7A4, 0xA7A4, mapped, "\ua7a5"},
+	{0xA7A5, 0xA7A5, valid, ""},
+	{0xA7A6, 0xA7A6, mapped, "\ua7a7"},
+	{0xA7A7, 0xA7A7, valid, ""},
+	{0xA7A8, 0xA7A8, mapped, "\ua7a9"},
+	{0xA7A9, 0xA7A9, valid, ""},
+	{0xA7AA, 0xA7AA, mapped, "\u0266"},
+	{0xA7AB, 0xA7AB, mapped, "\u025c"},
+	{0xA7AC, 0xA7AC, mapped, "\u0261"},
+	{0xA7AD, 0xA7AD, mapped, "\u026c"},
+	{0xA7AE, 0xA7AE, mapped, "\u026a"},
+	{0xA7AF, 0xA7AF, valid, ""},
+	{0xA7B0, 0xA7B0, mapped, "\u029e"},
+	{0xA7B1, 0xA7B1, mapped, "\u0287"},
+	{0xA7B2, 0xA7B2, mapped, "\u029d"},
+	{0xA7B3, 0xA7B3, mapped, "\uab53"},
+	{0xA7B4, 0xA7B4, mapped, "\ua7b5"},
+	{0xA7B5, 0xA7B5, valid, ""},
+	{0xA7B6, 0xA7B6, mapped, "\ua7b7"},
+	{0xA7B7, 0xA7B7, valid, ""},
+	{0xA7B8, 0xA7B8, mapped, "\ua7b9"},
+	{0xA7B9, 0xA7B9, valid, ""},
+	{0xA7BA, 0xA7BA, mapped, "\ua7bb"},
+	{0xA7BB, 0xA7BB, valid, ""},
+	{0xA7BC, 0xA7BC, mapped, "\ua7bd"},
+	{0xA7BD, 0xA7BD, valid, ""},
+	{0xA7BE, 0xA7BE, mapped, "\ua7bf"},
+	{0xA7BF, 0xA7BF, valid, ""},
+	{0xA7C0, 0xA7C0, mapped, "\ua7c1"},
+	{0xA7C1, 0xA7C1, valid, ""},
+	{0xA7C2, 0xA7C2, mapped, "\ua7c3"},
+	{0xA7C3, 0xA7C3, valid, ""},
+	{0xA7C4, 0xA7C4, mapped, "\ua794"},
+	{0xA7C5, 0xA7C5, mapped, "\u0282"},
+	{0xA7C6, 0xA7C6, mapped, "\u1d8e"},
+	{0xA7C7, 0xA7C7, mapped, "\ua7c8"},
+	{0xA7C8, 0xA7C8, valid, ""},
+	{0xA7C9, 0xA7C9, mapped, "\ua7ca"},
+	{0xA7CA, 0xA7CA, valid, ""},
+	{0xA7CB, 0xA7CB, mapped, "\u0264"},
+	{0xA7CC, 0xA7CC, mapped, "\ua7cd"},
+	{0xA7CD, 0xA7CD, valid, ""},
+	{0xA7CE, 0xA7CE, mapped, "\ua7cf"},
+	{0xA7CF, 0xA7CF, valid, ""},
+	{0xA7D0, 0xA7D0, mapped, "\ua7d1"},
+	{0xA7D1, 0xA7D1, valid, ""},
+	{0xA7D2, 0xA7D2, mapped, "\ua7d3"},
+	{0xA7D3, 0xA7D3, valid, ""},
+	{0xA7D4, 0xA7D4, mapped, "\ua7d5"},
+	{0xA7D5, 0xA7D5, valid, ""},
+	{0xA7D6, 0xA7D6, mapped, "\ua7d7"},
+	{0xA7D7, 0xA7D7, valid, ""},
+	{0xA7D8, 0xA7D8, mapped, "\ua7d9"},
+	{0xA7D9, 0xA7D9, valid, ""},
+	{0xA7DA, 0xA7DA, mapped, "\ua7db"},
+	{0xA7DB, 0xA7DB, valid, ""},
+	{0xA7DC, 0xA7DC, mapped, "\u019b"},
+	{0xA7DD, 0xA7F0, disallowed, ""},
+	{0xA7F1, 0xA7F1, mapped, "s"},
+	{0xA7F2, 0xA7F2, mapped, "c"},
+	{0xA7F3, 0xA7F3, mapped, "f"},
+	{0xA7F4, 0xA7F4, mapped, "q"},
+	{0xA7F5, 0xA7F5, mapped, "\ua7f6"},
+	{0xA7F6, 0xA7F7, valid, ""},
+	{0xA7F8, 0xA7F8, mapped, "\u0127"},
+	{0xA7F9, 0xA7F9, mapped, "\u0153"},
+	{0xA7FA, 0xA82C, valid, ""},
+	{0xA82D, 0xA82F, disallowed, ""},
+	{0xA830, 0xA839, valid, ""},
+	{0xA83A, 0xA83F, disallowed, ""},
+	{0xA840, 0xA877, valid, ""},
+	{0xA878, 0xA87F, disallowed, ""},
+	{0xA880, 0xA8C5, valid, ""},
+	{0xA8C6, 0xA8CD, disallowed, ""},
+	{0xA8CE, 0xA8D9, valid, ""},
+	{0xA8DA, 0xA8DF, disallowed, ""},
+	{0xA8E0, 0xA953, valid, ""},
+	{0xA954, 0xA95E, disallowed, ""},
+	{0xA95F, 0xA97C, valid, ""},
+	{0xA97D, 0xA97F, disallowed, ""},
+	{0xA980, 0xA9CD, valid, ""},
+	{0xA9CE, 0xA9CE, disallowed, ""},
+	{0xA9CF, 0xA9D9, valid, ""},
+	{0xA9DA, 0xA9DD, disallowed, ""},
+	{0xA9DE, 0xA9FE, valid, ""},
+	{0xA9FF, 0xA9FF, disallowed, ""},
+	{0xAA00, 0xAA36, valid, ""},
+	{0xAA37, 0xAA3F, disallowed, ""},
+	{0xAA40, 0xAA4D, valid, ""},
+	{0xAA4E, 0xAA4F, disallowed, ""},
+	{0xAA50, 0xAA59, valid, ""},
+	{0xAA5A, 0xAA5B, disallowed, ""},
+	{0xAA5C, 0xAAC2, valid, ""},
+	{0xAAC3, 0xAADA, disallowed, ""},
+	{0xAADB, 0xAAF6, valid, ""},
+	{0xAAF7, 0xAB00, disallowed, ""},
+	{0xAB01, 0xAB06, valid, ""},
+	{0xAB07, 0xAB08, disallowed, ""},
+	{0xAB09, 0xAB0E, valid, ""},
+	{0xAB0F, 0xAB10, disallowed, ""},
+	{0xAB11, 0xAB16, valid, ""},
+	{0xAB17, 0xAB1F, disallowed, ""},
+	{0xAB20, 0xAB26, valid, ""},
+	{0xAB27, 0xAB27, disallowed, ""},
+	{0xAB28, 0xAB2E, valid, ""},
+	{0xAB2F, 0xAB2F, disallowed, ""},
+	{0xAB30, 0xAB5B, valid, ""},
+	{0xAB5C, 0xAB5C, mapped, "\ua727"},
+	{0xAB5D, 0xAB5D, mapped, "\uab37"},
+	{0xAB5E, 0xAB5E, mapped, "\u026b"},
+	{0xAB5F, 0xAB5F, mapped, "\uab52"},
+	{0xAB60, 0xAB68, valid, ""},
+	{0xAB69, 0xAB69, mapped, "\u028d"},
+	{0xAB6A, 0xAB6B, valid, ""},
+	{0xAB6C, 0xAB6F, disallowed, ""},
+	{0xAB70, 0xAB70, mapped, "\u13a0"},
+	{0xAB71, 0xAB71, mapped, "\u13a1"},
+	{0xAB72, 0xAB72, mapped, "\u13a2"},
+	{0xAB73, 0xAB73, mapped, "\u13a3"},
+	{0xAB74, 0xAB74, mapped, "\u13a4"},
+	{0xAB75, 0xAB75, mapped, "\u13a5"},
+	{0xAB76, 0xAB76, mapped, "\u13a6"},
+	{0xAB77, 0xAB77, mapped, "\u13a7"},
+	{0xAB78, 0xAB78, mapped, "\u13a8"},
+	{0xAB79, 0xAB79, mapped, "\u13a9"},
+	{0xAB7A, 0xAB7A, mapped, "\u13aa"},
+	{0xAB7B, 0xAB7B, mapped, "\u13ab"},
+	{0xAB7C, 0xAB7C, mapped, "\u13ac"},
+	{0xAB7D, 0xAB7D, mapped, "\u13ad"},
+	{0xAB7E, 0xAB7E, mapped, "\u13ae"},
+	{0xAB7F, 0xAB7F, mapped, "\u13af"},
+	{0xAB80, 0xAB80, mapped, "\u13b0"},
+	{0xAB81, 0xAB81, mapped, "\u13b1"},
+	{0xAB82, 0xAB82, mapped, "\u13b2"},
+	{0xAB83, 0xAB83, mapped, "\u13b3"},
+	{0xAB84, 0xAB84, mapped, "\u13b4"},
+	{0xAB85, 0xAB85, mapped, "\u13b5"},
+	{0xAB86, 0xAB86, mapped, "\u13b6"},
+	{0xAB87, 0xAB87, mapped, "\u13b7"},
+	{0xAB88, 0xAB88, mapped, "\u13b8"},
+	{0xAB89, 0xAB89, mapped, "\u13b9"},
+	{0xAB8A, 0xAB8A, mapped, "\u13ba"},
+	{0xAB8B, 0xAB8B, mapped, "\u13bb"},
+	{0xAB8C, 0xAB8C, mapped, "\u13bc"},
+	{0xAB8D, 0xAB8D, mapped, "\u13bd"},
+	{0xAB8E, 0xAB8E, mapped, "\u13be"},
+	{0xAB8F, 0xAB8F, mapped, "\u13bf"},
+	{0xAB90, 0xAB90, mapped, "\u13c0"},
+	{0xAB91, 0xAB91, mapped, "\u13c1"},
+	{0xAB92, 0xAB92, mapped, "\u13c2"},
+	{0xAB93, 0xAB93, mapped, "\u13c3"},
+	{0xAB94, 0xAB94, mapped, "\u13c4"},
+	{0xAB95, 0xAB95, mapped, "\u13c5"},
+	{0xAB96, 0xAB96, mapped, "\u13c6"},
+	{0xAB97, 0xAB97, mapped, "\u13c7"},
+	{0xAB98, 0xAB98, mapped, "\u13c8"},
+	{0xAB99, 0xAB99, mapped, "\u13c9"},
+	{0xAB9A, 0xAB9A, mapped, "\u13ca"},
+	{0xAB9B, 0xAB9B, mapped, "\u13cb"},
+	{0xAB9C, 0xAB9C, mapped, "\u13cc"},
+	{0xAB9D, 0xAB9D, mapped, "\u13cd"},
+	{0xAB9E, 0xAB9E, mapped, "\u13ce"},
+	{0xAB9F, 0xAB9F, mapped, "\u13cf"},
+	{0xABA0, 0xABA0, mapped, "\u13d0"},
+	{0xABA1, 0xABA1, mapped, "\u13d1"},
+	{0xABA2, 0xABA2, mapped, "\u13d2"},
+	{0xABA3, 0xABA3, mapped, "\u13d3"},
+	{0xABA4, 0xABA4, mapped, "\u13d4"},
+	{0xABA5, 0xABA5, mapped, "\u13d5"},
+	{0xABA6, 0xABA6, mapped, "\u13d6"},
+	{0xABA7, 0xABA7, mapped, "\u13d7"},
+	{0xABA8, 0xABA8, mapped, "\u13d8"},
+	{0xABA9, 0xABA9, mapped, "\u13d9"},
+	{0xABAA, 0xABAA, mapped, "\u13da"},
+	{0xABAB, 0xABAB, mapped, "\u13db"},
+	{0xABAC, 0xABAC, mapped, "\u13dc"},
+	{0xABAD, 0xABAD, mapped, "\u13dd"},
+	{0xABAE, 0xABAE, mapped, "\u13de"},
+	{0xABAF, 0xABAF, mapped, "\u13df"},
+	{0xABB0, 0xABB0, mapped, "\u13e0"},
+	{0xABB1, 0xABB1, mapped, "\u13e1"},
+	{0xABB2, 0xABB2, mapped, "\u13e2"},
+	{0xABB3, 0xABB3, mapped, "\u13e3"},
+	{0xABB4, 0xABB4, mapped, "\u13e4"},
+	{0xABB5, 0xABB5, mapped, "\u13e5"},
+	{0xABB6, 0xABB6, mapped, "\u13e6"},
+	{0xABB7, 0xABB7, mapped, "\u13e7"},
+	{0xABB8, 0xABB8, mapped, "\u13e8"},
+	{0xABB9, 0xABB9, mapped, "\u13e9"},
+	{0xABBA, 0xABBA, mapped, "\u13ea"},
+	{0xABBB, 0xABBB, mapped, "\u13eb"},
+	{0xABBC, 0xABBC, mapped, "\u13ec"},
+	{0xABBD, 0xABBD, mapped, "\u13ed"},
+	{0xABBE, 0xABBE, mapped, "\u13ee"},
+	{0xABBF, 0xABBF, mapped, "\u13ef"},
+	{0xABC0, 0xABED, valid, ""},
+	{0xABEE, 0xABEF, disallowed, ""},
+	{0xABF0, 0xABF9, valid, ""},
+	{0xABFA, 0xABFF, disallowed, ""},
+	{0xAC00, 0xD7A3, valid, ""},
+	{0xD7A4, 0xD7AF, disallowed, ""},
+	{0xD7B0, 0xD7C6, valid, ""},
+	{0xD7C7, 0xD7CA, disallowed, ""},
+	{0xD7CB, 0xD7FB, valid, ""},
+	{0xD7FC, 0xF8FF, disallowed, ""},
+	{0xF900, 0xF900, mapped, "\u8c48"},
+	{0xF901, 0xF901, mapped, "\u66f4"},
+	{0xF902, 0xF902, mapped, "\u8eca"},
+	{0xF903, 0xF903, mapped, "\u8cc8"},
+	{0xF904, 0xF904, mapped, "\u6ed1"},
+	{0xF905, 0xF905, mapped, "\u4e32"},
+	{0xF906, 0xF906, mapped, "\u53e5"},
+	{0xF907, 0xF907, mapped, "\u9f9c"},
+	{0xF908, 0xF908, mapped, "\u9f9c"},
+	{0xF909, 0xF909, mapped, "\u5951"},
+	{0xF90A, 0xF90A, mapped, "\u91d1"},
+	{0xF90B, 0xF90B, mapped, "\u5587"},
+	{0xF90C, 0xF90C, mapped, "\u5948"},
+	{0xF90D, 0xF90D, mapped, "\u61f6"},
+	{0xF90E, 0xF90E, mapped, "\u7669"},
+	{0xF90F, 0xF90F, mapped, "\u7f85"},
+	{0xF910, 0xF910, mapped, "\u863f"},
+	{0xF911, 0xF911, mapped, "\u87ba"},
+	{0xF912, 0xF912, mapped, "\u88f8"},
+	{0xF913, 0xF913, mapped, "\u908f"},
+	{0xF914, 0xF914, mapped, "\u6a02"},
+	{0xF915, 0xF915, mapped, "\u6d1b"},
+	{0xF916, 0xF916, mapped, "\u70d9"},
+	{0xF917, 0xF917, mapped, "\u73de"},
+	{0xF918, 0xF918, mapped, "\u843d"},
+	{0xF919, 0xF919, mapped, "\u916a"},
+	{0xF91A, 0xF91A, mapped, "\u99f1"},
+	{0xF91B, 0xF91B, mapped, "\u4e82"},
+	{0xF91C, 0xF91C, mapped, "\u5375"},
+	{0xF91D, 0xF91D, mapped, "\u6b04"},
+	{0xF91E, 0xF91E, mapped, "\u721b"},
+	{0xF91F, 0xF91F, mapped, "\u862d"},
+	{0xF920, 0xF920, mapped, "\u9e1e"},
+	{0xF921, 0xF921, mapped, "\u5d50"},
+	{0xF922, 0xF922, mapped, "\u6feb"},
+	{0xF923, 0xF923, mapped, "\u85cd"},
+	{0xF924, 0xF924, mapped, "\u8964"},
+	{0xF925, 0xF925, mapped, "\u62c9"},
+	{0xF926, 0xF926, mapped, "\u81d8"},
+	{0xF927, 0xF927, mapped, "\u881f"},
+	{0xF928, 0xF928, mapped, "\u5eca"},
+	{0xF929, 0xF929, mapped, "\u6717"},
+	{0xF92A, 0xF92A, mapped, "\u6d6a"},
+	{0xF92B, 0xF92B, mapped, "\u72fc"},
+	{0xF92C, 0xF92C, mapped, "\u90ce"},
+	{0xF92D, 0xF92D, mapped, "\u4f86"},
+	{0xF92E, 0xF92E, mapped, "\u51b7"},
+	{0xF92F, 0xF92F, mapped, "\u52de"},
+	{0xF930, 0xF930, mapped, "\u64c4"},
+	{0xF931, 0xF931, mapped, "\u6ad3"},
+	{0xF932, 0xF932, mapped, "\u7210"},
+	{0xF933, 0xF933, mapped, "\u76e7"},
+	{0xF934, 0xF934, mapped, "\u8001"},
+	{0xF935, 0xF935, mapped, "\u8606"},
+	{0xF936, 0xF936, mapped, "\u865c"},
+	{0xF937, 0xF937, mapped, "\u8def"},
+	{0xF938, 0xF938, mapped, "\u9732"},
+	{0xF939, 0xF939, mapped, "\u9b6f"},
+	{0xF93A, 0xF93A, mapped, "\u9dfa"},
+	{0xF93B, 0xF93B, mapped, "\u788c"},
+	{0xF93C, 0xF93C, mapped, "\u797f"},
+	{0xF93D, 0xF93D, mapped, "\u7da0"},
+	{0xF93E, 0xF93E, mapped, "\u83c9"},
+	{0xF93F, 0xF93F, mapped, "\u9304"},
+	{0xF940, 0xF940, mapped, "\u9e7f"},
+	{0xF941, 0xF941, mapped, "\u8ad6"},
+	{0xF942, 0xF942, mapped, "\u58df"},
+	{0xF943, 0xF943, mapped, "\u5f04"},
+	{0xF944, 0xF944, mapped, "\u7c60"},
+	{0xF945, 0xF945, mapped, "\u807e"},
+	{0xF946, 0xF946, mapped, "\u7262"},
+	{0xF947, 0xF947, mapped, "\u78ca"},
+	{0xF948, 0xF948, mapped, "\u8cc2"},
+	{0xF949, 0xF949, mapped, "\u96f7"},
+	{0xF94A, 0xF94A, mapped, "\u58d8"},
+	{0xF94B, 0xF94B, mapped, "\u5c62"},
+	{0xF94C, 0xF94C, mapped, "\u6a13"},
+	{0xF94D, 0xF94D, mapped, "\u6dda"},
+	{0xF94E, 0xF94E, mapped, "\u6f0f"},
+	{0xF94F, 0xF94F, mapped, "\u7d2f"},
+	{0xF950, 0xF950, mapped, "\u7e37"},
+	{0xF951, 0xF951, mapped, "\u964b"},
+	{0xF952, 0xF952, mapped, "\u52d2"},
+	{0xF953, 0xF953, mapped, "\u808b"},
+	{0xF954, 0xF954, mapped, "\u51dc"},
+	{0xF955, 0xF955, mapped, "\u51cc"},
+	{0xF956, 0xF956, mapped, "\u7a1c"},
+	{0xF957, 0xF957, mapped, "\u7dbe"},
+	{0xF958, 0xF958, mapped, "\u83f1"},
+	{0xF959, 0xF959, mapped, "\u9675"},
+	{0xF95A, 0xF95A, mapped, "\u8b80"},
+	{0xF95B, 0xF95B, mapped, "\u62cf"},
+	{0xF95C, 0xF95C, mapped, "\u6a02"},
+	{0xF95D, 0xF95D, mapped, "\u8afe"},
+	{0xF95E, 0xF95E, mapped, "\u4e39"},
+	{0xF95F, 0xF95F, mapped, "\u5be7"},
+	{0xF960, 0xF960, mapped, "\u6012"},
+	{0xF961, 0xF961, mapped, "\u7387"},
+	{0xF962, 0xF962, mapped, "\u7570"},
+	{0xF963, 0xF963, mapped, "\u5317"},
+	{0xF964, 0xF964, mapped, "\u78fb"},
+	{0xF965, 0xF965, mapped, "\u4fbf"},
+	{0xF966, 0xF966, mapped, "\u5fa9"},
+	{0xF967, 0xF967, mapped, "\u4e0d"},
+	{0xF968, 0xF968, mapped, "\u6ccc"},
+	{0xF969, 0xF969, mapped, "\u6578"},
+	{0xF96A, 0xF96A, mapped, "\u7d22"},
+	{0xF96B, 0xF96B, mapped, "\u53c3"},
+	{0xF96C, 0xF96C, mapped, "\u585e"},
+	{0xF96D, 0xF96D, mapped, "\u7701"},
+	{0xF96E, 0xF96E, mapped, "\u8449"},
+	{0xF96F, 0xF96F, mapped, "\u8aaa"},
+	{0xF970, 0xF970, mapped, "\u6bba"},
+	{0xF971, 0xF971, mapped, "\u8fb0"},
+	{0xF972, 0xF972, mapped, "\u6c88"},
+	{0xF973, 0xF973, mapped, "\u62fe"},
+	{0xF974, 0xF974, mapped, "\u82e5"},
+	{0xF975, 0xF975, mapped, "\u63a0"},
+	{0xF976, 0xF976, mapped, "\u7565"},
+	{0xF977, 0xF977, mapped, "\u4eae"},
+	{0xF978, 0xF978, mapped, "\u5169"},
+	{0xF979, 0xF979, mapped, "\u51c9"},
+	{0xF97A, 0xF97A, mapped, "\u6881"},
+	{0xF97B, 0xF97B, mapped, "\u7ce7"},
+	{0xF97C, 0xF97C, mapped, "\u826f"},
+	{0xF97D, 0xF97D, mapped, "\u8ad2"},
+	{0xF97E, 0xF97E, mapped, "\u91cf"},
+	{0xF97F, 0xF97F, mapped, "\u52f5"},
+	{0xF980, 0xF980, mapped, "\u5442"},
+	{0xF981, 0xF981, mapped, "\u5973"},
+	{0xF982, 0xF982, mapped, "\u5eec"},
+	{0xF983, 0xF983, mapped, "\u65c5"},
+	{0xF984, 0xF984, mapped, "\u6ffe"},
+	{0xF985, 0xF985, mapped, "\u792a"},
+	{0xF986, 0xF986, mapped, "\u95ad"},
+	{0xF987, 0xF987, mapped, "\u9a6a"},
+	{0xF988, 0xF988, mapped, "\u9e97"},
+	{0xF989, 0xF989, mapped, "\u9ece"},
+	{0xF98A, 0xF98A, mapped, "\u529b"},
+	{0xF98B, 0xF98B, mapped, "\u66c6"},
+	{0xF98C, 0xF98C, mapped, "\u6b77"},
+	{0xF98D, 0xF98D, mapped, "\u8f62"},
+	{0xF98E, 0xF98E, mapped, "\u5e74"},
+	{0xF98F, 0xF98F, mapped, "\u6190"},
+	{0xF990, 0xF990, mapped, "\u6200"},
+	{0xF991, 0xF991, mapped, "\u649a"},
+	{0xF992, 0xF992, mapped, "\u6f23"},
+	{0xF993, 0xF993, mapped, "\u7149"},
+	{0xF994, 0xF994, mapped, "\u7489"},
+	{0xF995, 0xF995, mapped, "\u79ca"},
+	{0xF996, 0xF996, mapped, "\u7df4"},
+	{0xF997, 0xF997, mapped, "\u806f"},
+	{0xF998, 0xF998, mapped, "\u8f26"},
+	{0xF999, 0xF999, mapped, "\u84ee"},
+	{0xF99A, 0xF99A, mapped, "\u9023"},
+	{0xF99B, 0xF99B, mapped, "\u934a"},
+	{0xF99C, 0xF99C, mapped, "\u5217"},
+	{0xF99D, 0xF99D, mapped, "\u52a3"},
+	{0xF99E, 0xF99E, mapped, "\u54bd"},
+	{0xF99F, 0xF99F, mapped, "\u70c8"},
+	{0xF9A0, 0xF9A0, mapped, "\u88c2"},
+	{0xF9A1, 0xF9A1, mapped, "\u8aaa"},
+	{0xF9A2, 0xF9A2, mapped, "\u5ec9"},
+	{0xF9A3, 0xF9A3, mapped, "\u5ff5"},
+	{0xF9A4, 0xF9A4, mapped, "\u637b"},
+	{0xF9A5, 0xF9A5, mapped, "\u6bae"},
+	{0xF9A6, 0xF9A6, mapped, "\u7c3e"},
+	{0xF9A7, 0xF9A7, mapped, "\u7375"},
+	{0xF9A8, 0xF9A8, mapped, "\u4ee4"},
+	{0xF9A9, 0xF9A9, mapped, "\u56f9"},
+	{0xF9AA, 0xF9AA, mapped, "\u5be7"},
+	{0xF9AB, 0xF9AB, mapped, "\u5dba"},
+	{0xF9AC, 0xF9AC, mapped, "\u601c"},
+	{0xF9AD, 0xF9AD, mapped, "\u73b2"},
+	{0xF9AE, 0xF9AE, mapped, "\u7469"},
+	{0xF9AF, 0xF9AF, mapped, "\u7f9a"},
+	{0xF9B0, 0xF9B0, mapped, "\u8046"},
+	{0xF9B1, 0xF9B1, mapped, "\u9234"},
+	{0xF9B2, 0xF9B2, mapped, "\u96f6"},
+	{0xF9B3, 0xF9B3, mapped, "\u9748"},
+	{0xF9B4, 0xF9B4, mapped, "\u9818"},
+	{0xF9B5, 0xF9B5, mapped, "\u4f8b"},
+	{0xF9B6, 0xF9B6, mapped, "\u79ae"},
+	{0xF9B7, 0xF9B7, mapped, "\u91b4"},
+	{0xF9B8, 0xF9B8, mapped, "\u96b8"},
+	{0xF9B9, 0xF9B9, mapped, "\u60e1"},
+	{0xF9BA, 0xF9BA, mapped, "\u4e86"},
+	{0xF9BB, 0xF9BB, mapped, "\u50da"},
+	{0xF9BC, 0xF9BC, mapped, "\u5bee"},
+	{0xF9BD, 0xF9BD, mapped, "\u5c3f"},
+	{0xF9BE, 0xF9BE, mapped, "\u6599"},
+	{0xF9BF, 0xF9BF, mapped, "\u6a02"},
+	{0xF9C0, 0xF9C0, mapped, "\u71ce"},
+	{0xF9C1, 0xF9C1, mapped, "\u7642"},
+	{0xF9C2, 0xF9C2, mapped, "\u84fc"},
+	{0xF9C3, 0xF9C3, mapped, "\u907c"},
+	{0xF9C4, 0xF9C4, mapped, "\u9f8d"},
+	{0xF9C5, 0xF9C5, mapped, "\u6688"},
+	{0xF9C6, 0xF9C6, mapped, "\u962e"},
+	{0xF9C7, 0xF9C7, mapped, "\u5289"},
+	{0xF9C8, 0xF9C8, mapped, "\u677b"},
+	{0xF9C9, 0xF9C9, mapped, "\u67f3"},
+	{0xF9CA, 0xF9CA, mapped, "\u6d41"},
+	{0xF9CB, 0xF9CB, mapped, "\u6e9c"},
+	{0xF9CC, 0xF9CC, mapped, "\u7409"},
+	{0xF9CD, 0xF9CD, mapped, "\u7559"},
+	{0xF9CE, 0xF9CE, mapped, "\u786b"},
+	{0xF9CF, 0xF9CF, mapped, "\u7d10"},
+	{0xF9D0, 0xF9D0, mapped, "\u985e"},
+	{0xF9D1, 0xF9D1, mapped, "\u516d"},
+	{0xF9D2, 0xF9D2, mapped, "\u622e"},
+	{0xF9D3, 0xF9D3, mapped, "\u9678"},
+	{0xF9D4, 0xF9D4, mapped, "\u502b"},
+	{0xF9D5, 0xF9D5, mapped, "\u5d19"},
+	{0xF9D6, 0xF9D6, mapped, "\u6dea"},
+	{0xF9D7, 0xF9D7, mapped, "\u8f2a"},
+	{0xF9D8, 0xF9D8, mapped, "\u5f8b"},
+	{0xF9D9, 0xF9D9, mapped, "\u6144"},
+	{0xF9DA, 0xF9DA, mapped, "\u6817"},
+	{0xF9DB, 0xF9DB, mapped, "\u7387"},
+	{0xF9DC, 0xF9DC, mapped, "\u9686"},
+	{0xF9DD, 0xF9DD, mapped, "\u5229"},
+	{0xF9DE, 0xF9DE, mapped, "\u540f"},
+	{0xF9DF, 0xF9DF, mapped, "\u5c65"},
+	{0xF9E0, 0xF9E0, mapped, "\u6613"},
+	{0xF9E1, 0xF9E1, mapped, "\u674e"},
+	{0xF9E2, 0xF9E2, mapped, "\u68a8"},
+	{0xF9E3, 0xF9E3, mapped, "\u6ce5"},
+	{0xF9E4, 0xF9E4, mapped, "\u7406"},
+	{0xF9E5, 0xF9E5, mapped, "\u75e2"},
+	{0xF9E6, 0xF9E6, mapped, "\u7f79"},
+	{0xF9E7, 0xF9E7, mapped, "\u88cf"},
+	{0xF9E8, 0xF9E8, mapped, "\u88e1"},
+	{0xF9E9, 0xF9E9, mapped, "\u91cc"},
+	{0xF9EA, 0xF9EA, mapped, "\u96e2"},
+	{0xF9EB, 0xF9EB, mapped, "\u533f"},
+	{0xF9EC, 0xF9EC, mapped, "\u6eba"},
+	{0xF9ED, 0xF9ED, mapped, "\u541d"},
+	{0xF9EE, 0xF9EE, mapped, "\u71d0"},
+	{0xF9EF, 0xF9EF, mapped, "\u7498"},
+	{0xF9F0, 0xF9F0, mapped, "\u85fa"},
+	{0xF9F1, 0xF9F1, mapped, "\u96a3"},
+	{0xF9F2, 0xF9F2, mapped, "\u9c57"},
+	{0xF9F3, 0xF9F3, mapped, "\u9e9f"},
+	{0xF9F4, 0xF9F4, mapped, "\u6797"},
+	{0xF9F5, 0xF9F5, mapped, "\u6dcb"},
+	{0xF9F6, 0xF9F6, mapped, "\u81e8"},
+	{0xF9F7, 0xF9F7, mapped, "\u7acb"},
+	{0xF9F8, 0xF9F8, mapped, "\u7b20"},
+	{0xF9F9, 0xF9F9, mapped, "\u7c92"},
+	{0xF9FA, 0xF9FA, mapped, "\u72c0"},
+	{0xF9FB, 0xF9FB, mapped, "\u7099"},
+	{0xF9FC, 0xF9FC, mapped, "\u8b58"},
+	{0xF9FD, 0xF9FD, mapped, "\u4ec0"},
+	{0xF9FE, 0xF9FE, mapped, "\u8336"},
+	{0xF9FF, 0xF9FF, mapped, "\u523a"},
+	{0xFA00, 0xFA00, mapped, "\u5207"},
+	{0xFA01, 0xFA01, mapped, "\u5ea6"},
+	{0xFA02, 0xFA02, mapped, "\u62d3"},
+	{0xFA03, 0xFA03, mapped, "\u7cd6"},
+	{0xFA04, 0xFA04, mapped, "\u5b85"},
+	{0xFA05, 0xFA05, mapped, "\u6d1e"},
+	{0xFA06, 0xFA06, mapped, "\u66b4"},
+	{0xFA07, 0xFA07, mapped, "\u8f3b"},
+	{0xFA08, 0xFA08, mapped, "\u884c"},
+	{0xFA09, 0xFA09, mapped, "\u964d"},
+	{0xFA0A, 0xFA0A, mapped, "\u898b"},
+	{0xFA0B, 0xFA0B, mapped, "\u5ed3"},
+	{0xFA0C, 0xFA0C, mapped, "\u5140"},
+	{0xFA0D, 0xFA0D, mapped, "\u55c0"},
+	{0xFA0E, 0xFA0F, valid, ""},
+	{0xFA10, 0xFA10, mapped, "\u585a"},
+	{0xFA11, 0xFA11, valid, ""},
+	{0xFA12, 0xFA12, mapped, "\u6674"},
+	{0xFA13, 0xFA14, valid, ""},
+	{0xFA15, 0xFA15, mapped, "\u51de"},
+	{0xFA16, 0xFA16, mapped, "\u732a"},
+	{0xFA17, 0xFA17, mapped, "\u76ca"},
+	{0xFA18, 0xFA18, mapped, "\u793c"},
+	{0xFA19, 0xFA19, mapped, "\u795e"},
+	{0xFA1A, 0xFA1A, mapped, "\u7965"},
+	{0xFA1B, 0xFA1B, mapped, "\u798f"},
+	{0xFA1C, 0xFA1C, mapped, "\u9756"},
+	{0xFA1D, 0xFA1D, mapped, "\u7cbe"},
+	{0xFA1E, 0xFA1E, mapped, "\u7fbd"},
+	{0xFA1F, 0xFA1F, valid, ""},
+	{0xFA20, 0xFA20, mapped, "\u8612"},
+	{0xFA21, 0xFA21, valid, ""},
+	{0xFA22, 0xFA22, mapped, "\u8af8"},
+	{0xFA23, 0xFA24, valid, ""},
+	{0xFA25, 0xFA25, mapped, "\u9038"},
+	{0xFA26, 0xFA26, mapped, "\u90fd"},
+	{0xFA27, 0xFA29, valid, ""},
+	{0xFA2A, 0xFA2A, mapped, "\u98ef"},
+	{0xFA2B, 0xFA2B, mapped, "\u98fc"},
+	{0xFA2C, 0xFA2C, mapped, "\u9928"},
+	{0xFA2D, 0xFA2D, mapped, "\u9db4"},
+	{0xFA2E, 0xFA2E, mapped, "\u90de"},
+	{0xFA2F, 0xFA2F, mapped, "\u96b7"},
+	{0xFA30, 0xFA30, mapped, "\u4fae"},
+	{0xFA31, 0xFA31, mapped, "\u50e7"},
+	{0xFA32, 0xFA32, mapped, "\u514d"},
+	{0xFA33, 0xFA33, mapped, "\u52c9"},
+	{0xFA34, 0xFA34, mapped, "\u52e4"},
+	{0xFA35, 0xFA35, mapped, "\u5351"},
+	{0xFA36, 0xFA36, mapped, "\u559d"},
+	{0xFA37, 0xFA37, mapped, "\u5606"},
+	{0xFA38, 0xFA38, mapped, "\u5668"},
+	{0xFA39, 0xFA39, mapped, "\u5840"},
+	{0xFA3A, 0xFA3A, mapped, "\u58a8"},
+	{0xFA3B, 0xFA3B, mapped, "\u5c64"},
+	{0xFA3C, 0xFA3C, mapped, "\u5c6e"},
+	{0xFA3D, 0xFA3D, mapped, "\u6094"},
+	{0xFA3E, 0xFA3E, mapped, "\u6168"},
+	{0xFA3F, 0xFA3F, mapped, "\u618e"},
+	{0xFA40, 0xFA40, mapped, "\u61f2"},
+	{0xFA41, 0xFA41, mapped, "\u654f"},
+	{0xFA42, 0xFA42, mapped, "\u65e2"},
+	{0xFA43, 0xFA43, mapped, "\u6691"},
+	{0xFA44, 0xFA44, mapped, "\u6885"},
+	{0xFA45, 0xFA45, mapped, "\u6d77"},
+	{0xFA46, 0xFA46, mapped, "\u6e1a"},
+	{0xFA47, 0xFA47, mapped, "\u6f22"},
+	{0xFA48, 0xFA48, mapped, "\u716e"},
+	{0xFA49, 0xFA49, mapped, "\u722b"},
+	{0xFA4A, 0xFA4A, mapped, "\u7422"},
+	{0xFA4B, 0xFA4B, mapped, "\u7891"},
+	{0xFA4C, 0xFA4C, mapped, "\u793e"},
+	{0xFA4D, 0xFA4D, mapped, "\u7949"},
+	{0xFA4E, 0xFA4E, mapped, "\u7948"},
+	{0xFA4F, 0xFA4F, mapped, "\u7950"},
+	{0xFA50, 0xFA50, mapped, "\u7956"},
+	{0xFA51, 0xFA51, mapped, "\u795d"},
+	{0xFA52, 0xFA52, mapped, "\u798d"},
+	{0xFA53, 0xFA53, mapped, "\u798e"},
+	{0xFA54, 0xFA54, mapped, "\u7a40"},
+	{0xFA55, 0xFA55, mapped, "\u7a81"},
+	{0xFA56, 0xFA56, mapped, "\u7bc0"},
+	{0xFA57, 0xFA57, mapped, "\u7df4"},
+	{0xFA58, 0xFA58, mapped, "\u7e09"},
+	{0xFA59, 0xFA59, mapped, "\u7e41"},
+	{0xFA5A, 0xFA5A, mapped, "\u7f72"},
+	{0xFA5B, 0xFA5B, mapped, "\u8005"},
+	{0xFA5C, 0xFA5C, mapped, "\u81ed"},
+	{0xFA5D, 0xFA5D, mapped, "\u8279"},
+	{0xFA5E, 0xFA5E, mapped, "\u8279"},
+	{0xFA5F, 0xFA5F, mapped, "\u8457"},
+	{0xFA60, 0xFA60, mapped, "\u8910"},
+	{0xFA61, 0xFA61, mapped, "\u8996"},
+	{0xFA62, 0xFA62, mapped, "\u8b01"},
+	{0xFA63, 0xFA63, mapped, "\u8b39"},
+	{0xFA64, 0xFA64, mapped, "\u8cd3"},
+	{0xFA65, 0xFA65, mapped, "\u8d08"},
+	{0xFA66, 0xFA66, mapped, "\u8fb6"},
+	{0xFA67, 0xFA67, mapped, "\u9038"},
+	{0xFA68, 0xFA68, mapped, "\u96e3"},
+	{0xFA69, 0xFA69, mapped, "\u97ff"},
+	{0xFA6A, 0xFA6A, mapped, "\u983b"},
+	{0xFA6B, 0xFA6B, mapped, "\u6075"},
+	{0xFA6C, 0xFA6C, mapped, "\U000242ee"},
+	{0xFA6D, 0xFA6D, mapped, "\u8218"},
+	{0xFA6E, 0xFA6F, disallowed, ""},
+	{0xFA70, 0xFA70, mapped, "\u4e26"},
+	{0xFA71, 0xFA71, mapped, "\u51b5"},
+	{0xFA72, 0xFA72, mapped, "\u5168"},
+	{0xFA73, 0xFA73, mapped, "\u4f80"},
+	{0xFA74, 0xFA74, mapped, "\u5145"},
+	{0xFA75, 0xFA75, mapped, "\u5180"},
+	{0xFA76, 0xFA76, mapped, "\u52c7"},
+	{0xFA77, 0xFA77, mapped, "\u52fa"},
+	{0xFA78, 0xFA78, mapped, "\u559d"},
+	{0xFA79, 0xFA79, mapped, "\u5555"},
+	{0xFA7A, 0xFA7A, mapped, "\u5599"},
+	{0xFA7B, 0xFA7B, mapped, "\u55e2"},
+	{0xFA7C, 0xFA7C, mapped, "\u585a"},
+	{0xFA7D, 0xFA7D, mapped, "\u58b3"},
+	{0xFA7E, 0xFA7E, mapped, "\u5944"},
+	{0xFA7F, 0xFA7F, mapped, "\u5954"},
+	{0xFA80, 0xFA80, mapped, "\u5a62"},
+	{0xFA81, 0xFA81, mapped, "\u5b28"},
+	{0xFA82, 0xFA82, mapped, "\u5ed2"},
+	{0xFA83, 0xFA83, mapped, "\u5ed9"},
+	{0xFA84, 0xFA84, mapped, "\u5f69"},
+	{0xFA85, 0xFA85, mapped, "\u5fad"},
+	{0xFA86, 0xFA86, mapped, "\u60d8"},
+	{0xFA87, 0xFA87, mapped, "\u614e"},
+	{0xFA88, 0xFA88, mapped, "\u6108"},
+	{0xFA89, 0xFA89, mapped, "\u618e"},
+	{0xFA8A, 0xFA8A, mapped, "\u6160"},
+	{0xFA8B, 0xFA8B, mapped, "\u61f2"},
+	{0xFA8C, 0xFA8C, mapped, "\u6234"},
+	{0xFA8D, 0xFA8D, mapped, "\u63c4"},
+	{0xFA8E, 0xFA8E, mapped, "\u641c"},
+	{0xFA8F, 0xFA8F, mapped, "\u6452"},
+	{0xFA90, 0xFA90, mapped, "\u6556"},
+	{0xFA91, 0xFA91, mapped, "\u6674"},
+	{0xFA92, 0xFA92, mapped, "\u6717"},
+	{0xFA93, 0xFA93, mapped, "\u671b"},
+	{0xFA94, 0xFA94, mapped, "\u6756"},
+	{0xFA95, 0xFA95, mapped, "\u6b79"},
+	{0xFA96, 0xFA96, mapped, "\u6bba"},
+	{0xFA97, 0xFA97, mapped, "\u6d41"},
+	{0xFA98, 0xFA98, mapped, "\u6edb"},
+	{0xFA99, 0xFA99, mapped, "\u6ecb"},
+	{0xFA9A, 0xFA9A, mapped, "\u6f22"},
+	{0xFA9B, 0xFA9B, mapped, "\u701e"},
+	{0xFA9C, 0xFA9C, mapped, "\u716e"},
+	{0xFA9D, 0xFA9D, mapped, "\u77a7"},
+	{0xFA9E, 0xFA9E, mapped, "\u7235"},
+	{0xFA9F, 0xFA9F, mapped, "\u72af"},
+	{0xFAA0, 0xFAA0, mapped, "\u732a"},
+	{0xFAA1, 0xFAA1, mapped, "\u7471"},
+	{0xFAA2, 0xFAA2, mapped, "\u7506"},
+	{0xFAA3, 0xFAA3, mapped, "\u753b"},
+	{0xFAA4, 0xFAA4, mapped, "\u761d"},
+	{0xFAA5, 0xFAA5, mapped, "\u761f"},
+	{0xFAA6, 0xFAA6, mapped, "\u76ca"},
+	{0xFAA7, 0xFAA7, mapped, "\u76db"},
+	{0xFAA8, 0xFAA8, mapped, "\u76f4"},
+	{0xFAA9, 0xFAA9, mapped, "\u774a"},
+	{0xFAAA, 0xFAAA, mapped, "\u7740"},
+	{0xFAAB, 0xFAAB, mapped, "\u78cc"},
+	{0xFAAC, 0xFAAC, mapped, "\u7ab1"},
+	{0xFAAD, 0xFAAD, mapped, "\u7bc0"},
+	{0xFAAE, 0xFAAE, mapped, "\u7c7b"},
+	{0xFAAF, 0xFAAF, mapped, "\u7d5b"},
+	{0xFAB0, 0xFAB0, mapped, "\u7df4"},
+	{0xFAB1, 0xFAB1, mapped, "\u7f3e"},
+	{0xFAB2, 0xFAB2, mapped, "\u8005"},
+	{0xFAB3, 0xFAB3, mapped, "\u8352"},
+	{0xFAB4, 0xFAB4, mapped, "\u83ef"},
+	{0xFAB5, 0xFAB5, mapped, "\u8779"},
+	{0xFAB6, 0xFAB6, mapped, "\u8941"},
+	{0xFAB7, 0xFAB7, mapped, "\u8986"},
+	{0xFAB8, 0xFAB8, mapped, "\u8996"},
+	{0xFAB9, 0xFAB9, mapped, "\u8abf"},
+	{0xFABA, 0xFABA, mapped, "\u8af8"},
+	{0xFABB, 0xFABB, mapped, "\u8acb"},
+	{0xFABC, 0xFABC, mapped, "\u8b01"},
+	{0xFABD, 0xFABD, mapped, "\u8afe"},
+	{0xFABE, 0xFABE, mapped, "\u8aed"},
+	{0xFABF, 0xFABF, mapped, "\u8b39"},
+	{0xFAC0, 0xFAC0, mapped, "\u8b8a"},
+	{0xFAC1, 0xFAC1, mapped, "\u8d08"},
+	{0xFAC2, 0xFAC2, mapped, "\u8f38"},
+	{0xFAC3, 0xFAC3, mapped, "\u9072"},
+	{0xFAC4, 0xFAC4, mapped, "\u9199"},
+	{0xFAC5, 0xFAC5, mapped, "\u9276"},
+	{0xFAC6, 0xFAC6, mapped, "\u967c"},
+	{0xFAC7, 0xFAC7, mapped, "\u96e3"},
+	{0xFAC8, 0xFAC8, mapped, "\u9756"},
+	{0xFAC9, 0xFAC9, mapped, "\u97db"},
+	{0xFACA, 0xFACA, mapped, "\u97ff"},
+	{0xFACB, 0xFACB, mapped, "\u980b"},
+	{0xFACC, 0xFACC, mapped, "\u983b"},
+	{0xFACD, 0xFACD, mapped, "\u9b12"},
+	{0xFACE, 0xFACE, mapped, "\u9f9c"},
+	{0xFACF, 0xFACF, mapped, "\U0002284a"},
+	{0xFAD0, 0xFAD0, mapped, "\U00022844"},
+	{0xFAD1, 0xFAD1, mapped, "\U000233d5"},
+	{0xFAD2, 0xFAD2, mapped, "\u3b9d"},
+	{0xFAD3, 0xFAD3, mapped, "\u4018"},
+	{0xFAD4, 0xFAD4, mapped, "\u4039"},
+	{0xFAD5, 0xFAD5, mapped, "\U00025249"},
+	{0xFAD6, 0xFAD6, mapped, "\U00025cd0"},
+	{0xFAD7, 0xFAD7, mapped, "\U00027ed3"},
+	{0xFAD8, 0xFAD8, mapped, "\u9f43"},
+	{0xFAD9, 0xFAD9, mapped, "\u9f8e"},
+	{0xFADA, 0xFAFF, disallowed, ""},
+	{0xFB00, 0xFB00, mapped, "ff"},
+	{0xFB01, 0xFB01, mapped, "fi"},
+	{0xFB02, 0xFB02, mapped, "fl"},
+	{0xFB03, 0xFB03, mapped, "ffi"},
+	{0xFB04, 0xFB04, mapped, "ffl"},
+	{0xFB05, 0xFB05, mapped, "st"},
+	{0xFB06, 0xFB06, mapped, "st"},
+	{0xFB07, 0xFB12, disallowed, ""},
+	{0xFB13, 0xFB13, mapped, "\u0574\u0576"},
+	{0xFB14, 0xFB14, mapped, "\u0574\u0565"},
+	{0xFB15, 0xFB15, mapped, "\u0574\u056b"},
+	{0xFB16, 0xFB16, mapped, "\u057e\u0576"},
+	{0xFB17, 0xFB17, mapped, "\u0574\u056d"},
+	{0xFB18, 0xFB1C, disallowed, ""},
+	{0xFB1D, 0xFB1D, mapped, "\u05d9\u05b4"},
+	{0xFB1E, 0xFB1E, valid, ""},
+	{0xFB1F, 0xFB1F, mapped, "\u05f2\u05b7"},
+	{0xFB20, 0xFB20, mapped, "\u05e2"},
+	{0xFB21, 0xFB21, mapped, "\u05d0"},
+	{0xFB22, 0xFB22, mapped, "\u05d3"},
+	{0xFB23, 0xFB23, mapped, "\u05d4"},
+	{0xFB24, 0xFB24, mapped, "\u05db"},
+	{0xFB25, 0xFB25, mapped, "\u05dc"},
+	{0xFB26, 0xFB26, mapped, "\u05dd"},
+	{0xFB27, 0xFB27, mapped, "\u05e8"},
+	{0xFB28, 0xFB28, mapped, "\u05ea"},
+	{0xFB29, 0xFB29, disallowedSTD3Mapped, "+"},
+	{0xFB2A, 0xFB2A, mapped, "\u05e9\u05c1"},
+	{0xFB2B, 0xFB2B, mapped, "\u05e9\u05c2"},
+	{0xFB2C, 0xFB2C, mapped, "\u05e9\u05bc\u05c1"},
+	{0xFB2D, 0xFB2D, mapped, "\u05e9\u05bc\u05c2"},
+	{0xFB2E, 0xFB2E, mapped, "\u05d0\u05b7"},
+	{0xFB2F, 0xFB2F, mapped, "\u05d0\u05b8"},
+	{0xFB30, 0xFB30, mapped, "\u05d0\u05bc"},
+	{0xFB31, 0xFB31, mapped, "\u05d1\u05bc"},
+	{0xFB32, 0xFB32, mapped, "\u05d2\u05bc"},
+	{0xFB33, 0xFB33, mapped, "\u05d3\u05bc"},
+	{0xFB34, 0xFB34, mapped, "\u05d4\u05bc"},
+	{0xFB35, 0xFB35, mapped, "\u05d5\u05bc"},
+	{0xFB36, 0xFB36, mapped, "\u05d6\u05bc"},
+	{0xFB37, 0xFB37, disallowed, ""},
+	{0xFB38, 0xFB38, mapped, "\u05d8\u05bc"},
+	{0xFB39, 0xFB39, mapped, "\u05d9\u05bc"},
+	{0xFB3A, 0xFB3A, mapped, "\u05da\u05bc"},
+	{0xFB3B, 0xFB3B, mapped, "\u05db\u05bc"},
+	{0xFB3C, 0xFB3C, mapped, "\u05dc\u05bc"},
+	{0xFB3D, 0xFB3D, disallowed, ""},
+	{0xFB3E, 0xFB3E, mapped, "\u05de\u05bc"},
+	{0xFB3F, 0xFB3F, disallowed, ""},
+	{0xFB40, 0xFB40, mapped, "\u05e0\u05bc"},
+	{0xFB41, 0xFB41, mapped, "\u05e1\u05bc"},
+	{0xFB42, 0xFB42, disallowed, ""},
+	{0xFB43, 0xFB43, mapped, "\u05e3\u05bc"},
+	{0xFB44, 0xFB44, mapped, "\u05e4\u05bc"},
+	{0xFB45, 0xFB45, disallowed, ""},
+	{0xFB46, 0xFB46, mapped, "\u05e6\u05bc"},
+	{0xFB47, 0xFB47, mapped, "\u05e7\u05bc"},
+	{0xFB48, 0xFB48, mapped, "\u05e8\u05bc"},
+	{0xFB49, 0xFB49, mapped, "\u05e9\u05bc"},
+	{0xFB4A, 0xFB4A, mapped, "\u05ea\u05bc"},
+	{0xFB4B, 0xFB4B, mapped, "\u05d5\u05b9"},
+	{0xFB4C, 0xFB4C, mapped, "\u05d1\u05bf"},
+	{0xFB4D, 0xFB4D, mapped, "\u05db\u05bf"},
+	{0xFB4E, 0xFB4E, mapped, "\u05e4\u05bf"},
+	{0xFB4F, 0xFB4F, mapped, "\u05d0\u05dc"},
+	{0xFB50, 0xFB50, mapped, "\u0671"},
+	{0xFB51, 0xFB51, mapped, "\u0671"},
+	{0xFB52, 0xFB52, mapped, "\u067b"},
+	{0xFB53, 0xFB53, mapped, "\u067b"},
+	{0xFB54, 0xFB54, mapped, "\u067b"},
+	{0xFB55, 0xFB55, mapped, "\u067b"},
+	{0xFB56, 0xFB56, mapped, "\u067e"},
+	{0xFB57, 0xFB57, mapped, "\u067e"},
+	{0xFB58, 0xFB58, mapped, "\u067e"},
+	{0xFB59, 0xFB59, mapped, "\u067e"},
+	{0xFB5A, 0xFB5A, mapped, "\u0680"},
+	{0xFB5B, 0xFB5B, mapped, "\u0680"},
+	{0xFB5C, 0xFB5C, mapped, "\u0680"},
+	{0xFB5D, 0xFB5D, mapped, "\u0680"},
+	{0xFB5E, 0xFB5E, mapped, "\u067a"},
+	{0xFB5F, 0xFB5F, mapped, "\u067a"},
+	{0xFB60, 0xFB60, mapped, "\u067a"},
+	{0xFB61, 0xFB61, mapped, "\u067a"},
+	{0xFB62, 0xFB62, mapped, "\u067f"},
+	{0xFB63, 0xFB63, mapped, "\u067f"},
+	{0xFB64, 0xFB64, mapped, "\u067f"},
+	{0xFB65, 0xFB65, mapped, "\u067f"},
+	{0xFB66, 0xFB66, mapped, "\u0679"},
+	{0xFB67, 0xFB67, mapped, "\u0679"},
+	{0xFB68, 0xFB68, mapped, "\u0679"},
+	{0xFB69, 0xFB69, mapped, "\u0679"},
+	{0xFB6A, 0xFB6A, mapped, "\u06a4"},
+	{0xFB6B, 0xFB6B, mapped, "\u06a4"},
+	{0xFB6C, 0xFB6C, mapped, "\u06a4"},
+	{0xFB6D, 0xFB6D, mapped, "\u06a4"},
+	{0xFB6E, 0xFB6E, mapped, "\u06a6"},
+	{0xFB6F, 0xFB6F, mapped, "\u06a6"},
+	{0xFB70, 0xFB70, mapped, "\u06a6"},
+	{0xFB71, 0xFB71, mapped, "\u06a6"},
+	{0xFB72, 0xFB72, mapped, "\u0684"},
+	{0xFB73, 0xFB73, mapped, "\u0684"},
+	{0xFB74, 0xFB74, mapped, "\u0684"},
+	{0xFB75, 0xFB75, mapped, "\u0684"},
+	{0xFB76, 0xFB76, mapped, "\u0683"},
+	{0xFB77, 0xFB77, mapped, "\u0683"},
+	{0xFB78, 0xFB78, mapped, "\u0683"},
+	{0xFB79, 0xFB79, mapped, "\u0683"},
+	{0xFB7A, 0xFB7A, mapped, "\u0686"},
+	{0xFB7B, 0xFB7B, mapped, "\u0686"},
+	{0xFB7C, 0xFB7C, mapped, "\u0686"},
+	{0xFB7D, 0xFB7D, mapped, "\u0686"},
+	{0xFB7E, 0xFB7E, mapped, "\u0687"},
+	{0xFB7F, 0xFB7F, mapped, "\u0687"},
+	{0xFB80, 0xFB80, mapped, "\u0687"},
+	{0xFB81, 0xFB81, mapped, "\u0687"},
+	{0xFB82, 0xFB82, mapped, "\u068d"},
+	{0xFB83, 0xFB83, mapped, "\u068d"},
+	{0xFB84, 0xFB84, mapped, "\u068c"},
+	{0xFB85, 0xFB85, mapped, "\u068c"},
+	{0xFB86, 0xFB86, mapped, "\u068e"},
+	{0xFB87, 0xFB87, mapped, "\u068e"},
+	{0xFB88, 0xFB88, mapped, "\u0688"},
+	{0xFB89, 0xFB89, mapped, "\u0688"},
+	{0xFB8A, 0xFB8A, mapped, "\u0698"},
+	{0xFB8B, 0xFB8B, mapped, "\u0698"},
+	{0xFB8C, 0xFB8C, mapped, "\u0691"},
+	{0xFB8D, 0xFB8D, mapped, "\u0691"},
+	{0xFB8E, 0xFB8E, mapped, "\u06a9"},
+	{0xFB8F, 0xFB8F, mapped, "\u06a9"},
+	{0xFB90, 0xFB90, mapped, "\u06a9"},
+	{0xFB91, 0xFB91, mapped, "\u06a9"},
+	{0xFB92, 0xFB92, mapped, "\u06af"},
+	{0xFB93, 0xFB93, mapped, "\u06af"},
+	{0xFB94, 0xFB94, mapped, "\u06af"},
+	{0xFB95, 0xFB95, mapped, "\u06af"},
+	{0xFB96, 0xFB96, mapped, "\u06b3"},
+	{0xFB97, 0xFB97, mapped, "\u06b3"},
+	{0xFB98, 0xFB98, mapped, "\u06b3"},
+	{0xFB99, 0xFB99, mapped, "\u06b3"},
+	{0xFB9A, 0xFB9A, mapped, "\u06b1"},
+	{0xFB9B, 0xFB9B, mapped, "\u06b1"},
+	{0xFB9C, 0xFB9C, mapped, "\u06b1"},
+	{0xFB9D, 0xFB9D, mapped, "\u06b1"},
+	{0xFB9E, 0xFB9E, mapped, "\u06ba"},
+	{0xFB9F, 0xFB9F, mapped, "\u06ba"},
+	{0xFBA0, 0xFBA0, mapped, "\u06bb"},
+	{0xFBA1, 0xFBA1, mapped, "\u06bb"},
+	{0xFBA2, 0xFBA2, mapped, "\u06bb"},
+	{0xFBA3, 0xFBA3, mapped, "\u06bb"},
+	{0xFBA4, 0xFBA4, mapped, "\u06c0"},
+	{0xFBA5, 0xFBA5, mapped, "\u06c0"},
+	{0xFBA6, 0xFBA6, mapped, "\u06c1"},
+	{0xFBA7, 0xFBA7, mapped, "\u06c1"},
+	{0xFBA8, 0xFBA8, mapped, "\u06c1"},
+	{0xFBA9, 0xFBA9, mapped, "\u06c1"},
+	{0xFBAA, 0xFBAA, mapped, "\u06be"},
+	{0xFBAB, 0xFBAB, mapped, "\u06be"},
+	{0xFBAC, 0xFBAC, mapped, "\u06be"},
+	{0xFBAD, 0xFBAD, mapped, "\u06be"},
+	{0xFBAE, 0xFBAE, mapped, "\u06d2"},
+	{0xFBAF, 0xFBAF, mapped, "\u06d2"},
+	{0xFBB0, 0xFBB0, mapped, "\u06d3"},
+	{0xFBB1, 0xFBB1, mapped, "\u06d3"},
+	{0xFBB2, 0xFBD2, valid, ""},
+	{0xFBD3, 0xFBD3, mapped, "\u06ad"},
+	{0xFBD4, 0xFBD4, mapped, "\u06ad"},
+	{0xFBD5, 0xFBD5, mapped, "\u06ad"},
+	{0xFBD6, 0xFBD6, mapped, "\u06ad"},
+	{0xFBD7, 0xFBD7, mapped, "\u06c7"},
+	{0xFBD8, 0xFBD8, mapped, "\u06c7"},
+	{0xFBD9, 0xFBD9, mapped, "\u06c6"},
+	{0xFBDA, 0xFBDA, mapped, "\u06c6"},
+	{0xFBDB, 0xFBDB, mapped, "\u06c8"},
+	{0xFBDC, 0xFBDC, mapped, "\u06c8"},
+	{0xFBDD, 0xFBDD, mapped, "\u06c7\u0674"},
+	{0xFBDE, 0xFBDE, mapped, "\u06cb"},
+	{0xFBDF, 0xFBDF, mapped, "\u06cb"},
+	{0xFBE0, 0xFBE0, mapped, "\u06c5"},
+	{0xFBE1, 0xFBE1, mapped, "\u06c5"},
+	{0xFBE2, 0xFBE2, mapped, "\u06c9"},
+	{0xFBE3, 0xFBE3, mapped, "\u06c9"},
+	{0xFBE4, 0xFBE4, mapped, "\u06d0"},
+	{0xFBE5, 0xFBE5, mapped, "\u06d0"},
+	{0xFBE6, 0xFBE6, mapped, "\u06d0"},
+	{0xFBE7, 0xFBE7, mapped, "\u06d0"},
+	{0xFBE8, 0xFBE8, mapped, "\u0649"},
+	{0xFBE9, 0xFBE9, mapped, "\u0649"},
+	{0xFBEA, 0xFBEA, mapped, "\u0626\u0627"},
+	{0xFBEB, 0xFBEB, mapped, "\u0626\u0627"},
+	{0xFBEC, 0xFBEC, mapped, "\u0626\u06d5"},
+	{0xFBED, 0xFBED, mapped, "\u0626\u06d5"},
+	{0xFBEE, 0xFBEE, mapped, "\u0626\u0648"},
+	{0xFBEF, 0xFBEF, mapped, "\u0626\u0648"},
+	{0xFBF0, 0xFBF0, mapped, "\u0626\u06c7"},
+	{0xFBF1, 0xFBF1, mapped, "\u0626\u06c7"},
+	{0xFBF2, 0xFBF2, mapped, "\u0626\u06c6"},
+	{0xFBF3, 0xFBF3, mapped, "\u0626\u06c6"},
+	{0xFBF4, 0xFBF4, mapped, "\u0626\u06c8"},
+	{0xFBF5, 0xFBF5, mapped, "\u0626\u06c8"},
+	{0xFBF6, 0xFBF6, mapped, "\u0626\u06d0"},
+	{0xFBF7, 0xFBF7, mapped, "\u0626\u06d0"},
+	{0xFBF8, 0xFBF8, mapped, "\u0626\u06d0"},
+	{0xFBF9, 0xFBF9, mapped, "\u0626\u0649"},
+	{0xFBFA, 0xFBFA, mapped, "\u0626\u0649"},
+	{0xFBFB, 0xFBFB, mapped, "\u0626\u0649"},
+	{0xFBFC, 0xFBFC, mapped, "\u06cc"},
+	{0xFBFD, 0xFBFD, mapped, "\u06cc"},
+	{0xFBFE, 0xFBFE, mapped, "\u06cc"},
+	{0xFBFF, 0xFBFF, mapped, "\u06cc"},
+	{0xFC00, 0xFC00, mapped, "\u0626\u062c"},
+	{0xFC01, 0xFC01, mapped, "\u0626\u062d"},
+	{0xFC02, 0xFC02, mapped, "\u0626\u0645"},
+	{0xFC03, 0xFC03, mapped, "\u0626\u0649"},
+	{0xFC04, 0xFC04, mapped, "\u0626\u064a"},
+	{0xFC05, 0xFC05, mapped, "\u0628\u062c"},
+	{0xFC06, 0xFC06, mapped, "\u0628\u062d"},
+	{0xFC07, 0xFC07, mapped, "\u0628\u062e"},
+	{0xFC08, 0xFC08, mapped, "\u0628\u0645"},
+	{0xFC09, 0xFC09, mapped, "\u0628\u0649"},
+	{0xFC0A, 0xFC0A, mapped, "\u0628\u064a"},
+	{0xFC0B, 0xFC0B, mapped, "\u062a\u062c"},
+	{0xFC0C, 0xFC0C, mapped, "\u062a\u062d"},
+	{0xFC0D, 0xFC0D, mapped, "\u062a\u062e"},
+	{0xFC0E, 0xFC0E, mapped, "\u062a\u0645"},
+	{0xFC0F, 0xFC0F, mapped, "\u062a\u0649"},
+	{0xFC10, 0xFC10, mapped, "\u062a\u064a"},
+	{0xFC11, 0xFC11, mapped, "\u062b\u062c"},
+	{0xFC12, 0xFC12, mapped, "\u062b\u0645"},
+	{0xFC13, 0xFC13, mapped, "\u062b\u0649"},
+	{0xFC14, 0xFC14, mapped, "\u062b\u064a"},
+	{0xFC15, 0xFC15, mapped, "\u062c\u062d"},
+	{0xFC16, 0xFC16, mapped, "\u062c\u0645"},
+	{0xFC17, 0xFC17, mapped, "\u062d\u062c"},
+	{0xFC18, 0xFC18, mapped, "\u062d\u0645"},
+	{0xFC19, 0xFC19, mapped, "\u062e\u062c"},
+	{0xFC1A, 0xFC1A, mapped, "\u062e\u062d"},
+	{0xFC1B, 0xFC1B, mapped, "\u062e\u0645"},
+	{0xFC1C, 0xFC1C, mapped, "\u0633\u062c"},
+	{0xFC1D, 0xFC1D, mapped, "\u0633\u062d"},
+	{0xFC1E, 0xFC1E, mapped, "\u0633\u062e"},
+	{0xFC1F, 0xFC1F, mapped, "\u0633\u0645"},
+	{0xFC20, 0xFC20, mapped, "\u0635\u062d"},
+	{0xFC21, 0xFC21, mapped, "\u0635\u0645"},
+	{0xFC22, 0xFC22, mapped, "\u0636\u062c"},
+	{0xFC23, 0xFC23, mapped, "\u0636\u062d"},
+	{0xFC24, 0xFC24, mapped, "\u0636\u062e"},
+	{0xFC25, 0xFC25, mapped, "\u0636\u0645"},
+	{0xFC26, 0xFC26, mapped, "\u0637\u062d"},
+	{0xFC27, 0xFC27, mapped, "\u0637\u0645"},
+	{0xFC28, 0xFC28, mapped, "\u0638\u0645"},
+	{0xFC29, 0xFC29, mapped, "\u0639\u062c"},
+	{0xFC2A, 0xFC2A, mapped, "\u0639\u0645"},
+	{0xFC2B, 0xFC2B, mapped, "\u063a\u062c"},
+	{0xFC2C, 0xFC2C, mapped, "\u063a\u0645"},
+	{0xFC2D, 0xFC2D, mapped, "\u0641\u062c"},
+	{0xFC2E, 0xFC2E, mapped, "\u0641\u062d"},
+	{0xFC2F, 0xFC2F, mapped, "\u0641\u062e"},
+	{0xFC30, 0xFC30, mapped, "\u0641\u0645"},
+	{0xFC31, 0xFC31, mapped, "\u0641\u0649"},
+	{0xFC32, 0xFC32, mapped, "\u0641\u064a"},
+	{0xFC33, 0xFC33, mapped, "\u0642\u062d"},
+	{0xFC34, 0xFC34, mapped, "\u0642\u0645"},
+	{0xFC35, 0xFC35, mapped, "\u0642\u0649"},
+	{0xFC36, 0xFC36, mapped, "\u0642\u064a"},
+	{0xFC37, 0xFC37, mapped, "\u0643\u0627"},
+	{0xFC38, 0xFC38, mapped, "\u0643\u062c"},
+	{0xFC39, 0xFC39, mapped, "\u0643\u062d"},
+	{0xFC3A, 0xFC3A, mapped, "\u0643\u062e"},
+	{0xFC3B, 0xFC3B, mapped, "\u0643\u0644"},
+	{0xFC3C, 0xFC3C, mapped, "\u0643\u0645"},
+	{0xFC3D, 0xFC3D, mapped, "\u0643\u0649"},
+	{0xFC3E, 0xFC3E, mapped, "\u0643\u064a"},
+	{0xFC3F, 0xFC3F, mapped, "\u0644\u062c"},
+	{0xFC40, 0xFC40, mapped, "\u0644\u062d"},
+	{0xFC41, 0xFC41, mapped, "\u0644\u062e"},
+	{0xFC42, 0xFC42, mapped, "\u0644\u0645"},
+	{0xFC43, 0xFC43, mapped, "\u0644\u0649"},
+	{0xFC44, 0xFC44, mapped, "\u0644\u064a"},
+	{0xFC45, 0xFC45, mapped, "\u0645\u062c"},
+	{0xFC46, 0xFC46, mapped, "\u0645\u062d"},
+	{0xFC47, 0xFC47, mapped, "\u0645\u062e"},
+	{0xFC48, 0xFC48, mapped, "\u0645\u0645"},
+	{0xFC49, 0xFC49, mapped, "\u0645\u0649"},
+	{0xFC4A, 0xFC4A, mapped, "\u0645\u064a"},
+	{0xFC4B, 0xFC4B, mapped, "\u0646\u062c"},
+	{0xFC4C, 0xFC4C, mapped, "\u0646\u062d"},
+	{0xFC4D, 0xFC4D, mapped, "\u0646\u062e"},
+	{0xFC4E, 0xFC4E, mapped, "\u0646\u0645"},
+	{0xFC4F, 0xFC4F, mapped, "\u0646\u0649"},
+	{0xFC50, 0xFC50, mapped, "\u0646\u064a"},
+	{0xFC51, 0xFC51, mapped, "\u0647\u062c"},
+	{0xFC52, 0xFC52, mapped, "\u0647\u0645"},
+	{0xFC53, 0xFC53, mapped, "\u0647\u0649"},
+	{0xFC54, 0xFC54, mapped, "\u0647\u064a"},
+	{0xFC55, 0xFC55, mapped, "\u064a\u062c"},
+	{0xFC56, 0xFC56, mapped, "\u064a\u062d"},
+	{0xFC57, 0xFC57, mapped, "\u064a\u062e"},
+	{0xFC58, 0xFC58, mapped, "\u064a\u0645"},
+	{0xFC59, 0xFC59, mapped, "\u064a\u0649"},
+	{0xFC5A, 0xFC5A, mapped, "\u064a\u064a"},
+	{0xFC5B, 0xFC5B, mapped, "\u0630\u0670"},
+	{0xFC5C, 0xFC5C, mapped, "\u0631\u0670"},
+	{0xFC5D, 0xFC5D, mapped, "\u0649\u0670"},
+	{0xFC5E, 0xFC5E, disallowedSTD3Mapped, " \u064c\u0651"},
+	{0xFC5F, 0xFC5F, disallowedSTD3Mapped, " \u064d\u0651"},
+	{0xFC60, 0xFC60, disallowedSTD3Mapped, " \u064e\u0651"},
+	{0xFC61, 0xFC61, disallowedSTD3Mapped, " \u064f\u0651"},
+	{0xFC62, 0xFC62, disallowedSTD3Mapped, " \u0650\u0651"},
+	{0xFC63, 0xFC63, disallowedSTD3Mapped, " \u0651\u0670"},
+	{0xFC64, 0xFC64, mapped, "\u0626\u0631"},
+	{0xFC65, 0xFC65, mapped, "\u0626\u0632"},
+	{0xFC66, 0xFC66, mapped, "\u0626\u0645"},
+	{0xFC67, 0xFC67, mapped, "\u0626\u0646"},
+	{0xFC68, 0xFC68, mapped, "\u0626\u0649"},
+	{0xFC69, 0xFC69, mapped, "\u0626\u064a"},
+	{0xFC6A, 0xFC6A, mapped, "\u0628\u0631"},
+	{0xFC6B, 0xFC6B, mapped, "\u0628\u0632"},
+	{0xFC6C, 0xFC6C, mapped, "\u0628\u0645"},
+	{0xFC6D, 0xFC6D, mapped, "\u0628\u0646"},
+	{0xFC6E, 0xFC6E, mapped, "\u0628\u0649"},
+	{0xFC6F, 0xFC6F, mapped, "\u0628\u064a"},
+	{0xFC70, 0xFC70, mapped, "\u062a\u0631"},
+	{0xFC71, 0xFC71, mapped, "\u062a\u0632"},
+	{0xFC72, 0xFC72, mapped, "\u062a\u0645"},
+	{0xFC73, 0xFC73, mapped, "\u062a\u0646"},
+	{0xFC74, 0xFC74, mapped, "\u062a\u0649"},
+	{0xFC75, 0xFC75, mapped, "\u062a\u064a"},
+	{0xFC76, 0xFC76, mapped, "\u062b\u0631"},
+	{0xFC77, 0xFC77, mapped, "\u062b\u0632"},
+	{0xFC78, 0xFC78, mapped, "\u062b\u0645"},
+	{0xFC79, 0xFC79, mapped, "\u062b\u0646"},
+	{0xFC7A, 0xFC7A, mapped, "\u062b\u0649"},
+	{0xFC7B, 0xFC7B, mapped, "\u062b\u064a"},
+	{0xFC7C, 0xFC7C, mapped, "\u0641\u0649"},
+	{0xFC7D, 0xFC7D, mapped, "\u0641\u064a"},
+	{0xFC7E, 0xFC7E, mapped, "\u0642\u0649"},
+	{0xFC7F, 0xFC7F, mapped, "\u0642\u064a"},
+	{0xFC80, 0xFC80, mapped, "\u0643\u0627"},
+	{0xFC81, 0xFC81, mapped, "\u0643\u0644"},
+	{0xFC82, 0xFC82, mapped, "\u0643\u0645"},
+	{0xFC83, 0xFC83, mapped, "\u0643\u0649"},
+	{0xFC84, 0xFC84, mapped, "\u0643\u064a"},
+	{0xFC85, 0xFC85, mapped, "\u0644\u0645"},
+	{0xFC86, 0xFC86, mapped, "\u0644\u0649"},
+	{0xFC87, 0xFC87, mapped, "\u0644\u064a"},
+	{0xFC88, 0xFC88, mapped, "\u0645\u0627"},
+	{0xFC89, 0xFC89, mapped, "\u0645\u0645"},
+	{0xFC8A, 0xFC8A, mapped, "\u0646\u0631"},
+	{0xFC8B, 0xFC8B, mapped, "\u0646\u0632"},
+	{0xFC8C, 0xFC8C, mapped, "\u0646\u0645"},
+	{0xFC8D, 0xFC8D, mapped, "\u0646\u0646"},
+	{0xFC8E, 0xFC8E, mapped, "\u0646\u0649"},
+	{0xFC8F, 0xFC8F, mapped, "\u0646\u064a"},
+	{0xFC90, 0xFC90, mapped, "\u0649\u0670"},
+	{0xFC91, 0xFC91, mapped, "\u064a\u0631"},
+	{0xFC92, 0xFC92, mapped, "\u064a\u0632"},
+	{0xFC93, 0xFC93, mapped, "\u064a\u0645"},
+	{0xFC94, 0xFC94, mapped, "\u064a\u0646"},
+	{0xFC95, 0xFC95, mapped, "\u064a\u0649"},
+	{0xFC96, 0xFC96, mapped, "\u064a\u064a"},
+	{0xFC97, 0xFC97, mapped, "\u0626\u062c"},
+	{0xFC98, 0xFC98, mapped, "\u0626\u062d"},
+	{0xFC99, 0xFC99, mapped, "\u0626\u062e"},
+	{0xFC9A, 0xFC9A, mapped, "\u0626\u0645"},
+	{0xFC9B, 0xFC9B, mapped, "\u0626\u0647"},
+	{0xFC9C, 0xFC9C, mapped, "\u0628\u062c"},
+	{0xFC9D, 0xFC9D, mapped, "\u0628\u062d"},
+	{0xFC9E, 0xFC9E, mapped, "\u0628\u062e"},
+	{0xFC9F, 0xFC9F, mapped, "\u0628\u0645"},
+	{0xFCA0, 0xFCA0, mapped, "\u0628\u0647"},
+	{0xFCA1, 0xFCA1, mapped, "\u062a\u062c"},
+	{0xFCA2, 0xFCA2, mapped, "\u062a\u062d"},
+	{0xFCA3, 0xFCA3, mapped, "\u062a\u062e"},
+	{0xFCA4, 0xFCA4, mapped, "\u062a\u0645"},
+	{0xFCA5, 0xFCA5, mapped, "\u062a\u0647"},
+	{0xFCA6, 0xFCA6, mapped, "\u062b\u0645"},
+	{0xFCA7, 0xFCA7, mapped, "\u062c\u062d"},
+	{0xFCA8, 0xFCA8, mapped, "\u062c\u0645"},
+	{0xFCA9, 0xFCA9, mapped, "\u062d\u062c"},
+	{0xFCAA, 0xFCAA, mapped, "\u062d\u0645"},
+	{0xFCAB, 0xFCAB, mapped, "\u062e\u062c"},
+	{0xFCAC, 0xFCAC, mapped, "\u062e\u0645"},
+	{0xFCAD, 0xFCAD, mapped, "\u0633\u062c"},
+	{0xFCAE, 0xFCAE, mapped, "\u0633\u062d"},
+	{0xFCAF, 0xFCAF, mapped, "\u0633\u062e"},
+	{0xFCB0, 0xFCB0, mapped, "\u0633\u0645"},
+	{0xFCB1, 0xFCB1, mapped, "\u0635\u062d"},
+	{0xFCB2, 0xFCB2, mapped, "\u0635\u062e"},
+	{0xFCB3, 0xFCB3, mapped, "\u0635\u0645"},
+	{0xFCB4, 0xFCB4, mapped, "\u0636\u062c"},
+	{0xFCB5, 0xFCB5, mapped, "\u0636\u062d"},
+	{0xFCB6, 0xFCB6, mapped, "\u0636\u062e"},
+	{0xFCB7, 0xFCB7, mapped, "\u0636\u0645"},
+	{0xFCB8, 0xFCB8, mapped, "\u0637\u062d"},
+	{0xFCB9, 0xFCB9, mapped, "\u0638\u0645"},
+	{0xFCBA, 0xFCBA, mapped, "\u0639\u062c"},
+	{0xFCBB, 0xFCBB, mapped, "\u0639\u0645"},
+	{0xFCBC, 0xFCBC, mapped, "\u063a\u062c"},
+	{0xFCBD, 0xFCBD, mapped, "\u063a\u0645"},
+	{0xFCBE, 0xFCBE, mapped, "\u0641\u062c"},
+	{0xFCBF, 0xFCBF, mapped, "\u0641\u062d"},
+	{0xFCC0, 0xFCC0, mapped, "\u0641\u062e"},
+	{0xFCC1, 0xFCC1, mapped, "\u0641\u0645"},
+	{0xFCC2, 0xFCC2, mapped, "\u0642\u062d"},
+	{0xFCC3, 0xFCC3, mapped, "\u0642\u0645"},
+	{0xFCC4, 0xFCC4, mapped, "\u0643\u062c"},
+	{0xFCC5, 0xFCC5, mapped, "\u0643\u062d"},
+	{0xFCC6, 0xFCC6, mapped, "\u0643\u062e"},
+	{0xFCC7, 0xFCC7, mapped, "\u0643\u0644"},
+	{0xFCC8, 0xFCC8, mapped, "\u0643\u0645"},
+	{0xFCC9, 0xFCC9, mapped, "\u0644\u062c"},
+	{0xFCCA, 0xFCCA, mapped, "\u0644\u062d"},
+	{0xFCCB, 0xFCCB, mapped, "\u0644\u062e"},
+	{0xFCCC, 0xFCCC, mapped, "\u0644\u0645"},
+	{0xFCCD, 0xFCCD, mapped, "\u0644\u0647"},
+	{0xFCCE, 0xFCCE, mapped, "\u0645\u062c"},
+	{0xFCCF, 0xFCCF, mapped, "\u0645\u062d"},
+	{0xFCD0, 0xFCD0, mapped, "\u0645\u062e"},
+	{0xFCD1, 0xFCD1, mapped, "\u0645\u0645"},
+	{0xFCD2, 0xFCD2, mapped, "\u0646\u062c"},
+	{0xFCD3, 0xFCD3, mapped, "\u0646\u062d"},
+	{0xFCD4, 0xFCD4, mapped, "\u0646\u062e"},
+	{0xFCD5, 0xFCD5, mapped, "\u0646\u0645"},
+	{0xFCD6, 0xFCD6, mapped, "\u0646\u0647"},
+	{0xFCD7, 0xFCD7, mapped, "\u0647\u062c"},
+	{0xFCD8, 0xFCD8, mapped, "\u0647\u0645"},
+	{0xFCD9, 0xFCD9, mapped, "\u0647\u0670"},
+	{0xFCDA, 0xFCDA, mapped, "\u064a\u062c"},
+	{0xFCDB, 0xFCDB, mapped, "\u064a\u062d"},
+	{0xFCDC, 0xFCDC, mapped, "\u064a\u062e"},
+	{0xFCDD, 0xFCDD, mapped, "\u064a\u0645"},
+	{0xFCDE, 0xFCDE, mapped, "\u064a\u0647"},
+	{0xFCDF, 0xFCDF, mapped, "\u0626\u0645"},
+	{0xFCE0, 0xFCE0, mapped, "\u0626\u0647"},
+	{0xFCE1, 0xFCE1, mapped, "\u0628\u0645"},
+	{0xFCE2, 0xFCE2, mapped, "\u0628\u0647"},
+	{0xFCE3, 0xFCE3, mapped, "\u062a\u0645"},
+	{0xFCE4, 0xFCE4, mapped, "\u062a\u0647"},
+	{0xFCE5, 0xFCE5, mapped, "\u062b\u0645"},
+	{0xFCE6, 0xFCE6, mapped, "\u062b\u0647"},
+	{0xFCE7, 0xFCE7, mapped, "\u0633\u0645"},
+	{0xFCE8, 0xFCE8, mapped, "\u0633\u0647"},
+	{0xFCE9, 0xFCE9, mapped, "\u0634\u0645"},
+	{0xFCEA, 0xFCEA, mapped, "\u0634\u0647"},
+	{0xFCEB, 0xFCEB, mapped, "\u0643\u0644"},
+	{0xFCEC, 0xFCEC, mapped, "\u0643\u0645"},
+	{0xFCED, 0xFCED, mapped, "\u0644\u0645"},
+	{0xFCEE, 0xFCEE, mapped, "\u0646\u0645"},
+	{0xFCEF, 0xFCEF, mapped, "\u0646\u0647"},
+	{0xFCF0, 0xFCF0, mapped, "\u064a\u0645"},
+	{0xFCF1, 0xFCF1, mapped, "\u064a\u0647"},
+	{0xFCF2, 0xFCF2, mapped, "\u0640\u064e\u0651"},
+	{0xFCF3, 0xFCF3, mapped, "\u0640\u064f\u0651"},
+	{0xFCF4, 0xFCF4, mapped, "\u0640\u0650\u0651"},
+	{0xFCF5, 0xFCF5, mapped, "\u0637\u0649"},
+	{0xFCF6, 0xFCF6, mapped, "\u0637\u064a"},
+	{0xFCF7, 0xFCF7, mapped, "\u0639\u0649"},
+	{0xFCF8, 0xFCF8, mapped, "\u0639\u064a"},
+	{0xFCF9, 0xFCF9, mapped, "\u063a\u0649"},
+	{0xFCFA, 0xFCFA, mapped, "\u063a\u064a"},
+	{0xFCFB, 0xFCFB, mapped, "\u0633\u0649"},
+	{0xFCFC, 0xFCFC, mapped, "\u0633\u064a"},
+	{0xFCFD, 0xFCFD, mapped, "\u0634\u0649"},
+	{0xFCFE, 0xFCFE, mapped, "\u0634\u064a"},
+	{0xFCFF, 0xFCFF, mapped, "\u062d\u0649"},
+	{0xFD00, 0xFD00, mapped, "\u062d\u064a"},
+	{0xFD01, 0xFD01, mapped, "\u062c\u0649"},
+	{0xFD02, 0xFD02, mapped, "\u062c\u064a"},
+	{0xFD03, 0xFD03, mapped, "\u062e\u0649"},
+	{0xFD04, 0xFD04, mapped, "\u062e\u064a"},
+	{0xFD05, 0xFD05, mapped, "\u0635\u0649"},
+	{0xFD06, 0xFD06, mapped, "\u0635\u064a"},
+	{0xFD07, 0xFD07, mapped, "\u0636\u0649"},
+	{0xFD08, 0xFD08, mapped, "\u0636\u064a"},
+	{0xFD09, 0xFD09, mapped, "\u0634\u062c"},
+	{0xFD0A, 0xFD0A, mapped, "\u0634\u062d"},
+	{0xFD0B, 0xFD0B, mapped, "\u0634\u062e"},
+	{0xFD0C, 0xFD0C, mapped, "\u0634\u0645"},
+	{0xFD0D, 0xFD0D, mapped, "\u0634\u0631"},
+	{0xFD0E, 0xFD0E, mapped, "\u0633\u0631"},
+	{0xFD0F, 0xFD0F, mapped, "\u0635\u0631"},
+	{0xFD10, 0xFD10, mapped, "\u0636\u0631"},
+	{0xFD11, 0xFD11, mapped, "\u0637\u0649"},
+	{0xFD12, 0xFD12, mapped, "\u0637\u064a"},
+	{0xFD13, 0xFD13, mapped, "\u0639\u0649"},
+	{0xFD14, 0xFD14, mapped, "\u0639\u064a"},
+	{0xFD15, 0xFD15, mapped, "\u063a\u0649"},
+	{0xFD16, 0xFD16, mapped, "\u063a\u064a"},
+	{0xFD17, 0xFD17, mapped, "\u0633\u0649"},
+	{0xFD18, 0xFD18, mapped, "\u0633\u064a"},
+	{0xFD19, 0xFD19, mapped, "\u0634\u0649"},
+	{0xFD1A, 0xFD1A, mapped, "\u0634\u064a"},
+	{0xFD1B, 0xFD1B, mapped, "\u062d\u0649"},
+	{0xFD1C, 0xFD1C, mapped, "\u062d\u064a"},
+	{0xFD1D, 0xFD1D, mapped, "\u062c\u0649"},
+	{0xFD1E, 0xFD1E, mapped, "\u062c\u064a"},
+	{0xFD1F, 0xFD1F, mapped, "\u062e\u0649"},
+	{0xFD20, 0xFD20, mapped, "\u062e\u064a"},
+	{0xFD21, 0xFD21, mapped, "\u0635\u0649"},
+	{0xFD22, 0xFD22, mapped, "\u0635\u064a"},
+	{0xFD23, 0xFD23, mapped, "\u0636\u0649"},
+	{0xFD24, 0xFD24, mapped, "\u0636\u064a"},
+	{0xFD25, 0xFD25, mapped, "\u0634\u062c"},
+	{0xFD26, 0xFD26, mapped, "\u0634\u062d"},
+	{0xFD27, 0xFD27, mapped, "\u0634\u062e"},
+	{0xFD28, 0xFD28, mapped, "\u0634\u0645"},
+	{0xFD29, 0xFD29, mapped, "\u0634\u0631"},
+	{0xFD2A, 0xFD2A, mapped, "\u0633\u0631"},
+	{0xFD2B, 0xFD2B, mapped, "\u0635\u0631"},
+	{0xFD2C, 0xFD2C, mapped, "\u0636\u0631"},
+	{0xFD2D, 0xFD2D, mapped, "\u0634\u062c"},
+	{0xFD2E, 0xFD2E, mapped, "\u0634\u062d"},
+	{0xFD2F, 0xFD2F, mapped, "\u0634\u062e"},
+	{0xFD30, 0xFD30, mapped, "\u0634\u0645"},
+	{0xFD31, 0xFD31, mapped, "\u0633\u0647"},
+	{0xFD32, 0xFD32, mapped, "\u0634\u0647"},
+	{0xFD33, 0xFD33, mapped, "\u0637\u0645"},
+	{0xFD34, 0xFD34, mapped, "\u0633\u062c"},
+	{0xFD35, 0xFD35, mapped, "\u0633\u062d"},
+	{0xFD36, 0xFD36, mapped, "\u0633\u062e"},
+	{0xFD37, 0xFD37, mapped, "\u0634\u062c"},
+	{0xFD38, 0xFD38, mapped, "\u0634\u062d"},
+	{0xFD39, 0xFD39, mapped, "\u0634\u062e"},
+	{0xFD3A, 0xFD3A, mapped, "\u0637\u0645"},
+	{0xFD3B, 0xFD3B, mapped, "\u0638\u0645"},
+	{0xFD3C, 0xFD3C, mapped, "\u0627\u064b"},
+	{0xFD3D, 0xFD3D, mapped, "\u0627\u064b"},
+	{0xFD3E, 0xFD4F, valid, ""},
+	{0xFD50, 0xFD50, mapped, "\u062a\u062c\u0645"},
+	{0xFD51, 0xFD51, mapped, "\u062a\u062d\u062c"},
+	{0xFD52, 0xFD52, mapped, "\u062a\u062d\u062c"},
+	{0xFD53, 0xFD53, mapped, "\u062a\u062d\u0645"},
+	{0xFD54, 0xFD54, mapped, "\u062a\u062e\u0645"},
+	{0xFD55, 0xFD55, mapped, "\u062a\u0645\u062c"},
+	{0xFD56, 0xFD56, mapped, "\u062a\u0645\u062d"},
+	{0xFD57, 0xFD57, mapped, "\u062a\u0645\u062e"},
+	{0xFD58, 0xFD58, mapped, "\u062c\u0645\u062d"},
+	{0xFD59, 0xFD59, mapped, "\u062c\u0645\u062d"},
+	{0xFD5A, 0xFD5A, mapped, "\u062d\u0645\u064a"},
+	{0xFD5B, 0xFD5B, mapped, "\u062d\u0645\u0649"},
+	{0xFD5C, 0xFD5C, mapped, "\u0633\u062d\u062c"},
+	{0xFD5D, 0xFD5D, mapped, "\u0633\u062c\u062d"},
+	{0xFD5E, 0xFD5E, mapped, "\u0633\u062c\u0649"},
+	{0xFD5F, 0xFD5F, mapped, "\u0633\u0645\u062d"},
+	{0xFD60, 0xFD60, mapped, "\u0633\u0645\u062d"},
+	{0xFD61, 0xFD61, mapped, "\u0633\u0645\u062c"},
+	{0xFD62, 0xFD62, mapped, "\u0633\u0645\u0645"},
+	{0xFD63, 0xFD63, mapped, "\u0633\u0645\u0645"},
+	{0xFD64, 0xFD64, mapped, "\u0635\u062d\u062d"},
+	{0xFD65, 0xFD65, mapped, "\u0635\u062d\u062d"},
+	{0xFD66, 0xFD66, mapped, "\u0635\u0645\u0645"},
+	{0xFD67, 0xFD67, mapped, "\u0634\u062d\u0645"},
+	{0xFD68, 0xFD68, mapped, "\u0634\u062d\u0645"},
+	{0xFD69, 0xFD69, mapped, "\u0634\u062c\u064a"},
+	{0xFD6A, 0xFD6A, mapped, "\u0634\u0645\u062e"},
+	{0xFD6B, 0xFD6B, mapped, "\u0634\u0645\u062e"},
+	{0xFD6C, 0xFD6C, mapped, "\u0634\u0645\u0645"},
+	{0xFD6D, 0xFD6D, mapped, "\u0634\u0645\u0645"},
+	{0xFD6E, 0xFD6E, mapped, "\u0636\u062d\u0649"},
+	{0xFD6F, 0xFD6F, mapped, "\u0636\u062e\u0645"},
+	{0xFD70, 0xFD70, mapped, "\u0636\u062e\u0645"},
+	{0xFD71, 0xFD71, mapped, "\u0637\u0645\u062d"},
+	{0xFD72, 0xFD72, mapped, "\u0637\u0645\u062d"},
+	{0xFD73, 0xFD73, mapped, "\u0637\u0645\u0645"},
+	{0xFD74, 0xFD74, mapped, "\u0637\u0645\u064a"},
+	{0xFD75, 0xFD75, mapped, "\u0639\u062c\u0645"},
+	{0xFD76, 0xFD76, mapped, "\u0639\u0645\u0645"},
+	{0xFD77, 0xFD77, mapped, "\u0639\u0645\u0645"},
+	{0xFD78, 0xFD78, mapped, "\u0639\u0645\u0649"},
+	{0xFD79, 0xFD79, mapped, "\u063a\u0645\u0645"},
+	{0xFD7A, 0xFD7A, mapped, "\u063a\u0645\u064a"},
+	{0xFD7B, 0xFD7B, mapped, "\u063a\u0645\u0649"},
+	{0xFD7C, 0xFD7C, mapped, "\u0641\u062e\u0645"},
+	{0xFD7D, 0xFD7D, mapped, "\u0641\u062e\u0645"},
+	{0xFD7E, 0xFD7E, mapped, "\u0642\u0645\u062d"},
+	{0xFD7F, 0xFD7F, mapped, "\u0642\u0645\u0645"},
+	{0xFD80, 0xFD80, mapped, "\u0644\u062d\u0645"},
+	{0xFD81, 0xFD81, mapped, "\u0644\u062d\u064a"},
+	{0xFD82, 0xFD82, mapped, "\u0644\u062d\u0649"},
+	{0xFD83, 0xFD83, mapped, "\u0644\u062c\u062c"},
+	{0xFD84, 0xFD84, mapped, "\u0644\u062c\u062c"},
+	{0xFD85, 0xFD85, mapped, "\u0644\u062e\u0645"},
+	{0xFD86, 0xFD86, mapped, "\u0644\u062e\u0645"},
+	{0xFD87, 0xFD87, mapped, "\u0644\u0645\u062d"},
+	{0xFD88, 0xFD88, mapped, "\u0644\u0645\u062d"},
+	{0xFD89, 0xFD89, mapped, "\u0645\u062d\u062c"},
+	{0xFD8A, 0xFD8A, mapped, "\u0645\u062d\u0645"},
+	{0xFD8B, 0xFD8B, mapped, "\u0645\u062d\u064a"},
+	{0xFD8C, 0xFD8C, mapped, "\u0645\u062c\u062d"},
+	{0xFD8D, 0xFD8D, mapped, "\u0645\u062c\u0645"},
+	{0xFD8E, 0xFD8E, mapped, "\u0645\u062e\u062c"},
+	{0xFD8F, 0xFD8F, mapped, "\u0645\u062e\u0645"},
+	{0xFD90, 0xFD91, valid, ""},
+	{0xFD92, 0xFD92, mapped, "\u0645\u062c\u062e"},
+	{0xFD93, 0xFD93, mapped, "\u0647\u0645\u062c"},
+	{0xFD94, 0xFD94, mapped, "\u0647\u0645\u0645"},
+	{0xFD95, 0xFD95, mapped, "\u0646\u062d\u0645"},
+	{0xFD96, 0xFD96, mapped, "\u0646\u062d\u0649"},
+	{0xFD97, 0xFD97, mapped, "\u0646\u062c\u0645"},
+	{0xFD98, 0xFD98, mapped, "\u0646\u062c\u0645"},
+	{0xFD99, 0xFD99, mapped, "\u0646\u062c\u0649"},
+	{0xFD9A, 0xFD9A, mapped, "\u0646\u0645\u064a"},
+	{0xFD9B, 0xFD9B, mapped, "\u0646\u0645\u0649"},
+	{0xFD9C, 0xFD9C, mapped, "\u064a\u0645\u0645"},
+	{0xFD9D, 0xFD9D, mapped, "\u064a\u0645\u0645"},
+	{0xFD9E, 0xFD9E, mapped, "\u0628\u062e\u064a"},
+	{0xFD9F, 0xFD9F, mapped, "\u062a\u062c\u064a"},
+	{0xFDA0, 0xFDA0, mapped, "\u062a\u062c\u0649"},
+	{0xFDA1, 0xFDA1, mapped, "\u062a\u062e\u064a"},
+	{0xFDA2, 0xFDA2, mapped, "\u062a\u062e\u0649"},
+	{0xFDA3, 0xFDA3, mapped, "\u062a\u0645\u064a"},
+	{0xFDA4, 0xFDA4, mapped, "\u062a\u0645\u0649"},
+	{0xFDA5, 0xFDA5, mapped, "\u062c\u0645\u064a"},
+	{0xFDA6, 0xFDA6, mapped, "\u062c\u062d\u0649"},
+	{0xFDA7, 0xFDA7, mapped, "\u062c\u0645\u0649"},
+	{0xFDA8, 0xFDA8, mapped, "\u0633\u062e\u0649"},
+	{0xFDA9, 0xFDA9, mapped, "\u0635\u062d\u064a"},
+	{0xFDAA, 0xFDAA, mapped, "\u0634\u062d\u064a"},
+	{0xFDAB, 0xFDAB, mapped, "\u0636\u062d\u064a"},
+	{0xFDAC, 0xFDAC, mapped, "\u0644\u062c\u064a"},
+	{0xFDAD, 0xFDAD, mapped, "\u0644\u0645\u064a"},
+	{0xFDAE, 0xFDAE, mapped, "\u064a\u062d\u064a"},
+	{0xFDAF, 0xFDAF, mapped, "\u064a\u062c\u064a"},
+	{0xFDB0, 0xFDB0, mapped, "\u064a\u0645\u064a"},
+	{0xFDB1, 0xFDB1, mapped, "\u0645\u0645\u064a"},
+	{0xFDB2, 0xFDB2, mapped, "\u0642\u0645\u064a"},
+	{0xFDB3, 0xFDB3, mapped, "\u0646\u062d\u064a"},
+	{0xFDB4, 0xFDB4, mapped, "\u0642\u0645\u062d"},
+	{0xFDB5, 0xFDB5, mapped, "\u0644\u062d\u0645"},
+	{0xFDB6, 0xFDB6, mapped, "\u0639\u0645\u064a"},
+	{0xFDB7, 0xFDB7, mapped, "\u0643\u0645\u064a"},
+	{0xFDB8, 0xFDB8, mapped, "\u0646\u062c\u062d"},
+	{0xFDB9, 0xFDB9, mapped, "\u0645\u062e\u064a"},
+	{0xFDBA, 0xFDBA, mapped, "\u0644\u062c\u0645"},
+	{0xFDBB, 0xFDBB, mapped, "\u0643\u0645\u0645"},
+	{0xFDBC, 0xFDBC, mapped, "\u0644\u062c\u0645"},
+	{0xFDBD, 0xFDBD, mapped, "\u0646\u062c\u062d"},
+	{0xFDBE, 0xFDBE, mapped, "\u062c\u062d\u064a"},
+	{0xFDBF, 0xFDBF, mapped, "\u062d\u062c\u064a"},
+	{0xFDC0, 0xFDC0, mapped, "\u0645\u062c\u064a"},
+	{0xFDC1, 0xFDC1, mapped, "\u0641\u0645\u064a"},
+	{0xFDC2, 0xFDC2, mapped, "\u0628\u062d\u064a"},
+	{0xFDC3, 0xFDC3, mapped, "\u0643\u0645\u0645"},
+	{0xFDC4, 0xFDC4, mapped, "\u0639\u062c\u0645"},
+	{0xFDC5, 0xFDC5, mapped, "\u0635\u0645\u0645"},
+	{0xFDC6, 0xFDC6, mapped, "\u0633\u062e\u064a"},
+	{0xFDC7, 0xFDC7, mapped, "\u0646\u062c\u064a"},
+	{0xFDC8, 0xFDCF, valid, ""},
+	{0xFDD0, 0xFDEF, disallowed, ""},
+	{0xFDF0, 0xFDF0, mapped, "\u0635\u0644\u06d2"},
+	{0xFDF1, 0xFDF1, mapped, "\u0642\u0644\u06d2"},
+	{0xFDF2, 0xFDF2, mapped, "\u0627\u0644\u0644\u0647"},
+	{0xFDF3, 0xFDF3, mapped, "\u0627\u0643\u0628\u0631"},
+	{0xFDF4, 0xFDF4, mapped, "\u0645\u062d\u0645\u062f"},
+	{0xFDF5, 0xFDF5, mapped, "\u0635\u0644\u0639\u0645"},
+	{0xFDF6, 0xFDF6, mapped, "\u0631\u0633\u0648\u0644"},
+	{0xFDF7, 0xFDF7, mapped, "\u0639\u0644\u064a\u0647"},
+	{0xFDF8, 0xFDF8, mapped, "\u0648\u0633\u0644\u0645"},
+	{0xFDF9, 0xFDF9, mapped, "\u0635\u0644\u0649"},
+	{0xFDFA, 0xFDFA, disallowedSTD3Mapped, "\u0635\u0644\u0649 \u0627\u0644\u0644\u0647 \u0639\u0644\u064a\u0647 \u0648\u0633\u0644\u0645"},
+	{0xFDFB, 0xFDFB, disallowedSTD3Mapped, "\u062c\u0644 \u062c\u0644\u0627\u0644\u0647"},
+	{0xFDFC, 0xFDFC, mapped, "\u0631\u06cc\u0627\u0644"},
+	{0xFDFD, 0xFDFF, valid, ""},
+	{0xFE00, 0xFE0F, ignored, ""},
+	{0xFE10, 0xFE10, disallowedSTD3Mapped, ","},
+	{0xFE11, 0xFE11, mapped, "\u3001"},
+	{0xFE12, 0xFE12, disallowed, ""},
+	{0xFE13, 0xFE13, disallowedSTD3Mapped, ":"},
+	{0xFE14, 0xFE14, disallowedSTD3Mapped, ";"},
+	{0xFE15, 0xFE15, disallowedSTD3Mapped, "!"},
+	{0xFE16, 0xFE16, disallowedSTD3Mapped, "?"},
+	{0xFE17, 0xFE17, mapped, "\u3016"},
+	{0xFE18, 0xFE18, mapped, "\u3017"},
+	{0xFE19, 0xFE1F, disallowed, ""},
+	{0xFE20, 0xFE2F, valid, ""},
+	{0xFE30, 0xFE30, disallowed, ""},
+	{0xFE31, 0xFE31, mapped, "\u2014"},
+	{0xFE32, 0xFE32, mapped, "\u2013"},
+	{0xFE33, 0xFE33, disallowedSTD3Mapped, "_"},
+	{0xFE34, 0xFE34, disallowedSTD3Mapped, "_"},
+	{0xFE35, 0xFE35, disallowedSTD3Mapped, "("},
+	{0xFE36, 0xFE36, disallowedSTD3Mapped, ")"},
+	{0xFE37, 0xFE37, disallowedSTD3Mapped, "{"},
+	{0xFE38, 0xFE38, disallowedSTD3Mapped, "}"},
+	{0xFE39, 0xFE39, mapped, "\u3014"},
+	{0xFE3A, 0xFE3A, mapped, "\u3015"},
+	{0xFE3B, 0xFE3B, mapped, "\u3010"},
+	{0xFE3C, 0xFE3C, mapped, "\u3011"},
+	{0xFE3D, 0xFE3D, mapped, "\u300a"},
+	{0xFE3E, 0xFE3E, mapped, "\u300b"},
+	{0xFE3F, 0xFE3F, mapped, "\u3008"},
+	{0xFE40, 0xFE40, mapped, "\u3009"},
+	{0xFE41, 0xFE41, mapped, "\u300c"},
+	{0xFE42, 0xFE42, mapped, "\u300d"},
+	{0xFE43, 0xFE43, mapped, "\u300e"},
+	{0xFE44, 0xFE44, mapped, "\u300f"},
+	{0xFE45, 0xFE46, valid, ""},
+	{0xFE47, 0xFE47, disallowedSTD3Mapped, "["},
+	{0xFE48, 0xFE48, disallowedSTD3Mapped, "]"},
+	{0xFE49, 0xFE49, disallowedSTD3Mapped, " \u0305"},
+	{0xFE4A, 0xFE4A, disallowedSTD3Mapped, " \u0305"},
+	{0xFE4B, 0xFE4B, disallowedSTD3Mapped, " \u0305"},
+	{0xFE4C, 0xFE4C, disallowedSTD3Mapped, " \u0305"},
+	{0xFE4D, 0xFE4D, disallowedSTD3Mapped, "_"},
+	{0xFE4E, 0xFE4E, disallowedSTD3Mapped, "_"},
+	{0xFE4F, 0xFE4F, disallowedSTD3Mapped, "_"},
+	{0xFE50, 0xFE50, disallowedSTD3Mapped, ","},
+	{0xFE51, 0xFE51, mapped, "\u3001"},
+	{0xFE52, 0xFE53, disallowed, ""},
+	{0xFE54, 0xFE54, disallowedSTD3Mapped, ";"},
+	{0xFE55, 0xFE55, disallowedSTD3Mapped, ":"},
+	{0xFE56, 0xFE56, disallowedSTD3Mapped, "?"},
+	{0xFE57, 0xFE57, disallowedSTD3Mapped, "!"},
+	{0xFE58, 0xFE58, mapped, "\u2014"},
+	{0xFE59, 0xFE59, disallowedSTD3Mapped, "("},
+	{0xFE5A, 0xFE5A, disallowedSTD3Mapped, ")"},
+	{0xFE5B, 0xFE5B, disallowedSTD3Mapped, "{"},
+	{0xFE5C, 0xFE5C, disallowedSTD3Mapped, "}"},
+	{0xFE5D, 0xFE5D, mapped, "\u3014"},
+	{0xFE5E, 0xFE5E, mapped, "\u3015"},
+	{0xFE5F, 0xFE5F, disallowedSTD3Mapped, "#"},
+	{0xFE60, 0xFE60, disallowedSTD3Mapped, "&"},
+	{0xFE61, 0xFE61, disallowedSTD3Mapped, "*"},
+	{0xFE62, 0xFE62, disallowedSTD3Mapped, "+"},
+	{0xFE63, 0xFE63, mapped, "-"},
+	{0xFE64, 0xFE64, disallowedSTD3Mapped, "<"},
+	{0xFE65, 0xFE65, disallowedSTD3Mapped, ">"},
+	{0xFE66, 0xFE66, disallowedSTD3Mapped, "="},
+	{0xFE67, 0xFE67, disallowed, ""},
+	{0xFE68, 0xFE68, disallowedSTD3Mapped, "\\"},
+	{0xFE69, 0xFE69, disallowedSTD3Mapped, "$"},
+	{0xFE6A, 0xFE6A, disallowedSTD3Mapped, "%"},
+	{0xFE6B, 0xFE6B, disallowedSTD3Mapped, "@"},
+	{0xFE6C, 0xFE6F, disallowed, ""},
+	{0xFE70, 0xFE70, disallowedSTD3Mapped, " \u064b"},
+	{0xFE71, 0xFE71, mapped, "\u0640\u064b"},
+	{0xFE72, 0xFE72, disallowedSTD3Mapped, " \u064c"},
+	{0xFE73, 0xFE73, valid, ""},
+	{0xFE74, 0xFE74, disallowedSTD3Mapped, " \u064d"},
+	{0xFE75, 0xFE75, disallowed, ""},
+	{0xFE76, 0xFE76, disallowedSTD3Mapped, " \u064e"},
+	{0xFE77, 0xFE77, mapped, "\u0640\u064e"},
+	{0xFE78, 0xFE78, disallowedSTD3Mapped, " \u064f"},
+	{0xFE79, 0xFE79, mapped, "\u0640\u064f"},
+	{0xFE7A, 0xFE7A, disallowedSTD3Mapped, " \u0650"},
+	{0xFE7B, 0xFE7B, mapped, "\u0640\u0650"},
+	{0xFE7C, 0xFE7C, disallowedSTD3Mapped, " \u0651"},
+	{0xFE7D, 0xFE7D, mapped, "\u0640\u0651"},
+	{0xFE7E, 0xFE7E, disallowedSTD3Mapped, " \u0652"},
+	{0xFE7F, 0xFE7F, mapped, "\u0640\u0652"},
+	{0xFE80, 0xFE80, mapped, "\u0621"},
+	{0xFE81, 0xFE81, mapped, "\u0622"},
+	{0xFE82, 0xFE82, mapped, "\u0622"},
+	{0xFE83, 0xFE83, mapped, "\u0623"},
+	{0xFE84, 0xFE84, mapped, "\u0623"},
+	{0xFE85, 0xFE85, mapped, "\u0624"},
+	{0xFE86, 0xFE86, mapped, "\u0624"},
+	{0xFE87, 0xFE87, mapped, "\u0625"},
+	{0xFE88, 0xFE88, mapped, "\u0625"},
+	{0xFE89, 0xFE89, mapped, "\u0626"},
+	{0xFE8A, 0xFE8A, mapped, "\u0626"},
+	{0xFE8B, 0xFE8B, mapped, "\u0626"},
+	{0xFE8C, 0xFE8C, mapped, "\u0626"},
+	{0xFE8D, 0xFE8D, mapped, "\u0627"},
+	{0xFE8E, 0xFE8E, mapped, "\u0627"},
+	{0xFE8F, 0xFE8F, mapped, "\u0628"},
+	{0xFE90, 0xFE90, mapped, "\u0628"},
+	{0xFE91, 0xFE91, mapped, "\u0628"},
+	{0xFE92, 0xFE92, mapped, "\u0628"},
+	{0xFE93, 0xFE93, mapped, "\u0629"},
+	{0xFE94, 0xFE94, mapped, "\u0629"},
+	{0xFE95, 0xFE95, mapped, "\u062a"},
+	{0xFE96, 0xFE96, mapped, "\u062a"},
+	{0xFE97, 0xFE97, mapped, "\u062a"},
+	{0xFE98, 0xFE98, mapped, "\u062a"},
+	{0xFE99, 0xFE99, mapped, "\u062b"},
+	{0xFE9A, 0xFE9A, mapped, "\u062b"},
+	{0xFE9B, 0xFE9B, mapped, "\u062b"},
+	{0xFE9C, 0xFE9C, mapped, "\u062b"},
+	{0xFE9D, 0xFE9D, mapped, "\u062c"},
+	{0xFE9E, 0xFE9E, mapped, "\u062c"},
+	{0xFE9F, 0xFE9F, mapped, "\u062c"},
+	{0xFEA0, 0xFEA0, mapped, "\u062c"},
+	{0xFEA1, 0xFEA1, mapped, "\u062d"},
+	{0xFEA2, 0xFEA2, mapped, "\u062d"},
+	{0xFEA3, 0xFEA3, mapped, "\u062d"},
+	{0xFEA4, 0xFEA4, mapped, "\u062d"},
+	{0xFEA5, 0xFEA5, mapped, "\u062e"},
+	{0xFEA6, 0xFEA6, mapped, "\u062e"},
+	{0xFEA7, 0xFEA7, mapped, "\u062e"},
+	{0xFEA8, 0xFEA8, mapped, "\u062e"},
+	{0xFEA9, 0xFEA9, mapped, "\u062f"},
+	{0xFEAA, 0xFEAA, mapped, "\u062f"},
+	{0xFEAB, 0xFEAB, mapped, "\u0630"},
+	{0xFEAC, 0xFEAC, mapped, "\u0630"},
+	{0xFEAD, 0xFEAD, mapped, "\u0631"},
+	{0xFEAE, 0xFEAE, mapped, "\u0631"},
+	{0xFEAF, 0xFEAF, mapped, "\u0632"},
+	{0xFEB0, 0xFEB0, mapped, "\u0632"},
+	{0xFEB1, 0xFEB1, mapped, "\u0633"},
+	{0xFEB2, 0xFEB2, mapped, "\u0633"},
+	{0xFEB3, 0xFEB3, mapped, "\u0633"},
+	{0xFEB4, 0xFEB4, mapped, "\u0633"},
+	{0xFEB5, 0xFEB5, mapped, "\u0634"},
+	{0xFEB6, 0xFEB6, mapped, "\u0634"},
+	{0xFEB7, 0xFEB7, mapped, "\u0634"},
+	{0xFEB8, 0xFEB8, mapped, "\u0634"},
+	{0xFEB9, 0xFEB9, mapped, "\u0635"},
+	{0xFEBA, 0xFEBA, mapped, "\u0635"},
+	{0xFEBB, 0xFEBB, mapped, "\u0635"},
+	{0xFEBC, 0xFEBC, mapped, "\u0635"},
+	{0xFEBD, 0xFEBD, mapped, "\u0636"},
+	{0xFEBE, 0xFEBE, mapped, "\u0636"},
+	{0xFEBF, 0xFEBF, mapped, "\u0636"},
+	{0xFEC0, 0xFEC0, mapped, "\u0636"},
+	{0xFEC1, 0xFEC1, mapped, "\u0637"},
+	{0xFEC2, 0xFEC2, mapped, "\u0637"},
+	{0xFEC3, 0xFEC3, mapped, "\u0637"},
+	{0xFEC4, 0xFEC4, mapped, "\u0637"},
+	{0xFEC5, 0xFEC5, mapped, "\u0638"},
+	{0xFEC6, 0xFEC6, mapped, "\u0638"},
+	{0xFEC7, 0xFEC7, mapped, "\u0638"},
+	{0xFEC8, 0xFEC8, mapped, "\u0638"},
+	{0xFEC9, 0xFEC9, mapped, "\u0639"},
+	{0xFECA, 0xFECA, mapped, "\u0639"},
+	{0xFECB, 0xFECB, mapped, "\u0639"},
+	{0xFECC, 0xFECC, mapped, "\u0639"},
+	{0xFECD, 0xFECD, mapped, "\u063a"},
+	{0xFECE, 0xFECE, mapped, "\u063a"},
+	{0xFECF, 0xFECF, mapped, "\u063a"},
+	{0xFED0, 0xFED0, mapped, "\u063a"},
+	{0xFED1, 0xFED1, mapped, "\u0641"},
+	{0xFED2, 0xFED2, mapped, "\u0641"},
+	{0xFED3, 0xFED3, mapped, "\u0641"},
+	{0xFED4, 0xFED4, mapped, "\u0641"},
+	{0xFED5, 0xFED5, mapped, "\u0642"},
+	{0xFED6, 0xFED6, mapped, "\u0642"},
+	{0xFED7, 0xFED7, mapped, "\u0642"},
+	{0xFED8, 0xFED8, mapped, "\u0642"},
+	{0xFED9, 0xFED9, mapped, "\u0643"},
+	{0xFEDA, 0xFEDA, mapped, "\u0643"},
+	{0xFEDB, 0xFEDB, mapped, "\u0643"},
+	{0xFEDC, 0xFEDC, mapped, "\u0643"},
+	{0xFEDD, 0xFEDD, mapped, "\u0644"},
+	{0xFEDE, 0xFEDE, mapped, "\u0644"},
+	{0xFEDF, 0xFEDF, mapped, "\u0644"},
+	{0xFEE0, 0xFEE0, mapped, "\u0644"},
+	{0xFEE1, 0xFEE1, mapped, "\u0645"},
+	{0xFEE2, 0xFEE2, mapped, "\u0645"},
+	{0xFEE3, 0xFEE3, mapped, "\u0645"},
+	{0xFEE4, 0xFEE4, mapped, "\u0645"},
+	{0xFEE5, 0xFEE5, mapped, "\u0646"},
+	{0xFEE6, 0xFEE6, mapped, "\u0646"},
+	{0xFEE7, 0xFEE7, mapped, "\u0646"},
+	{0xFEE8, 0xFEE8, mapped, "\u0646"},
+	{0xFEE9, 0xFEE9, mapped, "\u0647"},
+	{0xFEEA, 0xFEEA, mapped, "\u0647"},
+	{0xFEEB, 0xFEEB, mapped, "\u0647"},
+	{0xFEEC, 0xFEEC, mapped, "\u0647"},
+	{0xFEED, 0xFEED, mapped, "\u0648"},
+	{0xFEEE, 0xFEEE, mapped, "\u0648"},
+	{0xFEEF, 0xFEEF, mapped, "\u0649"},
+	{0xFEF0, 0xFEF0, mapped, "\u0649"},
+	{0xFEF1, 0xFEF1, mapped, "\u064a"},
+	{0xFEF2, 0xFEF2, mapped, "\u064a"},
+	{0xFEF3, 0xFEF3, mapped, "\u064a"},
+	{0xFEF4, 0xFEF4, mapped, "\u064a"},
+	{0xFEF5, 0xFEF5, mapped, "\u0644\u0622"},
+	{0xFEF6, 0xFEF6, mapped, "\u0644\u0622"},
+	{0xFEF7, 0xFEF7, mapped, "\u0644\u0623"},
+	{0xFEF8, 0xFEF8, mapped, "\u0644\u0623"},
+	{0xFEF9, 0xFEF9, mapped, "\u0644\u0625"},
+	{0xFEFA, 0xFEFA, mapped, "\u0644\u0625"},
+	{0xFEFB, 0xFEFB, mapped, "\u0644\u0627"},
+	{0xFEFC, 0xFEFC, mapped, "\u0644\u0627"},
+	{0xFEFD, 0xFEFE, disallowed, ""},
+	{0xFEFF, 0xFEFF, ignored, ""},
+	{0xFF00, 0xFF00, disallowed, ""},
+	{0xFF01, 0xFF01, disallowedSTD3Mapped, "!"},
+	{0xFF02, 0xFF02, disallowedSTD3Mapped, "\""},
+	{0xFF03, 0xFF03, disallowedSTD3Mapped, "#"},
+	{0xFF04, 0xFF04, disallowedSTD3Mapped, "$"},
+	{0xFF05, 0xFF05, disallowedSTD3Mapped, "%"},
+	{0xFF06, 0xFF06, disallowedSTD3Mapped, "&"},
+	{0xFF07, 0xFF07, disallowedSTD3Mapped, "'"},
+	{0xFF08, 0xFF08, disallowedSTD3Mapped, "("},
+	{0xFF09, 0xFF09, disallowedSTD3Mapped, ")"},
+	{0xFF0A, 0xFF0A, disallowedSTD3Mapped, "*"},
+	{0xFF0B, 0xFF0B, disallowedSTD3Mapped, "+"},
+	{0xFF0C, 0xFF0C, disallowedSTD3Mapped, ","},
+	{0xFF0D, 0xFF0D, mapped, "-"},
+	{0xFF0E, 0xFF0E, mapped, "."},
+	{0xFF0F, 0xFF0F, disallowedSTD3Mapped, "/"},
+	{0xFF10, 0xFF10, mapped, "0"},
+	{0xFF11, 0xFF11, mapped, "1"},
+	{0xFF12, 0xFF12, mapped, "2"},
+	{0xFF13, 0xFF13, mapped, "3"},
+	{0xFF14, 0xFF14, mapped, "4"},
+	{0xFF15, 0xFF15, mapped, "5"},
+	{0xFF16, 0xFF16, mapped, "6"},
+	{0xFF17, 0xFF17, mapped, "7"},
+	{0xFF18, 0xFF18, mapped, "8"},
+	{0xFF19, 0xFF19, mapped, "9"},
+	{0xFF1A, 0xFF1A, disallowedSTD3Mapped, ":"},
+	{0xFF1B, 0xFF1B, disallowedSTD3Mapped, ";"},
+	{0xFF1C, 0xFF1C, disallowedSTD3Mapped, "<"},
+	{0xFF1D, 0xFF1D, disallowedSTD3Mapped, "="},
+	{0xFF1E, 0xFF1E, disallowedSTD3Mapped, ">"},
+	{0xFF1F, 0xFF1F, disallowedSTD3Mapped, "?"},
+	{0xFF20, 0xFF20, disallowedSTD3Mapped, "@"},
+	{0xFF21, 0xFF21, mapped, "a"},
+	{0xFF22, 0xFF22, mapped, "b"},
+	{0xFF23, 0xFF23, mapped, "c"},
+	{0xFF24, 0xFF24, mapped, "d"},
+	{0xFF25, 0xFF25, mapped, "e"},
+	{0xFF26, 0xFF26, mapped, "f"},
+	{0xFF27, 0xFF27, mapped, "g"},
+	{0xFF28, 0xFF28, mapped, "h"},
+	{0xFF29, 0xFF29, mapped, "i"},
+	{0xFF2A, 0xFF2A, mapped, "j"},
+	{0xFF2B, 0xFF2B, mapped, "k"},
+	{0xFF2C, 0xFF2C, mapped, "l"},
+	{0xFF2D, 0xFF2D, mapped, "m"},
+	{0xFF2E, 0xFF2E, mapped, "n"},
+	{0xFF2F, 0xFF2F, mapped, "o"},
+	{0xFF30, 0xFF30, mapped, "p"},
+	{0xFF31, 0xFF31, mapped, "q"},
+	{0xFF32, 0xFF32, mapped, "r"},
+	{0xFF33, 0xFF33, mapped, "s"},
+	{0xFF34, 0xFF34, mapped, "t"},
+	{0xFF35, 0xFF35, mapped, "u"},
+	{0xFF36, 0xFF36, mapped, "v"},
+	{0xFF37, 0xFF37, mapped, "w"},
+	{0xFF38, 0xFF38, mapped, "x"},
+	{0xFF39, 0xFF39, mapped, "y"},
+	{0xFF3A, 0xFF3A, mapped, "z"},
+	{0xFF3B, 0xFF3B, disallowedSTD3Mapped, "["},
+	{0xFF3C, 0xFF3C, disallowedSTD3Mapped, "\\"},
+	{0xFF3D, 0xFF3D, disallowedSTD3Mapped, "]"},
+	{0xFF3E, 0xFF3E, disallowedSTD3Mapped, "^"},
+	{0xFF3F, 0xFF3F, disallowedSTD3Mapped, "_"},
+	{0xFF40, 0xFF40, disallowedSTD3Mapped, "`"},
+	{0xFF41, 0xFF41, mapped, "a"},
+	{0xFF42, 0xFF42, mapped, "b"},
+	{0xFF43, 0xFF43, mapped, "c"},
+	{0xFF44, 0xFF44, mapped, "d"},
+	{0xFF45, 0xFF45, mapped, "e"},
+	{0xFF46, 0xFF46, mapped, "f"},
+	{0xFF47, 0xFF47, mapped, "g"},
+	{0xFF48, 0xFF48, mapped, "h"},
+	{0xFF49, 0xFF49, mapped, "i"},
+	{0xFF4A, 0xFF4A, mapped, "j"},
+	{0xFF4B, 0xFF4B, mapped, "k"},
+	{0xFF4C, 0xFF4C, mapped, "l"},
+	{0xFF4D, 0xFF4D, mapped, "m"},
+	{0xFF4E, 0xFF4E, mapped, "n"},
+	{0xFF4F, 0xFF4F, mapped, "o"},
+	{0xFF50, 0xFF50, mapped, "p"},
+	{0xFF51, 0xFF51, mapped, "q"},
+	{0xFF52, 0xFF52, mapped, "r"},
+	{0xFF53, 0xFF53, mapped, "s"},
+	{0xFF54, 0xFF54, mapped, "t"},
+	{0xFF55, 0xFF55, mapped, "u"},
+	{0xFF56, 0xFF56, mapped, "v"},
+	{0xFF57, 0xFF57, mapped, "w"},
+	{0xFF58, 0xFF58, mapped, "x"},
+	{0xFF59, 0xFF59, mapped, "y"},
+	{0xFF5A, 0xFF5A, mapped, "z"},
+	{0xFF5B, 0xFF5B, disallowedSTD3Mapped, "{"},
+	{0xFF5C, 0xFF5C, disallowedSTD3Mapped, "|"},
+	{0xFF5D, 0xFF5D, disallowedSTD3Mapped, "}"},
+	{0xFF5E, 0xFF5E, disallowedSTD3Mapped, "~"},
+	{0xFF5F, 0xFF5F, mapped, "\u2985"},
+	{0xFF60, 0xFF60, mapped, "\u2986"},
+	{0xFF61, 0xFF61, mapped, "."},
+	{0xFF62, 0xFF62, mapped, "\u300c"},
+	{0xFF63, 0xFF63, mapped, "\u300d"},
+	{0xFF64, 0xFF64, mapped, "\u3001"},
+	{0xFF65, 0xFF65, mapped, "\u30fb"},
+	{0xFF66, 0xFF66, mapped, "\u30f2"},
+	{0xFF67, 0xFF67, mapped, "\u30a1"},
+	{0xFF68, 0xFF68, mapped, "\u30a3"},
+	{0xFF69, 0xFF69, mapped, "\u30a5"},
+	{0xFF6A, 0xFF6A, mapped, "\u30a7"},
+	{0xFF6B, 0xFF6B, mapped, "\u30a9"},
+	{0xFF6C, 0xFF6C, mapped, "\u30e3"},
+	{0xFF6D, 0xFF6D, mapped, "\u30e5"},
+	{0xFF6E, 0xFF6E, mapped, "\u30e7"},
+	{0xFF6F, 0xFF6F, mapped, "\u30c3"},
+	{0xFF70, 0xFF70, mapped, "\u30fc"},
+	{0xFF71, 0xFF71, mapped, "\u30a2"},
+	{0xFF72, 0xFF72, mapped, "\u30a4"},
+	{0xFF73, 0xFF73, mapped, "\u30a6"},
+	{0xFF74, 0xFF74, mapped, "\u30a8"},
+	{0xFF75, 0xFF75, mapped, "\u30aa"},
+	{0xFF76, 0xFF76, mapped, "\u30ab"},
+	{0xFF77, 0xFF77, mapped, "\u30ad"},
+	{0xFF78, 0xFF78, mapped, "\u30af"},
+	{0xFF79, 0xFF79, mapped, "\u30b1"},
+	{0xFF7A, 0xFF7A, mapped, "\u30b3"},
+	{0xFF7B, 0xFF7B, mapped, "\u30b5"},
+	{0xFF7C, 0xFF7C, mapped, "\u30b7"},
+	{0xFF7D, 0xFF7D, mapped, "\u30b9"},
+	{0xFF7E, 0xFF7E, mapped, "\u30bb"},
+	{0xFF7F, 0xFF7F, mapped, "\u30bd"},
+	{0xFF80, 0xFF80, mapped, "\u30bf"},
+	{0xFF81, 0xFF81, mapped, "\u30c1"},
+	{0xFF82, 0xFF82, mapped, "\u30c4"},
+	{0xFF83, 0xFF83, mapped, "\u30c6"},
+	{0xFF84, 0xFF84, mapped, "\u30c8"},
+	{0xFF85, 0xFF85, mapped, "\u30ca"},
+	{0xFF86, 0xFF86, mapped, "\u30cb"},
+	{0xFF87, 0xFF87, mapped, "\u30cc"},
+	{0xFF88, 0xFF88, mapped, "\u30cd"},
+	{0xFF89, 0xFF89, mapped, "\u30ce"},
+	{0xFF8A, 0xFF8A, mapped, "\u30cf"},
+	{0xFF8B, 0xFF8B, mapped, "\u30d2"},
+	{0xFF8C, 0xFF8C, mapped, "\u30d5"},
+	{0xFF8D, 0xFF8D, mapped, "\u30d8"},
+	{0xFF8E, 0xFF8E, mapped, "\u30db"},
+	{0xFF8F, 0xFF8F, mapped, "\u30de"},
+	{0xFF90, 0xFF90, mapped, "\u30df"},
+	{0xFF91, 0xFF91, mapped, "\u30e0"},
+	{0xFF92, 0xFF92, mapped, "\u30e1"},
+	{0xFF93, 0xFF93, mapped, "\u30e2"},
+	{0xFF94, 0xFF94, mapped, "\u30e4"},
+	{0xFF95, 0xFF95, mapped, "\u30e6"},
+	{0xFF96, 0xFF96, mapped, "\u30e8"},
+	{0xFF97, 0xFF97, mapped, "\u30e9"},
+	{0xFF98, 0xFF98, mapped, "\u30ea"},
+	{0xFF99, 0xFF99, mapped, "\u30eb"},
+	{0xFF9A, 0xFF9A, mapped, "\u30ec"},
+	{0xFF9B, 0xFF9B, mapped, "\u30ed"},
+	{0xFF9C, 0xFF9C, mapped, "\u30ef"},
+	{0xFF9D, 0xFF9D, mapped, "\u30f3"},
+	{0xFF9E, 0xFF9E, mapped, "\u3099"},
+	{0xFF9F, 0xFF9F, mapped, "\u309a"},
+	{0xFFA0, 0xFFA0, ignored, ""},
+	{0xFFA1, 0xFFA1, mapped, "\u1100"},
+	{0xFFA2, 0xFFA2, mapped, "\u1101"},
+	{0xFFA3, 0xFFA3, mapped, "\u11aa"},
+	{0xFFA4, 0xFFA4, mapped, "\u1102"},
+	{0xFFA5, 0xFFA5, mapped, "\u11ac"},
+	{0xFFA6, 0xFFA6, mapped, "\u11ad"},
+	{0xFFA7, 0xFFA7, mapped, "\u1103"},
+	{0xFFA8, 0xFFA8, mapped, "\u1104"},
+	{0xFFA9, 0xFFA9, mapped, "\u1105"},
+	{0xFFAA, 0xFFAA, mapped, "\u11b0"},
+	{0xFFAB, 0xFFAB, mapped, "\u11b1"},
+	{0xFFAC, 0xFFAC, mapped, "\u11b2"},
+	{0xFFAD, 0xFFAD, mapped, "\u11b3"},
+	{0xFFAE, 0xFFAE, mapped, "\u11b4"},
+	{0xFFAF, 0xFFAF, mapped, "\u11b5"},
+	{0xFFB0, 0xFFB0, mapped, "\u111a"},
+	{0xFFB1, 0xFFB1, mapped, "\u1106"},
+	{0xFFB2, 0xFFB2, mapped, "\u1107"},
+	{0xFFB3, 0xFFB3, mapped, "\u1108"},
+	{0xFFB4, 0xFFB4, mapped, "\u1121"},
+	{0xFFB5, 0xFFB5, mapped, "\u1109"},
+	{0xFFB6, 0xFFB6, mapped, "\u110a"},
+	{0xFFB7, 0xFFB7, mapped, "\u110b"},
+	{0xFFB8, 0xFFB8, mapped, "\u110c"},
+	{0xFFB9, 0xFFB9, mapped, "\u110d"},
+	{0xFFBA, 0xFFBA, mapped, "\u110e"},
+	{0xFFBB, 0xFFBB, mapped, "\u110f"},
+	{0xFFBC, 0xFFBC, mapped, "\u1110"},
+	{0xFFBD, 0xFFBD, mapped, "\u1111"},
+	{0xFFBE, 0xFFBE, mapped, "\u1112"},
+	{0xFFBF, 0xFFC1, disallowed, ""},
+	{0xFFC2, 0xFFC2, mapped, "\u1161"},
+	{0xFFC3, 0xFFC3, mapped, "\u1162"},
+	{0xFFC4, 0xFFC4, mapped, "\u1163"},
+	{0xFFC5, 0xFFC5, mapped, "\u1164"},
+	{0xFFC6, 0xFFC6, mapped, "\u1165"},
+	{0xFFC7, 0xFFC7, mapped, "\u1166"},
+	{0xFFC8, 0xFFC9, disallowed, ""},
+	{0xFFCA, 0xFFCA, mapped, "\u1167"},
+	{0xFFCB, 0xFFCB, mapped, "\u1168"},
+	{0xFFCC, 0xFFCC, mapped, "\u1169"},
+	{0xFFCD, 0xFFCD, mapped, "\u116a"},
+	{0xFFCE, 0xFFCE, mapped, "\u116b"},
+	{0xFFCF, 0xFFCF, mapped, "\u116c"},
+	{0xFFD0, 0xFFD1, disallowed, ""},
+	{0xFFD2, 0xFFD2, mapped, "\u116d"},
+	{0xFFD3, 0xFFD3, mapped, "\u116e"},
+	{0xFFD4, 0xFFD4, mapped, "\u116f"},
+	{0xFFD5, 0xFFD5, mapped, "\u1170"},
+	{0xFFD6, 0xFFD6, mapped, "\u1171"},
+	{0xFFD7, 0xFFD7, mapped, "\u1172"},
+	{0xFFD8, 0xFFD9, disallowed, ""},
+	{0xFFDA, 0xFFDA, mapped, "\u1173"},
+	{0xFFDB, 0xFFDB, mapped, "\u1174"},
+	{0xFFDC, 0xFFDC, mapped, "\u1175"},
+	{0xFFDD, 0xFFDF, disallowed, ""},
+	{0xFFE0, 0xFFE0, mapped, "\u00a2"},
+	{0xFFE1, 0xFFE1, mapped, "\u00a3"},
+	{0xFFE2, 0xFFE2, mapped, "\u00ac"},
+	{0xFFE3, 0xFFE3, disallowedSTD3Mapped, " \u0304"},
+	{0xFFE4, 0xFFE4, mapped, "\u00a6"},
+	{0xFFE5, 0xFFE5, mapped, "\u00a5"},
+	{0xFFE6, 0xFFE6, mapped, "\u20a9"},
+	{0xFFE7, 0xFFE7, disallowed, ""},
+	{0xFFE8, 0xFFE8, mapped, "\u2502"},
+	{0xFFE9, 0xFFE9, mapped, "\u2190"},
+	{0xFFEA, 0xFFEA, mapped, "\u2191"},
+	{0xFFEB, 0xFFEB, mapped, "\u2192"},
+	{0xFFEC, 0xFFEC, mapped, "\u2193"},
+	{0xFFED, 0xFFED, mapped, "\u25a0"},
+	{0xFFEE, 0xFFEE, mapped, "\u25cb"},
+	{0xFFEF, 0xFFFF, disallowed, ""},
+	{0x10000, 0x1000B, valid, ""},
+	{0x1000C, 0x1000C, disallowed, ""},
+	{0x1000D, 0x10026, valid, ""},
+	{0x10027, 0x10027, disallowed, ""},
+	{0x10028, 0x1003A, valid, ""},
+	{0x1003B, 0x1003B, disallowed, ""},
+	{0x1003C, 0x1003D, valid, ""},
+	{0x1003E, 0x1003E, disallowed, ""},
+	{0x1003F, 0x1004D, valid, ""},
+	{0x1004E, 0x1004F, disallowed, ""},
+	{0x10050, 0x1005D, valid, ""},
+	{0x1005E, 0x1007F, disallowed, ""},
+	{0x10080, 0x100FA, valid, ""},
+	{0x100FB, 0x100FF, disallowed, ""},
+	{0x10100, 0x10102, valid, ""},
+	{0x10103, 0x10106, disallowed, ""},
+	{0x10107, 0x10133, valid, ""},
+	{0x10134, 0x10136, disallowed, ""},
+	{0x10137, 0x1018E, valid, ""},
+	{0x1018F, 0x1018F, disallowed, ""},
+	{0x10190, 0x1019C, valid, ""},
+	{0x1019D, 0x1019F, disallowed, ""},
+	{0x101A0, 0x101A0, valid, ""},
+	{0x101A1, 0x101CF, disallowed, ""},
+	{0x101D0, 0x101FD, valid, ""},
+	{0x101FE, 0x1027F, disallowed, ""},
+	{0x10280, 0x1029C, valid, ""},
+	{0x1029D, 0x1029F, disallowed, ""},
+	{0x102A0, 0x102D0, valid, ""},
+	{0x102D1, 0x102DF, disallowed, ""},
+	{0x102E0, 0x102FB, valid, ""},
+	{0x102FC, 0x102FF, disallowed, ""},
+	{0x10300, 0x10323, valid, ""},
+	{0x10324, 0x1032C, disallowed, ""},
+	{0x1032D, 0x1034A, valid, ""},
+	{0x1034B, 0x1034F, disallowed, ""},
+	{0x10350, 0x1037A, valid, ""},
+	{0x1037B, 0x1037F, disallowed, ""},
+	{0x10380, 0x1039D, valid, ""},
+	{0x1039E, 0x1039E, disallowed, ""},
+	{0x1039F, 0x103C3, valid, ""},
+	{0x103C4, 0x103C7, disallowed, ""},
+	{0x103C8, 0x103D5, valid, ""},
+	{0x103D6, 0x103FF, disallowed, ""},
+	{0x10400, 0x10400, mapped, "\U00010428"},
+	{0x10401, 0x10401, mapped, "\U00010429"},
+	{0x10402, 0x10402, mapped, "\U0001042a"},
+	{0x10403, 0x10403, mapped, "\U0001042b"},
+	{0x10404, 0x10404, mapped, "\U0001042c"},
+	{0x10405, 0x10405, mapped, "\U0001042d"},
+	{0x10406, 0x10406, mapped, "\U0001042e"},
+	{0x10407, 0x10407, mapped, "\U0001042f"},
+	{0x10408, 0x10408, mapped, "\U00010430"},
+	{0x10409, 0x10409, mapped, "\U00010431"},
+	{0x1040A, 0x1040A, mapped, "\U00010432"},
+	{0x1040B, 0x1040B, mapped, "\U00010433"},
+	{0x1040C, 0x1040C, mapped, "\U00010434"},
+	{0x1040D, 0x1040D, mapped, "\U00010435"},
+	{0x1040E, 0x1040E, mapped, "\U00010436"},
+	{0x1040F, 0x1040F, mapped, "\U00010437"},
+	{0x10410, 0x10410, mapped, "\U00010438"},
+	{0x10411, 0x10411, mapped, "\U00010439"},
+	{0x10412, 0x10412, mapped, "\U0001043a"},
+	{0x10413, 0x10413, mapped, "\U0001043b"},
+	{0x10414, 0x10414, mapped, "\U0001043c"},
+	{0x10415, 0x10415, mapped, "\U0001043d"},
+	{0x10416, 0x10416, mapped, "\U0001043e"},
+	{0x10417, 0x10417, mapped, "\U0001043f"},
+	{0x10418, 0x10418, mapped, "\U00010440"},
+	{0x10419, 0x10419, mapped, "\U00010441"},
+	{0x1041A, 0x1041A, mapped, "\U00010442"},
+	{0x1041B, 0x1041B, mapped, "\U00010443"},
+	{0x1041C, 0x1041C, mapped, "\U00010444"},
+	{0x1041D, 0x1041D, mapped, "\U00010445"},
+	{0x1041E, 0x1041E, mapped, "\U00010446"},
+	{0x1041F, 0x1041F, mapped, "\U00010447"},
+	{0x10420, 0x10420, mapped, "\U00010448"},
+	{0x10421, 0x10421, mapped, "\U00010449"},
+	{0x10422, 0x10422, mapped, "\U0001044a"},
+	{0x10423, 0x10423, mapped, "\U0001044b"},
+	{0x10424, 0x10424, mapped, "\U0001044c"},
+	{0x10425, 0x10425, mapped, "\U0001044d"},
+	{0x10426, 0x10426, mapped, "\U0001044e"},
+	{0x10427, 0x10427, mapped, "\U0001044f"},
+	{0x10428, 0x1049D, valid, ""},
+	{0x1049E, 0x1049F, disallowed, ""},
+	{0x104A0, 0x104A9, valid, ""},
+	{0x104AA, 0x104AF, disallowed, ""},
+	{0x104B0, 0x104B0, mapped, "\U000104d8"},
+	{0x104B1, 0x104B1, mapped, "\U000104d9"},
+	{0x104B2, 0x104B2, mapped, "\U000104da"},
+	{0x104B3, 0x104B3, mapped, "\U000104db"},
+	{0x104B4, 0x104B4, mapped, "\U000104dc"},
+	{0x104B5, 0x104B5, mapped, "\U000104dd"},
+	{0x104B6, 0x104B6, mapped, "\U000104de"},
+	{0x104B7, 0x104B7, mapped, "\U000104df"},
+	{0x104B8, 0x104B8, mapped, "\U000104e0"},
+	{0x104B9, 0x104B9, mapped, "\U000104e1"},
+	{0x104BA, 0x104BA, mapped, "\U000104e2"},
+	{0x104BB, 0x104BB, mapped, "\U000104e3"},
+	{0x104BC, 0x104BC, mapped, "\U000104e4"},
+	{0x104BD, 0x104BD, mapped, "\U000104e5"},
+	{0x104BE, 0x104BE, mapped, "\U000104e6"},
+	{0x104BF, 0x104BF, mapped, "\U000104e7"},
+	{0x104C0, 0x104C0, mapped, "\U000104e8"},
+	{0x104C1, 0x104C1, mapped, "\U000104e9"},
+	{0x104C2, 0x104C2, mapped, "\U000104ea"},
+	{0x104C3, 0x104C3, mapped, "\U000104eb"},
+	{0x104C4, 0x104C4, mapped, "\U000104ec"},
+	{0x104C5, 0x104C5, mapped, "\U000104ed"},
+	{0x104C6, 0x104C6, mapped, "\U000104ee"},
+	{0x104C7, 0x104C7, mapped, "\U000104ef"},
+	{0x104C8, 0x104C8, mapped, "\U000104f0"},
+	{0x104C9, 0x104C9, mapped, "\U000104f1"},
+	{0x104CA, 0x104CA, mapped, "\U000104f2"},
+	{0x104CB, 0x104CB, mapped, "\U000104f3"},
+	{0x104CC, 0x104CC, mapped, "\U000104f4"},
+	{0x104CD, 0x104CD, mapped, "\U000104f5"},
+	{0x104CE, 0x104CE, mapped, "\U000104f6"},
+	{0x104CF, 0x104CF, mapped, "\U000104f7"},
+	{0x104D0, 0x104D0, mapped, "\U000104f8"},
+	{0x104D1, 0x104D1, mapped, "\U000104f9"},
+	{0x104D2, 0x104D2, mapped, "\U000104fa"},
+	{0x104D3, 0x104D3, mapped, "\U000104fb"},
+	{0x104D4, 0x104D7, disallowed, ""},
+	{0x104D8, 0x104FB, valid, ""},
+	{0x104FC, 0x104FF, disallowed, ""},
+	{0x10500, 0x10527, valid, ""},
+	{0x10528, 0x1052F, disallowed, ""},
+	{0x10530, 0x10563, valid, ""},
+	{0x10564, 0x1056E, disallowed, ""},
+	{0x1056F, 0x1056F, valid, ""},
+	{0x10570, 0x10570, mapped, "\U00010597"},
+	{0x10571, 0x10571, mapped, "\U00010598"},
+	{0x10572, 0x10572, mapped, "\U00010599"},
+	{0x10573, 0x10573, mapped, "\U0001059a"},
+	{0x10574, 0x10574, mapped, "\U0001059b"},
+	{0x10575, 0x10575, mapped, "\U0001059c"},
+	{0x10576, 0x10576, mapped, "\U0001059d"},
+	{0x10577, 0x10577, mapped, "\U0001059e"},
+	{0x10578, 0x10578, mapped, "\U0001059f"},
+	{0x10579, 0x10579, mapped, "\U000105a0"},
+	{0x1057A, 0x1057A, mapped, "\U000105a1"},
+	{0x1057B, 0x1057B, disallowed, ""},
+	{0x1057C, 0x1057C, mapped, "\U000105a3"},
+	{0x1057D, 0x1057D, mapped, "\U000105a4"},
+	{0x1057E, 0x1057E, mapped, "\U000105a5"},
+	{0x1057F, 0x1057F, mapped, "\U000105a6"},
+	{0x10580, 0x10580, mapped, "\U000105a7"},
+	{0x10581, 0x10581, mapped, "\U000105a8"},
+	{0x10582, 0x10582, mapped, "\U000105a9"},
+	{0x10583, 0x10583, mapped, "\U000105aa"},
+	{0x10584, 0x10584, mapped, "\U000105ab"},
+	{0x10585, 0x10585, mapped, "\U000105ac"},
+	{0x10586, 0x10586, mapped, "\U000105ad"},
+	{0x10587, 0x10587, mapped, "\U000105ae"},
+	{0x10588, 0x10588, mapped, "\U000105af"},
+	{0x10589, 0x10589, mapped, "\U000105b0"},
+	{0x1058A, 0x1058A, mapped, "\U000105b1"},
+	{0x1058B, 0x1058B, disallowed, ""},
+	{0x1058C, 0x1058C, mapped, "\U000105b3"},
+	{0x1058D, 0x1058D, mapped, "\U000105b4"},
+	{0x1058E, 0x1058E, mapped, "\U000105b5"},
+	{0x1058F, 0x1058F, mapped, "\U000105b6"},
+	{0x10590, 0x10590, mapped, "\U000105b7"},
+	{0x10591, 0x10591, mapped, "\U000105b8"},
+	{0x10592, 0x10592, mapped, "\U000105b9"},
+	{0x10593, 0x10593, disallowed, ""},
+	{0x10594, 0x10594, mapped, "\U000105bb"},
+	{0x10595, 0x10595, mapped, "\U000105bc"},
+	{0x10596, 0x10596, disallowed, ""},
+	{0x10597, 0x105A1, valid, ""},
+	{0x105A2, 0x105A2, disallowed, ""},
+	{0x105A3, 0x105B1, valid, ""},
+	{0x105B2, 0x105B2, disallowed, ""},
+	{0x105B3, 0x105B9, valid, ""},
+	{0x105BA, 0x105BA, disallowed, ""},
+	{0x105BB, 0x105BC, valid, ""},
+	{0x105BD, 0x105BF, disallowed, ""},
+	{0x105C0, 0x105F3, valid, ""},
+	{0x105F4, 0x105FF, disallowed, ""},
+	{0x10600, 0x10736, valid, ""},
+	{0x10737, 0x1073F, disallowed, ""},
+	{0x10740, 0x10755, valid, ""},
+	{0x10756, 0x1075F, disallowed, ""},
+	{0x10760, 0x10767, valid, ""},
+	{0x10768, 0x1077F, disallowed, ""},
+	{0x10780, 0x10780, valid, ""},
+	{0x10781, 0x10781, mapped, "\u02d0"},
+	{0x10782, 0x10782, mapped, "\u02d1"},
+	{0x10783, 0x10783, mapped, "\u00e6"},
+	{0x10784, 0x10784, mapped, "\u0299"},
+	{0x10785, 0x10785, mapped, "\u0253"},
+	{0x10786, 0x10786, disallowed, ""},
+	{0x10787, 0x10787, mapped, "\u02a3"},
+	{0x10788, 0x10788, mapped, "\uab66"},
+	{0x10789, 0x10789, mapped, "\u02a5"},
+	{0x1078A, 0x1078A, mapped, "\u02a4"},
+	{0x1078B, 0x1078B, mapped, "\u0256"},
+	{0x1078C, 0x1078C, mapped, "\u0257"},
+	{0x1078D, 0x1078D, mapped, "\u1d91"},
+	{0x1078E, 0x1078E, mapped, "\u0258"},
+	{0x1078F, 0x1078F, mapped, "\u025e"},
+	{0x10790, 0x10790, mapped, "\u02a9"},
+	{0x10791, 0x10791, mapped, "\u0264"},
+	{0x10792, 0x10792, mapped, "\u0262"},
+	{0x10793, 0x10793, mapped, "\u0260"},
+	{0x10794, 0x10794, mapped, "\u029b"},
+	{0x10795, 0x10795, mapped, "\u0127"},
+	{0x10796, 0x10796, mapped, "\u029c"},
+	{0x10797, 0x10797, mapped, "\u0267"},
+	{0x10798, 0x10798, mapped, "\u0284"},
+	{0x10799, 0x10799, mapped, "\u02aa"},
+	{0x1079A, 0x1079A, mapped, "\u02ab"},
+	{0x1079B, 0x1079B, mapped, "\u026c"},
+	{0x1079C, 0x1079C, mapped, "\U0001df04"},
+	{0x1079D, 0x1079D, mapped, "\ua78e"},
+	{0x1079E, 0x1079E, mapped, "\u026e"},
+	{0x1079F, 0x1079F, mapped, "\U0001df05"},
+	{0x107A0, 0x107A0, mapped, "\u028e"},
+	{0x107A1, 0x107A1, mapped, "\U0001df06"},
+	{0x107A2, 0x107A2, mapped, "\u00f8"},
+	{0x107A3, 0x107A3, mapped, "\u0276"},
+	{0x107A4, 0x107A4, mapped, "\u0277"},
+	{0x107A5, 0x107A5, mapped, "q"},
+	{0x107A6, 0x107A6, mapped, "\u027a"},
+	{0x107A7, 0x107A7, mapped, "\U0001df08"},
+	{0x107A8, 0x107A8, mapped, "\u027d"},
+	{0x107A9, 0x107A9, mapped, "\u027e"},
+	{0x107AA, 0x107AA, mapped, "\u0280"},
+	{0x107AB, 0x107AB, mapped, "\u02a8"},
+	{0x107AC, 0x107AC, mapped, "\u02a6"},
+	{0x107AD, 0x107AD, mapped, "\uab67"},
+	{0x107AE, 0x107AE, mapped, "\u02a7"},
+	{0x107AF, 0x107AF, mapped, "\u0288"},
+	{0x107B0, 0x107B0, mapped, "\u2c71"},
+	{0x107B1, 0x107B1, disallowed, ""},
+	{0x107B2, 0x107B2, mapped, "\u028f"},
+	{0x107B3, 0x107B3, mapped, "\u02a1"},
+	{0x107B4, 0x107B4, mapped, "\u02a2"},
+	{0x107B5, 0x107B5, mapped, "\u0298"},
+	{0x107B6, 0x107B6, mapped, "\u01c0"},
+	{0x107B7, 0x107B7, mapped, "\u01c1"},
+	{0x107B8, 0x107B8, mapped, "\u01c2"},
+	{0x107B9, 0x107B9, mapped, "\U0001df0a"},
+	{0x107BA, 0x107BA, mapped, "\U0001df1e"},
+	{0x107BB, 0x107FF, disallowed, ""},
+	{0x10800, 0x10805, valid, ""},
+	{0x10806, 0x10807, disallowed, ""},
+	{0x10808, 0x10808, valid, ""},
+	{0x10809, 0x10809, disallowed, ""},
+	{0x1080A, 0x10835, valid, ""},
+	{0x10836, 0x10836, disallowed, ""},
+	{0x10837, 0x10838, valid, ""},
+	{0x10839, 0x1083B, disallowed, ""},
+	{0x1083C, 0x1083C, valid, ""},
+	{0x1083D, 0x1083E, disallowed, ""},
+	{0x1083F, 0x10855, valid, ""},
+	{0x10856, 0x10856, disallowed, ""},
+	{0x10857, 0x1089E, valid, ""},
+	{0x1089F, 0x108A6, disallowed, ""},
+	{0x108A7, 0x108AF, valid, ""},
+	{0x108B0, 0x108DF, disallowed, ""},
+	{0x108E0, 0x108F2, valid, ""},
+	{0x108F3, 0x108F3, disallowed, ""},
+	{0x108F4, 0x108F5, valid, ""},
+	{0x108F6, 0x108FA, disallowed, ""},
+	{0x108FB, 0x1091B, valid, ""},
+	{0x1091C, 0x1091E, disallowed, ""},
+	{0x1091F, 0x10939, valid, ""},
+	{0x1093A, 0x1093E, disallowed, ""},
+	{0x1093F, 0x10959, valid, ""},
+	{0x1095A, 0x1097F, disallowed, ""},
+	{0x10980, 0x109B7, valid, ""},
+	{0x109B8, 0x109BB, disallowed, ""},
+	{0x109BC, 0x109CF, valid, ""},
+	{0x109D0, 0x109D1, disallowed, ""},
+	{0x109D2, 0x10A03, valid, ""},
+	{0x10A04, 0x10A04, disallowed, ""},
+	{0x10A05, 0x10A06, valid, ""},
+	{0x10A07, 0x10A0B, disallowed, ""},
+	{0x10A0C, 0x10A13, valid, ""},
+	{0x10A14, 0x10A14, disallowed, ""},
+	{0x10A15, 0x10A17, valid, ""},
+	{0x10A18, 0x10A18, disallowed, ""},
+	{0x10A19, 0x10A35, valid, ""},
+	{0x10A36, 0x10A37, disallowed, ""},
+	{0x10A38, 0x10A3A, valid, ""},
+	{0x10A3B, 0x10A3E, disallowed, ""},
+	{0x10A3F, 0x10A48, valid, ""},
+	{0x10A49, 0x10A4F, disallowed, ""},
+	{0x10A50, 0x10A58, valid, ""},
+	{0x10A59, 0x10A5F, disallowed, ""},
+	{0x10A60, 0x10A9F, valid, ""},
+	{0x10AA0, 0x10ABF, disallowed, ""},
+	{0x10AC0, 0x10AE6, valid, ""},
+	{0x10AE7, 0x10AEA, disallowed, ""},
+	{0x10AEB, 0x10AF6, valid, ""},
+	{0x10AF7, 0x10AFF, disallowed, ""},
+	{0x10B00, 0x10B35, valid, ""},
+	{0x10B36, 0x10B38, disallowed, ""},
+	{0x10B39, 0x10B55, valid, ""},
+	{0x10B56, 0x10B57, disallowed, ""},
+	{0x10B58, 0x10B72, valid, ""},
+	{0x10B73, 0x10B77, disallowed, ""},
+	{0x10B78, 0x10B91, valid, ""},
+	{0x10B92, 0x10B98, disallowed, ""},
+	{0x10B99, 0x10B9C, valid, ""},
+	{0x10B9D, 0x10BA8, disallowed, ""},
+	{0x10BA9, 0x10BAF, valid, ""},
+	{0x10BB0, 0x10BFF, disallowed, ""},
+	{0x10C00, 0x10C48, valid, ""},
+	{0x10C49, 0x10C7F, disallowed, ""},
+	{0x10C80, 0x10C80, mapped, "\U00010cc0"},
+	{0x10C81, 0x10C81, mapped, "\U00010cc1"},
+	{0x10C82, 0x10C82, mapped, "\U00010cc2"},
+	{0x10C83, 0x10C83, mapped, "\U00010cc3"},
+	{0x10C84, 0x10C84, mapped, "\U00010cc4"},
+	{0x10C85, 0x10C85, mapped, "\U00010cc5"},
+	{0x10C86, 0x10C86, mapped, "\U00010cc6"},
+	{0x10C87, 0x10C87, mapped, "\U00010cc7"},
+	{0x10C88, 0x10C88, mapped, "\U00010cc8"},
+	{0x10C89, 0x10C89, mapped, "\U00010cc9"},
+	{0x10C8A, 0x10C8A, mapped, "\U00010cca"},
+	{0x10C8B, 0x10C8B, mapped, "\U00010ccb"},
+	{0x10C8C, 0x10C8C, mapped, "\U00010ccc"},
+	{0x10C8D, 0x10C8D, mapped, "\U00010ccd"},
+	{0x10C8E, 0x10C8E, mapped, "\U00010cce"},
+	{0x10C8F, 0x10C8F, mapped, "\U00010ccf"},
+	{0x10C90, 0x10C90, mapped, "\U00010cd0"},
+	{0x10C91, 0x10C91, mapped, "\U00010cd1"},
+	{0x10C92, 0x10C92, mapped, "\U00010cd2"},
+	{0x10C93, 0x10C93, mapped, "\U00010cd3"},
+	{0x10C94, 0x10C94, mapped, "\U00010cd4"},
+	{0x10C95, 0x10C95, mapped, "\U00010cd5"},
+	{0x10C96, 0x10C96, mapped, "\U00010cd6"},
+	{0x10C97, 0x10C97, mapped, "\U00010cd7"},
+	{0x10C98, 0x10C98, mapped, "\U00010cd8"},
+	{0x10C99, 0x10C99, mapped, "\U00010cd9"},
+	{0x10C9A, 0x10C9A, mapped, "\U00010cda"},
+	{0x10C9B, 0x10C9B, mapped, "\U00010cdb"},
+	{0x10C9C, 0x10C9C, mapped, "\U00010cdc"},
+	{0x10C9D, 0x10C9D, mapped, "\U00010cdd"},
+	{0x10C9E, 0x10C9E, mapped, "\U00010cde"},
+	{0x10C9F, 0x10C9F, mapped, "\U00010cdf"},
+	{0x10CA0, 0x10CA0, mapped, "\U00010ce0"},
+	{0x10CA1, 0x10CA1, mapped, "\U00010ce1"},
+	{0x10CA2, 0x10CA2, mapped, "\U00010ce2"},
+	{0x10CA3, 0x10CA3, mapped, "\U00010ce3"},
+	{0x10CA4, 0x10CA4, mapped, "\U00010ce4"},
+	{0x10CA5, 0x10CA5, mapped, "\U00010ce5"},
+	{0x10CA6, 0x10CA6, mapped, "\U00010ce6"},
+	{0x10CA7, 0x10CA7, mapped, "\U00010ce7"},
+	{0x10CA8, 0x10CA8, mapped, "\U00010ce8"},
+	{0x10CA9, 0x10CA9, mapped, "\U00010ce9"},
+	{0x10CAA, 0x10CAA, mapped, "\U00010cea"},
+	{0x10CAB, 0x10CAB, mapped, "\U00010ceb"},
+	{0x10CAC, 0x10CAC, mapped, "\U00010cec"},
+	{0x10CAD, 0x10CAD, mapped, "\U00010ced"},
+	{0x10CAE, 0x10CAE, mapped, "\U00010cee"},
+	{0x10CAF, 0x10CAF, mapped, "\U00010cef"},
+	{0x10CB0, 0x10CB0, mapped, "\U00010cf0"},
+	{0x10CB1, 0x10CB1, mapped, "\U00010cf1"},
+	{0x10CB2, 0x10CB2, mapped, "\U00010cf2"},
+	{0x10CB3, 0x10CBF, disallowed, ""},
+	{0x10CC0, 0x10CF2, valid, ""},
+	{0x10CF3, 0x10CF9, disallowed, ""},
+	{0x10CFA, 0x10D27, valid, ""},
+	{0x10D28, 0x10D2F, disallowed, ""},
+	{0x10D30, 0x10D39, valid, ""},
+	{0x10D3A, 0x10D3F, disallowed, ""},
+	{0x10D40, 0x10D4F, valid, ""},
+	{0x10D50, 0x10D50, mapped, "\U00010d70"},
+	{0x10D51, 0x10D51, mapped, "\U00010d71"},
+	{0x10D52, 0x10D52, mapped, "\U00010d72"},
+	{0x10D53, 0x10D53, mapped, "\U00010d73"},
+	{0x10D54, 0x10D54, mapped, "\U00010d74"},
+	{0x10D55, 0x10D55, mapped, "\U00010d75"},
+	{0x10D56, 0x10D56, mapped, "\U00010d76"},
+	{0x10D57, 0x10D57, mapped, "\U00010d77"},
+	{0x10D58, 0x10D58, mapped, "\U00010d78"},
+	{0x10D59, 0x10D59, mapped, "\U00010d79"},
+	{0x10D5A, 0x10D5A, mapped, "\U00010d7a"},
+	{0x10D5B, 0x10D5B, mapped, "\U00010d7b"},
+	{0x10D5C, 0x10D5C, mapped, "\U00010d7c"},
+	{0x10D5D, 0x10D5D, mapped, "\U00010d7d"},
+	{0x10D5E, 0x10D5E, mapped, "\U00010d7e"},
+	{0x10D5F, 0x10D5F, mapped, "\U00010d7f"},
+	{0x10D60, 0x10D60, mapped, "\U00010d80"},
+	{0x10D61, 0x10D61, mapped, "\U00010d81"},
+	{0x10D62, 0x10D62, mapped, "\U00010d82"},
+	{0x10D63, 0x10D63, mapped, "\U00010d83"},
+	{0x10D64, 0x10D64, mapped, "\U00010d84"},
+	{0x10D65, 0x10D65, mapped, "\U00010d85"},
+	{0x10D66, 0x10D68, disallowed, ""},
+	{0x10D69, 0x10D85, valid, ""},
+	{0x10D86, 0x10D8D, disallowed, ""},
+	{0x10D8E, 0x10D8F, valid, ""},
+	{0x10D90, 0x10E5F, disallowed, ""},
+	{0x10E60, 0x10E7E, valid, ""},
+	{0x10E7F, 0x10E7F, disallowed, ""},
+	{0x10E80, 0x10EA9, valid, ""},
+	{0x10EAA, 0x10EAA, disallowed, ""},
+	{0x10EAB, 0x10EAD, valid, ""},
+	{0x10EAE, 0x10EAF, disallowed, ""},
+	{0x10EB0, 0x10EB1, valid, ""},
+	{0x10EB2, 0x10EC1, disallowed, ""},
+	{0x10EC2, 0x10EC7, valid, ""},
+	{0x10EC8, 0x10ECF, disallowed, ""},
+	{0x10ED0, 0x10ED8, valid, ""},
+	{0x10ED9, 0x10EF9, disallowed, ""},
+	{0x10EFA, 0x10F27, valid, ""},
+	{0x10F28, 0x10F2F, disallowed, ""},
+	{0x10F30, 0x10F59, valid, ""},
+	{0x10F5A, 0x10F6F, disallowed, ""},
+	{0x10F70, 0x10F89, valid, ""},
+	{0x10F8A, 0x10FAF, disallowed, ""},
+	{0x10FB0, 0x10FCB, valid, ""},
+	{0x10FCC, 0x10FDF, disallowed, ""},
+	{0x10FE0, 0x10FF6, valid, ""},
+	{0x10FF7, 0x10FFF, disallowed, ""},
+	{0x11000, 0x1104D, valid, ""},
+	{0x1104E, 0x11051, disallowed, ""},
+	{0x11052, 0x11075, valid, ""},
+	{0x11076, 0x1107E, disallowed, ""},
+	{0x1107F, 0x110BC, valid, ""},
+	{0x110BD, 0x110BD, disallowed, ""},
+	{0x110BE, 0x110C2, valid, ""},
+	{0x110C3, 0x110CF, disallowed, ""},
+	{0x110D0, 0x110E8, valid, ""},
+	{0x110E9, 0x110EF, disallowed, ""},
+	{0x110F0, 0x110F9, valid, ""},
+	{0x110FA, 0x110FF, disallowed, ""},
+	{0x11100, 0x11134, valid, ""},
+	{0x11135, 0x11135, disallowed, ""},
+	{0x11136, 0x11147, valid, ""},
+	{0x11148, 0x1114F, disallowed, ""},
+	{0x11150, 0x11176, valid, ""},
+	{0x11177, 0x1117F, disallowed, ""},
+	{0x11180, 0x111DF, valid, ""},
+	{0x111E0, 0x111E0, disallowed, ""},
+	{0x111E1, 0x111F4, valid, ""},
+	{0x111F5, 0x111FF, disallowed, ""},
+	{0x11200, 0x11211, valid, ""},
+	{0x11212, 0x11212, disallowed, ""},
+	{0x11213, 0x11241, valid, ""},
+	{0x11242, 0x1127F, disallowed, ""},
+	{0x11280, 0x11286, valid, ""},
+	{0x11287, 0x11287, disallowed, ""},
+	{0x11288, 0x11288, valid, ""},
+	{0x11289, 0x11289, disallowed, ""},
+	{0x1128A, 0x1128D, valid, ""},
+	{0x1128E, 0x1128E, disallowed, ""},
+	{0x1128F, 0x1129D, valid, ""},
+	{0x1129E, 0x1129E, disallowed, ""},
+	{0x1129F, 0x112A9, valid, ""},
+	{0x112AA, 0x112AF, disallowed, ""},
+	{0x112B0, 0x112EA, valid, ""},
+	{0x112EB, 0x112EF, disallowed, ""},
+	{0x112F0, 0x112F9, valid, ""},
+	{0x112FA, 0x112FF, disallowed, ""},
+	{0x11300, 0x11303, valid, ""},
+	{0x11304, 0x11304, disallowed, ""},
+	{0x11305, 0x1130C, valid, ""},
+	{0x1130D, 0x1130E, disallowed, ""},
+	{0x1130F, 0x11310, valid, ""},
+	{0x11311, 0x11312, disallowed, ""},
+	{0x11313, 0x11328, valid, ""},
+	{0x11329, 0x11329, disallowed, ""},
+	{0x1132A, 0x11330, valid, ""},
+	{0x11331, 0x11331, disallowed, ""},
+	{0x11332, 0x11333, valid, ""},
+	{0x11334, 0x11334, disallowed, ""},
+	{0x11335, 0x11339, valid, ""},
+	{0x1133A, 0x1133A, disallowed, ""},
+	{0x1133B, 0x11344, valid, ""},
+	{0x11345, 0x11346, disallowed, ""},
+	{0x11347, 0x11348, valid, ""},
+	{0x11349, 0x1134A, disallowed, ""},
+	{0x1134B, 0x1134D, valid, ""},
+	{0x1134E, 0x1134F, disallowed, ""},
+	{0x11350, 0x11350, valid, ""},
+	{0x11351, 0x11356, disallowed, ""},
+	{0x11357, 0x11357, valid, ""},
+	{0x11358, 0x1135C, disallowed, ""},
+	{0x1135D, 0x11363, valid, ""},
+	{0x11364, 0x11365, disallowed, ""},
+	{0x11366, 0x1136C, valid, ""},
+	{0x1136D, 0x1136F, disallowed, ""},
+	{0x11370, 0x11374, valid, ""},
+	{0x11375, 0x1137F, disallowed, ""},
+	{0x11380, 0x11389, valid, ""},
+	{0x1138A, 0x1138A, disallowed, ""},
+	{0x1138B, 0x1138B, valid, ""},
+	{0x1138C, 0x1138D, disallowed, ""},
+	{0x1138E, 0x1138E, valid, ""},
+	{0x1138F, 0x1138F, disallowed, ""},
+	{0x11390, 0x113B5, valid, ""},
+	{0x113B6, 0x113B6, disallowed, ""},
+	{0x113B7, 0x113C0, valid, ""},
+	{0x113C1, 0x113C1, disallowed, ""},
+	{0x113C2, 0x113C2, valid, ""},
+	{0x113C3, 0x113C4, disallowed, ""},
+	{0x113C5, 0x113C5, valid, ""},
+	{0x113C6, 0x113C6, disallowed, ""},
+	{0x113C7, 0x113CA, valid, ""},
+	{0x113CB, 0x113CB, disallowed, ""},
+	{0x113CC, 0x113D5, valid, ""},
+	{0x113D6, 0x113D6, disallowed, ""},
+	{0x113D7, 0x113D8, valid, ""},
+	{0x113D9, 0x113E0, disallowed, ""},
+	{0x113E1, 0x113E2, valid, ""},
+	{0x113E3, 0x113FF, disallowed, ""},
+	{0x11400, 0x1145B, valid, ""},
+	{0x1145C, 0x1145C, disallowed, ""},
+	{0x1145D, 0x11461, valid, ""},
+	{0x11462, 0x1147F, disallowed, ""},
+	{0x11480, 0x114C7, valid, ""},
+	{0x114C8, 0x114CF, disallowed, ""},
+	{0x114D0, 0x114D9, valid, ""},
+	{0x114DA, 0x1157F, disallowed, ""},
+	{0x11580, 0x115B5, valid, ""},
+	{0x115B6, 0x115B7, disallowed, ""},
+	{0x115B8, 0x115DD, valid, ""},
+	{0x115DE, 0x115FF, disallowed, ""},
+	{0x11600, 0x11644, valid, ""},
+	{0x11645, 0x1164F, disallowed, ""},
+	{0x11650, 0x11659, valid, ""},
+	{0x1165A, 0x1165F, disallowed, ""},
+	{0x11660, 0x1166C, valid, ""},
+	{0x1166D, 0x1167F, disallowed, ""},
+	{0x11680, 0x116B9, valid, ""},
+	{0x116BA, 0x116BF, disallowed, ""},
+	{0x116C0, 0x116C9, valid, ""},
+	{0x116CA, 0x116CF, disallowed, ""},
+	{0x116D0, 0x116E3, valid, ""},
+	{0x116E4, 0x116FF, disallowed, ""},
+	{0x11700, 0x1171A, valid, ""},
+	{0x1171B, 0x1171C, disallowed, ""},
+	{0x1171D, 0x1172B, valid, ""},
+	{0x1172C, 0x1172F, disallowed, ""},
+	{0x11730, 0x11746, valid, ""},
+	{0x11747, 0x117FF, disallowed, ""},
+	{0x11800, 0x1183B, valid, ""},
+	{0x1183C, 0x1189F, disallowed, ""},
+	{0x118A0, 0x118A0, mapped, "\U000118c0"},
+	{0x118A1, 0x118A1, mapped, "\U000118c1"},
+	{0x118A2, 0x118A2, mapped, "\U000118c2"},
+	{0x118A3, 0x118A3, mapped, "\U000118c3"},
+	{0x118A4, 0x118A4, mapped, "\U000118c4"},
+	{0x118A5, 0x118A5, mapped, "\U000118c5"},
+	{0x118A6, 0x118A6, mapped, "\U000118c6"},
+	{0x118A7, 0x118A7, mapped, "\U000118c7"},
+	{0x118A8, 0x118A8, mapped, "\U000118c8"},
+	{0x118A9, 0x118A9, mapped, "\U000118c9"},
+	{0x118AA, 0x118AA, mapped, "\U000118ca"},
+	{0x118AB, 0x118AB, mapped, "\U000118cb"},
+	{0x118AC, 0x118AC, mapped, "\U000118cc"},
+	{0x118AD, 0x118AD, mapped, "\U000118cd"},
+	{0x118AE, 0x118AE, mapped, "\U000118ce"},
+	{0x118AF, 0x118AF, mapped, "\U000118cf"},
+	{0x118B0, 0x118B0, mapped, "\U000118d0"},
+	{0x118B1, 0x118B1, mapped, "\U000118d1"},
+	{0x118B2, 0x118B2, mapped, "\U000118d2"},
+	{0x118B3, 0x118B3, mapped, "\U000118d3"},
+	{0x118B4, 0x118B4, mapped, "\U000118d4"},
+	{0x118B5, 0x118B5, mapped, "\U000118d5"},
+	{0x118B6, 0x118B6, mapped, "\U000118d6"},
+	{0x118B7, 0x118B7, mapped, "\U000118d7"},
+	{0x118B8, 0x118B8, mapped, "\U000118d8"},
+	{0x118B9, 0x118B9, mapped, "\U000118d9"},
+	{0x118BA, 0x118BA, mapped, "\U000118da"},
+	{0x118BB, 0x118BB, mapped, "\U000118db"},
+	{0x118BC, 0x118BC, mapped, "\U000118dc"},
+	{0x118BD, 0x118BD, mapped, "\U000118dd"},
+	{0x118BE, 0x118BE, mapped, "\U000118de"},
+	{0x118BF, 0x118BF, mapped, "\U000118df"},
+	{0x118C0, 0x118F2, valid, ""},
+	{0x118F3, 0x118FE, disallowed, ""},
+	{0x118FF, 0x11906, valid, ""},
+	{0x11907, 0x11908, disallowed, ""},
+	{0x11909, 0x11909, valid, ""},
+	{0x1190A, 0x1190B, disallowed, ""},
+	{0x1190C, 0x11913, valid, ""},
+	{0x11914, 0x11914, disallowed, ""},
+	{0x11915, 0x11916, valid, ""},
+	{0x11917, 0x11917, disallowed, ""},
+	{0x11918, 0x11935, valid, ""},
+	{0x11936, 0x11936, disallowed, ""},
+	{0x11937, 0x11938, valid, ""},
+	{0x11939, 0x1193A, disallowed, ""},
+	{0x1193B, 0x11946, valid, ""},
+	{0x11947, 0x1194F, disallowed, ""},
+	{0x11950, 0x11959, valid, ""},
+	{0x1195A, 0x1199F, disallowed, ""},
+	{0x119A0, 0x119A7, valid, ""},
+	{0x119A8, 0x119A9, disallowed, ""},
+	{0x119AA, 0x119D7, valid, ""},
+	{0x119D8, 0x119D9, disallowed, ""},
+	{0x119DA, 0x119E4, valid, ""},
+	{0x119E5, 0x119FF, disallowed, ""},
+	{0x11A00, 0x11A47, valid, ""},
+	{0x11A48, 0x11A4F, disallowed, ""},
+	{0x11A50, 0x11AA2, valid, ""},
+	{0x11AA3, 0x11AAF, disallowed, ""},
+	{0x11AB0, 0x11AF8, valid, ""},
+	{0x11AF9, 0x11AFF, disallowed, ""},
+	{0x11B00, 0x11B09, valid, ""},
+	{0x11B0A, 0x11B5F, disallowed, ""},
+	{0x11B60, 0x11B67, valid, ""},
+	{0x11B68, 0x11BBF, disallowed, ""},
+	{0x11BC0, 0x11BE1, valid, ""},
+	{0x11BE2, 0x11BEF, disallowed, ""},
+	{0x11BF0, 0x11BF9, valid, ""},
+	{0x11BFA, 0x11BFF, disallowed, ""},
+	{0x11C00, 0x11C08, valid, ""},
+	{0x11C09, 0x11C09, disallowed, ""},
+	{0x11C0A, 0x11C36, valid, ""},
+	{0x11C37, 0x11C37, disallowed, ""},
+	{0x11C38, 0x11C45, valid, ""},
+	{0x11C46, 0x11C4F, disallowed, ""},
+	{0x11C50, 0x11C6C, valid, ""},
+	{0x11C6D, 0x11C6F, disallowed, ""},
+	{0x11C70, 0x11C8F, valid, ""},
+	{0x11C90, 0x11C91, disallowed, ""},
+	{0x11C92, 0x11CA7, valid, ""},
+	{0x11CA8, 0x11CA8, disallowed, ""},
+	{0x11CA9, 0x11CB6, valid, ""},
+	{0x11CB7, 0x11CFF, disallowed, ""},
+	{0x11D00, 0x11D06, valid, ""},
+	{0x11D07, 0x11D07, disallowed, ""},
+	{0x11D08, 0x11D09, valid, ""},
+	{0x11D0A, 0x11D0A, disallowed, ""},
+	{0x11D0B, 0x11D36, valid, ""},
+	{0x11D37, 0x11D39, disallowed, ""},
+	{0x11D3A, 0x11D3A, valid, ""},
+	{0x11D3B, 0x11D3B, disallowed, ""},
+	{0x11D3C, 0x11D3D, valid, ""},
+	{0x11D3E, 0x11D3E, disallowed, ""},
+	{0x11D3F, 0x11D47, valid, ""},
+	{0x11D48, 0x11D4F, disallowed, ""},
+	{0x11D50, 0x11D59, valid, ""},
+	{0x11D5A, 0x11D5F, disallowed, ""},
+	{0x11D60, 0x11D65, valid, ""},
+	{0x11D66, 0x11D66, disallowed, ""},
+	{0x11D67, 0x11D68, valid, ""},
+	{0x11D69, 0x11D69, disallowed, ""},
+	{0x11D6A, 0x11D8E, valid, ""},
+	{0x11D8F, 0x11D8F, disallowed, ""},
+	{0x11D90, 0x11D91, valid, ""},
+	{0x11D92, 0x11D92, disallowed, ""},
+	{0x11D93, 0x11D98, valid, ""},
+	{0x11D99, 0x11D9F, disallowed, ""},
+	{0x11DA0, 0x11DA9, valid, ""},
+	{0x11DAA, 0x11DAF, disallowed, ""},
+	{0x11DB0, 0x11DDB, valid, ""},
+	{0x11DDC, 0x11DDF, disallowed, ""},
+	{0x11DE0, 0x11DE9, valid, ""},
+	{0x11DEA, 0x11EDF, disallowed, ""},
+	{0x11EE0, 0x11EF8, valid, ""},
+	{0x11EF9, 0x11EFF, disallowed, ""},
+	{0x11F00, 0x11F10, valid, ""},
+	{0x11F11, 0x11F11, disallowed, ""},
+	{0x11F12, 0x11F3A, valid, ""},
+	{0x11F3B, 0x11F3D, disallowed, ""},
+	{0x11F3E, 0x11F5A, valid, ""},
+	{0x11F5B, 0x11FAF, disallowed, ""},
+	{0x11FB0, 0x11FB0, valid, ""},
+	{0x11FB1, 0x11FBF, disallowed, ""},
+	{0x11FC0, 0x11FF1, valid, ""},
+	{0x11FF2, 0x11FFE, disallowed, ""},
+	{0x11FFF, 0x12399, valid, ""},
+	{0x1239A, 0x123FF, disallowed, ""},
+	{0x12400, 0x1246E, valid, ""},
+	{0x1246F, 0x1246F, disallowed, ""},
+	{0x12470, 0x12474, valid, ""},
+	{0x12475, 0x1247F, disallowed, ""},
+	{0x12480, 0x12543, valid, ""},
+	{0x12544, 0x12F8F, disallowed, ""},
+	{0x12F90, 0x12FF2, valid, ""},
+	{0x12FF3, 0x12FFF, disallowed, ""},
+	{0x13000, 0x1342F, valid, ""},
+	{0x13430, 0x1343F, disallowed, ""},
+	{0x13440, 0x13455, valid, ""},
+	{0x13456, 0x1345F, disallowed, ""},
+	{0x13460, 0x143FA, valid, ""},
+	{0x143FB, 0x143FF, disallowed, ""},
+	{0x14400, 0x14646, valid, ""},
+	{0x14647, 0x160FF, disallowed, ""},
+	{0x16100, 0x16139, valid, ""},
+	{0x1613A, 0x167FF, disallowed, ""},
+	{0x16800, 0x16A38, valid, ""},
+	{0x16A39, 0x16A3F, disallowed, ""},
+	{0x16A40, 0x16A5E, valid, ""},
+	{0x16A5F, 0x16A5F, disallowed, ""},
+	{0x16A60, 0x16A69, valid, ""},
+	{0x16A6A, 0x16A6D, disallowed, ""},
+	{0x16A6E, 0x16ABE, valid, ""},
+	{0x16ABF, 0x16ABF, disallowed, ""},
+	{0x16AC0, 0x16AC9, valid, ""},
+	{0x16ACA, 0x16ACF, disallowed, ""},
+	{0x16AD0, 0x16AED, valid, ""},
+	{0x16AEE, 0x16AEF, disallowed, ""},
+	{0x16AF0, 0x16AF5, valid, ""},
+	{0x16AF6, 0x16AFF, disallowed, ""},
+	{0x16B00, 0x16B45, valid, ""},
+	{0x16B46, 0x16B4F, disallowed, ""},
+	{0x16B50, 0x16B59, valid, ""},
+	{0x16B5A, 0x16B5A, disallowed, ""},
+	{0x16B5B, 0x16B61, valid, ""},
+	{0x16B62, 0x16B62, disallowed, ""},
+	{0x16B63, 0x16B77, valid, ""},
+	{0x16B78, 0x16B7C, disallowed, ""},
+	{0x16B7D, 0x16B8F, valid, ""},
+	{0x16B90, 0x16D3F, disallowed, ""},
+	{0x16D40, 0x16D79, valid, ""},
+	{0x16D7A, 0x16E3F, disallowed, ""},
+	{0x16E40, 0x16E40, mapped, "\U00016e60"},
+	{0x16E41, 0x16E41, mapped, "\U00016e61"},
+	{0x16E42, 0x16E42, mapped, "\U00016e62"},
+	{0x16E43, 0x16E43, mapped, "\U00016e63"},
+	{0x16E44, 0x16E44, mapped, "\U00016e64"},
+	{0x16E45, 0x16E45, mapped, "\U00016e65"},
+	{0x16E46, 0x16E46, mapped, "\U00016e66"},
+	{0x16E47, 0x16E47, mapped, "\U00016e67"},
+	{0x16E48, 0x16E48, mapped, "\U00016e68"},
+	{0x16E49, 0x16E49, mapped, "\U00016e69"},
+	{0x16E4A, 0x16E4A, mapped, "\U00016e6a"},
+	{0x16E4B, 0x16E4B, mapped, "\U00016e6b"},
+	{0x16E4C, 0x16E4C, mapped, "\U00016e6c"},
+	{0x16E4D, 0x16E4D, mapped, "\U00016e6d"},
+	{0x16E4E, 0x16E4E, mapped, "\U00016e6e"},
+	{0x16E4F, 0x16E4F, mapped, "\U00016e6f"},
+	{0x16E50, 0x16E50, mapped, "\U00016e70"},
+	{0x16E51, 0x16E51, mapped, "\U00016e71"},
+	{0x16E52, 0x16E52, mapped, "\U00016e72"},
+	{0x16E53, 0x16E53, mapped, "\U00016e73"},
+	{0x16E54, 0x16E54, mapped, "\U00016e74"},
+	{0x16E55, 0x16E55, mapped, "\U00016e75"},
+	{0x16E56, 0x16E56, mapped, "\U00016e76"},
+	{0x16E57, 0x16E57, mapped, "\U00016e77"},
+	{0x16E58, 0x16E58, mapped, "\U00016e78"},
+	{0x16E59, 0x16E59, mapped, "\U00016e79"},
+	{0x16E5A, 0x16E5A, mapped, "\U00016e7a"},
+	{0x16E5B, 0x16E5B, mapped, "\U00016e7b"},
+	{0x16E5C, 0x16E5C, mapped, "\U00016e7c"},
+	{0x16E5D, 0x16E5D, mapped, "\U00016e7d"},
+	{0x16E5E, 0x16E5E, mapped, "\U00016e7e"},
+	{0x16E5F, 0x16E5F, mapped, "\U00016e7f"},
+	{0x16E60, 0x16E9A, valid, ""},
+	{0x16E9B, 0x16E9F, disallowed, ""},
+	{0x16EA0, 0x16EA0, mapped, "\U00016ebb"},
+	{0x16EA1, 0x16EA1, mapped, "\U00016ebc"},
+	{0x16EA2, 0x16EA2, mapped, "\U00016ebd"},
+	{0x16EA3, 0x16EA3, mapped, "\U00016ebe"},
+	{0x16EA4, 0x16EA4, mapped, "\U00016ebf"},
+	{0x16EA5, 0x16EA5, mapped, "\U00016ec0"},
+	{0x16EA6, 0x16EA6, mapped, "\U00016ec1"},
+	{0x16EA7, 0x16EA7, mapped, "\U00016ec2"},
+	{0x16EA8, 0x16EA8, mapped, "\U00016ec3"},
+	{0x16EA9, 0x16EA9, mapped, "\U00016ec4"},
+	{0x16EAA, 0x16EAA, mapped, "\U00016ec5"},
+	{0x16EAB, 0x16EAB, mapped, "\U00016ec6"},
+	{0x16EAC, 0x16EAC, mapped, "\U00016ec7"},
+	{0x16EAD, 0x16EAD, mapped, "\U00016ec8"},
+	{0x16EAE, 0x16EAE, mapped, "\U00016ec9"},
+	{0x16EAF, 0x16EAF, mapped, "\U00016eca"},
+	{0x16EB0, 0x16EB0, mapped, "\U00016ecb"},
+	{0x16EB1, 0x16EB1, mapped, "\U00016ecc"},
+	{0x16EB2, 0x16EB2, mapped, "\U00016ecd"},
+	{0x16EB3, 0x16EB3, mapped, "\U00016ece"},
+	{0x16EB4, 0x16EB4, mapped, "\U00016ecf"},
+	{0x16EB5, 0x16EB5, mapped, "\U00016ed0"},
+	{0x16EB6, 0x16EB6, mapped, "\U00016ed1"},
+	{0x16EB7, 0x16EB7, mapped, "\U00016ed2"},
+	{0x16EB8, 0x16EB8, mapped, "\U00016ed3"},
+	{0x16EB9, 0x16EBA, disallowed, ""},
+	{0x16EBB, 0x16ED3, valid, ""},
+	{0x16ED4, 0x16EFF, disallowed, ""},
+	{0x16F00, 0x16F4A, valid, ""},
+	{0x16F4B, 0x16F4E, disallowed, ""},
+	{0x16F4F, 0x16F87, valid, ""},
+	{0x16F88, 0x16F8E, disallowed, ""},
+	{0x16F8F, 0x16F9F, valid, ""},
+	{0x16FA0, 0x16FDF, disallowed, ""},
+	{0x16FE0, 0x16FE4, valid, ""},
+	{0x16FE5, 0x16FEF, disallowed, ""},
+	{0x16FF0, 0x16FF6, valid, ""},
+	{0x16FF7, 0x16FFF, disallowed, ""},
+	{0x17000, 0x18CD5, valid, ""},
+	{0x18CD6, 0x18CFE, disallowed, ""},
+	{0x18CFF, 0x18D1E, valid, ""},
+	{0x18D1F, 0x18D7F, disallowed, ""},
+	{0x18D80, 0x18DF2, valid, ""},
+	{0x18DF3, 0x1AFEF, disallowed, ""},
+	{0x1AFF0, 0x1AFF3, valid, ""},
+	{0x1AFF4, 0x1AFF4, disallowed, ""},
+	{0x1AFF5, 0x1AFFB, valid, ""},
+	{0x1AFFC, 0x1AFFC, disallowed, ""},
+	{0x1AFFD, 0x1AFFE, valid, ""},
+	{0x1AFFF, 0x1AFFF, disallowed, ""},
+	{0x1B000, 0x1B122, valid, ""},
+	{0x1B123, 0x1B131, disallowed, ""},
+	{0x1B132, 0x1B132, valid, ""},
+	{0x1B133, 0x1B14F, disallowed, ""},
+	{0x1B150, 0x1B152, valid, ""},
+	{0x1B153, 0x1B154, disallowed, ""},
+	{0x1B155, 0x1B155, valid, ""},
+	{0x1B156, 0x1B163, disallowed, ""},
+	{0x1B164, 0x1B167, valid, ""},
+	{0x1B168, 0x1B16F, disallowed, ""},
+	{0x1B170, 0x1B2FB, valid, ""},
+	{0x1B2FC, 0x1BBFF, disallowed, ""},
+	{0x1BC00, 0x1BC6A, valid, ""},
+	{0x1BC6B, 0x1BC6F, disallowed, ""},
+	{0x1BC70, 0x1BC7C, valid, ""},
+	{0x1BC7D, 0x1BC7F, disallowed, ""},
+	{0x1BC80, 0x1BC88, valid, ""},
+	{0x1BC89, 0x1BC8F, disallowed, ""},
+	{0x1BC90, 0x1BC99, valid, ""},
+	{0x1BC9A, 0x1BC9B, disallowed, ""},
+	{0x1BC9C, 0x1BC9F, valid, ""},
+	{0x1BCA0, 0x1BCA3, ignored, ""},
+	{0x1BCA4, 0x1CBFF, disallowed, ""},
+	{0x1CC00, 0x1CCD5, valid, ""},
+	{0x1CCD6, 0x1CCD6, mapped, "a"},
+	{0x1CCD7, 0x1CCD7, mapped, "b"},
+	{0x1CCD8, 0x1CCD8, mapped, "c"},
+	{0x1CCD9, 0x1CCD9, mapped, "d"},
+	{0x1CCDA, 0x1CCDA, mapped, "e"},
+	{0x1CCDB, 0x1CCDB, mapped, "f"},
+	{0x1CCDC, 0x1CCDC, mapped, "g"},
+	{0x1CCDD, 0x1CCDD, mapped, "h"},
+	{0x1CCDE, 0x1CCDE, mapped, "i"},
+	{0x1CCDF, 0x1CCDF, mapped, "j"},
+	{0x1CCE0, 0x1CCE0, mapped, "k"},
+	{0x1CCE1, 0x1CCE1, mapped, "l"},
+	{0x1CCE2, 0x1CCE2, mapped, "m"},
+	{0x1CCE3, 0x1CCE3, mapped, "n"},
+	{0x1CCE4, 0x1CCE4, mapped, "o"},
+	{0x1CCE5, 0x1CCE5, mapped, "p"},
+	{0x1CCE6, 0x1CCE6, mapped, "q"},
+	{0x1CCE7, 0x1CCE7, mapped, "r"},
+	{0x1CCE8, 0x1CCE8, mapped, "s"},
+	{0x1CCE9, 0x1CCE9, mapped, "t"},
+	{0x1CCEA, 0x1CCEA, mapped, "u"},
+	{0x1CCEB, 0x1CCEB, mapped, "v"},
+	{0x1CCEC, 0x1CCEC, mapped, "w"},
+	{0x1CCED, 0x1CCED, mapped, "x"},
+	{0x1CCEE, 0x1CCEE, mapped, "y"},
+	{0x1CCEF, 0x1CCEF, mapped, "z"},
+	{0x1CCF0, 0x1CCF0, mapped, "0"},
+	{0x1CCF1, 0x1CCF1, mapped, "1"},
+	{0x1CCF2, 0x1CCF2, mapped, "2"},
+	{0x1CCF3, 0x1CCF3, mapped, "3"},
+	{0x1CCF4, 0x1CCF4, mapped, "4"},
+	{0x1CCF5, 0x1CCF5, mapped, "5"},
+	{0x1CCF6, 0x1CCF6, mapped, "6"},
+	{0x1CCF7, 0x1CCF7, mapped, "7"},
+	{0x1CCF8, 0x1CCF8, mapped, "8"},
+	{0x1CCF9, 0x1CCF9, mapped, "9"},
+	{0x1CCFA, 0x1CCFC, valid, ""},
+	{0x1CCFD, 0x1CCFF, disallowed, ""},
+	{0x1CD00, 0x1CEB3, valid, ""},
+	{0x1CEB4, 0x1CEB9, disallowed, ""},
+	{0x1CEBA, 0x1CED0, valid, ""},
+	{0x1CED1, 0x1CEDF, disallowed, ""},
+	{0x1CEE0, 0x1CEF0, valid, ""},
+	{0x1CEF1, 0x1CEFF, disallowed, ""},
+	{0x1CF00, 0x1CF2D, valid, ""},
+	{0x1CF2E, 0x1CF2F, disallowed, ""},
+	{0x1CF30, 0x1CF46, valid, ""},
+	{0x1CF47, 0x1CF4F, disallowed, ""},
+	{0x1CF50, 0x1CFC3, valid, ""},
+	{0x1CFC4, 0x1CFFF, disallowed, ""},
+	{0x1D000, 0x1D0F5, valid, ""},
+	{0x1D0F6, 0x1D0FF, disallowed, ""},
+	{0x1D100, 0x1D126, valid, ""},
+	{0x1D127, 0x1D128, disallowed, ""},
+	{0x1D129, 0x1D15D, valid, ""},
+	{0x1D15E, 0x1D15E, mapped, "\U0001d157\U0001d165"},
+	{0x1D15F, 0x1D15F, mapped, "\U0001d158\U0001d165"},
+	{0x1D160, 0x1D160, mapped, "\U0001d158\U0001d165\U0001d16e"},
+	{0x1D161, 0x1D161, mapped, "\U0001d158\U0001d165\U0001d16f"},
+	{0x1D162, 0x1D162, mapped, "\U0001d158\U0001d165\U0001d170"},
+	{0x1D163, 0x1D163, mapped, "\U0001d158\U0001d165\U0001d171"},
+	{0x1D164, 0x1D164, mapped, "\U0001d158\U0001d165\U0001d172"},
+	{0x1D165, 0x1D172, valid, ""},
+	{0x1D173, 0x1D17A, ignored, ""},
+	{0x1D17B, 0x1D1BA, valid, ""},
+	{0x1D1BB, 0x1D1BB, mapped, "\U0001d1b9\U0001d165"},
+	{0x1D1BC, 0x1D1BC, mapped, "\U0001d1ba\U0001d165"},
+	{0x1D1BD, 0x1D1BD, mapped, "\U0001d1b9\U0001d165\U0001d16e"},
+	{0x1D1BE, 0x1D1BE, mapped, "\U0001d1ba\U0001d165\U0001d16e"},
+	{0x1D1BF, 0x1D1BF, mapped, "\U0001d1b9\U0001d165\U0001d16f"},
+	{0x1D1C0, 0x1D1C0, mapped, "\U0001d1ba\U0001d165\U0001d16f"},
+	{0x1D1C1, 0x1D1EA, valid, ""},
+	{0x1D1EB, 0x1D1FF, disallowed, ""},
+	{0x1D200, 0x1D245, valid, ""},
+	{0x1D246, 0x1D2BF, disallowed, ""},
+	{0x1D2C0, 0x1D2D3, valid, ""},
+	{0x1D2D4, 0x1D2DF, disallowed, ""},
+	{0x1D2E0, 0x1D2F3, valid, ""},
+	{0x1D2F4, 0x1D2FF, disallowed, ""},
+	{0x1D300, 0x1D356, valid, ""},
+	{0x1D357, 0x1D35F, disallowed, ""},
+	{0x1D360, 0x1D378, valid, ""},
+	{0x1D379, 0x1D3FF, disallowed, ""},
+	{0x1D400, 0x1D400, mapped, "a"},
+	{0x1D401, 0x1D401, mapped, "b"},
+	{0x1D402, 0x1D402, mapped, "c"},
+	{0x1D403, 0x1D403, mapped, "d"},
+	{0x1D404, 0x1D404, mapped, "e"},
+	{0x1D405, 0x1D405, mapped, "f"},
+	{0x1D406, 0x1D406, mapped, "g"},
+	{0x1D407, 0x1D407, mapped, "h"},
+	{0x1D408, 0x1D408, mapped, "i"},
+	{0x1D409, 0x1D409, mapped, "j"},
+	{0x1D40A, 0x1D40A, mapped, "k"},
+	{0x1D40B, 0x1D40B, mapped, "l"},
+	{0x1D40C, 0x1D40C, mapped, "m"},
+	{0x1D40D, 0x1D40D, mapped, "n"},
+	{0x1D40E, 0x1D40E, mapped, "o"},
+	{0x1D40F, 0x1D40F, mapped, "p"},
+	{0x1D410, 0x1D410, mapped, "q"},
+	{0x1D411, 0x1D411, mapped, "r"},
+	{0x1D412, 0x1D412, mapped, "s"},
+	{0x1D413, 0x1D413, mapped, "t"},
+	{0x1D414, 0x1D414, mapped, "u"},
+	{0x1D415, 0x1D415, mapped, "v"},
+	{0x1D416, 0x1D416, mapped, "w"},
+	{0x1D417, 0x1D417, mapped, "x"},
+	{0x1D418, 0x1D418, mapped, "y"},
+	{0x1D419, 0x1D419, mapped, "z"},
+	{0x1D41A, 0x1D41A, mapped, "a"},
+	{0x1D41B, 0x1D41B, mapped, "b"},
+	{0x1D41C, 0x1D41C, mapped, "c"},
+	{0x1D41D, 0x1D41D, mapped, "d"},
+	{0x1D41E, 0x1D41E, mapped, "e"},
+	{0x1D41F, 0x1D41F, mapped, "f"},
+	{0x1D420, 0x1D420, mapped, "g"},
+	{0x1D421, 0x1D421, mapped, "h"},
+	{0x1D422, 0x1D422, mapped, "i"},
+	{0x1D423, 0x1D423, mapped, "j"},
+	{0x1D424, 0x1D424, mapped, "k"},
+	{0x1D425, 0x1D425, mapped, "l"},
+	{0x1D426, 0x1D426, mapped, "m"},
+	{0x1D427, 0x1D427, mapped, "n"},
+	{0x1D428, 0x1D428, mapped, "o"},
+	{0x1D429, 0x1D429, mapped, "p"},
+	{0x1D42A, 0x1D42A, mapped, "q"},
+	{0x1D42B, 0x1D42B, mapped, "r"},
+	{0x1D42C, 0x1D42C, mapped, "s"},
+	{0x1D42D, 0x1D42D, mapped, "t"},
+	{0x1D42E, 0x1D42E, mapped, "u"},
+	{0x1D42F, 0x1D42F, mapped, "v"},
+	{0x1D430, 0x1D430, mapped, "w"},
+	{0x1D431, 0x1D431, mapped, "x"},
+	{0x1D432, 0x1D432, mapped, "y"},
+	{0x1D433, 0x1D433, mapped, "z"},
+	{0x1D434, 0x1D434, mapped, "a"},
+	{0x1D435, 0x1D435, mapped, "b"},
+	{0x1D436, 0x1D436, mapped, "c"},
+	{0x1D437, 0x1D437, mapped, "d"},
+	{0x1D438, 0x1D438, mapped, "e"},
+	{0x1D439, 0x1D439, mapped, "f"},
+	{0x1D43A, 0x1D43A, mapped, "g"},
+	{0x1D43B, 0x1D43B, mapped, "h"},
+	{0x1D43C, 0x1D43C, mapped, "i"},
+	{0x1D43D, 0x1D43D, mapped, "j"},
+	{0x1D43E, 0x1D43E, mapped, "k"},
+	{0x1D43F, 0x1D43F, mapped, "l"},
+	{0x1D440, 0x1D440, mapped, "m"},
+	{0x1D441, 0x1D441, mapped, "n"},
+	{0x1D442, 0x1D442, mapped, "o"},
+	{0x1D443, 0x1D443, mapped, "p"},
+	{0x1D444, 0x1D444, mapped, "q"},
+	{0x1D445, 0x1D445, mapped, "r"},
+	{0x1D446, 0x1D446, mapped, "s"},
+	{0x1D447, 0x1D447, mapped, "t"},
+	{0x1D448, 0x1D448, mapped, "u"},
+	{0x1D449, 0x1D449, mapped, "v"},
+	{0x1D44A, 0x1D44A, mapped, "w"},
+	{0x1D44B, 0x1D44B, mapped, "x"},
+	{0x1D44C, 0x1D44C, mapped, "y"},
+	{0x1D44D, 0x1D44D, mapped, "z"},
+	{0x1D44E, 0x1D44E, mapped, "a"},
+	{0x1D44F, 0x1D44F, mapped, "b"},
+	{0x1D450, 0x1D450, mapped, "c"},
+	{0x1D451, 0x1D451, mapped, "d"},
+	{0x1D452, 0x1D452, mapped, "e"},
+	{0x1D453, 0x1D453, mapped, "f"},
+	{0x1D454, 0x1D454, mapped, "g"},
+	{0x1D455, 0x1D455, disallowed, ""},
+	{0x1D456, 0x1D456, mapped, "i"},
+	{0x1D457, 0x1D457, mapped, "j"},
+	{0x1D458, 0x1D458, mapped, "k"},
+	{0x1D459, 0x1D459, mapped, "l"},
+	{0x1D45A, 0x1D45A, mapped, "m"},
+	{0x1D45B, 0x1D45B, mapped, "n"},
+	{0x1D45C, 0x1D45C, mapped, "o"},
+	{0x1D45D, 0x1D45D, mapped, "p"},
+	{0x1D45E, 0x1D45E, mapped, "q"},
+	{0x1D45F, 0x1D45F, mapped, "r"},
+	{0x1D460, 0x1D460, mapped, "s"},
+	{0x1D461, 0x1D461, mapped, "t"},
+	{0x1D462, 0x1D462, mapped, "u"},
+	{0x1D463, 0x1D463, mapped, "v"},
+	{0x1D464, 0x1D464, mapped, "w"},
+	{0x1D465, 0x1D465, mapped, "x"},
+	{0x1D466, 0x1D466, mapped, "y"},
+	{0x1D467, 0x1D467, mapped, "z"},
+	{0x1D468, 0x1D468, mapped, "a"},
+	{0x1D469, 0x1D469, mapped, "b"},
+	{0x1D46A, 0x1D46A, mapped, "c"},
+	{0x1D46B, 0x1D46B, mapped, "d"},
+	{0x1D46C, 0x1D46C, mapped, "e"},
+	{0x1D46D, 0x1D46D, mapped, "f"},
+	{0x1D46E, 0x1D46E, mapped, "g"},
+	{0x1D46F, 0x1D46F, mapped, "h"},
+	{0x1D470, 0x1D470, mapped, "i"},
+	{0x1D471, 0x1D471, mapped, "j"},
+	{0x1D472, 0x1D472, mapped, "k"},
+	{0x1D473, 0x1D473, mapped, "l"},
+	{0x1D474, 0x1D474, mapped, "m"},
+	{0x1D475, 0x1D475, mapped, "n"},
+	{0x1D476, 0x1D476, mapped, "o"},
+	{0x1D477, 0x1D477, mapped, "p"},
+	{0x1D478, 0x1D478, mapped, "q"},
+	{0x1D479, 0x1D479, mapped, "r"},
+	{0x1D47A, 0x1D47A, mapped, "s"},
+	{0x1D47B, 0x1D47B, mapped, "t"},
+	{0x1D47C, 0x1D47C, mapped, "u"},
+	{0x1D47D, 0x1D47D, mapped, "v"},
+	{0x1D47E, 0x1D47E, mapped, "w"},
+	{0x1D47F, 0x1D47F, mapped, "x"},
+	{0x1D480, 0x1D480, mapped, "y"},
+	{0x1D481, 0x1D481, mapped, "z"},
+	{0x1D482, 0x1D482, mapped, "a"},
+	{0x1D483, 0x1D483, mapped, "b"},
+	{0x1D484, 0x1D484, mapped, "c"},
+	{0x1D485, 0x1D485, mapped, "d"},
+	{0x1D486, 0x1D486, mapped, "e"},
+	{0x1D487, 0x1D487, mapped, "f"},
+	{0x1D488, 0x1D488, mapped, "g"},
+	{0x1D489, 0x1D489, mapped, "h"},
+	{0x1D48A, 0x1D48A, mapped, "i"},
+	{0x1D48B, 0x1D48B, mapped, "j"},
+	{0x1D48C, 0x1D48C, mapped, "k"},
+	{0x1D48D, 0x1D48D, mapped, "l"},
+	{0x1D48E, 0x1D48E, mapped, "m"},
+	{0x1D48F, 0x1D48F, mapped, "n"},
+	{0x1D490, 0x1D490, mapped, "o"},
+	{0x1D491, 0x1D491, mapped, "p"},
+	{0x1D492, 0x1D492, mapped, "q"},
+	{0x1D493, 0x1D493, mapped, "r"},
+	{0x1D494, 0x1D494, mapped, "s"},
+	{0x1D495, 0x1D495, mapped, "t"},
+	{0x1D496, 0x1D496, mapped, "u"},
+	{0x1D497, 0x1D497, mapped, "v"},
+	{0x1D498, 0x1D498, mapped, "w"},
+	{0x1D499, 0x1D499, mapped, "x"},
+	{0x1D49A, 0x1D49A, mapped, "y"},
+	{0x1D49B, 0x1D49B, mapped, "z"},
+	{0x1D49C, 0x1D49C, mapped, "a"},
+	{0x1D49D, 0x1D49D, disallowed, ""},
+	{0x1D49E, 0x1D49E, mapped, "c"},
+	{0x1D49F, 0x1D49F, mapped, "d"},
+	{0x1D4A0, 0x1D4A1, disallowed, ""},
+	{0x1D4A2, 0x1D4A2, mapped, "g"},
+	{0x1D4A3, 0x1D4A4, disallowed, ""},
+	{0x1D4A5, 0x1D4A5, mapped, "j"},
+	{0x1D4A6, 0x1D4A6, mapped, "k"},
+	{0x1D4A7, 0x1D4A8, disallowed, ""},
+	{0x1D4A9, 0x1D4A9, mapped, "n"},
+	{0x1D4AA, 0x1D4AA, mapped, "o"},
+	{0x1D4AB, 0x1D4AB, mapped, "p"},
+	{0x1D4AC, 0x1D4AC, mapped, "q"},
+	{0x1D4AD, 0x1D4AD, disallowed, ""},
+	{0x1D4AE, 0x1D4AE, mapped, "s"},
+	{0x1D4AF, 0x1D4AF, mapped, "t"},
+	{0x1D4B0, 0x1D4B0, mapped, "u"},
+	{0x1D4B1, 0x1D4B1, mapped, "v"},
+	{0x1D4B2, 0x1D4B2, mapped, "w"},
+	{0x1D4B3, 0x1D4B3, mapped, "x"},
+	{0x1D4B4, 0x1D4B4, mapped, "y"},
+	{0x1D4B5, 0x1D4B5, mapped, "z"},
+	{0x1D4B6, 0x1D4B6, mapped, "a"},
+	{0x1D4B7, 0x1D4B7, mapped, "b"},
+	{0x1D4B8, 0x1D4B8, mapped, "c"},
+	{0x1D4B9, 0x1D4B9, mapped, "d"},
+	{0x1D4BA, 0x1D4BA, disallowed, ""},
+	{0x1D4BB, 0x1D4BB, mapped, "f"},
+	{0x1D4BC, 0x1D4BC, disallowed, ""},
+	{0x1D4BD, 0x1D4BD, mapped, "h"},
+	{0x1D4BE, 0x1D4BE, mapped, "i"},
+	{0x1D4BF, 0x1D4BF, mapped, "j"},
+	{0x1D4C0, 0x1D4C0, mapped, "k"},
+	{0x1D4C1, 0x1D4C1, mapped, "l"},
+	{0x1D4C2, 0x1D4C2, mapped, "m"},
+	{0x1D4C3, 0x1D4C3, mapped, "n"},
+	{0x1D4C4, 0x1D4C4, disallowed, ""},
+	{0x1D4C5, 0x1D4C5, mapped, "p"},
+	{0x1D4C6, 0x1D4C6, mapped, "q"},
+	{0x1D4C7, 0x1D4C7, mapped, "r"},
+	{0x1D4C8, 0x1D4C8, mapped, "s"},
+	{0x1D4C9, 0x1D4C9, mapped, "t"},
+	{0x1D4CA, 0x1D4CA, mapped, "u"},
+	{0x1D4CB, 0x1D4CB, mapped, "v"},
+	{0x1D4CC, 0x1D4CC, mapped, "w"},
+	{0x1D4CD, 0x1D4CD, mapped, "x"},
+	{0x1D4CE, 0x1D4CE, mapped, "y"},
+	{0x1D4CF, 0x1D4CF, mapped, "z"},
+	{0x1D4D0, 0x1D4D0, mapped, "a"},
+	{0x1D4D1, 0x1D4D1, mapped, "b"},
+	{0x1D4D2, 0x1D4D2, mapped, "c"},
+	{0x1D4D3, 0x1D4D3, mapped, "d"},
+	{0x1D4D4, 0x1D4D4, mapped, "e"},
+	{0x1D4D5, 0x1D4D5, mapped, "f"},
+	{0x1D4D6, 0x1D4D6, mapped, "g"},
+	{0x1D4D7, 0x1D4D7, mapped, "h"},
+	{0x1D4D8, 0x1D4D8, mapped, "i"},
+	{0x1D4D9, 0x1D4D9, mapped, "j"},
+	{0x1D4DA, 0x1D4DA, mapped, "k"},
+	{0x1D4DB, 0x1D4DB, mapped, "l"},
+	{0x1D4DC, 0x1D4DC, mapped, "m"},
+	{0x1D4DD, 0x1D4DD, mapped, "n"},
+	{0x1D4DE, 0x1D4DE, mapped, "o"},
+	{0x1D4DF, 0x1D4DF, mapped, "p"},
+	{0x1D4E0, 0x1D4E0, mapped, "q"},
+	{0x1D4E1, 0x1D4E1, mapped, "r"},
+	{0x1D4E2, 0x1D4E2, mapped, "s"},
+	{0x1D4E3, 0x1D4E3, mapped, "t"},
+	{0x1D4E4, 0x1D4E4, mapped, "u"},
+	{0x1D4E5, 0x1D4E5, mapped, "v"},
+	{0x1D4E6, 0x1D4E6, mapped, "w"},
+	{0x1D4E7, 0x1D4E7, mapped, "x"},
+	{0x1D4E8, 0x1D4E8, mapped, "y"},
+	{0x1D4E9, 0x1D4E9, mapped, "z"},
+	{0x1D4EA, 0x1D4EA, mapped, "a"},
+	{0x1D4EB, 0x1D4EB, mapped, "b"},
+	{0x1D4EC, 0x1D4EC, mapped, "c"},
+	{0x1D4ED, 0x1D4ED, mapped, "d"},
+	{0x1D4EE, 0x1D4EE, mapped, "e"},
+	{0x1D4EF, 0x1D4EF, mapped, "f"},
+	{0x1D4F0, 0x1D4F0, mapped, "g"},
+	{0x1D4F1, 0x1D4F1, mapped, "h"},
+	{0x1D4F2, 0x1D4F2, mapped, "i"},
+	{0x1D4F3, 0x1D4F3, mapped, "j"},
+	{0x1D4F4, 0x1D4F4, mapped, "k"},
+	{0x1D4F5, 0x1D4F5, mapped, "l"},
+	{0x1D4F6, 0x1D4F6, mapped, "m"},
+	{0x1D4F7, 0x1D4F7, mapped, "n"},
+	{0x1D4F8, 0x1D4F8, mapped, "o"},
+	{0x1D4F9, 0x1D4F9, mapped, "p"},
+	{0x1D4FA, 0x1D4FA, mapped, "q"},
+	{0x1D4FB, 0x1D4FB, mapped, "r"},
+	{0x1D4FC, 0x1D4FC, mapped, "s"},
+	{0x1D4FD, 0x1D4FD, mapped, "t"},
+	{0x1D4FE, 0x1D4FE, mapped, "u"},
+	{0x1D4FF, 0x1D4FF, mapped, "v"},
+	{0x1D500, 0x1D500, mapped, "w"},
+	{0x1D501, 0x1D501, mapped, "x"},
+	{0x1D502, 0x1D502, mapped, "y"},
+	{0x1D503, 0x1D503, mapped, "z"},
+	{0x1D504, 0x1D504, mapped, "a"},
+	{0x1D505, 0x1D505, mapped, "b"},
+	{0x1D506, 0x1D506, disallowed, ""},
+	{0x1D507, 0x1D507, mapped, "d"},
+	{0x1D508, 0x1D508, mapped, "e"},
+	{0x1D509, 0x1D509, mapped, "f"},
+	{0x1D50A, 0x1D50A, mapped, "g"},
+	{0x1D50B, 0x1D50C, disallowed, ""},
+	{0x1D50D, 0x1D50D, mapped, "j"},
+	{0x1D50E, 0x1D50E, mapped, "k"},
+	{0x1D50F, 0x1D50F, mapped, "l"},
+	{0x1D510, 0x1D510, mapped, "m"},
+	{0x1D511, 0x1D511, mapped, "n"},
+	{0x1D512, 0x1D512, mapped, "o"},
+	{0x1D513, 0x1D513, mapped, "p"},
+	{0x1D514, 0x1D514, mapped, "q"},
+	{0x1D515, 0x1D515, disallowed, ""},
+	{0x1D516, 0x1D516, mapped, "s"},
+	{0x1D517, 0x1D517, mapped, "t"},
+	{0x1D518, 0x1D518, mapped, "u"},
+	{0x1D519, 0x1D519, mapped, "v"},
+	{0x1D51A, 0x1D51A, mapped, "w"},
+	{0x1D51B, 0x1D51B, mapped, "x"},
+	{0x1D51C, 0x1D51C, mapped, "y"},
+	{0x1D51D, 0x1D51D, disallowed, ""},
+	{0x1D51E, 0x1D51E, mapped, "a"},
+	{0x1D51F, 0x1D51F, mapped, "b"},
+	{0x1D520, 0x1D520, mapped, "c"},
+	{0x1D521, 0x1D521, mapped, "d"},
+	{0x1D522, 0x1D522, mapped, "e"},
+	{0x1D523, 0x1D523, mapped, "f"},
+	{0x1D524, 0x1D524, mapped, "g"},
+	{0x1D525, 0x1D525, mapped, "h"},
+	{0x1D526, 0x1D526, mapped, "i"},
+	{0x1D527, 0x1D527, mapped, "j"},
+	{0x1D528, 0x1D528, mapped, "k"},
+	{0x1D529, 0x1D529, mapped, "l"},
+	{0x1D52A, 0x1D52A, mapped, "m"},
+	{0x1D52B, 0x1D52B, mapped, "n"},
+	{0x1D52C, 0x1D52C, mapped, "o"},
+	{0x1D52D, 0x1D52D, mapped, "p"},
+	{0x1D52E, 0x1D52E, mapped, "q"},
+	{0x1D52F, 0x1D52F, mapped, "r"},
+	{0x1D530, 0x1D530, mapped, "s"},
+	{0x1D531, 0x1D531, mapped, "t"},
+	{0x1D532, 0x1D532, mapped, "u"},
+	{0x1D533, 0x1D533, mapped, "v"},
+	{0x1D534, 0x1D534, mapped, "w"},
+	{0x1D535, 0x1D535, mapped, "x"},
+	{0x1D536, 0x1D536, mapped, "y"},
+	{0x1D537, 0x1D537, mapped, "z"},
+	{0x1D538, 0x1D538, mapped, "a"},
+	{0x1D539, 0x1D539, mapped, "b"},
+	{0x1D53A, 0x1D53A, disallowed, ""},
+	{0x1D53B, 0x1D53B, mapped, "d"},
+	{0x1D53C, 0x1D53C, mapped, "e"},
+	{0x1D53D, 0x1D53D, mapped, "f"},
+	{0x1D53E, 0x1D53E, mapped, "g"},
+	{0x1D53F, 0x1D53F, disallowed, ""},
+	{0x1D540, 0x1D540, mapped, "i"},
+	{0x1D541, 0x1D541, mapped, "j"},
+	{0x1D542, 0x1D542, mapped, "k"},
+	{0x1D543, 0x1D543, mapped, "l"},
+	{0x1D544, 0x1D544, mapped, "m"},
+	{0x1D545, 0x1D545, disallowed, ""},
+	{0x1D546, 0x1D546, mapped, "o"},
+	{0x1D547, 0x1D549, disallowed, ""},
+	{0x1D54A, 0x1D54A, mapped, "s"},
+	{0x1D54B, 0x1D54B, mapped, "t"},
+	{0x1D54C, 0x1D54C, mapped, "u"},
+	{0x1D54D, 0x1D54D, mapped, "v"},
+	{0x1D54E, 0x1D54E, mapped, "w"},
+	{0x1D54F, 0x1D54F, mapped, "x"},
+	{0x1D550, 0x1D550, mapped, "y"},
+	{0x1D551, 0x1D551, disallowed, ""},
+	{0x1D552, 0x1D552, mapped, "a"},
+	{0x1D553, 0x1D553, mapped, "b"},
+	{0x1D554, 0x1D554, mapped, "c"},
+	{0x1D555, 0x1D555, mapped, "d"},
+	{0x1D556, 0x1D556, mapped, "e"},
+	{0x1D557, 0x1D557, mapped, "f"},
+	{0x1D558, 0x1D558, mapped, "g"},
+	{0x1D559, 0x1D559, mapped, "h"},
+	{0x1D55A, 0x1D55A, mapped, "i"},
+	{0x1D55B, 0x1D55B, mapped, "j"},
+	{0x1D55C, 0x1D55C, mapped, "k"},
+	{0x1D55D, 0x1D55D, mapped, "l"},
+	{0x1D55E, 0x1D55E, mapped, "m"},
+	{0x1D55F, 0x1D55F, mapped, "n"},
+	{0x1D560, 0x1D560, mapped, "o"},
+	{0x1D561, 0x1D561, mapped, "p"},
+	{0x1D562, 0x1D562, mapped, "q"},
+	{0x1D563, 0x1D563, mapped, "r"},
+	{0x1D564, 0x1D564, mapped, "s"},
+	{0x1D565, 0x1D565, mapped, "t"},
+	{0x1D566, 0x1D566, mapped, "u"},
+	{0x1D567, 0x1D567, mapped, "v"},
+	{0x1D568, 0x1D568, mapped, "w"},
+	{0x1D569, 0x1D569, mapped, "x"},
+	{0x1D56A, 0x1D56A, mapped, "y"},
+	{0x1D56B, 0x1D56B, mapped, "z"},
+	{0x1D56C, 0x1D56C, mapped, "a"},
+	{0x1D56D, 0x1D56D, mapped, "b"},
+	{0x1D56E, 0x1D56E, mapped, "c"},
+	{0x1D56F, 0x1D56F, mapped, "d"},
+	{0x1D570, 0x1D570, mapped, "e"},
+	{0x1D571, 0x1D571, mapped, "f"},
+	{0x1D572, 0x1D572, mapped, "g"},
+	{0x1D573, 0x1D573, mapped, "h"},
+	{0x1D574, 0x1D574, mapped, "i"},
+	{0x1D575, 0x1D575, mapped, "j"},
+	{0x1D576, 0x1D576, mapped, "k"},
+	{0x1D577, 0x1D577, mapped, "l"},
+	{0x1D578, 0x1D578, mapped, "m"},
+	{0x1D579, 0x1D579, mapped, "n"},
+	{0x1D57A, 0x1D57A, mapped, "o"},
+	{0x1D57B, 0x1D57B, mapped, "p"},
+	{0x1D57C, 0x1D57C, mapped, "q"},
+	{0x1D57D, 0x1D57D, mapped, "r"},
+	{0x1D57E, 0x1D57E, mapped, "s"},
+	{0x1D57F, 0x1D57F, mapped, "t"},
+	{0x1D580, 0x1D580, mapped, "u"},
+	{0x1D581, 0x1D581, mapped, "v"},
+	{0x1D582, 0x1D582, mapped, "w"},
+	{0x1D583, 0x1D583, mapped, "x"},
+	{0x1D584, 0x1D584, mapped, "y"},
+	{0x1D585, 0x1D585, mapped, "z"},
+	{0x1D586, 0x1D586, mapped, "a"},
+	{0x1D587, 0x1D587, mapped, "b"},
+	{0x1D588, 0x1D588, mapped, "c"},
+	{0x1D589, 0x1D589, mapped, "d"},
+	{0x1D58A, 0x1D58A, mapped, "e"},
+	{0x1D58B, 0x1D58B, mapped, "f"},
+	{0x1D58C, 0x1D58C, mapped, "g"},
+	{0x1D58D, 0x1D58D, mapped, "h"},
+	{0x1D58E, 0x1D58E, mapped, "i"},
+	{0x1D58F, 0x1D58F, mapped, "j"},
+	{0x1D590, 0x1D590, mapped, "k"},
+	{0x1D591, 0x1D591, mapped, "l"},
+	{0x1D592, 0x1D592, mapped, "m"},
+	{0x1D593, 0x1D593, mapped, "n"},
+	{0x1D594, 0x1D594, mapped, "o"},
+	{0x1D595, 0x1D595, mapped, "p"},
+	{0x1D596, 0x1D596, mapped, "q"},
+	{0x1D597, 0x1D597, mapped, "r"},
+	{0x1D598, 0x1D598, mapped, "s"},
+	{0x1D599, 0x1D599, mapped, "t"},
+	{0x1D59A, 0x1D59A, mapped, "u"},
+	{0x1D59B, 0x1D59B, mapped, "v"},
+	{0x1D59C, 0x1D59C, mapped, "w"},
+	{0x1D59D, 0x1D59D, mapped, "x"},
+	{0x1D59E, 0x1D59E, mapped, "y"},
+	{0x1D59F, 0x1D59F, mapped, "z"},
+	{0x1D5A0, 0x1D5A0, mapped, "a"},
+	{0x1D5A1, 0x1D5A1, mapped, "b"},
+	{0x1D5A2, 0x1D5A2, mapped, "c"},
+	{0x1D5A3, 0x1D5A3, mapped, "d"},
+	{0x1D5A4, 0x1D5A4, mapped, "e"},
+	{0x1D5A5, 0x1D5A5, mapped, "f"},
+	{0x1D5A6, 0x1D5A6, mapped, "g"},
+	{0x1D5A7, 0x1D5A7, mapped, "h"},
+	{0x1D5A8, 0x1D5A8, mapped, "i"},
+	{0x1D5A9, 0x1D5A9, mapped, "j"},
+	{0x1D5AA, 0x1D5AA, mapped, "k"},
+	{0x1D5AB, 0x1D5AB, mapped, "l"},
+	{0x1D5AC, 0x1D5AC, mapped, "m"},
+	{0x1D5AD, 0x1D5AD, mapped, "n"},
+	{0x1D5AE, 0x1D5AE, mapped, "o"},
+	{0x1D5AF, 0x1D5AF, mapped, "p"},
+	{0x1D5B0, 0x1D5B0, mapped, "q"},
+	{0x1D5B1, 0x1D5B1, mapped, "r"},
+	{0x1D5B2, 0x1D5B2, mapped, "s"},
+	{0x1D5B3, 0x1D5B3, mapped, "t"},
+	{0x1D5B4, 0x1D5B4, mapped, "u"},
+	{0x1D5B5, 0x1D5B5, mapped, "v"},
+	{0x1D5B6, 0x1D5B6, mapped, "w"},
+	{0x1D5B7, 0x1D5B7, mapped, "x"},
+	{0x1D5B8, 0x1D5B8, mapped, "y"},
+	{0x1D5B9, 0x1D5B9, mapped, "z"},
+	{0x1D5BA, 0x1D5BA, mapped, "a"},
+	{0x1D5BB, 0x1D5BB, mapped, "b"},
+	{0x1D5BC, 0x1D5BC, mapped, "c"},
+	{0x1D5BD, 0x1D5BD, mapped, "d"},
+	{0x1D5BE, 0x1D5BE, mapped, "e"},
+	{0x1D5BF, 0x1D5BF, mapped, "f"},
+	{0x1D5C0, 0x1D5C0, mapped, "g"},
+	{0x1D5C1, 0x1D5C1, mapped, "h"},
+	{0x1D5C2, 0x1D5C2, mapped, "i"},
+	{0x1D5C3, 0x1D5C3, mapped, "j"},
+	{0x1D5C4, 0x1D5C4, mapped, "k"},
+	{0x1D5C5, 0x1D5C5, mapped, "l"},
+	{0x1D5C6, 0x1D5C6, mapped, "m"},
+	{0x1D5C7, 0x1D5C7, mapped, "n"},
+	{0x1D5C8, 0x1D5C8, mapped, "o"},
+	{0x1D5C9, 0x1D5C9, mapped, "p"},
+	{0x1D5CA, 0x1D5CA, mapped, "q"},
+	{0x1D5CB, 0x1D5CB, mapped, "r"},
+	{0x1D5CC, 0x1D5CC, mapped, "s"},
+	{0x1D5CD, 0x1D5CD, mapped, "t"},
+	{0x1D5CE, 0x1D5CE, mapped, "u"},
+	{0x1D5CF, 0x1D5CF, mapped, "v"},
+	{0x1D5D0, 0x1D5D0, mapped, "w"},
+	{0x1D5D1, 0x1D5D1, mapped, "x"},
+	{0x1D5D2, 0x1D5D2, mapped, "y"},
+	{0x1D5D3, 0x1D5D3, mapped, "z"},
+	{0x1D5D4, 0x1D5D4, mapped, "a"},
+	{0x1D5D5, 0x1D5D5, mapped, "b"},
+	{0x1D5D6, 0x1D5D6, mapped, "c"},
+	{0x1D5D7, 0x1D5D7, mapped, "d"},
+	{0x1D5D8, 0x1D5D8, mapped, "e"},
+	{0x1D5D9, 0x1D5D9, mapped, "f"},
+	{0x1D5DA, 0x1D5DA, mapped, "g"},
+	{0x1D5DB, 0x1D5DB, mapped, "h"},
+	{0x1D5DC, 0x1D5DC, mapped, "i"},
+	{0x1D5DD, 0x1D5DD, mapped, "j"},
+	{0x1D5DE, 0x1D5DE, mapped, "k"},
+	{0x1D5DF, 0x1D5DF, mapped, "l"},
+	{0x1D5E0, 0x1D5E0, mapped, "m"},
+	{0x1D5E1, 0x1D5E1, mapped, "n"},
+	{0x1D5E2, 0x1D5E2, mapped, "o"},
+	{0x1D5E3, 0x1D5E3, mapped, "p"},
+	{0x1D5E4, 0x1D5E4, mapped, "q"},
+	{0x1D5E5, 0x1D5E5, mapped, "r"},
+	{0x1D5E6, 0x1D5E6, mapped, "s"},
+	{0x1D5E7, 0x1D5E7, mapped, "t"},
+	{0x1D5E8, 0x1D5E8, mapped, "u"},
+	{0x1D5E9, 0x1D5E9, mapped, "v"},
+	{0x1D5EA, 0x1D5EA, mapped, "w"},
+	{0x1D5EB, 0x1D5EB, mapped, "x"},
+	{0x1D5EC, 0x1D5EC, mapped, "y"},
+	{0x1D5ED, 0x1D5ED, mapped, "z"},
+	{0x1D5EE, 0x1D5EE, mapped, "a"},
+	{0x1D5EF, 0x1D5EF, mapped, "b"},
+	{0x1D5F0, 0x1D5F0, mapped, "c"},
+	{0x1D5F1, 0x1D5F1, mapped, "d"},
+	{0x1D5F2, 0x1D5F2, mapped, "e"},
+	{0x1D5F3, 0x1D5F3, mapped, "f"},
+	{0x1D5F4, 0x1D5F4, mapped, "g"},
+	{0x1D5F5, 0x1D5F5, mapped, "h"},
+	{0x1D5F6, 0x1D5F6, mapped, "i"},
+	{0x1D5F7, 0x1D5F7, mapped, "j"},
+	{0x1D5F8, 0x1D5F8, mapped, "k"},
+	{0x1D5F9, 0x1D5F9, mapped, "l"},
+	{0x1D5FA, 0x1D5FA, mapped, "m"},
+	{0x1D5FB, 0x1D5FB, mapped, "n"},
+	{0x1D5FC, 0x1D5FC, mapped, "o"},
+	{0x1D5FD, 0x1D5FD, mapped, "p"},
+	{0x1D5FE, 0x1D5FE, mapped, "q"},
+	{0x1D5FF, 0x1D5FF, mapped, "r"},
+	{0x1D600, 0x1D600, mapped, "s"},
+	{0x1D601, 0x1D601, mapped, "t"},
+	{0x1D602, 0x1D602, mapped, "u"},
+	{0x1D603, 0x1D603, mapped, "v"},
+	{0x1D604, 0x1D604, mapped, "w"},
+	{0x1D605, 0x1D605, mapped, "x"},
+	{0x1D606, 0x1D606, mapped, "y"},
+	{0x1D607, 0x1D607, mapped, "z"},
+	{0x1D608, 0x1D608, mapped, "a"},
+	{0x1D609, 0x1D609, mapped, "b"},
+	{0x1D60A, 0x1D60A, mapped, "c"},
+	{0x1D60B, 0x1D60B, mapped, "d"},
+	{0x1D60C, 0x1D60C, mapped, "e"},
+	{0x1D60D, 0x1D60D, mapped, "f"},
+	{0x1D60E, 0x1D60E, mapped, "g"},
+	{0x1D60F, 0x1D60F, mapped, "h"},
+	{0x1D610, 0x1D610, mapped, "i"},
+	{0x1D611, 0x1D611, mapped, "j"},
+	{0x1D612, 0x1D612, mapped, "k"},
+	{0x1D613, 0x1D613, mapped, "l"},
+	{0x1D614, 0x1D614, mapped, "m"},
+	{0x1D615, 0x1D615, mapped, "n"},
+	{0x1D616, 0x1D616, mapped, "o"},
+	{0x1D617, 0x1D617, mapped, "p"},
+	{0x1D618, 0x1D618, mapped, "q"},
+	{0x1D619, 0x1D619, mapped, "r"},
+	{0x1D61A, 0x1D61A, mapped, "s"},
+	{0x1D61B, 0x1D61B, mapped, "t"},
+	{0x1D61C, 0x1D61C, mapped, "u"},
+	{0x1D61D, 0x1D61D, mapped, "v"},
+	{0x1D61E, 0x1D61E, mapped, "w"},
+	{0x1D61F, 0x1D61F, mapped, "x"},
+	{0x1D620, 0x1D620, mapped, "y"},
+	{0x1D621, 0x1D621, mapped, "z"},
+	{0x1D622, 0x1D622, mapped, "a"},
+	{0x1D623, 0x1D623, mapped, "b"},
+	{0x1D624, 0x1D624, mapped, "c"},
+	{0x1D625, 0x1D625, mapped, "d"},
+	{0x1D626, 0x1D626, mapped, "e"},
+	{0x1D627, 0x1D627, mapped, "f"},
+	{0x1D628, 0x1D628, mapped, "g"},
+	{0x1D629, 0x1D629, mapped, "h"},
+	{0x1D62A, 0x1D62A, mapped, "i"},
+	{0x1D62B, 0x1D62B, mapped, "j"},
+	{0x1D62C, 0x1D62C, mapped, "k"},
+	{0x1D62D, 0x1D62D, mapped, "l"},
+	{0x1D62E, 0x1D62E, mapped, "m"},
+	{0x1D62F, 0x1D62F, mapped, "n"},
+	{0x1D630, 0x1D630, mapped, "o"},
+	{0x1D631, 0x1D631, mapped, "p"},
+	{0x1D632, 0x1D632, mapped, "q"},
+	{0x1D633, 0x1D633, mapped, "r"},
+	{0x1D634, 0x1D634, mapped, "s"},
+	{0x1D635, 0x1D635, mapped, "t"},
+	{0x1D636, 0x1D636, mapped, "u"},
+	{0x1D637, 0x1D637, mapped, "v"},
+	{0x1D638, 0x1D638, mapped, "w"},
+	{0x1D639, 0x1D639, mapped, "x"},
+	{0x1D63A, 0x1D63A, mapped, "y"},
+	{0x1D63B, 0x1D63B, mapped, "z"},
+	{0x1D63C, 0x1D63C, mapped, "a"},
+	{0x1D63D, 0x1D63D, mapped, "b"},
+	{0x1D63E, 0x1D63E, mapped, "c"},
+	{0x1D63F, 0x1D63F, mapped, "d"},
+	{0x1D640, 0x1D640, mapped, "e"},
+	{0x1D641, 0x1D641, mapped, "f"},
+	{0x1D642, 0x1D642, mapped, "g"},
+	{0x1D643, 0x1D643, mapped, "h"},
+	{0x1D644, 0x1D644, mapped, "i"},
+	{0x1D645, 0x1D645, mapped, "j"},
+	{0x1D646, 0x1D646, mapped, "k"},
+	{0x1D647, 0x1D647, mapped, "l"},
+	{0x1D648, 0x1D648, mapped, "m"},
+	{0x1D649, 0x1D649, mapped, "n"},
+	{0x1D64A, 0x1D64A, mapped, "o"},
+	{0x1D64B, 0x1D64B, mapped, "p"},
+	{0x1D64C, 0x1D64C, mapped, "q"},
+	{0x1D64D, 0x1D64D, mapped, "r"},
+	{0x1D64E, 0x1D64E, mapped, "s"},
+	{0x1D64F, 0x1D64F, mapped, "t"},
+	{0x1D650, 0x1D650, mapped, "u"},
+	{0x1D651, 0x1D651, mapped, "v"},
+	{0x1D652, 0x1D652, mapped, "w"},
+	{0x1D653, 0x1D653, mapped, "x"},
+	{0x1D654, 0x1D654, mapped, "y"},
+	{0x1D655, 0x1D655, mapped, "z"},
+	{0x1D656, 0x1D656, mapped, "a"},
+	{0x1D657, 0x1D657, mapped, "b"},
+	{0x1D658, 0x1D658, mapped, "c"},
+	{0x1D659, 0x1D659, mapped, "d"},
+	{0x1D65A, 0x1D65A, mapped, "e"},
+	{0x1D65B, 0x1D65B, mapped, "f"},
+	{0x1D65C, 0x1D65C, mapped, "g"},
+	{0x1D65D, 0x1D65D, mapped, "h"},
+	{0x1D65E, 0x1D65E, mapped, "i"},
+	{0x1D65F, 0x1D65F, mapped, "j"},
+	{0x1D660, 0x1D660, mapped, "k"},
+	{0x1D661, 0x1D661, mapped, "l"},
+	{0x1D662, 0x1D662, mapped, "m"},
+	{0x1D663, 0x1D663, mapped, "n"},
+	{0x1D664, 0x1D664, mapped, "o"},
+	{0x1D665, 0x1D665, mapped, "p"},
+	{0x1D666, 0x1D666, mapped, "q"},
+	{0x1D667, 0x1D667, mapped, "r"},
+	{0x1D668, 0x1D668, mapped, "s"},
+	{0x1D669, 0x1D669, mapped, "t"},
+	{0x1D66A, 0x1D66A, mapped, "u"},
+	{0x1D66B, 0x1D66B, mapped, "v"},
+	{0x1D66C, 0x1D66C, mapped, "w"},
+	{0x1D66D, 0x1D66D, mapped, "x"},
+	{0x1D66E, 0x1D66E, mapped, "y"},
+	{0x1D66F, 0x1D66F, mapped, "z"},
+	{0x1D670, 0x1D670, mapped, "a"},
+	{0x1D671, 0x1D671, mapped, "b"},
+	{0x1D672, 0x1D672, mapped, "c"},
+	{0x1D673, 0x1D673, mapped, "d"},
+	{0x1D674, 0x1D674, mapped, "e"},
+	{0x1D675, 0x1D675, mapped, "f"},
+	{0x1D676, 0x1D676, mapped, "g"},
+	{0x1D677, 0x1D677, mapped, "h"},
+	{0x1D678, 0x1D678, mapped, "i"},
+	{0x1D679, 0x1D679, mapped, "j"},
+	{0x1D67A, 0x1D67A, mapped, "k"},
+	{0x1D67B, 0x1D67B, mapped, "l"},
+	{0x1D67C, 0x1D67C, mapped, "m"},
+	{0x1D67D, 0x1D67D, mapped, "n"},
+	{0x1D67E, 0x1D67E, mapped, "o"},
+	{0x1D67F, 0x1D67F, mapped, "p"},
+	{0x1D680, 0x1D680, mapped, "q"},
+	{0x1D681, 0x1D681, mapped, "r"},
+	{0x1D682, 0x1D682, mapped, "s"},
+	{0x1D683, 0x1D683, mapped, "t"},
+	{0x1D684, 0x1D684, mapped, "u"},
+	{0x1D685, 0x1D685, mapped, "v"},
+	{0x1D686, 0x1D686, mapped, "w"},
+	{0x1D687, 0x1D687, mapped, "x"},
+	{0x1D688, 0x1D688, mapped, "y"},
+	{0x1D689, 0x1D689, mapped, "z"},
+	{0x1D68A, 0x1D68A, mapped, "a"},
+	{0x1D68B, 0x1D68B, mapped, "b"},
+	{0x1D68C, 0x1D68C, mapped, "c"},
+	{0x1D68D, 0x1D68D, mapped, "d"},
+	{0x1D68E, 0x1D68E, mapped, "e"},
+	{0x1D68F, 0x1D68F, mapped, "f"},
+	{0x1D690, 0x1D690, mapped, "g"},
+	{0x1D691, 0x1D691, mapped, "h"},
+	{0x1D692, 0x1D692, mapped, "i"},
+	{0x1D693, 0x1D693, mapped, "j"},
+	{0x1D694, 0x1D694, mapped, "k"},
+	{0x1D695, 0x1D695, mapped, "l"},
+	{0x1D696, 0x1D696, mapped, "m"},
+	{0x1D697, 0x1D697, mapped, "n"},
+	{0x1D698, 0x1D698, mapped, "o"},
+	{0x1D699, 0x1D699, mapped, "p"},
+	{0x1D69A, 0x1D69A, mapped, "q"},
+	{0x1D69B, 0x1D69B, mapped, "r"},
+	{0x1D69C, 0x1D69C, mapped, "s"},
+	{0x1D69D, 0x1D69D, mapped, "t"},
+	{0x1D69E, 0x1D69E, mapped, "u"},
+	{0x1D69F, 0x1D69F, mapped, "v"},
+	{0x1D6A0, 0x1D6A0, mapped, "w"},
+	{0x1D6A1, 0x1D6A1, mapped, "x"},
+	{0x1D6A2, 0x1D6A2, mapped, "y"},
+	{0x1D6A3, 0x1D6A3, mapped, "z"},
+	{0x1D6A4, 0x1D6A4, mapped, "\u0131"},
+	{0x1D6A5, 0x1D6A5, mapped, "\u0237"},
+	{0x1D6A6, 0x1D6A7, disallowed, ""},
+	{0x1D6A8, 0x1D6A8, mapped, "\u03b1"},
+	{0x1D6A9, 0x1D6A9, mapped, "\u03b2"},
+	{0x1D6AA, 0x1D6AA, mapped, "\u03b3"},
+	{0x1D6AB, 0x1D6AB, mapped, "\u03b4"},
+	{0x1D6AC, 0x1D6AC, mapped, "\u03b5"},
+	{0x1D6AD, 0x1D6AD, mapped, "\u03b6"},
+	{0x1D6AE, 0x1D6AE, mapped, "\u03b7"},
+	{0x1D6AF, 0x1D6AF, mapped, "\u03b8"},
+	{0x1D6B0, 0x1D6B0, mapped, "\u03b9"},
+	{0x1D6B1, 0x1D6B1, mapped, "\u03ba"},
+	{0x1D6B2, 0x1D6B2, mapped, "\u03bb"},
+	{0x1D6B3, 0x1D6B3, mapped, "\u03bc"},
+	{0x1D6B4, 0x1D6B4, mapped, "\u03bd"},
+	{0x1D6B5, 0x1D6B5, mapped, "\u03be"},
+	{0x1D6B6, 0x1D6B6, mapped, "\u03bf"},
+	{0x1D6B7, 0x1D6B7, mapped, "\u03c0"},
+	{0x1D6B8, 0x1D6B8, mapped, "\u03c1"},
+	{0x1D6B9, 0x1D6B9, mapped, "\u03b8"},
+	{0x1D6BA, 0x1D6BA, mapped, "\u03c3"},
+	{0x1D6BB, 0x1D6BB, mapped, "\u03c4"},
+	{0x1D6BC, 0x1D6BC, mapped, "\u03c5"},
+	{0x1D6BD, 0x1D6BD, mapped, "\u03c6"},
+	{0x1D6BE, 0x1D6BE, mapped, "\u03c7"},
+	{0x1D6BF, 0x1D6BF, mapped, "\u03c8"},
+	{0x1D6C0, 0x1D6C0, mapped, "\u03c9"},
+	{0x1D6C1, 0x1D6C1, mapped, "\u2207"},
+	{0x1D6C2, 0x1D6C2, mapped, "\u03b1"},
+	{0x1D6C3, 0x1D6C3, mapped, "\u03b2"},
+	{0x1D6C4, 0x1D6C4, mapped, "\u03b3"},
+	{0x1D6C5, 0x1D6C5, mapped, "\u03b4"},
+	{0x1D6C6, 0x1D6C6, mapped, "\u03b5"},
+	{0x1D6C7, 0x1D6C7, mapped, "\u03b6"},
+	{0x1D6C8, 0x1D6C8, mapped, "\u03b7"},
+	{0x1D6C9, 0x1D6C9, mapped, "\u03b8"},
+	{0x1D6CA, 0x1D6CA, mapped, "\u03b9"},
+	{0x1D6CB, 0x1D6CB, mapped, "\u03ba"},
+	{0x1D6CC, 0x1D6CC, mapped, "\u03bb"},
+	{0x1D6CD, 0x1D6CD, mapped, "\u03bc"},
+	{0x1D6CE, 0x1D6CE, mapped, "\u03bd"},
+	{0x1D6CF, 0x1D6CF, mapped, "\u03be"},
+	{0x1D6D0, 0x1D6D0, mapped, "\u03bf"},
+	{0x1D6D1, 0x1D6D1, mapped, "\u03c0"},
+	{0x1D6D2, 0x1D6D2, mapped, "\u03c1"},
+	{0x1D6D3, 0x1D6D3, mapped, "\u03c3"},
+	{0x1D6D4, 0x1D6D4, mapped, "\u03c3"},
+	{0x1D6D5, 0x1D6D5, mapped, "\u03c4"},
+	{0x1D6D6, 0x1D6D6, mapped, "\u03c5"},
+	{0x1D6D7, 0x1D6D7, mapped, "\u03c6"},
+	{0x1D6D8, 0x1D6D8, mapped, "\u03c7"},
+	{0x1D6D9, 0x1D6D9, mapped, "\u03c8"},
+	{0x1D6DA, 0x1D6DA, mapped, "\u03c9"},
+	{0x1D6DB, 0x1D6DB, mapped, "\u2202"},
+	{0x1D6DC, 0x1D6DC, mapped, "\u03b5"},
+	{0x1D6DD, 0x1D6DD, mapped, "\u03b8"},
+	{0x1D6DE, 0x1D6DE, mapped, "\u03ba"},
+	{0x1D6DF, 0x1D6DF, mapped, "\u03c6"},
+	{0x1D6E0, 0x1D6E0, mapped, "\u03c1"},
+	{0x1D6E1, 0x1D6E1, mapped, "\u03c0"},
+	{0x1D6E2, 0x1D6E2, mapped, "\u03b1"},
+	{0x1D6E3, 0x1D6E3, mapped, "\u03b2"},
+	{0x1D6E4, 0x1D6E4, mapped, "\u03b3"},
+	{0x1D6E5, 0x1D6E5, mapped, "\u03b4"},
+	{0x1D6E6, 0x1D6E6, mapped, "\u03b5"},
+	{0x1D6E7, 0x1D6E7, mapped, "\u03b6"},
+	{0x1D6E8, 0x1D6E8, mapped, "\u03b7"},
+	{0x1D6E9, 0x1D6E9, mapped, "\u03b8"},
+	{0x1D6EA, 0x1D6EA, mapped, "\u03b9"},
+	{0x1D6EB, 0x1D6EB, mapped, "\u03ba"},
+	{0x1D6EC, 0x1D6EC, mapped, "\u03bb"},
+	{0x1D6ED, 0x1D6ED, mapped, "\u03bc"},
+	{0x1D6EE, 0x1D6EE, mapped, "\u03bd"},
+	{0x1D6EF, 0x1D6EF, mapped, "\u03be"},
+	{0x1D6F0, 0x1D6F0, mapped, "\u03bf"},
+	{0x1D6F1, 0x1D6F1, mapped, "\u03c0"},
+	{0x1D6F2, 0x1D6F2, mapped, "\u03c1"},
+	{0x1D6F3, 0x1D6F3, mapped, "\u03b8"},
+	{0x1D6F4, 0x1D6F4, mapped, "\u03c3"},
+	{0x1D6F5, 0x1D6F5, mapped, "\u03c4"},
+	{0x1D6F6, 0x1D6F6, mapped, "\u03c5"},
+	{0x1D6F7, 0x1D6F7, mapped, "\u03c6"},
+	{0x1D6F8, 0x1D6F8, mapped, "\u03c7"},
+	{0x1D6F9, 0x1D6F9, mapped, "\u03c8"},
+	{0x1D6FA, 0x1D6FA, mapped, "\u03c9"},
+	{0x1D6FB, 0x1D6FB, mapped, "\u2207"},
+	{0x1D6FC, 0x1D6FC, mapped, "\u03b1"},
+	{0x1D6FD, 0x1D6FD, mapped, "\u03b2"},
+	{0x1D6FE, 0x1D6FE, mapped, "\u03b3"},
+	{0x1D6FF, 0x1D6FF, mapped, "\u03b4"},
+	{0x1D700, 0x1D700, mapped, "\u03b5"},
+	{0x1D701, 0x1D701, mapped, "\u03b6"},
+	{0x1D702, 0x1D702, mapped, "\u03b7"},
+	{0x1D703, 0x1D703, mapped, "\u03b8"},
+	{0x1D704, 0x1D704, mapped, "\u03b9"},
+	{0x1D705, 0x1D705, mapped, "\u03ba"},
+	{0x1D706, 0x1D706, mapped, "\u03bb"},
+	{0x1D707, 0x1D707, mapped, "\u03bc"},
+	{0x1D708, 0x1D708, mapped, "\u03bd"},
+	{0x1D709, 0x1D709, mapped, "\u03be"},
+	{0x1D70A, 0x1D70A, mapped, "\u03bf"},
+	{0x1D70B, 0x1D70B, mapped, "\u03c0"},
+	{0x1D70C, 0x1D70C, mapped, "\u03c1"},
+	{0x1D70D, 0x1D70D, mapped, "\u03c3"},
+	{0x1D70E, 0x1D70E, mapped, "\u03c3"},
+	{0x1D70F, 0x1D70F, mapped, "\u03c4"},
+	{0x1D710, 0x1D710, mapped, "\u03c5"},
+	{0x1D711, 0x1D711, mapped, "\u03c6"},
+	{0x1D712, 0x1D712, mapped, "\u03c7"},
+	{0x1D713, 0x1D713, mapped, "\u03c8"},
+	{0x1D714, 0x1D714, mapped, "\u03c9"},
+	{0x1D715, 0x1D715, mapped, "\u2202"},
+	{0x1D716, 0x1D716, mapped, "\u03b5"},
+	{0x1D717, 0x1D717, mapped, "\u03b8"},
+	{0x1D718, 0x1D718, mapped, "\u03ba"},
+	{0x1D719, 0x1D719, mapped, "\u03c6"},
+	{0x1D71A, 0x1D71A, mapped, "\u03c1"},
+	{0x1D71B, 0x1D71B, mapped, "\u03c0"},
+	{0x1D71C, 0x1D71C, mapped, "\u03b1"},
+	{0x1D71D, 0x1D71D, mapped, "\u03b2"},
+	{0x1D71E, 0x1D71E, mapped, "\u03b3"},
+	{0x1D71F, 0x1D71F, mapped, "\u03b4"},
+	{0x1D720, 0x1D720, mapped, "\u03b5"},
+	{0x1D721, 0x1D721, mapped, "\u03b6"},
+	{0x1D722, 0x1D722, mapped, "\u03b7"},
+	{0x1D723, 0x1D723, mapped, "\u03b8"},
+	{0x1D724, 0x1D724, mapped, "\u03b9"},
+	{0x1D725, 0x1D725, mapped, "\u03ba"},
+	{0x1D726, 0x1D726, mapped, "\u03bb"},
+	{0x1D727, 0x1D727, mapped, "\u03bc"},
+	{0x1D728, 0x1D728, mapped, "\u03bd"},
+	{0x1D729, 0x1D729, mapped, "\u03be"},
+	{0x1D72A, 0x1D72A, mapped, "\u03bf"},
+	{0x1D72B, 0x1D72B, mapped, "\u03c0"},
+	{0x1D72C, 0x1D72C, mapped, "\u03c1"},
+	{0x1D72D, 0x1D72D, mapped, "\u03b8"},
+	{0x1D72E, 0x1D72E, mapped, "\u03c3"},
+	{0x1D72F, 0x1D72F, mapped, "\u03c4"},
+	{0x1D730, 0x1D730, mapped, "\u03c5"},
+	{0x1D731, 0x1D731, mapped, "\u03c6"},
+	{0x1D732, 0x1D732, mapped, "\u03c7"},
+	{0x1D733, 0x1D733, mapped, "\u03c8"},
+	{0x1D734, 0x1D734, mapped, "\u03c9"},
+	{0x1D735, 0x1D735, mapped, "\u2207"},
+	{0x1D736, 0x1D736, mapped, "\u03b1"},
+	{0x1D737, 0x1D737, mapped, "\u03b2"},
+	{0x1D738, 0x1D738, mapped, "\u03b3"},
+	{0x1D739, 0x1D739, mapped, "\u03b4"},
+	{0x1D73A, 0x1D73A, mapped, "\u03b5"},
+	{0x1D73B, 0x1D73B, mapped, "\u03b6"},
+	{0x1D73C, 0x1D73C, mapped, "\u03b7"},
+	{0x1D73D, 0x1D73D, mapped, "\u03b8"},
+	{0x1D73E, 0x1D73E, mapped, "\u03b9"},
+	{0x1D73F, 0x1D73F, mapped, "\u03ba"},
+	{0x1D740, 0x1D740, mapped, "\u03bb"},
+	{0x1D741, 0x1D741, mapped, "\u03bc"},
+	{0x1D742, 0x1D742, mapped, "\u03bd"},
+	{0x1D743, 0x1D743, mapped, "\u03be"},
+	{0x1D744, 0x1D744, mapped, "\u03bf"},
+	{0x1D745, 0x1D745, mapped, "\u03c0"},
+	{0x1D746, 0x1D746, mapped, "\u03c1"},
+	{0x1D747, 0x1D747, mapped, "\u03c3"},
+	{0x1D748, 0x1D748, mapped, "\u03c3"},
+	{0x1D749, 0x1D749, mapped, "\u03c4"},
+	{0x1D74A, 0x1D74A, mapped, "\u03c5"},
+	{0x1D74B, 0x1D74B, mapped, "\u03c6"},
+	{0x1D74C, 0x1D74C, mapped, "\u03c7"},
+	{0x1D74D, 0x1D74D, mapped, "\u03c8"},
+	{0x1D74E, 0x1D74E, mapped, "\u03c9"},
+	{0x1D74F, 0x1D74F, mapped, "\u2202"},
+	{0x1D750, 0x1D750, mapped, "\u03b5"},
+	{0x1D751, 0x1D751, mapped, "\u03b8"},
+	{0x1D752, 0x1D752, mapped, "\u03ba"},
+	{0x1D753, 0x1D753, mapped, "\u03c6"},
+	{0x1D754, 0x1D754, mapped, "\u03c1"},
+	{0x1D755, 0x1D755, mapped, "\u03c0"},
+	{0x1D756, 0x1D756, mapped, "\u03b1"},
+	{0x1D757, 0x1D757, mapped, "\u03b2"},
+	{0x1D758, 0x1D758, mapped, "\u03b3"},
+	{0x1D759, 0x1D759, mapped, "\u03b4"},
+	{0x1D75A, 0x1D75A, mapped, "\u03b5"},
+	{0x1D75B, 0x1D75B, mapped, "\u03b6"},
+	{0x1D75C, 0x1D75C, mapped, "\u03b7"},
+	{0x1D75D, 0x1D75D, mapped, "\u03b8"},
+	{0x1D75E, 0x1D75E, mapped, "\u03b9"},
+	{0x1D75F, 0x1D75F, mapped, "\u03ba"},
+	{0x1D760, 0x1D760, mapped, "\u03bb"},
+	{0x1D761, 0x1D761, mapped, "\u03bc"},
+	{0x1D762, 0x1D762, mapped, "\u03bd"},
+	{0x1D763, 0x1D763, mapped, "\u03be"},
+	{0x1D764, 0x1D764, mapped, "\u03bf"},
+	{0x1D765, 0x1D765, mapped, "\u03c0"},
+	{0x1D766, 0x1D766, mapped, "\u03c1"},
+	{0x1D767, 0x1D767, mapped, "\u03b8"},
+	{0x1D768, 0x1D768, mapped, "\u03c3"},
+	{0x1D769, 0x1D769, mapped, "\u03c4"},
+	{0x1D76A, 0x1D76A, mapped, "\u03c5"},
+	{0x1D76B, 0x1D76B, mapped, "\u03c6"},
+	{0x1D76C, 0x1D76C, mapped, "\u03c7"},
+	{0x1D76D, 0x1D76D, mapped, "\u03c8"},
+	{0x1D76E, 0x1D76E, mapped, "\u03c9"},
+	{0x1D76F, 0x1D76F, mapped, "\u2207"},
+	{0x1D770, 0x1D770, mapped, "\u03b1"},
+	{0x1D771, 0x1D771, mapped, "\u03b2"},
+	{0x1D772, 0x1D772, mapped, "\u03b3"},
+	{0x1D773, 0x1D773, mapped, "\u03b4"},
+	{0x1D774, 0x1D774, mapped, "\u03b5"},
+	{0x1D775, 0x1D775, mapped, "\u03b6"},
+	{0x1D776, 0x1D776, mapped, "\u03b7"},
+	{0x1D777, 0x1D777, mapped, "\u03b8"},
+	{0x1D778, 0x1D778, mapped, "\u03b9"},
+	{0x1D779, 0x1D779, mapped, "\u03ba"},
+	{0x1D77A, 0x1D77A, mapped, "\u03bb"},
+	{0x1D77B, 0x1D77B, mapped, "\u03bc"},
+	{0x1D77C, 0x1D77C, mapped, "\u03bd"},
+	{0x1D77D, 0x1D77D, mapped, "\u03be"},
+	{0x1D77E, 0x1D77E, mapped, "\u03bf"},
+	{0x1D77F, 0x1D77F, mapped, "\u03c0"},
+	{0x1D780, 0x1D780, mapped, "\u03c1"},
+	{0x1D781, 0x1D781, mapped, "\u03c3"},
+	{0x1D782, 0x1D782, mapped, "\u03c3"},
+	{0x1D783, 0x1D783, mapped, "\u03c4"},
+	{0x1D784, 0x1D784, mapped, "\u03c5"},
+	{0x1D785, 0x1D785, mapped, "\u03c6"},
+	{0x1D786, 0x1D786, mapped, "\u03c7"},
+	{0x1D787, 0x1D787, mapped, "\u03c8"},
+	{0x1D788, 0x1D788, mapped, "\u03c9"},
+	{0x1D789, 0x1D789, mapped, "\u2202"},
+	{0x1D78A, 0x1D78A, mapped, "\u03b5"},
+	{0x1D78B, 0x1D78B, mapped, "\u03b8"},
+	{0x1D78C, 0x1D78C, mapped, "\u03ba"},
+	{0x1D78D, 0x1D78D, mapped, "\u03c6"},
+	{0x1D78E, 0x1D78E, mapped, "\u03c1"},
+	{0x1D78F, 0x1D78F, mapped, "\u03c0"},
+	{0x1D790, 0x1D790, mapped, "\u03b1"},
+	{0x1D791, 0x1D791, mapped, "\u03b2"},
+	{0x1D792, 0x1D792, mapped, "\u03b3"},
+	{0x1D793, 0x1D793, mapped, "\u03b4"},
+	{0x1D794, 0x1D794, mapped, "\u03b5"},
+	{0x1D795, 0x1D795, mapped, "\u03b6"},
+	{0x1D796, 0x1D796, mapped, "\u03b7"},
+	{0x1D797, 0x1D797, mapped, "\u03b8"},
+	{0x1D798, 0x1D798, mapped, "\u03b9"},
+	{0x1D799, 0x1D799, mapped, "\u03ba"},
+	{0x1D79A, 0x1D79A, mapped, "\u03bb"},
+	{0x1D79B, 0x1D79B, mapped, "\u03bc"},
+	{0x1D79C, 0x1D79C, mapped, "\u03bd"},
+	{0x1D79D, 0x1D79D, mapped, "\u03be"},
+	{0x1D79E, 0x1D79E, mapped, "\u03bf"},
+	{0x1D79F, 0x1D79F, mapped, "\u03c0"},
+	{0x1D7A0, 0x1D7A0, mapped, "\u03c1"},
+	{0x1D7A1, 0x1D7A1, mapped, "\u03b8"},
+	{0x1D7A2, 0x1D7A2, mapped, "\u03c3"},
+	{0x1D7A3, 0x1D7A3, mapped, "\u03c4"},
+	{0x1D7A4, 0x1D7A4, mapped, "\u03c5"},
+	{0x1D7A5, 0x1D7A5, mapped, "\u03c6"},
+	{0x1D7A6, 0x1D7A6, mapped, "\u03c7"},
+	{0x1D7A7, 0x1D7A7, mapped, "\u03c8"},
+	{0x1D7A8, 0x1D7A8, mapped, "\u03c9"},
+	{0x1D7A9, 0x1D7A9, mapped, "\u2207"},
+	{0x1D7AA, 0x1D7AA, mapped, "\u03b1"},
+	{0x1D7AB, 0x1D7AB, mapped, "\u03b2"},
+	{0x1D7AC, 0x1D7AC, mapped, "\u03b3"},
+	{0x1D7AD, 0x1D7AD, mapped, "\u03b4"},
+	{0x1D7AE, 0x1D7AE, mapped, "\u03b5"},
+	{0x1D7AF, 0x1D7AF, mapped, "\u03b6"},
+	{0x1D7B0, 0x1D7B0, mapped, "\u03b7"},
+	{0x1D7B1, 0x1D7B1, mapped, "\u03b8"},
+	{0x1D7B2, 0x1D7B2, mapped, "\u03b9"},
+	{0x1D7B3, 0x1D7B3, mapped, "\u03ba"},
+	{0x1D7B4, 0x1D7B4, mapped, "\u03bb"},
+	{0x1D7B5, 0x1D7B5, mapped, "\u03bc"},
+	{0x1D7B6, 0x1D7B6, mapped, "\u03bd"},
+	{0x1D7B7, 0x1D7B7, mapped, "\u03be"},
+	{0x1D7B8, 0x1D7B8, mapped, "\u03bf"},
+	{0x1D7B9, 0x1D7B9, mapped, "\u03c0"},
+	{0x1D7BA, 0x1D7BA, mapped, "\u03c1"},
+	{0x1D7BB, 0x1D7BB, mapped, "\u03c3"},
+	{0x1D7BC, 0x1D7BC, mapped, "\u03c3"},
+	{0x1D7BD, 0x1D7BD, mapped, "\u03c4"},
+	{0x1D7BE, 0x1D7BE, mapped, "\u03c5"},
+	{0x1D7BF, 0x1D7BF, mapped, "\u03c6"},
+	{0x1D7C0, 0x1D7C0, mapped, "\u03c7"},
+	{0x1D7C1, 0x1D7C1, mapped, "\u03c8"},
+	{0x1D7C2, 0x1D7C2, mapped, "\u03c9"},
+	{0x1D7C3, 0x1D7C3, mapped, "\u2202"},
+	{0x1D7C4, 0x1D7C4, mapped, "\u03b5"},
+	{0x1D7C5, 0x1D7C5, mapped, "\u03b8"},
+	{0x1D7C6, 0x1D7C6, mapped, "\u03ba"},
+	{0x1D7C7, 0x1D7C7, mapped, "\u03c6"},
+	{0x1D7C8, 0x1D7C8, mapped, "\u03c1"},
+	{0x1D7C9, 0x1D7C9, mapped, "\u03c0"},
+	{0x1D7CA, 0x1D7CA, mapped, "\u03dd"},
+	{0x1D7CB, 0x1D7CB, mapped, "\u03dd"},
+	{0x1D7CC, 0x1D7CD, disallowed, ""},
+	{0x1D7CE, 0x1D7CE, mapped, "0"},
+	{0x1D7CF, 0x1D7CF, mapped, "1"},
+	{0x1D7D0, 0x1D7D0, mapped, "2"},
+	{0x1D7D1, 0x1D7D1, mapped, "3"},
+	{0x1D7D2, 0x1D7D2, mapped, "4"},
+	{0x1D7D3, 0x1D7D3, mapped, "5"},
+	{0x1D7D4, 0x1D7D4, mapped, "6"},
+	{0x1D7D5, 0x1D7D5, mapped, "7"},
+	{0x1D7D6, 0x1D7D6, mapped, "8"},
+	{0x1D7D7, 0x1D7D7, mapped, "9"},
+	{0x1D7D8, 0x1D7D8, mapped, "0"},
+	{0x1D7D9, 0x1D7D9, mapped, "1"},
+	{0x1D7DA, 0x1D7DA, mapped, "2"},
+	{0x1D7DB, 0x1D7DB, mapped, "3"},
+	{0x1D7DC, 0x1D7DC, mapped, "4"},
+	{0x1D7DD, 0x1D7DD, mapped, "5"},
+	{0x1D7DE, 0x1D7DE, mapped, "6"},
+	{0x1D7DF, 0x1D7DF, mapped, "7"},
+	{0x1D7E0, 0x1D7E0, mapped, "8"},
+	{0x1D7E1, 0x1D7E1, mapped, "9"},
+	{0x1D7E2, 0x1D7E2, mapped, "0"},
+	{0x1D7E3, 0x1D7E3, mapped, "1"},
+	{0x1D7E4, 0x1D7E4, mapped, "2"},
+	{0x1D7E5, 0x1D7E5, mapped, "3"},
+	{0x1D7E6, 0x1D7E6, mapped, "4"},
+	{0x1D7E7, 0x1D7E7, mapped, "5"},
+	{0x1D7E8, 0x1D7E8, mapped, "6"},
+	{0x1D7E9, 0x1D7E9, mapped, "7"},
+	{0x1D7EA, 0x1D7EA, mapped, "8"},
+	{0x1D7EB, 0x1D7EB, mapped, "9"},
+	{0x1D7EC, 0x1D7EC, mapped, "0"},
+	{0x1D7ED, 0x1D7ED, mapped, "1"},
+	{0x1D7EE, 0x1D7EE, mapped, "2"},
+	{0x1D7EF, 0x1D7EF, mapped, "3"},
+	{0x1D7F0, 0x1D7F0, mapped, "4"},
+	{0x1D7F1, 0x1D7F1, mapped, "5"},
+	{0x1D7F2, 0x1D7F2, mapped, "6"},
+	{0x1D7F3, 0x1D7F3, mapped, "7"},
+	{0x1D7F4, 0x1D7F4, mapped, "8"},
+	{0x1D7F5, 0x1D7F5, mapped, "9"},
+	{0x1D7F6, 0x1D7F6, mapped, "0"},
+	{0x1D7F7, 0x1D7F7, mapped, "1"},
+	{0x1D7F8, 0x1D7F8, mapped, "2"},
+	{0x1D7F9, 0x1D7F9, mapped, "3"},
+	{0x1D7FA, 0x1D7FA, mapped, "4"},
+	{0x1D7FB, 0x1D7FB, mapped, "5"},
+	{0x1D7FC, 0x1D7FC, mapped, "6"},
+	{0x1D7FD, 0x1D7FD, mapped, "7"},
+	{0x1D7FE, 0x1D7FE, mapped, "8"},
+	{0x1D7FF, 0x1D7FF, mapped, "9"},
+	{0x1D800, 0x1DA8B, valid, ""},
+	{0x1DA8C, 0x1DA9A, disallowed, ""},
+	{0x1DA9B, 0x1DA9F, valid, ""},
+	{0x1DAA0, 0x1DAA0, disallowed, ""},
+	{0x1DAA1, 0x1DAAF, valid, ""},
+	{0x1DAB0, 0x1DEFF, disallowed, ""},
+	{0x1DF00, 0x1DF1E, valid, ""},
+	{0x1DF1F, 0x1DF24, disallowed, ""},
+	{0x1DF25, 0x1DF2A, valid, ""},
+	{0x1DF2B, 0x1DFFF, disallowed, ""},
+	{0x1E000, 0x1E006, valid, ""},
+	{0x1E007, 0x1E007, disallowed, ""},
+	{0x1E008, 0x1E018, valid, ""},
+	{0x1E019, 0x1E01A, disallowed, ""},
+	{0x1E01B, 0x1E021, valid, ""},
+	{0x1E022, 0x1E022, disallowed, ""},
+	{0x1E023, 0x1E024, valid, ""},
+	{0x1E025, 0x1E025, disallowed, ""},
+	{0x1E026, 0x1E02A, valid, ""},
+	{0x1E02B, 0x1E02F, disallowed, ""},
+	{0x1E030, 0x1E030, mapped, "\u0430"},
+	{0x1E031, 0x1E031, mapped, "\u0431"},
+	{0x1E032, 0x1E032, mapped, "\u0432"},
+	{0x1E033, 0x1E033, mapped, "\u0433"},
+	{0x1E034, 0x1E034, mapped, "\u0434"},
+	{0x1E035, 0x1E035, mapped, "\u0435"},
+	{0x1E036, 0x1E036, mapped, "\u0436"},
+	{0x1E037, 0x1E037, mapped, "\u0437"},
+	{0x1E038, 0x1E038, mapped, "\u0438"},
+	{0x1E039, 0x1E039, mapped, "\u043a"},
+	{0x1E03A, 0x1E03A, mapped, "\u043b"},
+	{0x1E03B, 0x1E03B, mapped, "\u043c"},
+	{0x1E03C, 0x1E03C, mapped, "\u043e"},
+	{0x1E03D, 0x1E03D, mapped, "\u043f"},
+	{0x1E03E, 0x1E03E, mapped, "\u0440"},
+	{0x1E03F, 0x1E03F, mapped, "\u0441"},
+	{0x1E040, 0x1E040, mapped, "\u0442"},
+	{0x1E041, 0x1E041, mapped, "\u0443"},
+	{0x1E042, 0x1E042, mapped, "\u0444"},
+	{0x1E043, 0x1E043, mapped, "\u0445"},
+	{0x1E044, 0x1E044, mapped, "\u0446"},
+	{0x1E045, 0x1E045, mapped, "\u0447"},
+	{0x1E046, 0x1E046, mapped, "\u0448"},
+	{0x1E047, 0x1E047, mapped, "\u044b"},
+	{0x1E048, 0x1E048, mapped, "\u044d"},
+	{0x1E049, 0x1E049, mapped, "\u044e"},
+	{0x1E04A, 0x1E04A, mapped, "\ua689"},
+	{0x1E04B, 0x1E04B, mapped, "\u04d9"},
+	{0x1E04C, 0x1E04C, mapped, "\u0456"},
+	{0x1E04D, 0x1E04D, mapped, "\u0458"},
+	{0x1E04E, 0x1E04E, mapped, "\u04e9"},
+	{0x1E04F, 0x1E04F, mapped, "\u04af"},
+	{0x1E050, 0x1E050, mapped, "\u04cf"},
+	{0x1E051, 0x1E051, mapped, "\u0430"},
+	{0x1E052, 0x1E052, mapped, "\u0431"},
+	{0x1E053, 0x1E053, mapped, "\u0432"},
+	{0x1E054, 0x1E054, mapped, "\u0433"},
+	{0x1E055, 0x1E055, mapped, "\u0434"},
+	{0x1E056, 0x1E056, mapped, "\u0435"},
+	{0x1E057, 0x1E057, mapped, "\u0436"},
+	{0x1E058, 0x1E058, mapped, "\u0437"},
+	{0x1E059, 0x1E059, mapped, "\u0438"},
+	{0x1E05A, 0x1E05A, mapped, "\u043a"},
+	{0x1E05B, 0x1E05B, mapped, "\u043b"},
+	{0x1E05C, 0x1E05C, mapped, "\u043e"},
+	{0x1E05D, 0x1E05D, mapped, "\u043f"},
+	{0x1E05E, 0x1E05E, mapped, "\u0441"},
+	{0x1E05F, 0x1E05F, mapped, "\u0443"},
+	{0x1E060, 0x1E060, mapped, "\u0444"},
+	{0x1E061, 0x1E061, mapped, "\u0445"},
+	{0x1E062, 0x1E062, mapped, "\u0446"},
+	{0x1E063, 0x1E063, mapped, "\u0447"},
+	{0x1E064, 0x1E064, mapped, "\u0448"},
+	{0x1E065, 0x1E065, mapped, "\u044a"},
+	{0x1E066, 0x1E066, mapped, "\u044b"},
+	{0x1E067, 0x1E067, mapped, "\u0491"},
+	{0x1E068, 0x1E068, mapped, "\u0456"},
+	{0x1E069, 0x1E069, mapped, "\u0455"},
+	{0x1E06A, 0x1E06A, mapped, "\u045f"},
+	{0x1E06B, 0x1E06B, mapped, "\u04ab"},
+	{0x1E06C, 0x1E06C, mapped, "\ua651"},
+	{0x1E06D, 0x1E06D, mapped, "\u04b1"},
+	{0x1E06E, 0x1E08E, disallowed, ""},
+	{0x1E08F, 0x1E08F, valid, ""},
+	{0x1E090, 0x1E0FF, disallowed, ""},
+	{0x1E100, 0x1E12C, valid, ""},
+	{0x1E12D, 0x1E12F, disallowed, ""},
+	{0x1E130, 0x1E13D, valid, ""},
+	{0x1E13E, 0x1E13F, disallowed, ""},
+	{0x1E140, 0x1E149, valid, ""},
+	{0x1E14A, 0x1E14D, disallowed, ""},
+	{0x1E14E, 0x1E14F, valid, ""},
+	{0x1E150, 0x1E28F, disallowed, ""},
+	{0x1E290, 0x1E2AE, valid, ""},
+	{0x1E2AF, 0x1E2BF, disallowed, ""},
+	{0x1E2C0, 0x1E2F9, valid, ""},
+	{0x1E2FA, 0x1E2FE, disallowed, ""},
+	{0x1E2FF, 0x1E2FF, valid, ""},
+	{0x1E300, 0x1E4CF, disallowed, ""},
+	{0x1E4D0, 0x1E4F9, valid, ""},
+	{0x1E4FA, 0x1E5CF, disallowed, ""},
+	{0x1E5D0, 0x1E5FA, valid, ""},
+	{0x1E5FB, 0x1E5FE, disallowed, ""},
+	{0x1E5FF, 0x1E5FF, valid, ""},
+	{0x1E600, 0x1E6BF, disallowed, ""},
+	{0x1E6C0, 0x1E6DE, valid, ""},
+	{0x1E6DF, 0x1E6DF, disallowed, ""},
+	{0x1E6E0, 0x1E6F5, valid, ""},
+	{0x1E6F6, 0x1E6FD, disallowed, ""},
+	{0x1E6FE, 0x1E6FF, valid, ""},
+	{0x1E700, 0x1E7DF, disallowed, ""},
+	{0x1E7E0, 0x1E7E6, valid, ""},
+	{0x1E7E7, 0x1E7E7, disallowed, ""},
+	{0x1E7E8, 0x1E7EB, valid, ""},
+	{0x1E7EC, 0x1E7EC, disallowed, ""},
+	{0x1E7ED, 0x1E7EE, valid, ""},
+	{0x1E7EF, 0x1E7EF, disallowed, ""},
+	{0x1E7F0, 0x1E7FE, valid, ""},
+	{0x1E7FF, 0x1E7FF, disallowed, ""},
+	{0x1E800, 0x1E8C4, valid, ""},
+	{0x1E8C5, 0x1E8C6, disallowed, ""},
+	{0x1E8C7, 0x1E8D6, valid, ""},
+	{0x1E8D7, 0x1E8FF, disallowed, ""},
+	{0x1E900, 0x1E900, mapped, "\U0001e922"},
+	{0x1E901, 0x1E901, mapped, "\U0001e923"},
+	{0x1E902, 0x1E902, mapped, "\U0001e924"},
+	{0x1E903, 0x1E903, mapped, "\U0001e925"},
+	{0x1E904, 0x1E904, mapped, "\U0001e926"},
+	{0x1E905, 0x1E905, mapped, "\U0001e927"},
+	{0x1E906, 0x1E906, mapped, "\U0001e928"},
+	{0x1E907, 0x1E907, mapped, "\U0001e929"},
+	{0x1E908, 0x1E908, mapped, "\U0001e92a"},
+	{0x1E909, 0x1E909, mapped, "\U0001e92b"},
+	{0x1E90A, 0x1E90A, mapped, "\U0001e92c"},
+	{0x1E90B, 0x1E90B, mapped, "\U0001e92d"},
+	{0x1E90C, 0x1E90C, mapped, "\U0001e92e"},
+	{0x1E90D, 0x1E90D, mapped, "\U0001e92f"},
+	{0x1E90E, 0x1E90E, mapped, "\U0001e930"},
+	{0x1E90F, 0x1E90F, mapped, "\U0001e931"},
+	{0x1E910, 0x1E910, mapped, "\U0001e932"},
+	{0x1E911, 0x1E911, mapped, "\U0001e933"},
+	{0x1E912, 0x1E912, mapped, "\U0001e934"},
+	{0x1E913, 0x1E913, mapped, "\U0001e935"},
+	{0x1E914, 0x1E914, mapped, "\U0001e936"},
+	{0x1E915, 0x1E915, mapped, "\U0001e937"},
+	{0x1E916, 0x1E916, mapped, "\U0001e938"},
+	{0x1E917, 0x1E917, mapped, "\U0001e939"},
+	{0x1E918, 0x1E918, mapped, "\U0001e93a"},
+	{0x1E919, 0x1E919, mapped, "\U0001e93b"},
+	{0x1E91A, 0x1E91A, mapped, "\U0001e93c"},
+	{0x1E91B, 0x1E91B, mapped, "\U0001e93d"},
+	{0x1E91C, 0x1E91C, mapped, "\U0001e93e"},
+	{0x1E91D, 0x1E91D, mapped, "\U0001e93f"},
+	{0x1E91E, 0x1E91E, mapped, "\U0001e940"},
+	{0x1E91F, 0x1E91F, mapped, "\U0001e941"},
+	{0x1E920, 0x1E920, mapped, "\U0001e942"},
+	{0x1E921, 0x1E921, mapped, "\U0001e943"},
+	{0x1E922, 0x1E94B, valid, ""},
+	{0x1E94C, 0x1E94F, disallowed, ""},
+	{0x1E950, 0x1E959, valid, ""},
+	{0x1E95A, 0x1E95D, disallowed, ""},
+	{0x1E95E, 0x1E95F, valid, ""},
+	{0x1E960, 0x1EC70, disallowed, ""},
+	{0x1EC71, 0x1ECB4, valid, ""},
+	{0x1ECB5, 0x1ED00, disallowed, ""},
+	{0x1ED01, 0x1ED3D, valid, ""},
+	{0x1ED3E, 0x1EDFF, disallowed, ""},
+	{0x1EE00, 0x1EE00, mapped, "\u0627"},
+	{0x1EE01, 0x1EE01, mapped, "\u0628"},
+	{0x1EE02, 0x1EE02, mapped, "\u062c"},
+	{0x1EE03, 0x1EE03, mapped, "\u062f"},
+	{0x1EE04, 0x1EE04, disallowed, ""},
+	{0x1EE05, 0x1EE05, mapped, "\u0648"},
+	{0x1EE06, 0x1EE06, mapped, "\u0632"},
+	{0x1EE07, 0x1EE07, mapped, "\u062d"},
+	{0x1EE08, 0x1EE08, mapped, "\u0637"},
+	{0x1EE09, 0x1EE09, mapped, "\u064a"},
+	{0x1EE0A, 0x1EE0A, mapped, "\u0643"},
+	{0x1EE0B, 0x1EE0B, mapped, "\u0644"},
+	{0x1EE0C, 0x1EE0C, mapped, "\u0645"},
+	{0x1EE0D, 0x1EE0D, mapped, "\u0646"},
+	{0x1EE0E, 0x1EE0E, mapped, "\u0633"},
+	{0x1EE0F, 0x1EE0F, mapped, "\u0639"},
+	{0x1EE10, 0x1EE10, mapped, "\u0641"},
+	{0x1EE11, 0x1EE11, mapped, "\u0635"},
+	{0x1EE12, 0x1EE12, mapped, "\u0642"},
+	{0x1EE13, 0x1EE13, mapped, "\u0631"},
+	{0x1EE14, 0x1EE14, mapped, "\u0634"},
+	{0x1EE15, 0x1EE15, mapped, "\u062a"},
+	{0x1EE16, 0x1EE16, mapped, "\u062b"},
+	{0x1EE17, 0x1EE17, mapped, "\u062e"},
+	{0x1EE18, 0x1EE18, mapped, "\u0630"},
+	{0x1EE19, 0x1EE19, mapped, "\u0636"},
+	{0x1EE1A, 0x1EE1A, mapped, "\u0638"},
+	{0x1EE1B, 0x1EE1B, mapped, "\u063a"},
+	{0x1EE1C, 0x1EE1C, mapped, "\u066e"},
+	{0x1EE1D, 0x1EE1D, mapped, "\u06ba"},
+	{0x1EE1E, 0x1EE1E, mapped, "\u06a1"},
+	{0x1EE1F, 0x1EE1F, mapped, "\u066f"},
+	{0x1EE20, 0x1EE20, disallowed, ""},
+	{0x1EE21, 0x1EE21, mapped, "\u0628"},
+	{0x1EE22, 0x1EE22, mapped, "\u062c"},
+	{0x1EE23, 0x1EE23, disallowed, ""},
+	{0x1EE24, 0x1EE24, mapped, "\u0647"},
+	{0x1EE25, 0x1EE26, disallowed, ""},
+	{0x1EE27, 0x1EE27, mapped, "\u062d"},
+	{0x1EE28, 0x1EE28, disallowed, ""},
+	{0x1EE29, 0x1EE29, mapped, "\u064a"},
+	{0x1EE2A, 0x1EE2A, mapped, "\u0643"},
+	{0x1EE2B, 0x1EE2B, mapped, "\u0644"},
+	{0x1EE2C, 0x1EE2C, mapped, "\u0645"},
+	{0x1EE2D, 0x1EE2D, mapped, "\u0646"},
+	{0x1EE2E, 0x1EE2E, mapped, "\u0633"},
+	{0x1EE2F, 0x1EE2F, mapped, "\u0639"},
+	{0x1EE30, 0x1EE30, mapped, "\u0641"},
+	{0x1EE31, 0x1EE31, mapped, "\u0635"},
+	{0x1EE32, 0x1EE32, mapped, "\u0642"},
+	{0x1EE33, 0x1EE33, disallowed, ""},
+	{0x1EE34, 0x1EE34, mapped, "\u0634"},
+	{0x1EE35, 0x1EE35, mapped, "\u062a"},
+	{0x1EE36, 0x1EE36, mapped, "\u062b"},
+	{0x1EE37, 0x1EE37, mapped, "\u062e"},
+	{0x1EE38, 0x1EE38, disallowed, ""},
+	{0x1EE39, 0x1EE39, mapped, "\u0636"},
+	{0x1EE3A, 0x1EE3A, disallowed, ""},
+	{0x1EE3B, 0x1EE3B, mapped, "\u063a"},
+	{0x1EE3C, 0x1EE41, disallowed, ""},
+	{0x1EE42, 0x1EE42, mapped, "\u062c"},
+	{0x1EE43, 0x1EE46, disallowed, ""},
+	{0x1EE47, 0x1EE47, mapped, "\u062d"},
+	{0x1EE48, 0x1EE48, disallowed, ""},
+	{0x1EE49, 0x1EE49, mapped, "\u064a"},
+	{0x1EE4A, 0x1EE4A, disallowed, ""},
+	{0x1EE4B, 0x1EE4B, mapped, "\u0644"},
+	{0x1EE4C, 0x1EE4C, disallowed, ""},
+	{0x1EE4D, 0x1EE4D, mapped, "\u0646"},
+	{0x1EE4E, 0x1EE4E, mapped, "\u0633"},
+	{0x1EE4F, 0x1EE4F, mapped, "\u0639"},
+	{0x1EE50, 0x1EE50, disallowed, ""},
+	{0x1EE51, 0x1EE51, mapped, "\u0635"},
+	{0x1EE52, 0x1EE52, mapped, "\u0642"},
+	{0x1EE53, 0x1EE53, disallowed, ""},
+	{0x1EE54, 0x1EE54, mapped, "\u0634"},
+	{0x1EE55, 0x1EE56, disallowed, ""},
+	{0x1EE57, 0x1EE57, mapped, "\u062e"},
+	{0x1EE58, 0x1EE58, disallowed, ""},
+	{0x1EE59, 0x1EE59, mapped, "\u0636"},
+	{0x1EE5A, 0x1EE5A, disallowed, ""},
+	{0x1EE5B, 0x1EE5B, mapped, "\u063a"},
+	{0x1EE5C, 0x1EE5C, disallowed, ""},
+	{0x1EE5D, 0x1EE5D, mapped, "\u06ba"},
+	{0x1EE5E, 0x1EE5E, disallowed, ""},
+	{0x1EE5F, 0x1EE5F, mapped, "\u066f"},
+	{0x1EE60, 0x1EE60, disallowed, ""},
+	{0x1EE61, 0x1EE61, mapped, "\u0628"},
+	{0x1EE62, 0x1EE62, mapped, "\u062c"},
+	{0x1EE63, 0x1EE63, disallowed, ""},
+	{0x1EE64, 0x1EE64, mapped, "\u0647"},
+	{0x1EE65, 0x1EE66, disallowed, ""},
+	{0x1EE67, 0x1EE67, mapped, "\u062d"},
+	{0x1EE68, 0x1EE68, mapped, "\u0637"},
+	{0x1EE69, 0x1EE69, mapped, "\u064a"},
+	{0x1EE6A, 0x1EE6A, mapped, "\u0643"},
+	{0x1EE6B, 0x1EE6B, disallowed, ""},
+	{0x1EE6C, 0x1EE6C, mapped, "\u0645"},
+	{0x1EE6D, 0x1EE6D, mapped, "\u0646"},
+	{0x1EE6E, 0x1EE6E, mapped, "\u0633"},
+	{0x1EE6F, 0x1EE6F, mapped, "\u0639"},
+	{0x1EE70, 0x1EE70, mapped, "\u0641"},
+	{0x1EE71, 0x1EE71, mapped, "\u0635"},
+	{0x1EE72, 0x1EE72, mapped, "\u0642"},
+	{0x1EE73, 0x1EE73, disallowed, ""},
+	{0x1EE74, 0x1EE74, mapped, "\u0634"},
+	{0x1EE75, 0x1EE75, mapped, "\u062a"},
+	{0x1EE76, 0x1EE76, mapped, "\u062b"},
+	{0x1EE77, 0x1EE77, mapped, "\u062e"},
+	{0x1EE78, 0x1EE78, disallowed, ""},
+	{0x1EE79, 0x1EE79, mapped, "\u0636"},
+	{0x1EE7A, 0x1EE7A, mapped, "\u0638"},
+	{0x1EE7B, 0x1EE7B, mapped, "\u063a"},
+	{0x1EE7C, 0x1EE7C, mapped, "\u066e"},
+	{0x1EE7D, 0x1EE7D, disallowed, ""},
+	{0x1EE7E, 0x1EE7E, mapped, "\u06a1"},
+	{0x1EE7F, 0x1EE7F, disallowed, ""},
+	{0x1EE80, 0x1EE80, mapped, "\u0627"},
+	{0x1EE81, 0x1EE81, mapped, "\u0628"},
+	{0x1EE82, 0x1EE82, mapped, "\u062c"},
+	{0x1EE83, 0x1EE83, mapped, "\u062f"},
+	{0x1EE84, 0x1EE84, mapped, "\u0647"},
+	{0x1EE85, 0x1EE85, mapped, "\u0648"},
+	{0x1EE86, 0x1EE86, mapped, "\u0632"},
+	{0x1EE87, 0x1EE87, mapped, "\u062d"},
+	{0x1EE88, 0x1EE88, mapped, "\u0637"},
+	{0x1EE89, 0x1EE89, mapped, "\u064a"},
+	{0x1EE8A, 0x1EE8A, disallowed, ""},
+	{0x1EE8B, 0x1EE8B, mapped, "\u0644"},
+	{0x1EE8C, 0x1EE8C, mapped, "\u0645"},
+	{0x1EE8D, 0x1EE8D, mapped, "\u0646"},
+	{0x1EE8E, 0x1EE8E, mapped, "\u0633"},
+	{0x1EE8F, 0x1EE8F, mapped, "\u0639"},
+	{0x1EE90, 0x1EE90, mapped, "\u0641"},
+	{0x1EE91, 0x1EE91, mapped, "\u0635"},
+	{0x1EE92, 0x1EE92, mapped, "\u0642"},
+	{0x1EE93, 0x1EE93, mapped, "\u0631"},
+	{0x1EE94, 0x1EE94, mapped, "\u0634"},
+	{0x1EE95, 0x1EE95, mapped, "\u062a"},
+	{0x1EE96, 0x1EE96, mapped, "\u062b"},
+	{0x1EE97, 0x1EE97, mapped, "\u062e"},
+	{0x1EE98, 0x1EE98, mapped, "\u0630"},
+	{0x1EE99, 0x1EE99, mapped, "\u0636"},
+	{0x1EE9A, 0x1EE9A, mapped, "\u0638"},
+	{0x1EE9B, 0x1EE9B, mapped, "\u063a"},
+	{0x1EE9C, 0x1EEA0, disallowed, ""},
+	{0x1EEA1, 0x1EEA1, mapped, "\u0628"},
+	{0x1EEA2, 0x1EEA2, mapped, "\u062c"},
+	{0x1EEA3, 0x1EEA3, mapped, "\u062f"},
+	{0x1EEA4, 0x1EEA4, disallowed, ""},
+	{0x1EEA5, 0x1EEA5, mapped, "\u0648"},
+	{0x1EEA6, 0x1EEA6, mapped, "\u0632"},
+	{0x1EEA7, 0x1EEA7, mapped, "\u062d"},
+	{0x1EEA8, 0x1EEA8, mapped, "\u0637"},
+	{0x1EEA9, 0x1EEA9, mapped, "\u064a"},
+	{0x1EEAA, 0x1EEAA, disallowed, ""},
+	{0x1EEAB, 0x1EEAB, mapped, "\u0644"},
+	{0x1EEAC, 0x1EEAC, mapped, "\u0645"},
+	{0x1EEAD, 0x1EEAD, mapped, "\u0646"},
+	{0x1EEAE, 0x1EEAE, mapped, "\u0633"},
+	{0x1EEAF, 0x1EEAF, mapped, "\u0639"},
+	{0x1EEB0, 0x1EEB0, mapped, "\u0641"},
+	{0x1EEB1, 0x1EEB1, mapped, "\u0635"},
+	{0x1EEB2, 0x1EEB2, mapped, "\u0642"},
+	{0x1EEB3, 0x1EEB3, mapped, "\u0631"},
+	{0x1EEB4, 0x1EEB4, mapped, "\u0634"},
+	{0x1EEB5, 0x1EEB5, mapped, "\u062a"},
+	{0x1EEB6, 0x1EEB6, mapped, "\u062b"},
+	{0x1EEB7, 0x1EEB7, mapped, "\u062e"},
+	{0x1EEB8, 0x1EEB8, mapped, "\u0630"},
+	{0x1EEB9, 0x1EEB9, mapped, "\u0636"},
+	{0x1EEBA, 0x1EEBA, mapped, "\u0638"},
+	{0x1EEBB, 0x1EEBB, mapped, "\u063a"},
+	{0x1EEBC, 0x1EEEF, disallowed, ""},
+	{0x1EEF0, 0x1EEF1, valid, ""},
+	{0x1EEF2, 0x1EFFF, disallowed, ""},
+	{0x1F000, 0x1F02B, valid, ""},
+	{0x1F02C, 0x1F02F, disallowed, ""},
+	{0x1F030, 0x1F093, valid, ""},
+	{0x1F094, 0x1F09F, disallowed, ""},
+	{0x1F0A0, 0x1F0AE, valid, ""},
+	{0x1F0AF, 0x1F0B0, disallowed, ""},
+	{0x1F0B1, 0x1F0BF, valid, ""},
+	{0x1F0C0, 0x1F0C0, disallowed, ""},
+	{0x1F0C1, 0x1F0CF, valid, ""},
+	{0x1F0D0, 0x1F0D0, disallowed, ""},
+	{0x1F0D1, 0x1F0F5, valid, ""},
+	{0x1F0F6, 0x1F100, disallowed, ""},
+	{0x1F101, 0x1F101, disallowedSTD3Mapped, "0,"},
+	{0x1F102, 0x1F102, disallowedSTD3Mapped, "1,"},
+	{0x1F103, 0x1F103, disallowedSTD3Mapped, "2,"},
+	{0x1F104, 0x1F104, disallowedSTD3Mapped, "3,"},
+	{0x1F105, 0x1F105, disallowedSTD3Mapped, "4,"},
+	{0x1F106, 0x1F106, disallowedSTD3Mapped, "5,"},
+	{0x1F107, 0x1F107, disallowedSTD3Mapped, "6,"},
+	{0x1F108, 0x1F108, disallowedSTD3Mapped, "7,"},
+	{0x1F109, 0x1F109, disallowedSTD3Mapped, "8,"},
+	{0x1F10A, 0x1F10A, disallowedSTD3Mapped, "9,"},
+	{0x1F10B, 0x1F10F, valid, ""},
+	{0x1F110, 0x1F110, disallowedSTD3Mapped, "(a)"},
+	{0x1F111, 0x1F111, disallowedSTD3Mapped, "(b)"},
+	{0x1F112, 0x1F112, disallowedSTD3Mapped, "(c)"},
+	{0x1F113, 0x1F113, disallowedSTD3Mapped, "(d)"},
+	{0x1F114, 0x1F114, disallowedSTD3Mapped, "(e)"},
+	{0x1F115, 0x1F115, disallowedSTD3Mapped, "(f)"},
+	{0x1F116, 0x1F116, disallowedSTD3Mapped, "(g)"},
+	{0x1F117, 0x1F117, disallowedSTD3Mapped, "(h)"},
+	{0x1F118, 0x1F118, disallowedSTD3Mapped, "(i)"},
+	{0x1F119, 0x1F119, disallowedSTD3Mapped, "(j)"},
+	{0x1F11A, 0x1F11A, disallowedSTD3Mapped, "(k)"},
+	{0x1F11B, 0x1F11B, disallowedSTD3Mapped, "(l)"},
+	{0x1F11C, 0x1F11C, disallowedSTD3Mapped, "(m)"},
+	{0x1F11D, 0x1F11D, disallowedSTD3Mapped, "(n)"},
+	{0x1F11E, 0x1F11E, disallowedSTD3Mapped, "(o)"},
+	{0x1F11F, 0x1F11F, disallowedSTD3Mapped, "(p)"},
+	{0x1F120, 0x1F120, disallowedSTD3Mapped, "(q)"},
+	{0x1F121, 0x1F121, disallowedSTD3Mapped, "(r)"},
+	{0x1F122, 0x1F122, disallowedSTD3Mapped, "(s)"},
+	{0x1F123, 0x1F123, disallowedSTD3Mapped, "(t)"},
+	{0x1F124, 0x1F124, disallowedSTD3Mapped, "(u)"},
+	{0x1F125, 0x1F125, disallowedSTD3Mapped, "(v)"},
+	{0x1F126, 0x1F126, disallowedSTD3Mapped, "(w)"},
+	{0x1F127, 0x1F127, disallowedSTD3Mapped, "(x)"},
+	{0x1F128, 0x1F128, disallowedSTD3Mapped, "(y)"},
+	{0x1F129, 0x1F129, disallowedSTD3Mapped, "(z)"},
+	{0x1F12A, 0x1F12A, mapped, "\u3014s\u3015"},
+	{0x1F12B, 0x1F12B, mapped, "c"},
+	{0x1F12C, 0x1F12C, mapped, "r"},
+	{0x1F12D, 0x1F12D, mapped, "cd"},
+	{0x1F12E, 0x1F12E, mapped, "wz"},
+	{0x1F12F, 0x1F12F, valid, ""},
+	{0x1F130, 0x1F130, mapped, "a"},
+	{0x1F131, 0x1F131, mapped, "b"},
+	{0x1F132, 0x1F132, mapped, "c"},
+	{0x1F133, 0x1F133, mapped, "d"},
+	{0x1F134, 0x1F134, mapped, "e"},
+	{0x1F135, 0x1F135, mapped, "f"},
+	{0x1F136, 0x1F136, mapped, "g"},
+	{0x1F137, 0x1F137, mapped, "h"},
+	{0x1F138, 0x1F138, mapped, "i"},
+	{0x1F139, 0x1F139, mapped, "j"},
+	{0x1F13A, 0x1F13A, mapped, "k"},
+	{0x1F13B, 0x1F13B, mapped, "l"},
+	{0x1F13C, 0x1F13C, mapped, "m"},
+	{0x1F13D, 0x1F13D, mapped, "n"},
+	{0x1F13E, 0x1F13E, mapped, "o"},
+	{0x1F13F, 0x1F13F, mapped, "p"},
+	{0x1F140, 0x1F140, mapped, "q"},
+	{0x1F141, 0x1F141, mapped, "r"},
+	{0x1F142, 0x1F142, mapped, "s"},
+	{0x1F143, 0x1F143, mapped, "t"},
+	{0x1F144, 0x1F144, mapped, "u"},
+	{0x1F145, 0x1F145, mapped, "v"},
+	{0x1F146, 0x1F146, mapped, "w"},
+	{0x1F147, 0x1F147, mapped, "x"},
+	{0x1F148, 0x1F148, mapped, "y"},
+	{0x1F149, 0x1F149, mapped, "z"},
+	{0x1F14A, 0x1F14A, mapped, "hv"},
+	{0x1F14B, 0x1F14B, mapped, "mv"},
+	{0x1F14C, 0x1F14C, mapped, "sd"},
+	{0x1F14D, 0x1F14D, mapped, "ss"},
+	{0x1F14E, 0x1F14E, mapped, "ppv"},
+	{0x1F14F, 0x1F14F, mapped, "wc"},
+	{0x1F150, 0x1F169, valid, ""},
+	{0x1F16A, 0x1F16A, mapped, "mc"},
+	{0x1F16B, 0x1F16B, mapped, "md"},
+	{0x1F16C, 0x1F16C, mapped, "mr"},
+	{0x1F16D, 0x1F18F, valid, ""},
+	{0x1F190, 0x1F190, mapped, "dj"},
+	{0x1F191, 0x1F1AD, valid, ""},
+	{0x1F1AE, 0x1F1E5, disallowed, ""},
+	{0x1F1E6, 0x1F1FF, valid, ""},
+	{0x1F200, 0x1F200, mapped, "\u307b\u304b"},
+	{0x1F201, 0x1F201, mapped, "\u30b3\u30b3"},
+	{0x1F202, 0x1F202, mapped, "\u30b5"},
+	{0x1F203, 0x1F20F, disallowed, ""},
+	{0x1F210, 0x1F210, mapped, "\u624b"},
+	{0x1F211, 0x1F211, mapped, "\u5b57"},
+	{0x1F212, 0x1F212, mapped, "\u53cc"},
+	{0x1F213, 0x1F213, mapped, "\u30c7"},
+	{0x1F214, 0x1F214, mapped, "\u4e8c"},
+	{0x1F215, 0x1F215, mapped, "\u591a"},
+	{0x1F216, 0x1F216, mapped, "\u89e3"},
+	{0x1F217, 0x1F217, mapped, "\u5929"},
+	{0x1F218, 0x1F218, mapped, "\u4ea4"},
+	{0x1F219, 0x1F219, mapped, "\u6620"},
+	{0x1F21A, 0x1F21A, mapped, "\u7121"},
+	{0x1F21B, 0x1F21B, mapped, "\u6599"},
+	{0x1F21C, 0x1F21C, mapped, "\u524d"},
+	{0x1F21D, 0x1F21D, mapped, "\u5f8c"},
+	{0x1F21E, 0x1F21E, mapped, "\u518d"},
+	{0x1F21F, 0x1F21F, mapped, "\u65b0"},
+	{0x1F220, 0x1F220, mapped, "\u521d"},
+	{0x1F221, 0x1F221, mapped, "\u7d42"},
+	{0x1F222, 0x1F222, mapped, "\u751f"},
+	{0x1F223, 0x1F223, mapped, "\u8ca9"},
+	{0x1F224, 0x1F224, mapped, "\u58f0"},
+	{0x1F225, 0x1F225, mapped, "\u5439"},
+	{0x1F226, 0x1F226, mapped, "\u6f14"},
+	{0x1F227, 0x1F227, mapped, "\u6295"},
+	{0x1F228, 0x1F228, mapped, "\u6355"},
+	{0x1F229, 0x1F229, mapped, "\u4e00"},
+	{0x1F22A, 0x1F22A, mapped, "\u4e09"},
+	{0x1F22B, 0x1F22B, mapped, "\u904a"},
+	{0x1F22C, 0x1F22C, mapped, "\u5de6"},
+	{0x1F22D, 0x1F22D, mapped, "\u4e2d"},
+	{0x1F22E, 0x1F22E, mapped, "\u53f3"},
+	{0x1F22F, 0x1F22F, mapped, "\u6307"},
+	{0x1F230, 0x1F230, mapped, "\u8d70"},
+	{0x1F231, 0x1F231, mapped, "\u6253"},
+	{0x1F232, 0x1F232, mapped, "\u7981"},
+	{0x1F233, 0x1F233, mapped, "\u7a7a"},
+	{0x1F234, 0x1F234, mapped, "\u5408"},
+	{0x1F235, 0x1F235, mapped, "\u6e80"},
+	{0x1F236, 0x1F236, mapped, "\u6709"},
+	{0x1F237, 0x1F237, mapped, "\u6708"},
+	{0x1F238, 0x1F238, mapped, "\u7533"},
+	{0x1F239, 0x1F239, mapped, "\u5272"},
+	{0x1F23A, 0x1F23A, mapped, "\u55b6"},
+	{0x1F23B, 0x1F23B, mapped, "\u914d"},
+	{0x1F23C, 0x1F23F, disallowed, ""},
+	{0x1F240, 0x1F240, mapped, "\u3014\u672c\u3015"},
+	{0x1F241, 0x1F241, mapped, "\u3014\u4e09\u3015"},
+	{0x1F242, 0x1F242, mapped, "\u3014\u4e8c\u3015"},
+	{0x1F243, 0x1F243, mapped, "\u3014\u5b89\u3015"},
+	{0x1F244, 0x1F244, mapped, "\u3014\u70b9\u3015"},
+	{0x1F245, 0x1F245, mapped, "\u3014\u6253\u3015"},
+	{0x1F246, 0x1F246, mapped, "\u3014\u76d7\u3015"},
+	{0x1F247, 0x1F247, mapped, "\u3014\u52dd\u3015"},
+	{0x1F248, 0x1F248, mapped, "\u3014\u6557\u3015"},
+	{0x1F249, 0x1F24F, disallowed, ""},
+	{0x1F250, 0x1F250, mapped, "\u5f97"},
+	{0x1F251, 0x1F251, mapped, "\u53ef"},
+	{0x1F252, 0x1F25F, disallowed, ""},
+	{0x1F260, 0x1F265, valid, ""},
+	{0x1F266, 0x1F2FF, disallowed, ""},
+	{0x1F300, 0x1F6D8, valid, ""},
+	{0x1F6D9, 0x1F6DB, disallowed, ""},
+	{0x1F6DC, 0x1F6EC, valid, ""},
+	{0x1F6ED, 0x1F6EF, disallowed, ""},
+	{0x1F6F0, 0x1F6FC, valid, ""},
+	{0x1F6FD, 0x1F6FF, disallowed, ""},
+	{0x1F700, 0x1F7D9, valid, ""},
+	{0x1F7DA, 0x1F7DF, disallowed, ""},
+	{0x1F7E0, 0x1F7EB, valid, ""},
+	{0x1F7EC, 0x1F7EF, disallowed, ""},
+	{0x1F7F0, 0x1F7F0, valid, ""},
+	{0x1F7F1, 0x1F7FF, disallowed, ""},
+	{0x1F800, 0x1F80B, valid, ""},
+	{0x1F80C, 0x1F80F, disallowed, ""},
+	{0x1F810, 0x1F847, valid, ""},
+	{0x1F848, 0x1F84F, disallowed, ""},
+	{0x1F850, 0x1F859, valid, ""},
+	{0x1F85A, 0x1F85F, disallowed, ""},
+	{0x1F860, 0x1F887, valid, ""},
+	{0x1F888, 0x1F88F, disallowed, ""},
+	{0x1F890, 0x1F8AD, valid, ""},
+	{0x1F8AE, 0x1F8AF, disallowed, ""},
+	{0x1F8B0, 0x1F8BB, valid, ""},
+	{0x1F8BC, 0x1F8BF, disallowed, ""},
+	{0x1F8C0, 0x1F8C1, valid, ""},
+	{0x1F8C2, 0x1F8CF, disallowed, ""},
+	{0x1F8D0, 0x1F8D8, valid, ""},
+	{0x1F8D9, 0x1F8FF, disallowed, ""},
+	{0x1F900, 0x1FA57, valid, ""},
+	{0x1FA58, 0x1FA5F, disallowed, ""},
+	{0x1FA60, 0x1FA6D, valid, ""},
+	{0x1FA6E, 0x1FA6F, disallowed, ""},
+	{0x1FA70, 0x1FA7C, valid, ""},
+	{0x1FA7D, 0x1FA7F, disallowed, ""},
+	{0x1FA80, 0x1FA8A, valid, ""},
+	{0x1FA8B, 0x1FA8D, disallowed, ""},
+	{0x1FA8E, 0x1FAC6, valid, ""},
+	{0x1FAC7, 0x1FAC7, disallowed, ""},
+	{0x1FAC8, 0x1FAC8, valid, ""},
+	{0x1FAC9, 0x1FACC, disallowed, ""},
+	{0x1FACD, 0x1FADC, valid, ""},
+	{0x1FADD, 0x1FADE, disallowed, ""},
+	{0x1FADF, 0x1FAEA, valid, ""},
+	{0x1FAEB, 0x1FAEE, disallowed, ""},
+	{0x1FAEF, 0x1FAF8, valid, ""},
+	{0x1FAF9, 0x1FAFF, disallowed, ""},
+	{0x1FB00, 0x1FB92, valid, ""},
+	{0x1FB93, 0x1FB93, disallowed, ""},
+	{0x1FB94, 0x1FBEF, valid, ""},
+	{0x1FBF0, 0x1FBF0, mapped, "0"},
+	{0x1FBF1, 0x1FBF1, mapped, "1"},
+	{0x1FBF2, 0x1FBF2, mapped, "2"},
+	{0x1FBF3, 0x1FBF3, mapped, "3"},
+	{0x1FBF4, 0x1FBF4, mapped, "4"},
+	{0x1FBF5, 0x1FBF5, mapped, "5"},
+	{0x1FBF6, 0x1FBF6, mapped, "6"},
+	{0x1FBF7, 0x1FBF7, mapped, "7"},
+	{0x1FBF8, 0x1FBF8, mapped, "8"},
+	{0x1FBF9, 0x1FBF9, mapped, "9"},
+	{0x1FBFA, 0x1FBFA, valid, ""},
+	{0x1FBFB, 0x1FFFF, disallowed, ""},
+	{0x20000, 0x2A6DF, valid, ""},
+	{0x2A6E0, 0x2A6FF, disallowed, ""},
+	{0x2A700, 0x2B81D, valid, ""},
+	{0x2B81E, 0x2B81F, disallowed, ""},
+	{0x2B820, 0x2CEAD, valid, ""},
+	{0x2CEAE, 0x2CEAF, disallowed, ""},
+	{0x2CEB0, 0x2EBE0, valid, ""},
+	{0x2EBE1, 0x2EBEF, disallowed, ""},
+	{0x2EBF0, 0x2EE5D, valid, ""},
+	{0x2EE5E, 0x2F7FF, disallowed, ""},
+	{0x2F800, 0x2F800, mapped, "\u4e3d"},
+	{0x2F801, 0x2F801, mapped, "\u4e38"},
+	{0x2F802, 0x2F802, mapped, "\u4e41"},
+	{0x2F803, 0x2F803, mapped, "\U00020122"},
+	{0x2F804, 0x2F804, mapped, "\u4f60"},
+	{0x2F805, 0x2F805, mapped, "\u4fae"},
+	{0x2F806, 0x2F806, mapped, "\u4fbb"},
+	{0x2F807, 0x2F807, mapped, "\u5002"},
+	{0x2F808, 0x2F808, mapped, "\u507a"},
+	{0x2F809, 0x2F809, mapped, "\u5099"},
+	{0x2F80A, 0x2F80A, mapped, "\u50e7"},
+	{0x2F80B, 0x2F80B, mapped, "\u50cf"},
+	{0x2F80C, 0x2F80C, mapped, "\u349e"},
+	{0x2F80D, 0x2F80D, mapped, "\U0002063a"},
+	{0x2F80E, 0x2F80E, mapped, "\u514d"},
+	{0x2F80F, 0x2F80F, mapped, "\u5154"},
+	{0x2F810, 0x2F810, mapped, "\u5164"},
+	{0x2F811, 0x2F811, mapped, "\u5177"},
+	{0x2F812, 0x2F812, mapped, "\U0002051c"},
+	{0x2F813, 0x2F813, mapped, "\u34b9"},
+	{0x2F814, 0x2F814, mapped, "\u5167"},
+	{0x2F815, 0x2F815, mapped, "\u518d"},
+	{0x2F816, 0x2F816, mapped, "\U0002054b"},
+	{0x2F817, 0x2F817, mapped, "\u5197"},
+	{0x2F818, 0x2F818, mapped, "\u51a4"},
+	{0x2F819, 0x2F819, mapped, "\u4ecc"},
+	{0x2F81A, 0x2F81A, mapped, "\u51ac"},
+	{0x2F81B, 0x2F81B, mapped, "\u51b5"},
+	{0x2F81C, 0x2F81C, mapped, "\U000291df"},
+	{0x2F81D, 0x2F81D, mapped, "\u51f5"},
+	{0x2F81E, 0x2F81E, mapped, "\u5203"},
+	{0x2F81F, 0x2F81F, mapped, "\u34df"},
+	{0x2F820, 0x2F820, mapped, "\u523b"},
+	{0x2F821, 0x2F821, mapped, "\u5246"},
+	{0x2F822, 0x2F822, mapped, "\u5272"},
+	{0x2F823, 0x2F823, mapped, "\u5277"},
+	{0x2F824, 0x2F824, mapped, "\u3515"},
+	{0x2F825, 0x2F825, mapped, "\u52c7"},
+	{0x2F826, 0x2F826, mapped, "\u52c9"},
+	{0x2F827, 0x2F827, mapped, "\u52e4"},
+	{0x2F828, 0x2F828, mapped, "\u52fa"},
+	{0x2F829, 0x2F829, mapped, "\u5305"},
+	{0x2F82A, 0x2F82A, mapped, "\u5306"},
+	{0x2F82B, 0x2F82B, mapped, "\u5317"},
+	{0x2F82C, 0x2F82C, mapped, "\u5349"},
+	{0x2F82D, 0x2F82D, mapped, "\u5351"},
+	{0x2F82E, 0x2F82E, mapped, "\u535a"},
+	{0x2F82F, 0x2F82F, mapped, "\u5373"},
+	{0x2F830, 0x2F830, mapped, "\u537d"},
+	{0x2F831, 0x2F831, mapped, "\u537f"},
+	{0x2F832, 0x2F832, mapped, "\u537f"},
+	{0x2F833, 0x2F833, mapped, "\u537f"},
+	{0x2F834, 0x2F834, mapped, "\U00020a2c"},
+	{0x2F835, 0x2F835, mapped, "\u7070"},
+	{0x2F836, 0x2F836, mapped, "\u53ca"},
+	{0x2F837, 0x2F837, mapped, "\u53df"},
+	{0x2F838, 0x2F838, mapped, "\U00020b63"},
+	{0x2F839, 0x2F839, mapped, "\u53eb"},
+	{0x2F83A, 0x2F83A, mapped, "\u53f1"},
+	{0x2F83B, 0x2F83B, mapped, "\u5406"},
+	{0x2F83C, 0x2F83C, mapped, "\u549e"},
+	{0x2F83D, 0x2F83D, mapped, "\u5438"},
+	{0x2F83E, 0x2F83E, mapped, "\u5448"},
+	{0x2F83F, 0x2F83F, mapped, "\u5468"},
+	{0x2F840, 0x2F840, mapped, "\u54a2"},
+	{0x2F841, 0x2F841, mapped, "\u54f6"},
+	{0x2F842, 0x2F842, mapped, "\u5510"},
+	{0x2F843, 0x2F843, mapped, "\u5553"},
+	{0x2F844, 0x2F844, mapped, "\u5563"},
+	{0x2F845, 0x2F845, mapped, "\u5584"},
+	{0x2F846, 0x2F846, mapped, "\u5584"},
+	{0x2F847, 0x2F847, mapped, "\u5599"},
+	{0x2F848, 0x2F848, mapped, "\u55ab"},
+	{0x2F849, 0x2F849, mapped, "\u55b3"},
+	{0x2F84A, 0x2F84A, mapped, "\u55c2"},
+	{0x2F84B, 0x2F84B, mapped, "\u5716"},
+	{0x2F84C, 0x2F84C, mapped, "\u5606"},
+	{0x2F84D, 0x2F84D, mapped, "\u5717"},
+	{0x2F84E, 0x2F84E, mapped, "\u5651"},
+	{0x2F84F, 0x2F84F, mapped, "\u5674"},
+	{0x2F850, 0x2F850, mapped, "\u5207"},
+	{0x2F851, 0x2F851, mapped, "\u58ee"},
+	{0x2F852, 0x2F852, mapped, "\u57ce"},
+	{0x2F853, 0x2F853, mapped, "\u57f4"},
+	{0x2F854, 0x2F854, mapped, "\u580d"},
+	{0x2F855, 0x2F855, mapped, "\u578b"},
+	{0x2F856, 0x2F856, mapped, "\u5832"},
+	{0x2F857, 0x2F857, mapped, "\u5831"},
+	{0x2F858, 0x2F858, mapped, "\u58ac"},
+	{0x2F859, 0x2F859, mapped, "\U000214e4"},
+	{0x2F85A, 0x2F85A, mapped, "\u58f2"},
+	{0x2F85B, 0x2F85B, mapped, "\u58f7"},
+	{0x2F85C, 0x2F85C, mapped, "\u5906"},
+	{0x2F85D, 0x2F85D, mapped, "\u591a"},
+	{0x2F85E, 0x2F85E, mapped, "\u5922"},
+	{0x2F85F, 0x2F85F, mapped, "\u5962"},
+	{0x2F860, 0x2F860, mapped, "\U000216a8"},
+	{0x2F861, 0x2F861, mapped, "\U000216ea"},
+	{0x2F862, 0x2F862, mapped, "\u59ec"},
+	{0x2F863, 0x2F863, mapped, "\u5a1b"},
+	{0x2F864, 0x2F864, mapped, "\u5a27"},
+	{0x2F865, 0x2F865, mapped, "\u59d8"},
+	{0x2F866, 0x2F866, mapped, "\u5a66"},
+	{0x2F867, 0x2F867, mapped, "\u36ee"},
+	{0x2F868, 0x2F868, mapped, "\u36fc"},
+	{0x2F869, 0x2F869, mapped, "\u5b08"},
+	{0x2F86A, 0x2F86A, mapped, "\u5b3e"},
+	{0x2F86B, 0x2F86B, mapped, "\u5b3e"},
+	{0x2F86C, 0x2F86C, mapped, "\U000219c8"},
+	{0x2F86D, 0x2F86D, mapped, "\u5bc3"},
+	{0x2F86E, 0x2F86E, mapped, "\u5bd8"},
+	{0x2F86F, 0x2F86F, mapped, "\u5be7"},
+	{0x2F870, 0x2F870, mapped, "\u5bf3"},
+	{0x2F871, 0x2F871, mapped, "\U00021b18"},
+	{0x2F872, 0x2F872, mapped, "\u5bff"},
+	{0x2F873, 0x2F873, mapped, "\u5c06"},
+	{0x2F874, 0x2F874, mapped, "\u5f53"},
+	{0x2F875, 0x2F875, mapped, "\u5c22"},
+	{0x2F876, 0x2F876, mapped, "\u3781"},
+	{0x2F877, 0x2F877, mapped, "\u5c60"},
+	{0x2F878, 0x2F878, mapped, "\u5c6e"},
+	{0x2F879, 0x2F879, mapped, "\u5cc0"},
+	{0x2F87A, 0x2F87A, mapped, "\u5c8d"},
+	{0x2F87B, 0x2F87B, mapped, "\U00021de4"},
+	{0x2F87C, 0x2F87C, mapped, "\u5d43"},
+	{0x2F87D, 0x2F87D, mapped, "\U00021de6"},
+	{0x2F87E, 0x2F87E, mapped, "\u5d6e"},
+	{0x2F87F, 0x2F87F, mapped, "\u5d6b"},
+	{0x2F880, 0x2F880, mapped, "\u5d7c"},
+	{0x2F881, 0x2F881, mapped, "\u5de1"},
+	{0x2F882, 0x2F882, mapped, "\u5de2"},
+	{0x2F883, 0x2F883, mapped, "\u382f"},
+	{0x2F884, 0x2F884, mapped, "\u5dfd"},
+	{0x2F885, 0x2F885, mapped, "\u5e28"},
+	{0x2F886, 0x2F886, mapped, "\u5e3d"},
+	{0x2F887, 0x2F887, mapped, "\u5e69"},
+	{0x2F888, 0x2F888, mapped, "\u3862"},
+	{0x2F889, 0x2F889, mapped, "\U00022183"},
+	{0x2F88A, 0x2F88A, mapped, "\u387c"},
+	{0x2F88B, 0x2F88B, mapped, "\u5eb0"},
+	{0x2F88C, 0x2F88C, mapped, "\u5eb3"},
+	{0x2F88D, 0x2F88D, mapped, "\u5eb6"},
+	{0x2F88E, 0x2F88E, mapped, "\u5eca"},
+	{0x2F88F, 0x2F88F, mapped, "\U0002a392"},
+	{0x2F890, 0x2F890, mapped, "\u5efe"},
+	{0x2F891, 0x2F891, mapped, "\U00022331"},
+	{0x2F892, 0x2F892, mapped, "\U00022331"},
+	{0x2F893, 0x2F893, mapped, "\u8201"},
+	{0x2F894, 0x2F894, mapped, "\u5f22"},
+	{0x2F895, 0x2F895, mapped, "\u5f22"},
+	{0x2F896, 0x2F896, mapped, "\u38c7"},
+	{0x2F897, 0x2F897, mapped, "\U000232b8"},
+	{0x2F898, 0x2F898, mapped, "\U000261da"},
+	{0x2F899, 0x2F899, mapped, "\u5f62"},
+	{0x2F89A, 0x2F89A, mapped, "\u5f6b"},
+	{0x2F89B, 0x2F89B, mapped, "\u38e3"},
+	{0x2F89C, 0x2F89C, mapped, "\u5f9a"},
+	{0x2F89D, 0x2F89D, mapped, "\u5fcd"},
+	{0x2F89E, 0x2F89E, mapped, "\u5fd7"},
+	{0x2F89F, 0x2F89F, mapped, "\u5ff9"},
+	{0x2F8A0, 0x2F8A0, mapped, "\u6081"},
+	{0x2F8A1, 0x2F8A1, mapped, "\u393a"},
+	{0x2F8A2, 0x2F8A2, mapped, "\u391c"},
+	{0x2F8A3, 0x2F8A3, mapped, "\u6094"},
+	{0x2F8A4, 0x2F8A4, mapped, "\U000226d4"},
+	{0x2F8A5, 0x2F8A5, mapped, "\u60c7"},
+	{0x2F8A6, 0x2F8A6, mapped, "\u6148"},
+	{0x2F8A7, 0x2F8A7, mapped, "\u614c"},
+	{0x2F8A8, 0x2F8A8, mapped, "\u614e"},
+	{0x2F8A9, 0x2F8A9, mapped, "\u614c"},
+	{0x2F8AA, 0x2F8AA, mapped, "\u617a"},
+	{0x2F8AB, 0x2F8AB, mapped, "\u618e"},
+	{0x2F8AC, 0x2F8AC, mapped, "\u61b2"},
+	{0x2F8AD, 0x2F8AD, mapped, "\u61a4"},
+	{0x2F8AE, 0x2F8AE, mapped, "\u61af"},
+	{0x2F8AF, 0x2F8AF, mapped, "\u61de"},
+	{0x2F8B0, 0x2F8B0, mapped, "\u61f2"},
+	{0x2F8B1, 0x2F8B1, mapped, "\u61f6"},
+	{0x2F8B2, 0x2F8B2, mapped, "\u6210"},
+	{0x2F8B3, 0x2F8B3, mapped, "\u621b"},
+	{0x2F8B4, 0x2F8B4, mapped, "\u625d"},
+	{0x2F8B5, 0x2F8B5, mapped, "\u62b1"},
+	{0x2F8B6, 0x2F8B6, mapped, "\u62d4"},
+	{0x2F8B7, 0x2F8B7, mapped, "\u6350"},
+	{0x2F8B8, 0x2F8B8, mapped, "\U00022b0c"},
+	{0x2F8B9, 0x2F8B9, mapped, "\u633d"},
+	{0x2F8BA, 0x2F8BA, mapped, "\u62fc"},
+	{0x2F8BB, 0x2F8BB, mapped, "\u6368"},
+	{0x2F8BC, 0x2F8BC, mapped, "\u6383"},
+	{0x2F8BD, 0x2F8BD, mapped, "\u63e4"},
+	{0x2F8BE, 0x2F8BE, mapped, "\U00022bf1"},
+	{0x2F8BF, 0x2F8BF, mapped, "\u6422"},
+	{0x2F8C0, 0x2F8C0, mapped, "\u63c5"},
+	{0x2F8C1, 0x2F8C1, mapped, "\u63a9"},
+	{0x2F8C2, 0x2F8C2, mapped, "\u3a2e"},
+	{0x2F8C3, 0x2F8C3, mapped, "\u6469"},
+	{0x2F8C4, 0x2F8C4, mapped, "\u647e"},
+	{0x2F8C5, 0x2F8C5, mapped, "\u649d"},
+	{0x2F8C6, 0x2F8C6, mapped, "\u6477"},
+	{0x2F8C7, 0x2F8C7, mapped, "\u3a6c"},
+	{0x2F8C8, 0x2F8C8, mapped, "\u654f"},
+	{0x2F8C9, 0x2F8C9, mapped, "\u656c"},
+	{0x2F8CA, 0x2F8CA, mapped, "\U0002300a"},
+	{0x2F8CB, 0x2F8CB, mapped, "\u65e3"},
+	{0x2F8CC, 0x2F8CC, mapped, "\u66f8"},
+	{0x2F8CD, 0x2F8CD, mapped, "\u6649"},
+	{0x2F8CE, 0x2F8CE, mapped, "\u3b19"},
+	{0x2F8CF, 0x2F8CF, mapped, "\u6691"},
+	{0x2F8D0, 0x2F8D0, mapped, "\u3b08"},
+	{0x2F8D1, 0x2F8D1, mapped, "\u3ae4"},
+	{0x2F8D2, 0x2F8D2, mapped, "\u5192"},
+	{0x2F8D3, 0x2F8D3, mapped, "\u5195"},
+	{0x2F8D4, 0x2F8D4, mapped, "\u6700"},
+	{0x2F8D5, 0x2F8D5, mapped, "\u669c"},
+	{0x2F8D6, 0x2F8D6, mapped, "\u80ad"},
+	{0x2F8D7, 0x2F8D7, mapped, "\u43d9"},
+	{0x2F8D8, 0x2F8D8, mapped, "\u6717"},
+	{0x2F8D9, 0x2F8D9, mapped, "\u671b"},
+	{0x2F8DA, 0x2F8DA, mapped, "\u6721"},
+	{0x2F8DB, 0x2F8DB, mapped, "\u675e"},
+	{0x2F8DC, 0x2F8DC, mapped, "\u6753"},
+	{0x2F8DD, 0x2F8DD, mapped, "\U000233c3"},
+	{0x2F8DE, 0x2F8DE, mapped, "\u3b49"},
+	{0x2F8DF, 0x2F8DF, mapped, "\u67fa"},
+	{0x2F8E0, 0x2F8E0, mapped, "\u6785"},
+	{0x2F8E1, 0x2F8E1, mapped, "\u6852"},
+	{0x2F8E2, 0x2F8E2, mapped, "\u6885"},
+	{0x2F8E3, 0x2F8E3, mapped, "\U0002346d"},
+	{0x2F8E4, 0x2F8E4, mapped, "\u688e"},
+	{0x2F8E5, 0x2F8E5, mapped, "\u681f"},
+	{0x2F8E6, 0x2F8E6, mapped, "\u6914"},
+	{0x2F8E7, 0x2F8E7, mapped, "\u3b9d"},
+	{0x2F8E8, 0x2F8E8, mapped, "\u6942"},
+	{0x2F8E9, 0x2F8E9, mapped, "\u69a3"},
+	{0x2F8EA, 0x2F8EA, mapped, "\u69ea"},
+	{0x2F8EB, 0x2F8EB, mapped, "\u6aa8"},
+	{0x2F8EC, 0x2F8EC, mapped, "\U000236a3"},
+	{0x2F8ED, 0x2F8ED, mapped, "\u6adb"},
+	{0x2F8EE, 0x2F8EE, mapped, "\u3c18"},
+	{0x2F8EF, 0x2F8EF, mapped, "\u6b21"},
+	{0x2F8F0, 0x2F8F0, mapped, "\U000238a7"},
+	{0x2F8F1, 0x2F8F1, mapped, "\u6b54"},
+	{0x2F8F2, 0x2F8F2, mapped, "\u3c4e"},
+	{0x2F8F3, 0x2F8F3, mapped, "\u6b72"},
+	{0x2F8F4, 0x2F8F4, mapped, "\u6b9f"},
+	{0x2F8F5, 0x2F8F5, mapped, "\u6bba"},
+	{0x2F8F6, 0x2F8F6, mapped, "\u6bbb"},
+	{0x2F8F7, 0x2F8F7, mapped, "\U00023a8d"},
+	{0x2F8F8, 0x2F8F8, mapped, "\U00021d0b"},
+	{0x2F8F9, 0x2F8F9, mapped, "\U00023afa"},
+	{0x2F8FA, 0x2F8FA, mapped, "\u6c4e"},
+	{0x2F8FB, 0x2F8FB, mapped, "\U00023cbc"},
+	{0x2F8FC, 0x2F8FC, mapped, "\u6cbf"},
+	{0x2F8FD, 0x2F8FD, mapped, "\u6ccd"},
+	{0x2F8FE, 0x2F8FE, mapped, "\u6c67"},
+	{0x2F8FF, 0x2F8FF, mapped, "\u6d16"},
+	{0x2F900, 0x2F900, mapped, "\u6d3e"},
+	{0x2F901, 0x2F901, mapped, "\u6d77"},
+	{0x2F902, 0x2F902, mapped, "\u6d41"},
+	{0x2F903, 0x2F903, mapped, "\u6d69"},
+	{0x2F904, 0x2F904, mapped, "\u6d78"},
+	{0x2F905, 0x2F905, mapped, "\u6d85"},
+	{0x2F906, 0x2F906, mapped, "\U00023d1e"},
+	{0x2F907, 0x2F907, mapped, "\u6d34"},
+	{0x2F908, 0x2F908, mapped, "\u6e2f"},
+	{0x2F909, 0x2F909, mapped, "\u6e6e"},
+	{0x2F90A, 0x2F90A, mapped, "\u3d33"},
+	{0x2F90B, 0x2F90B, mapped, "\u6ecb"},
+	{0x2F90C, 0x2F90C, mapped, "\u6ec7"},
+	{0x2F90D, 0x2F90D, mapped, "\U00023ed1"},
+	{0x2F90E, 0x2F90E, mapped, "\u6df9"},
+	{0x2F90F, 0x2F90F, mapped, "\u6f6e"},
+	{0x2F910, 0x2F910, mapped, "\U00023f5e"},
+	{0x2F911, 0x2F911, mapped, "\U00023f8e"},
+	{0x2F912, 0x2F912, mapped, "\u6fc6"},
+	{0x2F913, 0x2F913, mapped, "\u7039"},
+	{0x2F914, 0x2F914, mapped, "\u701e"},
+	{0x2F915, 0x2F915, mapped, "\u701b"},
+	{0x2F916, 0x2F916, mapped, "\u3d96"},
+	{0x2F917, 0x2F917, mapped, "\u704a"},
+	{0x2F918, 0x2F918, mapped, "\u707d"},
+	{0x2F919, 0x2F919, mapped, "\u7077"},
+	{0x2F91A, 0x2F91A, mapped, "\u70ad"},
+	{0x2F91B, 0x2F91B, mapped, "\U00020525"},
+	{0x2F91C, 0x2F91C, mapped, "\u7145"},
+	{0x2F91D, 0x2F91D, mapped, "\U00024263"},
+	{0x2F91E, 0x2F91E, mapped, "\u719c"},
+	{0x2F91F, 0x2F91F, mapped, "\U000243ab"},
+	{0x2F920, 0x2F920, mapped, "\u7228"},
+	{0x2F921, 0x2F921, mapped, "\u7235"},
+	{0x2F922, 0x2F922, mapped, "\u7250"},
+	{0x2F923, 0x2F923, mapped, "\U00024608"},
+	{0x2F924, 0x2F924, mapped, "\u7280"},
+	{0x2F925, 0x2F925, mapped, "\u7295"},
+	{0x2F926, 0x2F926, mapped, "\U00024735"},
+	{0x2F927, 0x2F927, mapped, "\U00024814"},
+	{0x2F928, 0x2F928, mapped, "\u737a"},
+	{0x2F929, 0x2F929, mapped, "\u738b"},
+	{0x2F92A, 0x2F92A, mapped, "\u3eac"},
+	{0x2F92B, 0x2F92B, mapped, "\u73a5"},
+	{0x2F92C, 0x2F92C, mapped, "\u3eb8"},
+	{0x2F92D, 0x2F92D, mapped, "\u3eb8"},
+	{0x2F92E, 0x2F92E, mapped, "\u7447"},
+	{0x2F92F, 0x2F92F, mapped, "\u745c"},
+	{0x2F930, 0x2F930, mapped, "\u7471"},
+	{0x2F931, 0x2F931, mapped, "\u7485"},
+	{0x2F932, 0x2F932, mapped, "\u74ca"},
+	{0x2F933, 0x2F933, mapped, "\u3f1b"},
+	{0x2F934, 0x2F934, mapped, "\u7524"},
+	{0x2F935, 0x2F935, mapped, "\U00024c36"},
+	{0x2F936, 0x2F936, mapped, "\u753e"},
+	{0x2F937, 0x2F937, mapped, "\U00024c92"},
+	{0x2F938, 0x2F938, mapped, "\u7570"},
+	{0x2F939, 0x2F939, mapped, "\U0002219f"},
+	{0x2F93A, 0x2F93A, mapped, "\u7610"},
+	{0x2F93B, 0x2F93B, mapped, "\U00024fa1"},
+	{0x2F93C, 0x2F93C, mapped, "\U00024fb8"},
+	{0x2F93D, 0x2F93D, mapped, "\U00025044"},
+	{0x2F93E, 0x2F93E, mapped, "\u3ffc"},
+	{0x2F93F, 0x2F93F, mapped, "\u4008"},
+	{0x2F940, 0x2F940, mapped, "\u76f4"},
+	{0x2F941, 0x2F941, mapped, "\U000250f3"},
+	{0x2F942, 0x2F942, mapped, "\U000250f2"},
+	{0x2F943, 0x2F943, mapped, "\U00025119"},
+	{0x2F944, 0x2F944, mapped, "\U00025133"},
+	{0x2F945, 0x2F945, mapped, "\u771e"},
+	{0x2F946, 0x2F946, mapped, "\u771f"},
+	{0x2F947, 0x2F947, mapped, "\u771f"},
+	{0x2F948, 0x2F948, mapped, "\u774a"},
+	{0x2F949, 0x2F949, mapped, "\u4039"},
+	{0x2F94A, 0x2F94A, mapped, "\u778b"},
+	{0x2F94B, 0x2F94B, mapped, "\u4046"},
+	{0x2F94C, 0x2F94C, mapped, "\u4096"},
+	{0x2F94D, 0x2F94D, mapped, "\U0002541d"},
+	{0x2F94E, 0x2F94E, mapped, "\u784e"},
+	{0x2F94F, 0x2F94F, mapped, "\u788c"},
+	{0x2F950, 0x2F950, mapped, "\u78cc"},
+	{0x2F951, 0x2F951, mapped, "\u40e3"},
+	{0x2F952, 0x2F952, mapped, "\U00025626"},
+	{0x2F953, 0x2F953, mapped, "\u7956"},
+	{0x2F954, 0x2F954, mapped, "\U0002569a"},
+	{0x2F955, 0x2F955, mapped, "\U000256c5"},
+	{0x2F956, 0x2F956, mapped, "\u798f"},
+	{0x2F957, 0x2F957, mapped, "\u79eb"},
+	{0x2F958, 0x2F958, mapped, "\u412f"},
+	{0x2F959, 0x2F959, mapped, "\u7a40"},
+	{0x2F95A, 0x2F95A, mapped, "\u7a4a"},
+	{0x2F95B, 0x2F95B, mapped, "\u7a4f"},
+	{0x2F95C, 0x2F95C, mapped, "\U0002597c"},
+	{0x2F95D, 0x2F95D, mapped, "\U00025aa7"},
+	{0x2F95E, 0x2F95E, mapped, "\U00025aa7"},
+	{0x2F95F, 0x2F95F, mapped, "\u7aee"},
+	{0x2F960, 0x2F960, mapped, "\u4202"},
+	{0x2F961, 0x2F961, mapped, "\U00025bab"},
+	{0x2F962, 0x2F962, mapped, "\u7bc6"},
+	{0x2F963, 0x2F963, mapped, "\u7bc9"},
+	{0x2F964, 0x2F964, mapped, "\u4227"},
+	{0x2F965, 0x2F965, mapped, "\U00025c80"},
+	{0x2F966, 0x2F966, mapped, "\u7cd2"},
+	{0x2F967, 0x2F967, mapped, "\u42a0"},
+	{0x2F968, 0x2F968, mapped, "\u7ce8"},
+	{0x2F969, 0x2F969, mapped, "\u7ce3"},
+	{0x2F96A, 0x2F96A, mapped, "\u7d00"},
+	{0x2F96B, 0x2F96B, mapped, "\U00025f86"},
+	{0x2F96C, 0x2F96C, mapped, "\u7d63"},
+	{0x2F96D, 0x2F96D, mapped, "\u4301"},
+	{0x2F96E, 0x2F96E, mapped, "\u7dc7"},
+	{0x2F96F, 0x2F96F, mapped, "\u7e02"},
+	{0x2F970, 0x2F970, mapped, "\u7e45"},
+	{0x2F971, 0x2F971, mapped, "\u4334"},
+	{0x2F972, 0x2F972, mapped, "\U00026228"},
+	{0x2F973, 0x2F973, mapped, "\U00026247"},
+	{0x2F974, 0x2F974, mapped, "\u4359"},
+	{0x2F975, 0x2F975, mapped, "\U000262d9"},
+	{0x2F976, 0x2F976, mapped, "\u7f7a"},
+	{0x2F977, 0x2F977, mapped, "\U0002633e"},
+	{0x2F978, 0x2F978, mapped, "\u7f95"},
+	{0x2F979, 0x2F979, mapped, "\u7ffa"},
+	{0x2F97A, 0x2F97A, mapped, "\u8005"},
+	{0x2F97B, 0x2F97B, mapped, "\U000264da"},
+	{0x2F97C, 0x2F97C, mapped, "\U00026523"},
+	{0x2F97D, 0x2F97D, mapped, "\u8060"},
+	{0x2F97E, 0x2F97E, mapped, "\U000265a8"},
+	{0x2F97F, 0x2F97F, mapped, "\u8070"},
+	{0x2F980, 0x2F980, mapped, "\U0002335f"},
+	{0x2F981, 0x2F981, mapped, "\u43d5"},
+	{0x2F982, 0x2F982, mapped, "\u80b2"},
+	{0x2F983, 0x2F983, mapped, "\u8103"},
+	{0x2F984, 0x2F984, mapped, "\u440b"},
+	{0x2F985, 0x2F985, mapped, "\u813e"},
+	{0x2F986, 0x2F986, mapped, "\u5ab5"},
+	{0x2F987, 0x2F987, mapped, "\U000267a7"},
+	{0x2F988, 0x2F988, mapped, "\U000267b5"},
+	{0x2F989, 0x2F989, mapped, "\U00023393"},
+	{0x2F98A, 0x2F98A, mapped, "\U0002339c"},
+	{0x2F98B, 0x2F98B, mapped, "\u8201"},
+	{0x2F98C, 0x2F98C, mapped, "\u8204"},
+	{0x2F98D, 0x2F98D, mapped, "\u8f9e"},
+	{0x2F98E, 0x2F98E, mapped, "\u446b"},
+	{0x2F98F, 0x2F98F, mapped, "\u8291"},
+	{0x2F990, 0x2F990, mapped, "\u828b"},
+	{0x2F991, 0x2F991, mapped, "\u829d"},
+	{0x2F992, 0x2F992, mapped, "\u52b3"},
+	{0x2F993, 0x2F993, mapped, "\u82b1"},
+	{0x2F994, 0x2F994, mapped, "\u82b3"},
+	{0x2F995, 0x2F995, mapped, "\u82bd"},
+	{0x2F996, 0x2F996, mapped, "\u82e6"},
+	{0x2F997, 0x2F997, mapped, "\U00026b3c"},
+	{0x2F998, 0x2F998, mapped, "\u82e5"},
+	{0x2F999, 0x2F999, mapped, "\u831d"},
+	{0x2F99A, 0x2F99A, mapped, "\u8363"},
+	{0x2F99B, 0x2F99B, mapped, "\u83ad"},
+	{0x2F99C, 0x2F99C, mapped, "\u8323"},
+	{0x2F99D, 0x2F99D, mapped, "\u83bd"},
+	{0x2F99E, 0x2F99E, mapped, "\u83e7"},
+	{0x2F99F, 0x2F99F, mapped, "\u8457"},
+	{0x2F9A0, 0x2F9A0, mapped, "\u8353"},
+	{0x2F9A1, 0x2F9A1, mapped, "\u83ca"},
+	{0x2F9A2, 0x2F9A2, mapped, "\u83cc"},
+	{0x2F9A3, 0x2F9A3, mapped, "\u83dc"},
+	{0x2F9A4, 0x2F9A4, mapped, "\U00026c36"},
+	{0x2F9A5, 0x2F9A5, mapped, "\U00026d6b"},
+	{0x2F9A6, 0x2F9A6, mapped, "\U00026cd5"},
+	{0x2F9A7, 0x2F9A7, mapped, "\u452b"},
+	{0x2F9A8, 0x2F9A8, mapped, "\u84f1"},
+	{0x2F9A9, 0x2F9A9, mapped, "\u84f3"},
+	{0x2F9AA, 0x2F9AA, mapped, "\u8516"},
+	{0x2F9AB, 0x2F9AB, mapped, "\U000273ca"},
+	{0x2F9AC, 0x2F9AC, mapped, "\u8564"},
+	{0x2F9AD, 0x2F9AD, mapped, "\U00026f2c"},
+	{0x2F9AE, 0x2F9AE, mapped, "\u455d"},
+	{0x2F9AF, 0x2F9AF, mapped, "\u4561"},
+	{0x2F9B0, 0x2F9B0, mapped, "\U00026fb1"},
+	{0x2F9B1, 0x2F9B1, mapped, "\U000270d2"},
+	{0x2F9B2, 0x2F9B2, mapped, "\u456b"},
+	{0x2F9B3, 0x2F9B3, mapped, "\u8650"},
+	{0x2F9B4, 0x2F9B4, mapped, "\u865c"},
+	{0x2F9B5, 0x2F9B5, mapped, "\u8667"},
+	{0x2F9B6, 0x2F9B6, mapped, "\u8669"},
+	{0x2F9B7, 0x2F9B7, mapped, "\u86a9"},
+	{0x2F9B8, 0x2F9B8, mapped, "\u8688"},
+	{0x2F9B9, 0x2F9B9, mapped, "\u870e"},
+	{0x2F9BA, 0x2F9BA, mapped, "\u86e2"},
+	{0x2F9BB, 0x2F9BB, mapped, "\u8779"},
+	{0x2F9BC, 0x2F9BC, mapped, "\u8728"},
+	{0x2F9BD, 0x2F9BD, mapped, "\u876b"},
+	{0x2F9BE, 0x2F9BE, mapped, "\u8786"},
+	{0x2F9BF, 0x2F9BF, mapped, "\u45d7"},
+	{0x2F9C0, 0x2F9C0, mapped, "\u87e1"},
+	{0x2F9C1, 0x2F9C1, mapped, "\u8801"},
+	{0x2F9C2, 0x2F9C2, mapped, "\u45f9"},
+	{0x2F9C3, 0x2F9C3, mapped, "\u8860"},
+	{0x2F9C4, 0x2F9C4, mapped, "\u8863"},
+	{0x2F9C5, 0x2F9C5, mapped, "\U00027667"},
+	{0x2F9C6, 0x2F9C6, mapped, "\u88d7"},
+	{0x2F9C7, 0x2F9C7, mapped, "\u88de"},
+	{0x2F9C8, 0x2F9C8, mapped, "\u4635"},
+	{0x2F9C9, 0x2F9C9, mapped, "\u88fa"},
+	{0x2F9CA, 0x2F9CA, mapped, "\u34bb"},
+	{0x2F9CB, 0x2F9CB, mapped, "\U000278ae"},
+	{0x2F9CC, 0x2F9CC, mapped, "\U00027966"},
+	{0x2F9CD, 0x2F9CD, mapped, "\u46be"},
+	{0x2F9CE, 0x2F9CE, mapped, "\u46c7"},
+	{0x2F9CF, 0x2F9CF, mapped, "\u8aa0"},
+	{0x2F9D0, 0x2F9D0, mapped, "\u8aed"},
+	{0x2F9D1, 0x2F9D1, mapped, "\u8b8a"},
+	{0x2F9D2, 0x2F9D2, mapped, "\u8c55"},
+	{0x2F9D3, 0x2F9D3, mapped, "\U00027ca8"},
+	{0x2F9D4, 0x2F9D4, mapped, "\u8cab"},
+	{0x2F9D5, 0x2F9D5, mapped, "\u8cc1"},
+	{0x2F9D6, 0x2F9D6, mapped, "\u8d1b"},
+	{0x2F9D7, 0x2F9D7, mapped, "\u8d77"},
+	{0x2F9D8, 0x2F9D8, mapped, "\U00027f2f"},
+	{0x2F9D9, 0x2F9D9, mapped, "\U00020804"},
+	{0x2F9DA, 0x2F9DA, mapped, "\u8dcb"},
+	{0x2F9DB, 0x2F9DB, mapped, "\u8dbc"},
+	{0x2F9DC, 0x2F9DC, mapped, "\u8df0"},
+	{0x2F9DD, 0x2F9DD, mapped, "\U000208de"},
+	{0x2F9DE, 0x2F9DE, mapped, "\u8ed4"},
+	{0x2F9DF, 0x2F9DF, mapped, "\u8f38"},
+	{0x2F9E0, 0x2F9E0, mapped, "\U000285d2"},
+	{0x2F9E1, 0x2F9E1, mapped, "\U000285ed"},
+	{0x2F9E2, 0x2F9E2, mapped, "\u9094"},
+	{0x2F9E3, 0x2F9E3, mapped, "\u90f1"},
+	{0x2F9E4, 0x2F9E4, mapped, "\u9111"},
+	{0x2F9E5, 0x2F9E5, mapped, "\U0002872e"},
+	{0x2F9E6, 0x2F9E6, mapped, "\u911b"},
+	{0x2F9E7, 0x2F9E7, mapped, "\u9238"},
+	{0x2F9E8, 0x2F9E8, mapped, "\u92d7"},
+	{0x2F9E9, 0x2F9E9, mapped, "\u92d8"},
+	{0x2F9EA, 0x2F9EA, mapped, "\u927c"},
+	{0x2F9EB, 0x2F9EB, mapped, "\u93f9"},
+	{0x2F9EC, 0x2F9EC, mapped, "\u9415"},
+	{0x2F9ED, 0x2F9ED, mapped, "\U00028bfa"},
+	{0x2F9EE, 0x2F9EE, mapped, "\u958b"},
+	{0x2F9EF, 0x2F9EF, mapped, "\u4995"},
+	{0x2F9F0, 0x2F9F0, mapped, "\u95b7"},
+	{0x2F9F1, 0x2F9F1, mapped, "\U00028d77"},
+	{0x2F9F2, 0x2F9F2, mapped, "\u49e6"},
+	{0x2F9F3, 0x2F9F3, mapped, "\u96c3"},
+	{0x2F9F4, 0x2F9F4, mapped, "\u5db2"},
+	{0x2F9F5, 0x2F9F5, mapped, "\u9723"},
+	{0x2F9F6, 0x2F9F6, mapped, "\U00029145"},
+	{0x2F9F7, 0x2F9F7, mapped, "\U0002921a"},
+	{0x2F9F8, 0x2F9F8, mapped, "\u4a6e"},
+	{0x2F9F9, 0x2F9F9, mapped, "\u4a76"},
+	{0x2F9FA, 0x2F9FA, mapped, "\u97e0"},
+	{0x2F9FB, 0x2F9FB, mapped, "\U0002940a"},
+	{0x2F9FC, 0x2F9FC, mapped, "\u4ab2"},
+	{0x2F9FD, 0x2F9FD, mapped, "\U00029496"},
+	{0x2F9FE, 0x2F9FE, mapped, "\u980b"},
+	{0x2F9FF, 0x2F9FF, mapped, "\u980b"},
+	{0x2FA00, 0x2FA00, mapped, "\u9829"},
+	{0x2FA01, 0x2FA01, mapped, "\U000295b6"},
+	{0x2FA02, 0x2FA02, mapped, "\u98e2"},
+	{0x2FA03, 0x2FA03, mapped, "\u4b33"},
+	{0x2FA04, 0x2FA04, mapped, "\u9929"},
+	{0x2FA05, 0x2FA05, mapped, "\u99a7"},
+	{0x2FA06, 0x2FA06, mapped, "\u99c2"},
+	{0x2FA07, 0x2FA07, mapped, "\u99fe"},
+	{0x2FA08, 0x2FA08, mapped, "\u4bce"},
+	{0x2FA09, 0x2FA09, mapped, "\U00029b30"},
+	{0x2FA0A, 0x2FA0A, mapped, "\u9b12"},
+	{0x2FA0B, 0x2FA0B, mapped, "\u9c40"},
+	{0x2FA0C, 0x2FA0C, mapped, "\u9cfd"},
+	{0x2FA0D, 0x2FA0D, mapped, "\u4cce"},
+	{0x2FA0E, 0x2FA0E, mapped, "\u4ced"},
+	{0x2FA0F, 0x2FA0F, mapped, "\u9d67"},
+	{0x2FA10, 0x2FA10, mapped, "\U0002a0ce"},
+	{0x2FA11, 0x2FA11, mapped, "\u4cf8"},
+	{0x2FA12, 0x2FA12, mapped, "\U0002a105"},
+	{0x2FA13, 0x2FA13, mapped, "\U0002a20e"},
+	{0x2FA14, 0x2FA14, mapped, "\U0002a291"},
+	{0x2FA15, 0x2FA15, mapped, "\u9ebb"},
+	{0x2FA16, 0x2FA16, mapped, "\u4d56"},
+	{0x2FA17, 0x2FA17, mapped, "\u9ef9"},
+	{0x2FA18, 0x2FA18, mapped, "\u9efe"},
+	{0x2FA19, 0x2FA19, mapped, "\u9f05"},
+	{0x2FA1A, 0x2FA1A, mapped, "\u9f0f"},
+	{0x2FA1B, 0x2FA1B, mapped, "\u9f16"},
+	{0x2FA1C, 0x2FA1C, mapped, "\u9f3b"},
+	{0x2FA1D, 0x2FA1D, mapped, "\U0002a600"},
+	{0x2FA1E, 0x2FFFF, disallowed, ""},
+	{0x30000, 0x3134A, valid, ""},
+	{0x3134B, 0x3134F, disallowed, ""},
+	{0x31350, 0x33479, valid, ""},
+	{0x3347A, 0xE00FF, disallowed, ""},
+	{0xE0100, 0xE01EF, ignored, ""},
+	{0xE01F0, 0x10FFFF, disallowed, ""},
+}
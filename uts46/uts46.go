@@ -0,0 +1,443 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+// Package uts46 implements Unicode IDNA Compatibility Processing as described
+// in Unicode Technical Standard #46.
+//
+// UTS #46 maps user input with the IDNA Mapping Table before validating it
+// against rules derived from IDNA2008, which is how web browsers resolve
+// internationalized domain names. Both transitional processing, which maps
+// the deviation characters ß, ς, ZWJ and ZWNJ as IDNA2003 did, and
+// nontransitional processing, which keeps them, are supported.
+//
+// This package is in beta and has not been extensively tested.
+package uts46
+
+//go:generate sh -c "go run maketables.go > tables.go && gofmt -w tables.go"
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/DanielOaks/go-idn/idna2003/punycode"
+	"github.com/DanielOaks/go-idn/idna2008"
+	"golang.org/x/text/unicode/norm"
+)
+
+// UTS #46 section 4.2
+const (
+	AcePrefix = "xn--"
+)
+
+// status is the status of a code point in the IDNA Mapping Table.
+type status int
+
+const (
+	valid status = iota
+	ignored
+	mapped
+	deviation
+	disallowed
+	disallowedSTD3Valid
+	disallowedSTD3Mapped
+)
+
+// mappingEntry is a range of code points in the IDNA Mapping Table.
+type mappingEntry struct {
+	lo, hi  rune
+	status  status
+	mapping string
+}
+
+// lookup returns the mapping table entry for r. Code points missing from the
+// table are disallowed.
+func lookup(r rune) mappingEntry {
+	i := sort.Search(len(mappingTable), func(i int) bool {
+		return mappingTable[i].hi >= r
+	})
+	if i < len(mappingTable) && mappingTable[i].lo <= r {
+		return mappingTable[i]
+	}
+	return mappingEntry{r, r, disallowed, ""}
+}
+
+// A Profile holds the processing options for UTS #46 conversions.
+type Profile struct {
+	transitional      bool
+	checkHyphens      bool
+	checkBidi         bool
+	checkJoiners      bool
+	useSTD3ASCIIRules bool
+	verifyDNSLength   bool
+}
+
+// An Option configures a Profile.
+type Option func(*Profile)
+
+// Transitional sets whether the deviation characters are mapped as in
+// IDNA2003 (transitional processing) or kept (nontransitional processing).
+func Transitional(transitional bool) Option {
+	return func(p *Profile) { p.transitional = transitional }
+}
+
+// CheckHyphens sets whether labels may not begin or end with a hyphen or
+// contain hyphens in the third and fourth positions.
+func CheckHyphens(check bool) Option {
+	return func(p *Profile) { p.checkHyphens = check }
+}
+
+// CheckBidi sets whether the Bidi rule of RFC 5893 is applied to labels of
+// Bidi domain names.
+func CheckBidi(check bool) Option {
+	return func(p *Profile) { p.checkBidi = check }
+}
+
+// CheckJoiners sets whether the CONTEXTJ rules of RFC 5892 appendix A are
+// applied to ZERO WIDTH JOINER and ZERO WIDTH NON-JOINER.
+func CheckJoiners(check bool) Option {
+	return func(p *Profile) { p.checkJoiners = check }
+}
+
+// UseSTD3ASCIIRules sets whether only letters, digits and hyphens are allowed
+// among the ASCII code points, treating the disallowed_STD3_valid and
+// disallowed_STD3_mapped statuses as disallowed.
+func UseSTD3ASCIIRules(use bool) Option {
+	return func(p *Profile) { p.useSTD3ASCIIRules = use }
+}
+
+// VerifyDNSLength sets whether ToASCII verifies that labels are 1 to 63
+// octets long and the domain name at most 253 octets long.
+func VerifyDNSLength(verify bool) Option {
+	return func(p *Profile) { p.verifyDNSLength = verify }
+}
+
+// New returns a Profile with the given options applied. All checks are
+// disabled and processing is nontransitional unless an option says
+// otherwise.
+func New(opts ...Option) *Profile {
+	p := &Profile{}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+var (
+	// Lookup is a nontransitional profile with every check enabled, as
+	// recommended in UTS #46 section 4.
+	Lookup = New(CheckHyphens(true), CheckBidi(true), CheckJoiners(true),
+		UseSTD3ASCIIRules(true), VerifyDNSLength(true))
+
+	// TransitionalLookup is Lookup with transitional processing of the
+	// deviation characters, matching the results of IDNA2003 for them.
+	TransitionalLookup = New(Transitional(true), CheckHyphens(true), CheckBidi(true),
+		CheckJoiners(true), UseSTD3ASCIIRules(true), VerifyDNSLength(true))
+
+	// Browser uses the options from the WHATWG URL Standard "domain to ASCII"
+	// algorithm, which web browsers use to resolve host names.
+	Browser = New(CheckBidi(true), CheckJoiners(true))
+)
+
+// Converts a domain name to ASCII using the Lookup profile.
+func ToASCII(domain string) (string, error) {
+	return Lookup.ToASCII(domain)
+}
+
+// Converts a domain name to Unicode using the Lookup profile.
+func ToUnicode(domain string) (string, error) {
+	return Lookup.ToUnicode(domain)
+}
+
+// ToASCII converts a domain name to ASCII using the procedure in UTS #46
+// section 4.2. If an error occurs the input is returned unchanged along with
+// the error.
+func (p *Profile) ToASCII(domain string) (string, error) {
+	r := p.validate(domain, true)
+	if err := r.Err(); err != nil {
+		return domain, err
+	}
+	return r.ACE(), nil
+}
+
+// ToUnicode converts a domain name to Unicode using the procedure in UTS #46
+// section 4.3. As the specification requires, the processed domain name is
+// returned even if an error occurs, so it can be displayed to the user.
+func (p *Profile) ToUnicode(domain string) (string, error) {
+	r := p.validate(domain, false)
+	labels := make([]string, len(r.Labels))
+	for i, l := range r.Labels {
+		labels[i] = l.Prepared
+	}
+	if r.TrailingDot {
+		labels = append(labels, "")
+	}
+	return strings.Join(labels, "."), r.Err()
+}
+
+// validate performs the Map, Normalize, Break and Convert/Validate steps from
+// UTS #46 section 4 and records every error found in each label. If toASCII
+// is set the labels are also converted to ASCII and their lengths verified,
+// as in section 4.2.
+func (p *Profile) validate(domain string, toASCII bool) *DomainReport {
+	r := &DomainReport{}
+
+	// The input is broken into labels first so that each label can be
+	// reported with the part of the input it came from. The full stop is
+	// unchanged by mapping and normalization, so this gives the same labels
+	// as the Break step, unless a code point maps to a string containing a
+	// full stop; such a label is broken again after mapping.
+	for _, original := range splitLabels(domain) {
+		// 1. Map
+		mapped, errs := p.mapLabel(len(r.Labels), original)
+
+		// 2. Normalize
+		mapped = norm.NFC.String(mapped)
+
+		// 3. Break
+		for j, label := range strings.Split(mapped, ".") {
+			l := LabelReport{Original: original, Prepared: label}
+			if j == 0 {
+				l.Violations = errs
+			}
+			// 4. Convert/Validate
+			p.convertLabel(len(r.Labels), &l)
+			r.Labels = append(r.Labels, l)
+		}
+	}
+
+	if n := len(r.Labels); n > 1 && r.Labels[n-1].Prepared == "" && len(r.Labels[n-1].Violations) == 0 {
+		r.TrailingDot = true
+		r.Labels = r.Labels[:n-1]
+	}
+
+	// Validity criterion 8: the Bidi rule applies to Bidi domain names.
+	if p.checkBidi {
+		labels := make([]string, len(r.Labels))
+		for i, l := range r.Labels {
+			labels[i] = l.Prepared
+		}
+		if idna2008.IsBidiDomain(labels) {
+			for i := range r.Labels {
+				l := &r.Labels[i]
+				if l.Prepared != "" && !idna2008.CheckBidiRule([]rune(l.Prepared)) {
+					l.Violations = append(l.Violations, &LabelError{i, -1, 0, ErrBidi})
+				}
+			}
+		}
+	}
+
+	if !toASCII {
+		return r
+	}
+
+	for i := range r.Labels {
+		l := &r.Labels[i]
+		if len(l.Violations) > 0 {
+			continue
+		}
+		ace := l.Prepared
+		if !isASCII(ace) {
+			enc, err := punycode.EncodeString(ace)
+			if err != nil {
+				l.Violations = append(l.Violations, &LabelError{i, -1, 0, err})
+				continue
+			}
+			ace = AcePrefix + enc
+		}
+		if p.verifyDNSLength && (len(ace) < 1 || len(ace) > 63) {
+			l.Violations = append(l.Violations, &LabelError{i, -1, 0, ErrLabelLength})
+			continue
+		}
+		l.ACE = ace
+	}
+
+	if p.verifyDNSLength && r.Valid() && len(strings.TrimSuffix(r.ACE(), ".")) > 253 {
+		r.Violations = append(r.Violations, ErrDomainLength)
+	}
+	return r
+}
+
+// splitLabels splits domain at the full stop and the code points mapped to
+// it, such as U+3002 IDEOGRAPHIC FULL STOP.
+func splitLabels(domain string) []string {
+	var labels []string
+	start := 0
+	for i, r := range domain {
+		if r == '.' || lookup(r).mapping == "." {
+			labels = append(labels, domain[start:i])
+			start = i + utf8.RuneLen(r)
+		}
+	}
+	return append(labels, domain[start:])
+}
+
+// mapLabel performs the Map step, processing each code point according to
+// its status in the IDNA Mapping Table. Disallowed code points are kept so
+// they can be reported by the validity criteria, and an error is returned
+// for each of them, with its offset in label.
+func (p *Profile) mapLabel(index int, label string) (string, []error) {
+	var errs []error
+	disallow := func(offset int, r rune) {
+		errs = append(errs, &LabelError{index, offset, r, ErrDisallowed})
+	}
+	var b strings.Builder
+	for i, r := range label {
+		e := lookup(r)
+		switch e.status {
+		case valid:
+			b.WriteRune(r)
+		case ignored:
+		case mapped:
+			b.WriteString(e.mapping)
+		case deviation:
+			if p.transitional {
+				b.WriteString(e.mapping)
+			} else {
+				b.WriteRune(r)
+			}
+		case disallowedSTD3Valid:
+			if p.useSTD3ASCIIRules {
+				disallow(i, r)
+			}
+			b.WriteRune(r)
+		case disallowedSTD3Mapped:
+			if p.useSTD3ASCIIRules {
+				disallow(i, r)
+				b.WriteRune(r)
+			} else {
+				b.WriteString(e.mapping)
+			}
+		default:
+			disallow(i, r)
+			b.WriteRune(r)
+		}
+	}
+	return b.String(), errs
+}
+
+// convertLabel performs the Convert/Validate step for a label, decoding
+// A-labels and checking the validity criteria. Code points already reported
+// by mapLabel are not reported again. index is the index of the label in
+// the domain name, for errors.
+func (p *Profile) convertLabel(index int, l *LabelReport) {
+	label := l.Prepared
+	if strings.HasPrefix(label, AcePrefix) {
+		if !isASCII(label) {
+			l.Violations = append(l.Violations, &LabelError{index, -1, 0, ErrNonASCIIALabel})
+			return
+		}
+		u, err := punycode.DecodeString(label[len(AcePrefix):])
+		if err != nil {
+			l.Violations = append(l.Violations, &LabelError{index, -1, 0, err})
+			return
+		}
+		if u == "" || isASCII(u) {
+			l.Violations = append(l.Violations, &LabelError{index, -1, 0, ErrASCIILabel})
+			return
+		}
+		l.Prepared = u
+		l.Violations = append(l.Violations, p.labelViolations(index, u, false, true)...)
+		return
+	}
+	if label == "" {
+		// Empty labels are only rejected by VerifyDNSLength.
+		return
+	}
+	l.Violations = append(l.Violations, p.labelViolations(index, label, p.transitional, len(l.Violations) == 0)...)
+}
+
+// labelViolations checks the validity criteria in UTS #46 section 4.1 and
+// returns every violation found. Labels decoded from punycode are always
+// checked with nontransitional processing. If checkStatus is false the
+// status of each code point is not checked again. Each violation is a
+// *LabelError for the label at index.
+func (p *Profile) labelViolations(index int, label string, transitional, checkStatus bool) []error {
+	var v []error
+
+	// 1. The label must be in Unicode Normalization Form NFC.
+	if !norm.NFC.IsNormalString(label) {
+		v = append(v, &LabelError{index, -1, 0, ErrNotNFC})
+	}
+
+	if p.checkHyphens {
+		// 2. The label must not contain a U+002D HYPHEN-MINUS character in
+		// both the third and fourth positions.
+		if idna2008.HasReservedHyphens(label) {
+			_, n1 := utf8.DecodeRuneInString(label)
+			_, n2 := utf8.DecodeRuneInString(label[n1:])
+			v = append(v, &LabelError{index, n1 + n2, '-', ErrReservedHyphens})
+		}
+		// 3. The label must neither begin nor end with a U+002D
+		// HYPHEN-MINUS character.
+		if strings.HasPrefix(label, "-") {
+			v = append(v, &LabelError{index, 0, '-', ErrHyphen})
+		} else if strings.HasSuffix(label, "-") {
+			v = append(v, &LabelError{index, len(label) - 1, '-', ErrHyphen})
+		}
+	} else if strings.HasPrefix(label, AcePrefix) {
+		// 3. If not CheckHyphens, the label must not begin with "xn--".
+		v = append(v, &LabelError{index, -1, 0, ErrACEPrefix})
+	}
+
+	runes := make([]rune, 0, len(label))
+	offsets := make([]int, 0, len(label))
+	for i, r := range label {
+		runes = append(runes, r)
+		offsets = append(offsets, i)
+	}
+
+	// 4. The label must not contain a U+002E ( . ) FULL STOP.
+	// 5. The label must not begin with a combining mark.
+	if unicode.Is(unicode.M, runes[0]) {
+		v = append(v, &LabelError{index, 0, runes[0], ErrCombiningMark})
+	}
+
+	// 6. Each code point in the label must only have certain statuses.
+	for i, r := range runes {
+		if r == '.' {
+			v = append(v, &LabelError{index, offsets[i], r, ErrFullStop})
+			continue
+		}
+		if !checkStatus {
+			continue
+		}
+		switch lookup(r).status {
+		case valid:
+		case deviation:
+			if transitional {
+				v = append(v, &LabelError{index, offsets[i], r, ErrDeviation})
+			}
+		case disallowedSTD3Valid:
+			if p.useSTD3ASCIIRules {
+				v = append(v, &LabelError{index, offsets[i], r, ErrDisallowed})
+			}
+		default:
+			v = append(v, &LabelError{index, offsets[i], r, ErrDisallowed})
+		}
+	}
+
+	// 7. If CheckJoiners, the label must satisfy the ContextJ rules.
+	if p.checkJoiners {
+		for i, r := range runes {
+			if idna2008.DerivedProperty(r) == idna2008.CONTEXTJ && !idna2008.ContextRule(runes, i) {
+				v = append(v, &LabelError{index, offsets[i], r, ErrContext})
+			}
+		}
+	}
+
+	return v
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] > 127 {
+			return false
+		}
+	}
+	return true
+}
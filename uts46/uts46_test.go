@@ -0,0 +1,161 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package uts46
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type conversiontestcase struct {
+	Profile *Profile
+	Input   string
+	Output  string
+	Error   bool
+}
+
+var toASCIITests = []conversiontestcase{
+	{Lookup, "example.com", "example.com", false},
+	{Lookup, "Bücher.DE", "xn--bcher-kva.de", false},
+	{Lookup, "ＡＢＣ．com", "abc.com", false},
+	{Lookup, "例え。テスト", "xn--r8jz45g.xn--zckzah", false},
+	{Lookup, "faß.de", "xn--fa-hia.de", false},
+	{TransitionalLookup, "faß.de", "fass.de", false},
+	{Lookup, "βόλος", "xn--nxasmm1c", false},
+	{TransitionalLookup, "βόλος", "xn--nxasmq6b", false},
+	{Lookup, "a\u00ADb", "ab", false},
+	{Lookup, "xn--bcher-kva.de", "xn--bcher-kva.de", false},
+	{Lookup, "a_b.com", "a_b.com", true},
+	{Browser, "a_b.com", "a_b.com", false},
+	{Lookup, "-abc.com", "-abc.com", true},
+	{Browser, "-abc.com", "-abc.com", false},
+	{Lookup, "⑵.com", "⑵.com", true},
+	{Browser, "⑵.com", "(2).com", false},
+	{Lookup, "a\u200Db", "a\u200Db", true},
+	{Browser, "a\u200Db", "a\u200Db", true},
+	{New(), "a\u200Db", "xn--ab-m1t", false},
+	{Lookup, "\u0915\u094D\u200D\u0937", "xn--11b2ezcw70k", false},
+	{Lookup, "1.א", "1.א", true},
+	{Lookup, "\u0301abc", "\u0301abc", true},
+	{Lookup, "xn--a.com", "xn--a.com", true},
+	{Lookup, "xn--ls8h.la", "xn--ls8h.la", false},
+	{Lookup, "a..b", "a..b", true},
+	{Browser, "a..b", "a..b", false},
+	{Lookup, "example.com.", "example.com.", false},
+	{Lookup, "é--x.com", "xn----x-9la.com", false},
+	{Lookup, "éb--c.com", "éb--c.com", true},
+	{Lookup, "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijkl.com", "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijkl.com", true},
+	{Lookup, "\U000e0001", "\U000e0001", true},
+}
+
+var toUnicodeTests = []conversiontestcase{
+	{Lookup, "xn--bcher-kva.de", "bücher.de", false},
+	{Lookup, "XN--BCHER-KVA.DE", "bücher.de", false},
+	{Lookup, "xn--fa-hia.de", "faß.de", false},
+	{TransitionalLookup, "xn--fa-hia.de", "faß.de", false},
+	{TransitionalLookup, "faß.de", "fass.de", false},
+	{Lookup, "xn--ab-m1t", "a\u200Db", true},
+	{Lookup, "xn--bcher-kva-.de", "xn--bcher-kva-.de", true},
+	{Lookup, "Ab_c.xn--bcher-kva", "ab_c.bücher", true},
+}
+
+func TestToASCII(t *testing.T) {
+	for _, test := range toASCIITests {
+		out, err := test.Profile.ToASCII(test.Input)
+		if (err != nil) != test.Error {
+			t.Errorf("ToASCII(%+q) got error %v; want error %v", test.Input, err, test.Error)
+		}
+		if out != test.Output {
+			t.Errorf("ToASCII(%+q) = %+q; want %+q", test.Input, out, test.Output)
+		}
+	}
+}
+
+func TestToUnicode(t *testing.T) {
+	for _, test := range toUnicodeTests {
+		out, err := test.Profile.ToUnicode(test.Input)
+		if (err != nil) != test.Error {
+			t.Errorf("ToUnicode(%+q) got error %v; want error %v", test.Input, err, test.Error)
+		}
+		if out != test.Output {
+			t.Errorf("ToUnicode(%+q) = %+q; want %+q", test.Input, out, test.Output)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, r := range []rune{0x0000, 0x0041, 0x00DF, 0xD800, 0xFFFF, 0x10FFFF} {
+		if e := lookup(r); e.lo > r || e.hi < r {
+			t.Errorf("lookup(%U) returned range %U..%U", r, e.lo, e.hi)
+		}
+	}
+}
+
+func TestValidateDomain(t *testing.T) {
+	for _, test := range toASCIITests {
+		r := test.Profile.ValidateDomain(test.Input)
+		if r.Valid() == test.Error {
+			t.Errorf("ValidateDomain(%+q) error = %v; want error %v", test.Input, r.Err(), test.Error)
+		} else if r.Valid() && r.ACE() != test.Output {
+			t.Errorf("ValidateDomain(%+q) = %+q; want %+q", test.Input, r.ACE(), test.Output)
+		}
+	}
+
+	r := ValidateDomain("Café。a_b⑵-.xn--bcher-kva-｡com.")
+	if r.Valid() || !r.TrailingDot || len(r.Labels) != 4 {
+		t.Fatalf("ValidateDomain = %+v; want 4 labels and a trailing dot", r)
+	}
+	labels := []struct {
+		Original   string
+		Prepared   string
+		ACE        string
+		Violations int
+	}{
+		{"Café", "café", "xn--caf-dma", 0},
+		{"a_b⑵-", "a_b⑵-", "", 3},
+		{"xn--bcher-kva-", "xn--bcher-kva-", "", 1},
+		{"com", "com", "com", 0},
+	}
+	for i, want := range labels {
+		l := r.Labels[i]
+		if l.Original != want.Original || l.Prepared != want.Prepared || l.ACE != want.ACE || len(l.Violations) != want.Violations {
+			t.Errorf("label %d = %+q; want %+q", i, l, want)
+		}
+	}
+}
+
+var labelErrorTests = []struct {
+	profile *Profile
+	in      string
+	err     error
+	label   int
+	offset  int
+}{
+	{Lookup, "a.b_c", ErrDisallowed, 1, 1},
+	{Lookup, "\u00C0b_c", ErrDisallowed, 0, 3},
+	{Lookup, "a.-b", ErrHyphen, 1, 0},
+	{Lookup, "\u00E9b--c", ErrReservedHyphens, 0, 3},
+	{Lookup, "\u0301a", ErrCombiningMark, 0, 0},
+	{Lookup, "a\u200Cb", ErrContext, 0, 1},
+	{Lookup, "1.\u05D0", ErrBidi, 0, -1},
+	{Lookup, "xn--ab-", ErrASCIILabel, 0, -1},
+	{Lookup, strings.Repeat("a", 64), ErrLabelLength, 0, -1},
+}
+
+func TestLabelErrors(t *testing.T) {
+	for _, test := range labelErrorTests {
+		_, err := test.profile.ToASCII(test.in)
+		var lerr *LabelError
+		if !errors.As(err, &lerr) || !errors.Is(err, test.err) || lerr.Label != test.label || lerr.Offset != test.offset {
+			t.Errorf("ToASCII(%+q) error = %v; want %v in label %d at offset %d", test.in, err, test.err, test.label, test.offset)
+		}
+	}
+	if _, err := Lookup.ToASCII(strings.Repeat("a.", 127) + "a"); err != ErrDomainLength {
+		t.Errorf("ToASCII of a long domain error = %v; want %v", err, ErrDomainLength)
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package uts46
+
+import "github.com/DanielOaks/go-idn/report"
+
+// A LabelReport describes a label of a domain name checked by ValidateDomain.
+// Original is the part of the input the label was mapped from, and Prepared
+// is the label after mapping, normalization and decoding, as returned by
+// ToUnicode.
+type LabelReport = report.LabelReport
+
+// A DomainReport describes a domain name checked by ValidateDomain.
+type DomainReport = report.DomainReport
+
+// ValidateDomain checks a domain name with the Lookup profile and reports
+// every problem found in each of its labels.
+func ValidateDomain(domain string) *DomainReport {
+	return Lookup.ValidateDomain(domain)
+}
+
+// ValidateDomain checks a domain name like ToASCII, but instead of stopping
+// at the first problem it reports every problem found in each label. Empty
+// labels and the length of the domain name are only checked if the profile
+// verifies DNS lengths.
+func (p *Profile) ValidateDomain(domain string) *DomainReport {
+	return p.validate(domain, true)
+}
@@ -0,0 +1,66 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package zone
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors reported by Process, wrapped in an *Error that gives their
+// position. Domain names that cannot be converted are reported with the
+// errors of the idna2003 package, such as idna2003.ErrNonLDH or
+// idna2003.ErrEscape, in an
+// *idna2003.LabelError whose Label is the index of the label in the name as
+// written.
+var (
+	ErrSyntax     = errors.New("zone: Syntax error")
+	ErrUTF8       = errors.New("zone: Label is not valid UTF-8")
+	ErrNameLength = errors.New("zone: Domain name too long")
+)
+
+// An Error describes a problem at a position of a zone file.
+type Error struct {
+	File   string // the name of the file
+	Line   int    // the line, starting at 1
+	Column int    // the byte column, starting at 1
+	Name   string // the domain name as written, if the error is about one
+	Err    error  // the reason for the error
+}
+
+func (e *Error) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("%s:%d:%d: %v", e.File, e.Line, e.Column, e.Err)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s: %v", e.File, e.Line, e.Column, e.Name, e.Err)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// An ErrorList is the list of errors found in a zone file, in the order of
+// their positions.
+type ErrorList []*Error
+
+func (l ErrorList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", l[0], len(l)-1)
+}
+
+// Unwrap returns the errors of the list, so that errors.Is and errors.As
+// look at each of them.
+func (l ErrorList) Unwrap() []error {
+	errs := make([]error, len(l))
+	for i, e := range l {
+		errs[i] = e
+	}
+	return errs
+}
@@ -0,0 +1,154 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package zone
+
+import (
+	"bufio"
+	"io"
+)
+
+// A token is a field of an entry of a zone file.
+type token struct {
+	text   string
+	off    int // byte offset of the token in the entry
+	line   int // line of the token in the file
+	column int // byte column of the token in its line
+}
+
+// An entry of a zone file is a line, or several lines if it has
+// parentheses, with its tokens.
+type entry struct {
+	text   string // the lines of the entry, with their line endings
+	line   int    // the line of the first line of the entry
+	tokens []token
+	blank  bool // whether the entry starts with a blank, omitting its owner
+}
+
+// A lexer reads the entries of a zone file.
+type lexer struct {
+	r    *bufio.Reader
+	line int // the number of lines read
+}
+
+func newLexer(r io.Reader) *lexer {
+	return &lexer{r: bufio.NewReader(r)}
+}
+
+// next returns the next entry, or io.EOF at the end of the file. An entry
+// with a syntax error is returned with an *Error.
+func (l *lexer) next() (*entry, *Error, error) {
+	e := &entry{line: l.line + 1}
+	var text []byte
+	for {
+		line, err := l.r.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, nil, err
+		}
+		if line == "" && err == io.EOF {
+			if text == nil {
+				return nil, nil, io.EOF
+			}
+		} else {
+			l.line++
+			text = append(text, line...)
+		}
+
+		e.text = string(text)
+		depth, serr := e.tokenize()
+		if serr != nil || depth == 0 || err == io.EOF {
+			if serr == nil && depth > 0 {
+				serr = e.errorAt(len(e.text), ErrSyntax)
+			}
+			return e, serr, nil
+		}
+	}
+}
+
+// tokenize splits the text of the entry into tokens, and returns the number
+// of parentheses left open.
+func (e *entry) tokenize() (int, *Error) {
+	e.tokens = e.tokens[:0]
+	e.blank = len(e.text) > 0 && (e.text[0] == ' ' || e.text[0] == '\t')
+	depth := 0
+	s := e.text
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case c == ';':
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		case c == '(':
+			depth++
+			i++
+		case c == ')':
+			depth--
+			if depth < 0 {
+				return 0, e.errorAt(i, ErrSyntax)
+			}
+			i++
+		case c == '"':
+			start := i
+			for i++; i < len(s) && s[i] != '"' && s[i] != '\n'; i++ {
+				if s[i] == '\\' && i+1 < len(s) {
+					i++
+				}
+			}
+			if i == len(s) || s[i] != '"' {
+				return 0, e.errorAt(start, ErrSyntax)
+			}
+			i++
+			e.tokens = append(e.tokens, e.token(start, i))
+		default:
+			start := i
+			for ; i < len(s) && !isDelimiter(s[i]); i++ {
+				if s[i] == '\\' && i+1 < len(s) && s[i+1] != '\n' {
+					i++
+				}
+			}
+			e.tokens = append(e.tokens, e.token(start, i))
+		}
+	}
+	return depth, nil
+}
+
+// isDelimiter returns true if c ends an unquoted token.
+func isDelimiter(c byte) bool {
+	switch c {
+	case ' ', '\t', '\r', '\n', ';', '(', ')', '"':
+		return true
+	}
+	return false
+}
+
+// token returns the token of the entry between the byte offsets start and
+// end.
+func (e *entry) token(start, end int) token {
+	line, column := e.position(start)
+	return token{e.text[start:end], start, line, column}
+}
+
+// position returns the line and column of the byte offset off of the entry.
+func (e *entry) position(off int) (line, column int) {
+	line, column = e.line, 1
+	for i := 0; i < off && i < len(e.text); i++ {
+		if e.text[i] == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return line, column
+}
+
+// errorAt returns an *Error for the byte offset off of the entry.
+func (e *entry) errorAt(off int, err error) *Error {
+	line, column := e.position(off)
+	return &Error{Line: line, Column: column, Err: err}
+}
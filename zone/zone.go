@@ -0,0 +1,378 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+// Package zone converts the internationalized domain names of DNS zone
+// files, in the master file format of RFC 1035 section 5.
+//
+// A Processor copies a zone file, converting the owner name of each record
+// and the domain names in the data of CNAME, DNAME, NS, PTR, MX, SRV and SOA
+// records, either to ACE with IDNA2003 ToASCII or to Unicode for display.
+// Comments, spacing and the other fields are copied as they are. Each label
+// is also checked, whichever way it is converted, and problems are reported
+// with their file and line.
+//
+// Names follow the rules of RFC 1035 section 5.1: names that do not end
+// with a dot are relative to the current $ORIGIN, "@" stands for the origin,
+// and "\X" and "\DDD" escape a character or give a byte in decimal, so that
+// labels may contain dots. Labels
+// that only have ASCII characters, such as "www" or "_sip", are copied
+// without being converted, so that the underscores and case of the zone are
+// kept.
+//
+// This package is in beta and has not been extensively tested.
+package zone
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/DanielOaks/go-idn/idna2003"
+)
+
+// MaxNameLength is the limit on the length of a domain name in wire format,
+// including its length octets, from RFC 1035 section 2.3.4.
+const MaxNameLength = 255
+
+// A Processor converts the domain names of zone files. A Processor is safe
+// for concurrent use.
+type Processor struct {
+	converter *idna2003.Converter
+	unicode   bool
+	origin    string
+}
+
+// An Option configures a Processor.
+type Option func(*Processor)
+
+// Converter sets the converter used for the labels of domain names. It
+// defaults to idna2003.Default.
+func Converter(c *idna2003.Converter) Option {
+	return func(p *Processor) { p.converter = c }
+}
+
+// Unicode sets whether labels are converted to Unicode, for display,
+// rather than to ACE.
+func Unicode(unicode bool) Option {
+	return func(p *Processor) { p.unicode = unicode }
+}
+
+// Origin sets the origin of relative names until the first $ORIGIN
+// directive, such as "example.com.". Without it the length of relative names
+// before the first $ORIGIN is not checked.
+func Origin(origin string) Option {
+	return func(p *Processor) { p.origin = origin }
+}
+
+// New returns a Processor with the given options applied.
+func New(opts ...Option) *Processor {
+	p := &Processor{converter: idna2003.Default}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+var (
+	// ToASCII converts the domain names of zone files to ACE.
+	ToASCII = New()
+
+	// ToUnicode converts the domain names of zone files to Unicode.
+	ToUnicode = New(Unicode(true))
+)
+
+// nameFields gives the indexes of the domain names in the data of the
+// record types that have some.
+var nameFields = map[string][]int{
+	"CNAME": {0},
+	"DNAME": {0},
+	"NS":    {0},
+	"PTR":   {0},
+	"MX":    {1},
+	"SRV":   {3},
+	"SOA":   {0, 1},
+}
+
+// process holds the state of a call to Process.
+type process struct {
+	*Processor
+	file      string
+	originLen int // wire length of the origin, or 0 if it is not known
+	errs      ErrorList
+}
+
+// Process copies the zone file read from r to w, converting its domain
+// names. The file is called file in errors. Names that cannot be converted
+// are copied as they are; if there are any, or if the file has syntax
+// errors, Process returns an ErrorList once the whole file is copied. An
+// error reading r or writing w is returned as it is.
+//
+// $INCLUDE directives are copied, converting their origin, but the files
+// they name are not read.
+func (p *Processor) Process(w io.Writer, r io.Reader, file string) error {
+	s := &process{Processor: p, file: file}
+	if p.origin != "" {
+		if _, n, err := s.convertName(p.origin, 0); err == nil && n > 0 {
+			s.originLen = n
+		}
+	}
+
+	l := newLexer(r)
+	for {
+		e, serr, err := l.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		text := e.text
+		if serr != nil {
+			serr.File = file
+			s.errs = append(s.errs, serr)
+		} else {
+			text = s.entry(e)
+		}
+		if _, err := io.WriteString(w, text); err != nil {
+			return err
+		}
+	}
+	if len(s.errs) > 0 {
+		return s.errs
+	}
+	return nil
+}
+
+// ProcessFile is like Process for the named file.
+func (p *Processor) ProcessFile(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return p.Process(w, f, path)
+}
+
+// entry converts the domain names of an entry, and returns its new text.
+func (s *process) entry(e *entry) string {
+	toks := e.tokens
+	if len(toks) == 0 {
+		return e.text
+	}
+
+	var names []token
+	if !e.blank && strings.HasPrefix(toks[0].text, "$") {
+		switch strings.ToUpper(toks[0].text) {
+		case "$ORIGIN":
+			if len(toks) < 2 {
+				s.errorAt(toks[0], "", ErrSyntax)
+				return e.text
+			}
+			names = toks[1:2]
+		case "$INCLUDE":
+			if len(toks) > 2 {
+				names = toks[2:3]
+			}
+		}
+	} else {
+		i := 0
+		if !e.blank {
+			names = append(names, toks[0])
+			i = 1
+		}
+		for i < len(toks) && (isClass(toks[i].text) || isTTL(toks[i].text)) {
+			i++
+		}
+		if i == len(toks) {
+			s.errorAt(toks[len(toks)-1], "", ErrSyntax)
+			return e.text
+		}
+		rdata := toks[i+1:]
+		for _, n := range nameFields[strings.ToUpper(toks[i].text)] {
+			if n < len(rdata) {
+				names = append(names, rdata[n])
+			}
+		}
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, tok := range names {
+		out, n, err := s.convertName(tok.text, s.originLen)
+		if err != nil {
+			s.errorAt(tok, tok.text, err)
+			continue
+		}
+		if strings.EqualFold(toks[0].text, "$ORIGIN") && !e.blank && n > 0 {
+			s.originLen = n
+		}
+		b.WriteString(e.text[last:tok.off])
+		b.WriteString(out)
+		last = tok.off + len(tok.text)
+	}
+	b.WriteString(e.text[last:])
+	return b.String()
+}
+
+// errorAt records an error at the position of tok.
+func (s *process) errorAt(tok token, name string, err error) {
+	s.errs = append(s.errs, &Error{s.file, tok.line, tok.column, name, err})
+}
+
+// convertName converts a domain name as written in a zone file. It returns
+// the converted name and the length of the name in wire format, which is 0
+// if it is relative and the length of the origin, originLen, is 0.
+func (s *process) convertName(name string, originLen int) (string, int, error) {
+	if name == "@" {
+		return name, originLen, nil
+	}
+	labels, absolute := splitName(name)
+	if labels == nil && !absolute {
+		return name, 0, ErrSyntax
+	}
+
+	n := 0
+	out := make([]string, len(labels))
+	for i, raw := range labels {
+		label, ace, err := s.convertLabel(raw)
+		if err != nil {
+			var lerr *idna2003.LabelError
+			if errors.As(err, &lerr) {
+				lerr.Label = i
+			} else {
+				err = &idna2003.LabelError{Label: i, Offset: -1, Err: err}
+			}
+			return name, 0, err
+		}
+		out[i] = label
+		n += len(ace) + 1
+	}
+
+	switch {
+	case absolute:
+		n++
+	case originLen > 0:
+		n += originLen
+	default:
+		n = 0
+	}
+	if n > MaxNameLength {
+		return name, 0, ErrNameLength
+	}
+
+	result := strings.Join(out, ".")
+	if absolute {
+		result += "."
+	}
+	return result, n, nil
+}
+
+// convertLabel checks a label as written in a zone file, and converts it if
+// it is in the other form. It returns the new label, as written in a zone
+// file, and the label in ACE.
+func (s *process) convertLabel(raw string) (string, string, error) {
+	label, err := idna2003.UnescapeLabel(raw)
+	if err != nil {
+		return "", "", err
+	}
+	if label == "" {
+		return "", "", idna2003.ErrLabelLength
+	}
+
+	if isASCII(label) {
+		if !hasACEPrefix(label) {
+			return raw, label, nil
+		}
+		u, err := s.converter.LabelToUnicode(label)
+		if err != nil {
+			return "", "", err
+		}
+		if s.unicode {
+			return idna2003.EscapeLabel(u), label, nil
+		}
+		return raw, label, nil
+	}
+
+	if !utf8.ValidString(label) {
+		return "", "", ErrUTF8
+	}
+	ace, err := s.converter.LabelToASCII(label)
+	if err != nil {
+		return "", "", err
+	}
+	if s.unicode {
+		return raw, ace, nil
+	}
+	return idna2003.EscapeLabel(ace), ace, nil
+}
+
+// splitName splits a domain name as written in a zone file into its labels,
+// still escaped, and tells whether it is absolute. The root name "." has no
+// labels. Names with empty labels return no labels and false.
+func splitName(name string) (labels []string, absolute bool) {
+	if name == "." {
+		return nil, true
+	}
+	start := 0
+	for i := 0; i < len(name); i++ {
+		switch name[i] {
+		case '\\':
+			i++
+		case '.':
+			if i == start {
+				return nil, false
+			}
+			labels = append(labels, name[start:i])
+			start = i + 1
+		}
+	}
+	if start == len(name) {
+		return labels, len(labels) > 0
+	}
+	return append(labels, name[start:]), false
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+func hasACEPrefix(s string) bool {
+	return len(s) >= len(idna2003.AcePrefix) && strings.EqualFold(s[:len(idna2003.AcePrefix)], idna2003.AcePrefix)
+}
+
+// isTTL returns true if s is a TTL, such as "3600" or "1h30m".
+func isTTL(s string) bool {
+	return s != "" && isDigit(s[0])
+}
+
+// isClass returns true if s is a class, such as "IN" or "CLASS255".
+func isClass(s string) bool {
+	switch u := strings.ToUpper(s); u {
+	case "IN", "CS", "CH", "HS":
+		return true
+	default:
+		if !strings.HasPrefix(u, "CLASS") || len(u) == len("CLASS") {
+			return false
+		}
+		for i := len("CLASS"); i < len(u); i++ {
+			if !isDigit(u[i]) {
+				return false
+			}
+		}
+		return true
+	}
+}
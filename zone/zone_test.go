@@ -0,0 +1,156 @@
+// Copyright 2012 Hannes Baldursson. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is part of go-idn
+
+package zone
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/DanielOaks/go-idn/idna2003"
+)
+
+const unicodeZone = `$ORIGIN bücher.example.
+$TTL 3600
+@	IN	SOA	ns1.bücher.example. hostmaster.bücher.example. (
+		2024010101 ; serial of bücher
+		3600 900 604800 300 )
+	IN	NS	ns1
+ns1	IN	A	192.0.2.1
+www	300	IN	CNAME	münchen.example.
+münchen	IN	MX	10	mail.例子.
+_sip._tcp	IN	300	SRV	0 5 5060 sip.bücher.example.
+txt	IN	TXT	"bücher; (not a name)"
+Ümlaut	PTR	ümlaut
+`
+
+const asciiZone = `$ORIGIN xn--bcher-kva.example.
+$TTL 3600
+@	IN	SOA	ns1.xn--bcher-kva.example. hostmaster.xn--bcher-kva.example. (
+		2024010101 ; serial of bücher
+		3600 900 604800 300 )
+	IN	NS	ns1
+ns1	IN	A	192.0.2.1
+www	300	IN	CNAME	xn--mnchen-3ya.example.
+xn--mnchen-3ya	IN	MX	10	mail.xn--fsqu00a.
+_sip._tcp	IN	300	SRV	0 5 5060 sip.xn--bcher-kva.example.
+txt	IN	TXT	"bücher; (not a name)"
+xn--mlaut-jva	PTR	xn--mlaut-jva
+`
+
+func TestProcess(t *testing.T) {
+	var out bytes.Buffer
+	if err := ToASCII.Process(&out, strings.NewReader(unicodeZone), "db.example"); err != nil {
+		t.Fatalf("ToASCII error = %v", err)
+	}
+	if out.String() != asciiZone {
+		t.Errorf("ToASCII =\n%s\nwant\n%s", out.String(), asciiZone)
+	}
+
+	out.Reset()
+	if err := ToUnicode.Process(&out, strings.NewReader(asciiZone), "db.example"); err != nil {
+		t.Fatalf("ToUnicode error = %v", err)
+	}
+	want := strings.Replace(unicodeZone, "Ümlaut", "ümlaut", 1)
+	if out.String() != want {
+		t.Errorf("ToUnicode =\n%s\nwant\n%s", out.String(), want)
+	}
+}
+
+func TestProcessErrors(t *testing.T) {
+	zone := `$ORIGIN example.
+bad_ü	IN	A	192.0.2.1
+ok	IN	CNAME	ü\.x.example.
+ok	IN	CNAME	\300ab.
+ok	IN	NS	a..b.
+` + strings.Repeat("a", 63) + "." + strings.Repeat("b", 63) + "." + strings.Repeat("c", 63) + "." + strings.Repeat("d", 63) + `	IN	A	192.0.2.1
+ok	IN	MX	( 10
+	.bü_cher. )
+ok	IN	TXT	"unterminated
+ok	IN
+ok	IN	A	192.0.2.1 )
+ok	IN	A	192.0.2.1 (
+`
+	want := []struct {
+		line, column int
+		name         string
+		err          error
+	}{
+		{2, 1, "bad_ü", idna2003.ErrNonLDH},
+		{3, 13, `ü\.x.example.`, idna2003.ErrNonLDH},
+		{4, 13, `\300ab.`, idna2003.ErrEscape},
+		{5, 10, "a..b.", ErrSyntax},
+		{6, 1, strings.Repeat("a", 63) + "." + strings.Repeat("b", 63) + "." + strings.Repeat("c", 63) + "." + strings.Repeat("d", 63), ErrNameLength},
+		{8, 2, ".bü_cher.", ErrSyntax},
+		{9, 11, "", ErrSyntax},
+		{10, 4, "", ErrSyntax},
+		{11, 19, "", ErrSyntax},
+		{13, 1, "", ErrSyntax},
+	}
+
+	var out bytes.Buffer
+	err := ToASCII.Process(&out, strings.NewReader(zone), "db.example")
+	if out.String() != zone {
+		t.Errorf("Process changed the zone to\n%s", out.String())
+	}
+	var list ErrorList
+	if !errors.As(err, &list) {
+		t.Fatalf("Process error = %v; want ErrorList", err)
+	}
+	if len(list) != len(want) {
+		t.Errorf("Process gave %d errors; want %d:\n%v", len(list), len(want), list)
+	}
+	for i, e := range list {
+		if i >= len(want) {
+			break
+		}
+		w := want[i]
+		if e.File != "db.example" || e.Line != w.line || e.Column != w.column || e.Name != w.name || !errors.Is(e, w.err) {
+			t.Errorf("error %d = %v; want %v at %d:%d for %q", i, e, w.err, w.line, w.column, w.name)
+		}
+	}
+
+	var lerr *idna2003.LabelError
+	if !errors.As(list[0], &lerr) || lerr.Label != 0 || lerr.Offset != 3 {
+		t.Errorf("error 0 = %#v; want *idna2003.LabelError for label 0 at offset 3", list[0].Err)
+	}
+	if !errors.Is(err, idna2003.ErrEscape) {
+		t.Errorf("errors.Is(ErrorList, idna2003.ErrEscape) = false")
+	}
+}
+
+func TestOrigin(t *testing.T) {
+	long := strings.Repeat("a", 63) + "." + strings.Repeat("b", 63) + "." + strings.Repeat("c", 63)
+	zone := "www IN A 192.0.2.1\n$ORIGIN " + long + ".\n" + strings.Repeat("d", 62) + " IN A 192.0.2.1\nsub.example. IN A 192.0.2.1\n"
+	err := ToASCII.Process(&bytes.Buffer{}, strings.NewReader(zone), "db")
+	var list ErrorList
+	if !errors.As(err, &list) || len(list) != 1 || list[0].Line != 3 || !errors.Is(err, ErrNameLength) {
+		t.Errorf("Process error = %v; want ErrNameLength on line 3", err)
+	}
+
+	p := New(Origin(long + "."))
+	if err := p.Process(&bytes.Buffer{}, strings.NewReader("www IN A 192.0.2.1\n"), "db"); err != nil {
+		t.Errorf("Process error = %v", err)
+	}
+	err = p.Process(&bytes.Buffer{}, strings.NewReader(strings.Repeat("d", 62)+" IN A 192.0.2.1\n"), "db")
+	if !errors.Is(err, ErrNameLength) {
+		t.Errorf("Process error = %v; want ErrNameLength", err)
+	}
+}
+
+func TestConverter(t *testing.T) {
+	var out bytes.Buffer
+	p := New(Converter(idna2003.New(idna2003.UseSTD3ASCIIRules(false))))
+	in := "bad_ü IN CNAME a\\.bü.example.\n"
+	if err := p.Process(&out, strings.NewReader(in), "db"); err != nil {
+		t.Errorf("Process error = %v", err)
+	}
+	if want := "xn--bad_-3ra IN CNAME xn--a\\.b-joa.example.\n"; out.String() != want {
+		t.Errorf("Process = %q; want %q", out.String(), want)
+	}
+}